@@ -51,7 +51,10 @@ func init() {
 	rootCmd.AddCommand(pluginCmd)
 }
 
-type mqlPlugin struct{}
+type mqlPlugin struct {
+	// explain prints the profile of all resource calls after the query ran
+	explain bool
+}
 
 func (c *mqlPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Runtime, out iox.OutputHelper) error {
 	if conf.Command == "" && conf.Input == "" {
@@ -114,13 +117,26 @@ func (c *mqlPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Runtim
 		}
 	}
 
+	// the shell prints results in its default human-readable format, all
+	// other formats are handled by the reporter's formatters
+	var formatter reporter.Formatter
+	if conf.Format != "" && conf.Format != "llx" {
+		var err error
+		formatter, err = reporter.NewFormatter(conf.Format, out)
+		if err != nil {
+			return err
+		}
+	}
+
 	ctx := context.Background()
 	discoveredAssets, err := discovery.DiscoverAssets(ctx, conf.Inventory, upstreamConfig, runtime.Recording())
 	if err != nil {
 		return err
 	}
-	if conf.Format == "json" {
-		_ = out.WriteString("[")
+	if formatter != nil {
+		if err = formatter.Start(); err != nil {
+			return errors.Wrap(err, "failed to write results")
+		}
 	}
 
 	// anyResultFailed is a flag that will be switched on if any query result failed,
@@ -133,6 +149,11 @@ func (c *mqlPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Runtim
 			os.Exit(1)
 		}
 	}()
+	if c.explain {
+		defer func() {
+			_, _ = os.Stderr.WriteString(printer.DefaultPrinter.Explain(runtime.Profiler().Profile()))
+		}()
+	}
 
 	for i := range discoveredAssets.Assets {
 		asset := discoveredAssets.Assets[i]
@@ -215,18 +236,17 @@ func (c *mqlPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Runtim
 			return nil
 		}
 
-		if conf.Format != "json" {
+		if formatter == nil {
 			sh.PrintResults(code, results)
-		} else {
-			_ = reporter.CodeBundleToJSON(code, results, out)
-			if len(discoveredAssets.Assets) != i+1 {
-				_ = out.WriteString(",")
-			}
+		} else if err = formatter.Asset(asset.Asset, code, results); err != nil {
+			return errors.Wrap(err, "failed to write results")
 		}
 	}
 
-	if conf.Format == "json" {
-		_ = out.WriteString("]")
+	if formatter != nil {
+		if err = formatter.Finish(); err != nil {
+			return errors.Wrap(err, "failed to write results")
+		}
 	}
 
 	return nil
@@ -4,9 +4,11 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -23,9 +25,18 @@ func init() {
 	rootCmd.AddCommand(ProvidersCmd)
 	ProvidersCmd.AddCommand(listProvidersCmd)
 	ProvidersCmd.AddCommand(installProviderCmd)
+	ProvidersCmd.AddCommand(verifyProvidersCmd)
+	ProvidersCmd.AddCommand(uninstallProviderCmd)
+	ProvidersCmd.AddCommand(providerInfoCmd)
+	ProvidersCmd.AddCommand(pinProviderCmd)
 
 	installProviderCmd.Flags().StringP("file", "f", "", "Install a provider via a file")
 	installProviderCmd.Flags().String("url", "", "Install a provider via a URL")
+
+	providerInfoCmd.Flags().Bool("resources", false, "List all resources of the provider")
+
+	pinProviderCmd.Flags().Bool("all", false, "Pin all installed providers to their current versions")
+	pinProviderCmd.Flags().Bool("remove", false, "Remove the pinned versions of the given providers")
 }
 
 var ProvidersCmd = &cobra.Command{
@@ -77,6 +88,221 @@ var installProviderCmd = &cobra.Command{
 	},
 }
 
+var verifyProvidersCmd = &cobra.Command{
+	Use:    "verify [NAME...]",
+	Short:  "Verify that installed providers have not changed since they were installed",
+	Long:   "Re-compute the checksums of installed providers and compare them to the ones recorded at install time. This detects files that were corrupted or modified after the install. It does not check the files against the registry again: packages are verified against the registry's checksums when they are installed. Verifies all providers unless names are given.",
+	PreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		if !verifyProviders(args) {
+			os.Exit(1)
+		}
+	},
+}
+
+var uninstallProviderCmd = &cobra.Command{
+	Use:    "uninstall <NAME...>",
+	Short:  "Remove installed providers",
+	Long:   "Remove all installed versions of the given providers. Builtin providers cannot be removed. Providers that are pinned in the providers lock file are installed again the next time they are used.",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		for _, name := range args {
+			removed, err := providers.Uninstall(name)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to uninstall")
+			}
+			for _, provider := range removed {
+				log.Info().
+					Str("version", provider.Version).
+					Str("path", provider.Path).
+					Msg("successfully uninstalled " + provider.Name + " provider")
+			}
+			if providers.Lock.Version(name) != "" {
+				log.Warn().Msg("provider " + name + " is still pinned in " + providers.LockFilePath + " and will be installed again when it is used")
+			}
+		}
+	},
+}
+
+var providerInfoCmd = &cobra.Command{
+	Use:    "info <NAME>",
+	Short:  "Show details about a provider",
+	Long:   "Show the version, path, connectors, dependencies, and resources of a provider.",
+	Args:   cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		existing, err := providers.ListActive()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to list providers")
+		}
+		provider := existing.Lookup(providers.ProviderLookup{ProviderName: args[0]})
+		if provider == nil {
+			log.Fatal().Msg("provider '" + args[0] + "' is not installed")
+		}
+
+		listResources, _ := cmd.Flags().GetBool("resources")
+		printProviderInfo(provider, listResources)
+	},
+}
+
+var pinProviderCmd = &cobra.Command{
+	Use:   "pin [NAME[@VERSION]...]",
+	Short: "Pin providers to a version in the providers lock file",
+	Long: `Pin providers to a version, so that installs and updates use it instead of the latest one.
+Providers are pinned to their installed version unless a version is given.
+Without arguments, all pinned providers are listed.
+
+The lock file is the closest ` + providers.DefaultLockFile + ` in the working directory or its
+parents, up to the root of the project. Projects without one get it in their root and runs
+outside of projects use the one in the config directory. Another lock file can be configured
+via providers_lock_file.`,
+	PreRun: func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		all, _ := cmd.Flags().GetBool("all")
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		if len(args) == 0 && !all {
+			printPinnedProviders()
+			return
+		}
+
+		if remove {
+			for _, name := range args {
+				if !providers.Lock.Unpin(name) {
+					log.Warn().Msg("provider " + name + " is not pinned")
+				}
+			}
+		} else {
+			pins, err := resolvePins(args, all)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to pin providers")
+			}
+			for _, pin := range pins {
+				providers.Lock.Pin(pin.Name, pin.Version)
+				log.Info().Str("version", pin.Version).Msg("pinned " + pin.Name + " provider")
+			}
+		}
+
+		if err := providers.Lock.Save(providers.LockFilePath); err != nil {
+			log.Fatal().Err(err).Msg("failed to write providers lock file")
+		}
+		log.Info().Str("path", providers.LockFilePath).Msg("updated providers lock file")
+	},
+}
+
+// resolvePins determines the versions for NAME[@VERSION] arguments. Providers
+// without a version are pinned to the installed one.
+func resolvePins(args []string, all bool) ([]providers.ProviderVersion, error) {
+	existing, err := providers.ListActive()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []providers.ProviderVersion{}
+	if all {
+		for _, provider := range existing {
+			if provider.Path == "" {
+				continue
+			}
+			res = append(res, providers.ProviderVersion{Name: provider.Name, Version: provider.Version})
+		}
+	}
+
+	for _, arg := range args {
+		name, version, _ := strings.Cut(arg, "@")
+		// trim the v prefix, allowing users to specify both 9.0.0 and v9.0.0
+		version = strings.TrimPrefix(version, "v")
+		if version == "" {
+			provider := existing.Lookup(providers.ProviderLookup{ProviderName: name})
+			if provider == nil {
+				return nil, errors.New("provider '" + name + "' is not installed, please specify a version with NAME@VERSION")
+			}
+			if provider.Path == "" {
+				return nil, errors.New("cannot pin builtin provider '" + name + "'")
+			}
+			version = provider.Version
+		}
+		res = append(res, providers.ProviderVersion{Name: name, Version: version})
+	}
+	return res, nil
+}
+
+func printPinnedProviders() {
+	if len(providers.Lock.Providers) == 0 {
+		fmt.Println("No providers are pinned.")
+		return
+	}
+
+	log.Info().Msg(providers.LockFilePath + " (pins " + strconv.Itoa(len(providers.Lock.Providers)) + " providers)")
+	fmt.Println()
+	for _, pin := range providers.Lock.Providers {
+		fmt.Println("  " + theme.DefaultTheme.Primary(pin.Name) + " " + pin.Version)
+	}
+	fmt.Println()
+}
+
+func printProviderInfo(p *providers.Provider, listResources bool) {
+	path := p.Path
+	if path == "" {
+		path = "builtin"
+	}
+	version := p.Version
+	if pinned := providers.Lock.Version(p.Name); pinned != "" {
+		version += " (pinned to " + pinned + ")"
+	}
+
+	fmt.Println(theme.DefaultTheme.Primary(p.Name))
+	fmt.Println("  ID:            " + p.ID)
+	fmt.Println("  Version:       " + version)
+	fmt.Println("  Path:          " + path)
+
+	fmt.Println("  Connectors:")
+	if len(p.Connectors) == 0 {
+		fmt.Println("    none")
+	}
+	for _, conn := range p.Connectors {
+		fmt.Println("    " + theme.DefaultTheme.Secondary(conn.Name) + "  " + conn.Short)
+	}
+
+	fmt.Println("  Dependencies:")
+	var deps []string
+	if p.Schema != nil {
+		for id, dep := range p.Schema.AllDependencies() {
+			if dep.Name != "" {
+				id = dep.Name
+			}
+			deps = append(deps, id)
+		}
+	}
+	sort.Strings(deps)
+	if len(deps) == 0 {
+		fmt.Println("    none")
+	}
+	for _, dep := range deps {
+		fmt.Println("    " + dep)
+	}
+
+	if p.Schema == nil {
+		return
+	}
+	resources := p.Schema.AllResources()
+	fields := 0
+	for _, info := range resources {
+		fields += len(info.Fields)
+	}
+	fmt.Println("  Resources:     " + strconv.Itoa(len(resources)) + " resources with " + strconv.Itoa(fields) + " fields")
+	if listResources {
+		for _, name := range sortx.Keys(resources) {
+			line := "    " + name
+			if title := resources[name].Title; title != "" {
+				line += "  " + theme.DefaultTheme.Disabled(title)
+			}
+			fmt.Println(line)
+		}
+	}
+}
+
 func installProviderByName(name string) {
 	parts := strings.Split(name, "@")
 	if len(parts) > 2 {
@@ -128,6 +354,58 @@ func installProviderFile(path string) {
 	providers.PrintInstallResults(installed)
 }
 
+// verifyProviders prints the verification result of every installed provider
+// and returns false if any of them failed
+func verifyProviders(names []string) bool {
+	all, err := providers.ListAll()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to list providers")
+	}
+
+	selected := map[string]struct{}{}
+	for _, name := range names {
+		selected[name] = struct{}{}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+
+	ok := true
+	found := map[string]struct{}{}
+	for _, provider := range all {
+		if provider.Path == "" {
+			continue
+		}
+		if len(selected) != 0 {
+			if _, ok := selected[provider.Name]; !ok {
+				continue
+			}
+		}
+		found[provider.Name] = struct{}{}
+
+		name := theme.DefaultTheme.Primary(provider.Name) + " " + provider.Version
+		err := provider.Verify()
+		switch {
+		case err == nil:
+			fmt.Println("  " + name + " " + theme.DefaultTheme.Success("verified"))
+		case errors.Is(err, providers.ErrNoChecksums):
+			fmt.Println("  " + name + " " + theme.DefaultTheme.Disabled("unverified, reinstall it to record its checksums"))
+		default:
+			ok = false
+			fmt.Println("  " + name + " " + theme.DefaultTheme.Error("failed: "+err.Error()))
+		}
+	}
+
+	for _, name := range names {
+		if _, exists := found[name]; !exists {
+			ok = false
+			log.Error().Msg("provider '" + name + "' is not installed")
+		}
+	}
+	return ok
+}
+
 func list() {
 	list, err := providers.ListAll()
 	if err != nil {
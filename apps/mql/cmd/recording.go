@@ -0,0 +1,312 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.mondoo.com/mql/v13/cli/inventoryloader"
+	"go.mondoo.com/mql/v13/cli/reporter"
+	"go.mondoo.com/mql/v13/cli/theme"
+	"go.mondoo.com/mql/v13/providers"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/recording"
+	"go.mondoo.com/mql/v13/shared/proto"
+	"go.mondoo.com/mql/v13/types"
+	"go.mondoo.com/mql/v13/utils/iox"
+	"go.mondoo.com/mql/v13/utils/sortx"
+)
+
+// defaultRecordQuery is recorded if no queries are provided
+const defaultRecordQuery = "asset { * }"
+
+func init() {
+	rootCmd.AddCommand(recordCmd)
+	rootCmd.AddCommand(replayCmd)
+	rootCmd.AddCommand(recordingCmd)
+	recordingCmd.AddCommand(recordingAssetsCmd)
+	recordingCmd.AddCommand(recordingResourcesCmd)
+	recordingCmd.AddCommand(recordingMergeCmd)
+	recordingCmd.AddCommand(recordingRedactCmd)
+	recordingCmd.AddCommand(recordingConvertCmd)
+
+	_ = recordCmd.Flags().StringArrayP("command", "c", nil, "MQL query to record, may be used multiple times")
+	_ = recordCmd.Flags().StringP("output", "o", "recording.json", "Write the recording to this file, it is extended if it exists. Files ending in "+recording.BinaryExtension+" use the binary format")
+	_ = recordCmd.Flags().String("inventory-file", "", "Set the path to the inventory file")
+
+	_ = replayCmd.Flags().StringP("command", "c", "", "MQL query to execute")
+	_ = replayCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure")
+	_ = replayCmd.Flags().StringP("output", "o", "", "Set the output format: "+strings.Join(reporter.Formatters(), ", "))
+	_ = replayCmd.Flags().String("output-file", "", "Write the results to a file instead of stdout")
+	_ = replayCmd.Flags().String("platform-id", "", "Select a specific recorded asset by providing its platform ID")
+	_ = replayCmd.Flags().Bool("exit-1-on-failure", false, "Exit with error code 1 if one or more query results fail")
+
+	recordingResourcesCmd.Flags().String("asset", "", "Only list resources of the asset with this name, MRN, or platform ID")
+	recordingResourcesCmd.Flags().String("resource", "", "Only list resources with this name")
+	recordingResourcesCmd.Flags().Bool("fields", false, "List the recorded fields of every resource")
+
+	recordingMergeCmd.Flags().StringP("output", "o", "", "Write the merged recording to this file")
+	_ = recordingMergeCmd.MarkFlagRequired("output")
+
+	recordingRedactCmd.Flags().StringP("output", "o", "", "Write the redacted recording to this file")
+	recordingRedactCmd.Flags().StringSlice("field", nil, "Redact fields in the form of resource.field, supports wildcards, e.g. *.content")
+	recordingRedactCmd.Flags().StringSlice("pattern", nil, "Redact all matches of this regular expression in all recorded strings")
+	recordingRedactCmd.Flags().String("replacement", recording.RedactedValue, "Replace redacted strings with this value")
+	_ = recordingRedactCmd.MarkFlagRequired("output")
+}
+
+var recordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Record the data of an asset for offline use",
+	Long: `Record all resources that queries use on an asset and store them in a file.
+
+Use "mql replay" to run queries against the recording without access to the asset.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		_ = viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
+
+		// the recording is handled by the runtime, it just needs to know where to store it
+		output, _ := cmd.Flags().GetString("output")
+		if flag := cmd.Flags().Lookup("record"); flag != nil && !flag.Changed {
+			_ = cmd.Flags().Set("record", output)
+		}
+	},
+	// we have to initialize an empty run so it shows up as a runnable command in --help
+	Run: func(cmd *cobra.Command, args []string) {},
+}
+
+var recordCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plugin.ParseCLIRes) {
+	queries, _ := cmd.Flags().GetStringArray("command")
+	if len(queries) == 0 {
+		queries = []string{defaultRecordQuery}
+	}
+
+	in, err := inventoryloader.ParseOrUse(cliRes.Asset, viper.GetBool("insecure"), nil)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to resolve inventory")
+	}
+
+	conf := proto.RunQueryConfig{
+		Command:   strings.Join(queries, "\n"),
+		Inventory: in,
+	}
+	conf.Incognito, _ = cmd.Flags().GetBool("incognito")
+
+	x := mqlPlugin{}
+	w := iox.IOWriter{Writer: os.Stdout}
+	if err := x.RunQuery(&conf, runtime, &w); err != nil {
+		log.Fatal().Err(err).Msg("failed to record queries")
+	}
+}
+
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Run an MQL query against a recording",
+	Long: `Run an MQL query against a recording that was created with "mql record".
+The query can only use data that was recorded.`,
+	Example: `  mql replay recording.json -c "users { name }"`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		_ = viper.BindPFlag("platform-id", cmd.Flags().Lookup("platform-id"))
+	},
+	// we have to initialize an empty run so it shows up as a runnable command in --help
+	Run: func(cmd *cobra.Command, args []string) {},
+}
+
+var recordingCmd = &cobra.Command{
+	Use:   "recording",
+	Short: "Inspect, merge, redact, and convert recordings",
+}
+
+var recordingAssetsCmd = &cobra.Command{
+	Use:   "assets RECORDING",
+	Short: "List the assets in a recording",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rec, err := recording.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load recording")
+		}
+		printRecordedAssets(os.Stdout, rec.GetAssetRecordings())
+	},
+}
+
+var recordingResourcesCmd = &cobra.Command{
+	Use:   "resources RECORDING",
+	Short: "List the resources and fields in a recording",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rec, err := recording.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load recording")
+		}
+		assetFilter, _ := cmd.Flags().GetString("asset")
+		resourceFilter, _ := cmd.Flags().GetString("resource")
+		withFields, _ := cmd.Flags().GetBool("fields")
+
+		found := false
+		for _, asset := range rec.GetAssetRecordings() {
+			if assetFilter != "" && !matchesRecordedAsset(asset, assetFilter) {
+				continue
+			}
+			found = true
+			printRecordedResources(os.Stdout, asset, resourceFilter, withFields)
+		}
+		if !found {
+			log.Fatal().Str("asset", assetFilter).Msg("cannot find asset in recording")
+		}
+	},
+}
+
+var recordingMergeCmd = &cobra.Command{
+	Use:   "merge RECORDING...",
+	Short: "Merge recordings into one file",
+	Long: `Merge recordings into one file. Assets that are in multiple recordings are
+combined, later recordings take precedence over earlier ones.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rec, err := recording.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to load recording")
+		}
+		for _, path := range args[1:] {
+			other, err := recording.LoadRecordingFile(path)
+			if err != nil {
+				log.Fatal().Err(err).Str("file", path).Msg("failed to load recording")
+			}
+			rec.Merge(other)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if err := rec.SaveTo(output, true); err != nil {
+			log.Fatal().Err(err).Msg("failed to store merged recording")
+		}
+	},
+}
+
+var recordingRedactCmd = &cobra.Command{
+	Use:   "redact RECORDING",
+	Short: "Remove sensitive data from a recording",
+	Long: `Remove sensitive data from all recorded resources, either by field or by
+regular expressions that are applied to all recorded strings. The metadata
+of assets is kept, since it identifies them.`,
+	Example: `  mql recording redact recording.json -o redacted.json --field "*.content" --pattern "AKIA[0-9A-Z]{16}"`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := recording.RedactOptions{}
+		opts.Fields, _ = cmd.Flags().GetStringSlice("field")
+		opts.Replacement, _ = cmd.Flags().GetString("replacement")
+		patterns, _ := cmd.Flags().GetStringSlice("pattern")
+		for _, pattern := range patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				log.Fatal().Err(err).Str("pattern", pattern).Msg("invalid pattern")
+			}
+			opts.Patterns = append(opts.Patterns, re)
+		}
+		if len(opts.Fields) == 0 && len(opts.Patterns) == 0 {
+			log.Fatal().Msg("nothing to redact, please provide --field or --pattern")
+		}
+
+		rec, err := recording.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load recording")
+		}
+		cnt, err := rec.Redact(opts)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to redact recording")
+		}
+		log.Info().Int("fields", cnt).Msg("redacted recording")
+
+		output, _ := cmd.Flags().GetString("output")
+		if err := rec.SaveTo(output, true); err != nil {
+			log.Fatal().Err(err).Msg("failed to store redacted recording")
+		}
+	},
+}
+
+var recordingConvertCmd = &cobra.Command{
+	Use:   "convert INPUT OUTPUT",
+	Short: "Convert recordings between the JSON and the binary format",
+	Long: `Convert recordings between the JSON and the binary format. The format of the
+input is detected automatically. Outputs that end in ` + recording.BinaryExtension + ` use the binary
+format, all others use JSON.`,
+	Example: `  mql recording convert recording.json recording` + recording.BinaryExtension,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		rec, err := recording.LoadRecordingFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load recording")
+		}
+		if err := rec.SaveTo(args[1], true); err != nil {
+			log.Fatal().Err(err).Msg("failed to store converted recording")
+		}
+	},
+}
+
+func matchesRecordedAsset(asset *recording.Asset, selector string) bool {
+	a := asset.Asset
+	return a.Name == selector || a.Mrn == selector || slices.Contains(a.PlatformIds, selector)
+}
+
+func printRecordedAssets(out io.Writer, assets []*recording.Asset) {
+	if len(assets) == 0 {
+		fmt.Fprintln(out, "No assets recorded.")
+		return
+	}
+
+	for _, asset := range assets {
+		platform := ""
+		if asset.Asset.Platform != nil {
+			platform = " " + theme.DefaultTheme.Secondary(asset.Asset.Platform.Title)
+		}
+		fmt.Fprintln(out, theme.DefaultTheme.Primary(asset.Asset.Name)+platform+" ("+strconv.Itoa(len(asset.Resources))+" resources)")
+		if asset.Asset.Mrn != "" {
+			fmt.Fprintln(out, "  MRN:          "+asset.Asset.Mrn)
+		}
+		for _, id := range asset.Asset.PlatformIds {
+			fmt.Fprintln(out, "  Platform ID:  "+id)
+		}
+		for _, conn := range asset.Connections {
+			fmt.Fprintln(out, "  Connection:   "+conn.Url)
+		}
+	}
+}
+
+func printRecordedResources(out io.Writer, asset *recording.Asset, resourceFilter string, withFields bool) {
+	fmt.Fprintln(out, theme.DefaultTheme.Primary(asset.Asset.Name))
+	for _, resource := range asset.Resources {
+		if resourceFilter != "" && resource.Resource != resourceFilter {
+			continue
+		}
+
+		id := resource.ID
+		if id == "" {
+			id = "(no id)"
+		}
+		fmt.Fprintln(out, "  "+resource.Resource+" "+theme.DefaultTheme.Secondary(id)+" ("+strconv.Itoa(len(resource.Fields))+" fields)")
+		if !withFields {
+			continue
+		}
+
+		for _, name := range sortx.Keys(resource.Fields) {
+			field := resource.Fields[name]
+			switch {
+			case field == nil:
+				fmt.Fprintln(out, "    "+name)
+			case field.Error != nil:
+				fmt.Fprintln(out, "    "+name+" "+theme.DefaultTheme.Error("error: "+field.Error.Error()))
+			default:
+				fmt.Fprintln(out, "    "+name+" "+theme.DefaultTheme.Disabled(types.Type(field.Type).Label()))
+			}
+		}
+	}
+}
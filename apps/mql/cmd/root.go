@@ -18,6 +18,7 @@ import (
 	cliproviders "go.mondoo.com/mql/v13/cli/providers"
 	"go.mondoo.com/mql/v13/cli/theme"
 	"go.mondoo.com/mql/v13/logger"
+	"go.mondoo.com/mql/v13/metrics"
 )
 
 const (
@@ -32,8 +33,12 @@ var rootCmd = &cobra.Command{
 	Use:   "mql",
 	Short: "mql CLI",
 	Long:  theme.DefaultTheme.Landing + "\n\n" + rootCmdDesc,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		initLogger()
+		if addr := viper.GetString("metrics_addr"); addr != "" {
+			return metrics.StartServer(addr)
+		}
+		return nil
 	},
 }
 
@@ -50,6 +55,22 @@ func BuildRootCmd() (*cobra.Command, error) {
 			Run:     RunCmdRun,
 			Action:  "Run a query with ",
 		},
+		&cliproviders.Command{
+			Command: sbomCmd,
+			Run:     sbomRun,
+			Action:  "Generate a software bill of materials (SBOM) for ",
+		},
+		&cliproviders.Command{
+			Command: recordCmd,
+			Run:     recordCmdRun,
+			Action:  "Record a session with ",
+		},
+		&cliproviders.Command{
+			Command:          replayCmd,
+			Run:              RunCmdRun,
+			Action:           "Replay queries against ",
+			DefaultConnector: "recording",
+		},
 	)
 	return rootCmd, err
 }
@@ -99,10 +120,12 @@ func init() {
 	// Set NoOptDefVal to allow space-separated bool values (--auto-update false)
 	// Without this, "false" would be treated as a positional argument instead of the flag value
 	rootCmd.PersistentFlags().Lookup("auto-update").NoOptDefVal = "true"
+	rootCmd.PersistentFlags().String("metrics-addr", "", "Serve Prometheus metrics on this address, e.g. :2112")
 	_ = viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	_ = viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
 	_ = viper.BindPFlag("api_proxy", rootCmd.PersistentFlags().Lookup("api-proxy"))
 	_ = viper.BindPFlag("auto_update", rootCmd.PersistentFlags().Lookup("auto-update"))
+	_ = viper.BindPFlag("metrics_addr", rootCmd.PersistentFlags().Lookup("metrics-addr"))
 	_ = viper.BindEnv("features")
 	_ = viper.BindEnv("updates_url")
 	_ = viper.BindEnv("providers_url")
@@ -5,11 +5,16 @@ package cmd
 
 import (
 	"os"
+	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.mondoo.com/mql/v13/cli/inventoryloader"
+	"go.mondoo.com/mql/v13/cli/reporter"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/metrics"
 	"go.mondoo.com/mql/v13/providers"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
 	"go.mondoo.com/mql/v13/shared/proto"
@@ -24,6 +29,8 @@ func init() {
 	_ = RunCmd.Flags().Bool("ast", false, "Parse the query and return the abstract syntax tree (AST)")
 	_ = RunCmd.Flags().Bool("info", false, "Parse the query and provide information about it")
 	_ = RunCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure")
+	_ = RunCmd.Flags().StringP("output", "o", "", "Set the output format: "+strings.Join(reporter.Formatters(), ", "))
+	_ = RunCmd.Flags().String("output-file", "", "Write the results to a file instead of stdout")
 	_ = RunCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID")
 	_ = RunCmd.Flags().String("inventory-file", "", "Set the path to the inventory file")
 
@@ -34,6 +41,7 @@ func init() {
 	_ = RunCmd.Flags().StringToString("annotations", nil, "Specify annotations for this run")
 	_ = RunCmd.Flags().MarkHidden("annotations")
 	_ = RunCmd.Flags().Bool("exit-1-on-failure", false, "Exit with error code 1 if one or more query results fail")
+	_ = RunCmd.Flags().Bool("explain", false, "Print the time spent on every resource and field after running the query")
 }
 
 var RunCmd = &cobra.Command{
@@ -60,6 +68,9 @@ var RunCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plu
 	if doJSON, _ := cmd.Flags().GetBool("json"); doJSON {
 		conf.Format = "json"
 	}
+	if format, _ := cmd.Flags().GetString("output"); format != "" {
+		conf.Format = strings.ToLower(format)
+	}
 	if llx, _ := cmd.Flags().GetString("llx"); llx != "" {
 		conf.Format = "llx"
 		conf.Output = llx
@@ -85,9 +96,31 @@ var RunCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plu
 	conf.Incognito, _ = cmd.Flags().GetBool("incognito")
 
 	x := mqlPlugin{}
-	w := iox.IOWriter{Writer: os.Stdout}
-	err = x.RunQuery(&conf, runtime, &w)
-	if err != nil {
+	x.explain, _ = cmd.Flags().GetBool("explain")
+	if x.explain || metrics.Enabled() {
+		profiler := llx.NewProfiler()
+		runtime.SetProfiler(profiler)
+		unregister := metrics.RegisterProfiler(profiler)
+		defer unregister()
+	}
+
+	outputFile, _ := cmd.Flags().GetString("output-file")
+	if err := x.runQueryTo(outputFile, &conf, runtime); err != nil {
 		log.Fatal().Err(err).Msg("failed to run query")
 	}
 }
+
+// runQueryTo runs the query and writes its results to the given file, or to
+// stdout if no file is set
+func (c *mqlPlugin) runQueryTo(path string, conf *proto.RunQueryConfig, runtime *providers.Runtime) error {
+	w := iox.IOWriter{Writer: os.Stdout}
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return errors.Wrap(err, "failed to create output file")
+		}
+		defer f.Close()
+		w.Writer = f
+	}
+	return c.RunQuery(conf, runtime, &w)
+}
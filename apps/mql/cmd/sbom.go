@@ -0,0 +1,274 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.mondoo.com/mql/v13/cli/config"
+	"go.mondoo.com/mql/v13/cli/inventoryloader"
+	"go.mondoo.com/mql/v13/cli/reporter"
+	"go.mondoo.com/mql/v13/cli/shell"
+	"go.mondoo.com/mql/v13/discovery"
+	"go.mondoo.com/mql/v13/providers"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/recording"
+	"go.mondoo.com/mql/v13/sbom"
+	"go.mondoo.com/mql/v13/sbom/generator"
+	"go.mondoo.com/mql/v13/sbom/vex"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+func init() {
+	rootCmd.AddCommand(sbomCmd)
+	sbomCmd.AddCommand(sbomDiffCmd)
+
+	sbomCmd.Flags().StringP("format", "f", sbom.FormatList, "Set the SBOM format: "+sbom.AllFormats())
+	sbomCmd.Flags().StringP("output", "o", "", "Write the SBOM to a file instead of stdout")
+	sbomCmd.Flags().Bool("with-evidence", false, "Include the files that packages were found in")
+	sbomCmd.Flags().Bool("with-cpes", false, "Include CPEs of packages")
+	sbomCmd.Flags().String("inventory-file", "", "Set the path to the inventory file")
+	sbomCmd.Flags().StringToString("annotations", nil, "Specify annotations for this run")
+	_ = sbomCmd.Flags().MarkHidden("annotations")
+
+	sbomDiffCmd.Flags().StringP("format", "f", "text", "Set the output format: text, json")
+}
+
+var sbomCmd = &cobra.Command{
+	Use:   "sbom",
+	Short: "Generate a software bill of materials (SBOM)",
+	Long: `Generate a software bill of materials (SBOM) with all packages of an asset.
+
+Use "mql sbom diff" to compare two SBOMs.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		_ = viper.BindPFlag("annotations", cmd.Flags().Lookup("annotations"))
+		_ = viper.BindPFlag("inventory-file", cmd.Flags().Lookup("inventory-file"))
+	},
+	// we have to initialize an empty run so it shows up as a runnable command in --help
+	Run: func(cmd *cobra.Command, args []string) {},
+}
+
+var sbomDiffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "Compare the packages of two SBOMs",
+	Long: `Compare the packages of two SBOMs and list added, removed, and changed packages.
+
+The SBOMs may use any supported format: ` + sbom.AllFormats() + `.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		before, err := loadSbom(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[0]).Msg("failed to load SBOM")
+		}
+		after, err := loadSbom(args[1])
+		if err != nil {
+			log.Fatal().Err(err).Str("file", args[1]).Msg("failed to load SBOM")
+		}
+
+		diff := sbom.Diff(before, after)
+		format, _ := cmd.Flags().GetString("format")
+		switch format {
+		case "json":
+			err = diff.RenderJSON(os.Stdout)
+		case "text":
+			err = diff.RenderText(os.Stdout)
+		default:
+			log.Fatal().Str("format", format).Msg("unsupported output format, use text or json")
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to write SBOM diff")
+		}
+	},
+}
+
+func loadSbom(path string) (*sbom.Sbom, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return sbom.DefaultMultiDecoder().Parse(f)
+}
+
+var sbomRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plugin.ParseCLIRes) {
+	format, _ := cmd.Flags().GetString("format")
+	format = strings.ToLower(format)
+	if !slices.Contains(strings.Split(sbom.AllFormats(), ", "), format) {
+		log.Fatal().Str("format", format).Msg("unsupported SBOM format, use one of: " + sbom.AllFormats())
+	}
+
+	annotations, _ := cmd.Flags().GetStringToString("annotations")
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	cliRes.Asset.AddAnnotations(annotations)
+
+	// required to resolve secrets
+	in, err := inventoryloader.ParseOrUse(cliRes.Asset, viper.GetBool("insecure"), annotations)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to resolve inventory")
+	}
+
+	report, err := collectSbomData(runtime, in)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to collect SBOM data")
+	}
+
+	handler := sbom.New(format)
+	if withEvidence, _ := cmd.Flags().GetBool("with-evidence"); withEvidence {
+		handler.ApplyOptions(sbom.WithEvidence())
+	}
+	if withCpes, _ := cmd.Flags().GetBool("with-cpes"); withCpes {
+		handler.ApplyOptions(sbom.WithCPE())
+	}
+	if vexPaths := vexPathsFromInventory(in); len(vexPaths) > 0 {
+		doc, err := vex.Load(vexPaths...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load VEX documents")
+		}
+		handler.ApplyOptions(sbom.WithVex(doc))
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	boms := generator.GenerateBom(report)
+	for i := range boms {
+		bom := boms[i]
+		if bom.Status == sbom.Status_STATUS_FAILED {
+			log.Warn().Str("asset", bom.Asset.GetName()).Msg("SBOM is incomplete: " + bom.ErrorMessage)
+		}
+
+		if output == "" {
+			err = handler.Render(os.Stdout, bom)
+		} else {
+			err = renderSbomFile(handler, bom, sbomFilename(output, i, len(boms)))
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to write SBOM")
+		}
+	}
+}
+
+// vexPathsFromInventory returns the VEX documents configured via --vex or
+// in the connection options of the inventory
+func vexPathsFromInventory(in *inventory.Inventory) []string {
+	res := []string{}
+	for _, asset := range in.GetSpec().GetAssets() {
+		for _, conn := range asset.Connections {
+			for _, path := range vex.SplitPaths(conn.Options[vex.Option]) {
+				if !slices.Contains(res, path) {
+					res = append(res, path)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// collectSbomData runs the SBOM queries on all discovered assets and stores
+// their results in a report
+func collectSbomData(runtime *providers.Runtime, in *inventory.Inventory) (*reporter.Report, error) {
+	opts, err := config.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load configuration")
+	}
+	config.DisplayUsedConfig()
+
+	ctx := context.Background()
+	discoveredAssets, err := discovery.DiscoverAssets(ctx, in, nil, runtime.Recording())
+	if err != nil {
+		return nil, err
+	}
+
+	report := &reporter.Report{}
+	for i := range discoveredAssets.Assets {
+		asset := discoveredAssets.Assets[i]
+
+		if asset.Asset.Connections[0].DelayDiscovery {
+			discoveredAsset, err := discovery.HandleDelayedDiscovery(ctx, asset.Asset, asset.Runtime)
+			if err != nil {
+				log.Error().Err(err).Str("asset", asset.Asset.Name).Msg("failed to handle delayed discovery for asset")
+				continue
+			}
+			asset.Asset = discoveredAsset
+		}
+
+		reportAsset := &reporter.Asset{
+			Mrn:          sbomAssetMrn(asset.Asset, i),
+			Name:         asset.Asset.Name,
+			Labels:       asset.Asset.Labels,
+			PlatformName: asset.Asset.GetPlatform().GetName(),
+		}
+
+		sh := shell.NewShell(asset.Runtime, shell.WithFeatures(opts.GetFeatures()), shell.WithOutput(io.Discard))
+		for _, query := range generator.Queries {
+			code, results, err := sh.RunOnce(query.Mql)
+			if err != nil {
+				// not all assets support all resources, e.g. containers have no kernel
+				log.Debug().Err(err).Str("asset", asset.Asset.Name).Str("query", query.Uid).Msg("skip SBOM query")
+				continue
+			}
+
+			var buf bytes.Buffer
+			if err := reporter.CodeBundleToJSON(code, results, &iox.IOWriter{Writer: &buf}); err != nil {
+				return nil, err
+			}
+			if err := generator.AddQueryResult(report, reportAsset, query, buf.Bytes()); err != nil {
+				return nil, errors.Wrap(err, "failed to store results of "+query.Uid)
+			}
+		}
+
+		// prevent the recording from being closed multiple times
+		if err := asset.Runtime.SetRecording(recording.Null{}); err != nil {
+			log.Error().Err(err).Msg("failed to set the recording layer to null")
+		}
+		sh.Close()
+	}
+	return report, nil
+}
+
+func sbomAssetMrn(asset *inventory.Asset, idx int) string {
+	if asset.Mrn != "" {
+		return asset.Mrn
+	}
+	if len(asset.PlatformIds) > 0 {
+		return asset.PlatformIds[0]
+	}
+	return "//local.cnquery.io/run/local-execution/assets/" + strconv.Itoa(idx)
+}
+
+// sbomFilename returns the file for an SBOM. If there are multiple assets,
+// each SBOM is written to its own file, e.g. sbom-1.json and sbom-2.json
+func sbomFilename(output string, idx int, count int) string {
+	if count <= 1 {
+		return output
+	}
+	ext := filepath.Ext(output)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(output, ext), idx+1, ext)
+}
+
+func renderSbomFile(handler sbom.FormatSpecificationHandler, bom *sbom.Sbom, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := handler.Render(f, bom); err != nil {
+		f.Close()
+		return err
+	}
+	log.Info().Str("file", path).Str("asset", bom.Asset.GetName()).Msg("wrote SBOM")
+	return f.Close()
+}
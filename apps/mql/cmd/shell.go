@@ -18,6 +18,8 @@ import (
 	"go.mondoo.com/mql/v13/cli/shell"
 	"go.mondoo.com/mql/v13/cli/theme"
 	"go.mondoo.com/mql/v13/discovery"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/metrics"
 	"go.mondoo.com/mql/v13/providers"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
@@ -153,8 +155,17 @@ func StartShell(runtime *providers.Runtime, conf *ShellConfig) error {
 
 	log.Info().Msgf("connected to %s", connectAsset.Runtime.Provider.Connection.Asset.Platform.Title)
 
+	// resource stats are only collected for the metrics endpoint
+	unregisterProfiler := func() {}
+	if metrics.Enabled() {
+		profiler := llx.NewProfiler()
+		connectAsset.Runtime.SetProfiler(profiler)
+		unregisterProfiler = metrics.RegisterProfiler(profiler)
+	}
+
 	// when we close the shell, we need to close the backend and store the recording
 	onCloseHandler := func() {
+		unregisterProfiler()
 		connectAsset.Runtime.Close()
 		providers.Coordinator.Shutdown()
 	}
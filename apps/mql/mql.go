@@ -49,7 +49,7 @@ func main() {
 		}
 	}
 
-	go metrics.Start()
+	metrics.Start()
 	cmd.Execute()
 }
 
@@ -104,7 +104,7 @@ func shouldTrySelfUpdate() bool {
 		}
 	}
 
-	// Initialize viper to read config files (same as detectConnectorName in cli/providers)
+	// Initialize viper to read config files (same as AttachCLIs in cli/providers)
 	config.InitViperConfig()
 
 	// Check if the AutoUpdateEngine feature flag is enabled
@@ -281,6 +281,20 @@ type CommonOpts struct {
 	// This can be a custom URL for an internal provider registry
 	// Deprecated: use UpdatesURL instead
 	ProvidersURL string `json:"providers_url,omitempty" mapstructure:"providers_url"`
+
+	// ProviderSigningKeys are base64 encoded ed25519 public keys that are
+	// trusted to sign provider packages
+	ProviderSigningKeys []string `json:"provider_signing_keys,omitempty" mapstructure:"provider_signing_keys"`
+
+	// StrictProviderVerification refuses provider packages that have no
+	// checksum or no valid signature in the provider index
+	StrictProviderVerification bool `json:"strict_provider_verification,omitempty" mapstructure:"strict_provider_verification"`
+
+	// ProvidersLockFile pins provider versions for all runs, relative paths
+	// are resolved against the directory of the config file
+	// if not set, providers.lock.json in the project root is used, which is
+	// found by searching the working directory and its parents
+	ProvidersLockFile string `json:"providers_lock_file,omitempty" mapstructure:"providers_lock_file"`
 }
 
 // Workload Identity Federation
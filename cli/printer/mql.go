@@ -904,6 +904,59 @@ func (print *Printer) CompilerStats(stats mqlc.CompilerStats) string {
 	return res.String()
 }
 
+// Explain prints the profile of all resource calls as a tree, starting with
+// the resources and fields that took the most time
+func (print *Printer) Explain(profile []llx.ResourceProfile) string {
+	var res strings.Builder
+	res.WriteString("Resource calls:\n")
+	if len(profile) == 0 {
+		res.WriteString(print.Disabled("  no resources were called") + "\n")
+		return res.String()
+	}
+
+	var total llx.CallStats
+	for i := range profile {
+		resource := profile[i]
+		total.Add(resource.Total)
+		res.WriteString("- " + print.Yellow(resource.Name) + "  " + print.callStats(resource.Total) + "\n")
+		if resource.Init.Calls != 0 || resource.Init.ProviderCalls != 0 {
+			res.WriteString("  - " + print.Disabled("(init)") + "  " + print.callStats(resource.Init) + "\n")
+		}
+		for _, field := range resource.Fields {
+			res.WriteString("  - " + print.Primary(field.Name) + "  " + print.callStats(field.Stats) + "\n")
+		}
+	}
+	res.WriteString("Total: " + print.callStats(total) + "\n")
+	return res.String()
+}
+
+func (print *Printer) callStats(stats llx.CallStats) string {
+	res := []string{
+		print.Secondary(stats.Time().Round(time.Microsecond).String()),
+		"calls=" + strconv.FormatInt(stats.Calls, 10),
+		"provider=" + strconv.FormatInt(stats.ProviderCalls, 10),
+		"cached=" + strconv.FormatInt(stats.CacheHits, 10),
+		"bytes=" + formatBytes(stats.Bytes),
+	}
+	if stats.Errors != 0 {
+		res = append(res, print.Failed("errors="+strconv.FormatInt(stats.Errors, 10)))
+	}
+	return strings.Join(res, " ")
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + " B"
+	}
+	div, exp := int64(unit), 0
+	for x := n / unit; x >= unit; x /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 // CodeBundle prints the contents of the MQL query
 func (print *Printer) CodeBundle(bundle *llx.CodeBundle) string {
 	var res strings.Builder
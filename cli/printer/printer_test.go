@@ -579,3 +579,28 @@ func TestPrinter_Buggy(t *testing.T) {
 		},
 	})
 }
+
+func TestPrinter_Explain(t *testing.T) {
+	profiled, ok := x.Runtime.(interface{ SetProfiler(*llx.Profiler) })
+	require.True(t, ok)
+	profiler := llx.NewProfiler()
+	profiled.SetProfiler(profiler)
+	defer profiled.SetProfiler(nil)
+
+	testQuery(t, "mondoo.version")
+
+	profile := profiler.Profile()
+	require.Len(t, profile, 1)
+	assert.Equal(t, "mondoo", profile[0].Name)
+	require.Len(t, profile[0].Fields, 1)
+	assert.Equal(t, "version", profile[0].Fields[0].Name)
+	assert.Equal(t, int64(1), profile[0].Fields[0].Stats.Calls)
+
+	s := DefaultPrinter.Explain(profile)
+	assert.Contains(t, s, "mondoo")
+	assert.Contains(t, s, "version")
+	assert.Contains(t, s, "calls=1")
+	assert.Contains(t, s, "Total: ")
+
+	assert.Contains(t, DefaultPrinter.Explain(nil), "no resources were called")
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 
@@ -30,11 +31,23 @@ type Command struct {
 	Run                 func(*cobra.Command, *providers.Runtime, *plugin.ParseCLIRes)
 	Action              string
 	SupportedConnectors []string
+	// DefaultConnector is always used by the command, instead of attaching
+	// connectors as subcommands, e.g. to replay recordings
+	DefaultConnector string
 }
 
 // AttachCLIs will attempt to parse the current commandline and look for providers.
 // This step is done before cobra ever takes effect
 func AttachCLIs(rootCmd *cobra.Command, commands ...*Command) error {
+	config.InitViperConfig()
+
+	// verification and the lock file apply to all providers that are
+	// installed from here on, so they are set up before providers are listed
+	configureProviderVerification()
+	if err := loadProvidersLock(); err != nil {
+		return err
+	}
+
 	existing, err := providers.ListActive()
 	if err != nil {
 		return err
@@ -55,11 +68,62 @@ func AttachCLIs(rootCmd *cobra.Command, commands ...*Command) error {
 	return nil
 }
 
+// configureProviderVerification sets up the keys and the mode that are used
+// to verify provider packages before they are installed
+func configureProviderVerification() {
+	verification := providers.VerificationConfig{
+		Strict: viper.GetBool("strict_provider_verification"),
+	}
+	for _, key := range viper.GetStringSlice("provider_signing_keys") {
+		pub, err := providers.ParseTrustedKey(key)
+		if err != nil {
+			log.Warn().Err(err).Msg("ignoring provider signing key")
+			continue
+		}
+		verification.TrustedKeys = append(verification.TrustedKeys, pub)
+	}
+	providers.Verification = verification
+}
+
+// loadProvidersLock reads the lock file that pins provider versions. A
+// configured lock file is relative to the config file, otherwise the lock
+// file of the project in the working directory is used.
+func loadProvidersLock() error {
+	configDir := filepath.Dir(viper.ConfigFileUsed())
+	if viper.ConfigFileUsed() == "" {
+		var err error
+		if configDir, err = config.HomePath(); err != nil {
+			return fmt.Errorf("failed to determine the providers lock file: %w", err)
+		}
+	}
+
+	if path := viper.GetString("providers_lock_file"); path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(configDir, path)
+		}
+		providers.LockFilePath = path
+	} else {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to determine the providers lock file: %w", err)
+		}
+		providers.LockFilePath = providers.ResolveLockFile(cwd, configDir)
+	}
+
+	lock, err := providers.LoadLockFile(providers.LockFilePath)
+	if err != nil {
+		return err
+	}
+	if len(lock.Providers) != 0 {
+		log.Debug().Str("path", providers.LockFilePath).Int("providers", len(lock.Providers)).Msg("using providers lock file")
+	}
+	providers.Lock = lock
+	return nil
+}
+
 func detectConnectorName(args []string, rootCmd *cobra.Command, commands []*Command, existing providers.Providers) (string, bool) {
 	autoUpdate := true
 
-	config.InitViperConfig()
-
 	// Determine the providers URL:
 	// 1. If providers_url is explicitly set, use it (deprecated)
 	// 2. Otherwise, if updates_url is set, use updates_url + "/providers"
@@ -128,16 +192,19 @@ func detectConnectorName(args []string, rootCmd *cobra.Command, commands []*Comm
 		return "", autoUpdate
 	}
 
-	commandFound := false
+	var command *Command
 	for j := range commands {
-		if commands[j].Command.Use == parsedArgs[1] {
-			commandFound = true
+		if commands[j].Command.Name() == parsedArgs[1] {
+			command = commands[j]
 			break
 		}
 	}
-	if !commandFound {
+	if command == nil {
 		return "", autoUpdate
 	}
+	if command.DefaultConnector != "" {
+		return command.DefaultConnector, autoUpdate
+	}
 
 	// since we have a known command, we can now expect the connector to be
 	// local by default if nothing else is set
@@ -147,6 +214,13 @@ func detectConnectorName(args []string, rootCmd *cobra.Command, commands []*Comm
 
 	connector := parsedArgs[2]
 
+	// commands may have their own subcommands, which are not connectors
+	for _, sub := range command.Command.Commands() {
+		if sub.Name() == connector || sub.HasAlias(connector) {
+			return "", autoUpdate
+		}
+	}
+
 	return connector, autoUpdate
 }
 
@@ -157,6 +231,19 @@ func attachProviders(existing providers.Providers, commands []*Command) {
 }
 
 func attachProvidersToCmd(existing providers.Providers, cmd *Command) {
+	if cmd.DefaultConnector != "" {
+		for _, provider := range existing {
+			for j := range provider.Connectors {
+				if conn := provider.Connectors[j]; conn.Name == cmd.DefaultConnector {
+					setConnector(provider.Provider, &conn, cmd.Run, cmd.Command)
+					return
+				}
+			}
+		}
+		log.Warn().Msg("cannot find connector " + cmd.DefaultConnector + " for command " + cmd.Command.Name())
+		return
+	}
+
 	for i := range existing {
 		provider := existing[i]
 		for j := range provider.Connectors {
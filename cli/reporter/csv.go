@@ -0,0 +1,233 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/types"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+// csvTable collects all rows for one query entrypoint across assets. Assets
+// may return different columns, e.g. when a block has no entries on one of
+// them, so the header is the union of all columns. It is only known once all
+// assets are collected, which is why rows are padded when they are written.
+type csvTable struct {
+	header  []string
+	columns map[string]int
+	rows    [][]string
+}
+
+func newCSVTable() *csvTable {
+	return &csvTable{
+		header:  []string{"asset"},
+		columns: map[string]int{"asset": 0},
+	}
+}
+
+// add appends a row for the given columns, placing every value in the
+// column of the same name
+func (t *csvTable) add(columns []string, values []string) {
+	row := make([]string, len(t.header), len(t.header)+len(columns))
+	for i, column := range columns {
+		idx, ok := t.columns[column]
+		if !ok {
+			idx = len(t.header)
+			t.columns[column] = idx
+			t.header = append(t.header, column)
+			row = append(row, "")
+		}
+		if i < len(values) {
+			row[idx] = values[i]
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+// csvFormatter writes one table per query entrypoint. Lists of blocks, e.g.
+// `users { name uid }`, are flattened so that every list entry becomes a row
+// and every block field a column. Other values are written as a single
+// column. Tables are separated by an empty line.
+type csvFormatter struct {
+	out    iox.OutputHelper
+	order  []string
+	tables map[string]*csvTable
+}
+
+func newCSVFormatter(out iox.OutputHelper) Formatter {
+	return &csvFormatter{
+		out:    out,
+		tables: map[string]*csvTable{},
+	}
+}
+
+func (c *csvFormatter) Start() error {
+	return nil
+}
+
+func (c *csvFormatter) Asset(asset *inventory.Asset, code *llx.CodeBundle, results map[string]*llx.RawResult) error {
+	name := assetName(asset)
+
+	for _, res := range queryResults(code, results) {
+		table, ok := c.tables[res.Checksum]
+		if !ok {
+			table = newCSVTable()
+			c.tables[res.Checksum] = table
+			c.order = append(c.order, res.Checksum)
+		}
+
+		if res.Data == nil || (res.Data.Value == nil && res.Data.Error != nil) {
+			table.add([]string{"asset", res.Label}, []string{name, "error: " + res.Error})
+			continue
+		}
+
+		columns, rows := csvRows(res.Checksum, res.Label, res.Data, code)
+		columns = append([]string{"asset"}, columns...)
+		for _, row := range rows {
+			table.add(columns, append([]string{name}, row...))
+		}
+	}
+	return nil
+}
+
+func (c *csvFormatter) Finish() error {
+	w := csv.NewWriter(c.out)
+	for i, checksum := range c.order {
+		if i > 0 {
+			w.Flush()
+			if err := c.out.WriteString("\n"); err != nil {
+				return err
+			}
+		}
+
+		table := c.tables[checksum]
+		if err := w.Write(table.header); err != nil {
+			return err
+		}
+		for _, row := range table.rows {
+			for len(row) < len(table.header) {
+				row = append(row, "")
+			}
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// csvRows flattens a query result into columns and rows
+func csvRows(checksum string, label string, data *llx.RawData, code *llx.CodeBundle) ([]string, [][]string) {
+	typ := data.Type
+	switch {
+	case typ.IsArray() && typ.Child() == types.Block:
+		list, _ := data.Value.([]any)
+		var fields []string
+		rows := make([][]string, 0, len(list))
+		for i := range list {
+			block, _ := list[i].(map[string]any)
+			if fields == nil {
+				fields = blockFields(block, code)
+			}
+			rows = append(rows, blockRow(block, fields, code))
+		}
+		return fieldLabels(fields, code), rows
+
+	case typ.IsArray():
+		list, _ := data.Value.([]any)
+		rows := make([][]string, 0, len(list))
+		child := typ.Child()
+		for i := range list {
+			rows = append(rows, []string{csvValue(&llx.RawData{Type: child, Value: list[i]}, checksum, code)})
+		}
+		return []string{label}, rows
+
+	case typ == types.Block:
+		block, _ := data.Value.(map[string]any)
+		fields := blockFields(block, code)
+		return fieldLabels(fields, code), [][]string{blockRow(block, fields, code)}
+
+	default:
+		return []string{label}, [][]string{{csvValue(data, checksum, code)}}
+	}
+}
+
+// blockFields returns the checksums of all fields in a block, in the order
+// they were written in the query
+func blockFields(block map[string]any, code *llx.CodeBundle) []string {
+	refs := make(map[string]uint64, len(code.CodeV2.Checksums))
+	for ref, checksum := range code.CodeV2.Checksums {
+		refs[checksum] = ref
+	}
+
+	fields := make([]string, 0, len(block))
+	for k := range block {
+		if k == "" || k[0] == '_' {
+			continue
+		}
+		fields = append(fields, k)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return refs[fields[i]] < refs[fields[j]]
+	})
+	return fields
+}
+
+func fieldLabels(fields []string, code *llx.CodeBundle) []string {
+	res := make([]string, len(fields))
+	for i := range fields {
+		res[i] = entrypointLabel(code, fields[i])
+	}
+	return res
+}
+
+func blockRow(block map[string]any, fields []string, code *llx.CodeBundle) []string {
+	row := make([]string, len(fields))
+	for i, field := range fields {
+		raw, ok := block[field].(*llx.RawData)
+		if !ok {
+			continue
+		}
+		row[i] = csvValue(raw, field, code)
+	}
+	return row
+}
+
+// csvValue turns a value into a single CSV cell. Simple values are written
+// as-is, while complex values are written as JSON.
+func csvValue(data *llx.RawData, checksum string, code *llx.CodeBundle) string {
+	if data.Error != nil {
+		return "error: " + data.Error.Error()
+	}
+	if data.Value == nil {
+		return ""
+	}
+
+	switch data.Type.Underlying() {
+	case types.String:
+		if s, ok := data.Value.(string); ok {
+			return s
+		}
+	case types.ResourceLike:
+		if r, ok := data.Value.(llx.Resource); ok {
+			if id := r.MqlID(); id != "" {
+				return r.MqlName() + " id = " + id
+			}
+			return r.MqlName()
+		}
+	}
+
+	raw := data.JSON(checksum, code)
+	var s string
+	if len(raw) > 0 && raw[0] == '"' && json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/mql/v13/cli/printer"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+// Formatter renders the results of a query run across one or more assets.
+// Start is called once before the first asset, Asset once per asset, and
+// Finish once after the last asset has been written.
+type Formatter interface {
+	Start() error
+	Asset(asset *inventory.Asset, code *llx.CodeBundle, results map[string]*llx.RawResult) error
+	Finish() error
+}
+
+// FormatterFactory creates a new formatter that writes to the given output
+type FormatterFactory func(out iox.OutputHelper) Formatter
+
+var (
+	formattersLock sync.RWMutex
+	formatters     = map[string]FormatterFactory{}
+)
+
+func init() {
+	RegisterFormatter("json", newJSONFormatter)
+	RegisterFormatter("csv", newCSVFormatter)
+	RegisterFormatter("junit", newJUnitFormatter)
+	RegisterFormatter("sarif", newSarifFormatter)
+}
+
+// RegisterFormatter makes a formatter available under the given name.
+// Registering a name a second time replaces the previous formatter.
+func RegisterFormatter(name string, factory FormatterFactory) {
+	formattersLock.Lock()
+	formatters[strings.ToLower(name)] = factory
+	formattersLock.Unlock()
+}
+
+// Formatters returns the sorted names of all registered formatters
+func Formatters() []string {
+	formattersLock.RLock()
+	defer formattersLock.RUnlock()
+
+	res := make([]string, 0, len(formatters))
+	for name := range formatters {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// NewFormatter creates the formatter registered under the given name
+func NewFormatter(name string, out iox.OutputHelper) (Formatter, error) {
+	formattersLock.RLock()
+	factory, ok := formatters[strings.ToLower(name)]
+	formattersLock.RUnlock()
+	if !ok {
+		return nil, errors.New("unknown output format '" + name + "', available formats: " + strings.Join(Formatters(), ", "))
+	}
+	return factory(out), nil
+}
+
+// queryResult is the flattened outcome of one entrypoint of a query,
+// shared by formatters that report individual pass/fail results
+type queryResult struct {
+	Checksum    string
+	Label       string
+	IsAssertion bool
+	Success     bool
+	Error       string
+	Message     string
+	Data        *llx.RawData
+}
+
+func entrypointLabel(code *llx.CodeBundle, checksum string) string {
+	if code.Labels != nil {
+		if label := code.Labels.Labels[checksum]; label != "" {
+			return label
+		}
+	}
+	return code.Source
+}
+
+// queryResults collects one result per entrypoint of the code bundle, in
+// the order the entrypoints appear in the query
+func queryResults(code *llx.CodeBundle, results map[string]*llx.RawResult) []queryResult {
+	if code == nil || code.CodeV2 == nil {
+		return nil
+	}
+
+	items := map[string]*llx.AssessmentItem{}
+	if assessment := llx.Results2Assessment(code, results); assessment != nil {
+		for _, item := range assessment.Results {
+			items[item.Checksum] = item
+		}
+	}
+
+	eps := code.CodeV2.Entrypoints()
+	res := make([]queryResult, 0, len(eps))
+	for _, ref := range eps {
+		checksum := code.CodeV2.Checksums[ref]
+		cur := queryResult{
+			Checksum: checksum,
+			Label:    entrypointLabel(code, checksum),
+		}
+
+		result := results[checksum]
+		if result == nil || result.Data == nil {
+			cur.Error = "cannot find result for this query"
+			res = append(res, cur)
+			continue
+		}
+
+		cur.Data = result.Data
+		if result.Data.Error != nil {
+			cur.Error = result.Data.Error.Error()
+		}
+		cur.Success, _ = result.Data.IsTruthy()
+
+		if item, ok := items[checksum]; ok && item.IsAssertion {
+			cur.IsAssertion = true
+			cur.Success = item.Success
+			cur.Message = strings.TrimSpace(printer.PlainNoColorPrinter.Assessment(code, &llx.Assessment{
+				Results: []*llx.AssessmentItem{item},
+			}))
+		} else {
+			cur.Message = strings.TrimSpace(printer.PlainNoColorPrinter.Result(result, code))
+		}
+
+		res = append(res, cur)
+	}
+	return res
+}
+
+func assetName(asset *inventory.Asset) string {
+	if asset == nil {
+		return ""
+	}
+	if asset.Name != "" {
+		return asset.Name
+	}
+	if len(asset.PlatformIds) > 0 {
+		return asset.PlatformIds[0]
+	}
+	return asset.Mrn
+}
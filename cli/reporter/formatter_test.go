@@ -0,0 +1,137 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/logger"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/testutils"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+var x = testutils.InitTester(testutils.LinuxMock())
+
+func init() {
+	logger.InitTestEnv()
+}
+
+func runFormatter(t *testing.T, format string, query string) []byte {
+	code, err := x.Compile(query)
+	require.NoError(t, err)
+	results, err := x.ExecuteCode(code, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	f, err := NewFormatter(format, &iox.IOWriter{Writer: &buf})
+	require.NoError(t, err)
+	require.NoError(t, f.Start())
+	asset := &inventory.Asset{Name: "arch", PlatformIds: []string{"//platformid/arch"}}
+	require.NoError(t, f.Asset(asset, code, results))
+	require.NoError(t, f.Finish())
+	return buf.Bytes()
+}
+
+func TestFormatters(t *testing.T) {
+	assert.Equal(t, []string{"csv", "json", "junit", "sarif"}, Formatters())
+
+	_, err := NewFormatter("yaml", &iox.IOWriter{Writer: &bytes.Buffer{}})
+	assert.EqualError(t, err, "unknown output format 'yaml', available formats: csv, json, junit, sarif")
+
+	f, err := NewFormatter("JUnit", &iox.IOWriter{Writer: &bytes.Buffer{}})
+	require.NoError(t, err)
+	assert.IsType(t, &junitFormatter{}, f)
+}
+
+func TestJSONFormatter(t *testing.T) {
+	out := runFormatter(t, "json", "mondoo.version")
+	var res []map[string]any
+	require.NoError(t, json.Unmarshal(out, &res))
+	require.Len(t, res, 1)
+	assert.Contains(t, res[0], "mondoo.version")
+}
+
+func TestJUnitFormatter(t *testing.T) {
+	out := runFormatter(t, "junit", "users.length > 100\nusers.length > 1\nmondoo.version")
+
+	var res junitTestSuites
+	require.NoError(t, xml.Unmarshal(out, &res))
+	assert.Equal(t, 3, res.Tests)
+	assert.Equal(t, 1, res.Failures)
+	assert.Equal(t, 0, res.Errors)
+	require.Len(t, res.Suites, 1)
+
+	suite := res.Suites[0]
+	assert.Equal(t, "arch", suite.Name)
+	require.Len(t, suite.Cases, 3)
+	assert.Equal(t, "users.length > 100", suite.Cases[0].Name)
+	require.NotNil(t, suite.Cases[0].Failure)
+	assert.Nil(t, suite.Cases[1].Failure)
+	assert.Nil(t, suite.Cases[2].Failure)
+	assert.NotEmpty(t, suite.Cases[2].SystemOut)
+}
+
+func TestSarifFormatter(t *testing.T) {
+	out := runFormatter(t, "sarif", "users.length > 100\nusers.length > 1\nmondoo.version")
+
+	var res sarifLog
+	require.NoError(t, json.Unmarshal(out, &res))
+	assert.Equal(t, "2.1.0", res.Version)
+	require.Len(t, res.Runs, 1)
+	run := res.Runs[0]
+	assert.Len(t, run.Tool.Driver.Rules, 3)
+	require.Len(t, run.Results, 3)
+
+	assert.Equal(t, "fail", run.Results[0].Kind)
+	assert.Equal(t, "error", run.Results[0].Level)
+	assert.Equal(t, "pass", run.Results[1].Kind)
+	assert.Equal(t, "informational", run.Results[2].Kind)
+	assert.Equal(t, "arch", run.Results[0].Locations[0].LogicalLocations[0].Name)
+}
+
+func TestCSVFormatter(t *testing.T) {
+	t.Run("list of blocks", func(t *testing.T) {
+		out := runFormatter(t, "csv", "users.where(uid >= 1000) { name uid }")
+		rows, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"asset", "name", "uid"},
+			{"arch", "chris", "1000"},
+			{"arch", "christopher", "1001"},
+		}, rows)
+	})
+
+	t.Run("multiple queries", func(t *testing.T) {
+		out := runFormatter(t, "csv", "users.where(uid >= 1000).map(name)\nusers.length")
+		assert.Equal(t, "asset,users.where.map\narch,chris\narch,christopher\n\nasset,users.length\narch,4\n", string(out))
+	})
+
+	t.Run("columns differ across assets", func(t *testing.T) {
+		table := newCSVTable()
+		table.add([]string{"asset", "users.where"}, []string{"one", "error: failed"})
+		table.add([]string{"asset", "name", "uid"}, []string{"two", "chris", "1000"})
+
+		var buf bytes.Buffer
+		c := &csvFormatter{
+			out:    &iox.IOWriter{Writer: &buf},
+			order:  []string{"x"},
+			tables: map[string]*csvTable{"x": table},
+		}
+		require.NoError(t, c.Finish())
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"asset", "users.where", "name", "uid"},
+			{"one", "error: failed", "", ""},
+			{"two", "", "chris", "1000"},
+		}, rows)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
 	"go.mondoo.com/mql/v13/utils/iox"
 )
 
@@ -42,3 +43,32 @@ func CodeBundleToJSON(code *llx.CodeBundle, results map[string]*llx.RawResult, o
 
 	return nil
 }
+
+// jsonFormatter writes a JSON array with one object per asset, where each
+// object maps the query labels to their results
+type jsonFormatter struct {
+	out    iox.OutputHelper
+	assets int
+}
+
+func newJSONFormatter(out iox.OutputHelper) Formatter {
+	return &jsonFormatter{out: out}
+}
+
+func (j *jsonFormatter) Start() error {
+	return j.out.WriteString("[")
+}
+
+func (j *jsonFormatter) Asset(asset *inventory.Asset, code *llx.CodeBundle, results map[string]*llx.RawResult) error {
+	if j.assets > 0 {
+		if err := j.out.WriteString(","); err != nil {
+			return err
+		}
+	}
+	j.assets++
+	return CodeBundleToJSON(code, results, j.out)
+}
+
+func (j *jsonFormatter) Finish() error {
+	return j.out.WriteString("]")
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/xml"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+// junitFormatter writes one test suite per asset. Assertions become test
+// cases that pass or fail, all other queries are reported as passing test
+// cases with their data attached as system-out.
+type junitFormatter struct {
+	out    iox.OutputHelper
+	report junitTestSuites
+}
+
+func newJUnitFormatter(out iox.OutputHelper) Formatter {
+	return &junitFormatter{
+		out:    out,
+		report: junitTestSuites{Name: "mql"},
+	}
+}
+
+func (j *junitFormatter) Start() error {
+	return nil
+}
+
+func (j *junitFormatter) Asset(asset *inventory.Asset, code *llx.CodeBundle, results map[string]*llx.RawResult) error {
+	suite := junitTestSuite{
+		Name: assetName(asset),
+	}
+	if asset != nil {
+		for _, id := range asset.PlatformIds {
+			suite.Properties = append(suite.Properties, junitProperty{Name: "platform-id", Value: id})
+		}
+	}
+
+	for _, res := range queryResults(code, results) {
+		tc := junitTestCase{
+			Name:      res.Label,
+			Classname: suite.Name,
+		}
+
+		switch {
+		case res.Error != "":
+			tc.Error = &junitMessage{Message: res.Error, Type: "error"}
+			suite.Errors++
+		case res.IsAssertion && !res.Success:
+			tc.Failure = &junitMessage{Message: "assertion failed", Type: "failure", Content: res.Message}
+			suite.Failures++
+		case !res.IsAssertion:
+			tc.SystemOut = res.Message
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+		suite.Tests++
+	}
+
+	j.report.Tests += suite.Tests
+	j.report.Failures += suite.Failures
+	j.report.Errors += suite.Errors
+	j.report.Suites = append(j.report.Suites, suite)
+	return nil
+}
+
+func (j *junitFormatter) Finish() error {
+	data, err := xml.MarshalIndent(j.report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := j.out.WriteString(xml.Header); err != nil {
+		return err
+	}
+	if _, err := j.out.Write(data); err != nil {
+		return err
+	}
+	return j.out.WriteString("\n")
+}
@@ -0,0 +1,173 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reporter
+
+import (
+	"encoding/json"
+
+	"go.mondoo.com/mql/v13"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/utils/iox"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name,omitempty"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Kind      string          `json:"kind"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
+
+// sarifFormatter writes a single SARIF run. Every query entrypoint becomes a
+// rule, and every asset contributes one result per rule: assertions pass or
+// fail, errors are reported as failures and all other queries are
+// informational.
+type sarifFormatter struct {
+	out   iox.OutputHelper
+	run   sarifRun
+	rules map[string]int
+}
+
+func newSarifFormatter(out iox.OutputHelper) Formatter {
+	return &sarifFormatter{
+		out: out,
+		run: sarifRun{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "mql",
+				Version:        mql.GetVersion(),
+				InformationURI: "https://mondoo.com/mql",
+				Rules:          []sarifRule{},
+			}},
+			Results: []sarifResult{},
+		},
+		rules: map[string]int{},
+	}
+}
+
+func (s *sarifFormatter) Start() error {
+	return nil
+}
+
+func (s *sarifFormatter) rule(res queryResult) int {
+	if idx, ok := s.rules[res.Checksum]; ok {
+		return idx
+	}
+	idx := len(s.run.Tool.Driver.Rules)
+	s.run.Tool.Driver.Rules = append(s.run.Tool.Driver.Rules, sarifRule{
+		ID:               res.Checksum,
+		Name:             res.Label,
+		ShortDescription: sarifMessage{Text: res.Label},
+	})
+	s.rules[res.Checksum] = idx
+	return idx
+}
+
+func (s *sarifFormatter) Asset(asset *inventory.Asset, code *llx.CodeBundle, results map[string]*llx.RawResult) error {
+	name := assetName(asset)
+	var locations []sarifLocation
+	if name != "" {
+		loc := sarifLogicalLocation{Name: name, Kind: "asset"}
+		if asset.Mrn != "" {
+			loc.FullyQualifiedName = asset.Mrn
+		} else if len(asset.PlatformIds) > 0 {
+			loc.FullyQualifiedName = asset.PlatformIds[0]
+		}
+		locations = []sarifLocation{{LogicalLocations: []sarifLogicalLocation{loc}}}
+	}
+
+	for _, res := range queryResults(code, results) {
+		cur := sarifResult{
+			RuleID:    res.Checksum,
+			RuleIndex: s.rule(res),
+			Locations: locations,
+			Message:   sarifMessage{Text: res.Message},
+		}
+
+		switch {
+		case res.Error != "":
+			cur.Kind = "fail"
+			cur.Level = "error"
+			cur.Message.Text = res.Error
+		case !res.IsAssertion:
+			cur.Kind = "informational"
+			cur.Level = "none"
+		case res.Success:
+			cur.Kind = "pass"
+			cur.Level = "none"
+		default:
+			cur.Kind = "fail"
+			cur.Level = "error"
+		}
+		if cur.Message.Text == "" {
+			cur.Message.Text = res.Label
+		}
+
+		s.run.Results = append(s.run.Results, cur)
+	}
+	return nil
+}
+
+func (s *sarifFormatter) Finish() error {
+	data, err := json.MarshalIndent(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{s.run},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := s.out.Write(data); err != nil {
+		return err
+	}
+	return s.out.WriteString("\n")
+}
@@ -16,6 +16,7 @@ import (
 	"go.mondoo.com/mql/v13/internal/workerpool"
 	"go.mondoo.com/mql/v13/llx"
 	"go.mondoo.com/mql/v13/logger"
+	"go.mondoo.com/mql/v13/metrics"
 	"go.mondoo.com/mql/v13/providers"
 	inventory "go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory/manager"
@@ -93,6 +94,7 @@ func (d *DiscoveredAssets) Add(asset *inventory.Asset, runtime *providers.Runtim
 
 	log.Debug().Str("asset", asset.Name).Strs("platform-ids", asset.PlatformIds).Int("total", len(d.Assets)+1).Msg("discovery> added asset")
 	d.Assets = append(d.Assets, &AssetWithRuntime{Asset: asset, Runtime: runtime})
+	metrics.AssetsDiscovered.WithLabelValues(asset.GetPlatform().GetName()).Inc()
 	return true
 }
 
@@ -100,6 +102,12 @@ func (d *DiscoveredAssets) AddError(asset *inventory.Asset, err error) {
 	d.assetsLock.Lock()
 	defer d.assetsLock.Unlock()
 	d.Errors = append(d.Errors, &AssetWithError{Asset: asset, Err: err})
+
+	connector := ""
+	if len(asset.GetConnections()) != 0 {
+		connector = asset.Connections[0].Type
+	}
+	metrics.ConnectionFailures.WithLabelValues(connector).Inc()
 }
 
 func (d *DiscoveredAssets) GetAssetsByPlatformID(platformID string) []*AssetWithRuntime {
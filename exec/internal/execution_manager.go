@@ -10,6 +10,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/metrics"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/resources"
 )
 
@@ -158,7 +159,10 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 
 	codeID := codeBundle.CodeV2.GetId()
 	log.Debug().Str("qrid", codeID).Msg("starting query execution")
+	start := time.Now()
+	status := metrics.QueryFailed
 	defer func() {
+		metrics.QueryDuration.WithLabelValues(status).Observe(time.Since(start).Seconds())
 		log.Debug().Str("qrid", codeID).Msg("finished query execution")
 	}()
 
@@ -188,7 +192,9 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 	case <-timer.C:
 		log.Error().Dur("timeout", em.timeout).Str("qrid", codeID).Msg("execution timed out")
 		errOut = errQueryTimeout
+		status = metrics.QueryTimedOut
 	case <-execDoneChan:
+		status = metrics.QuerySucceeded
 	}
 
 	unreported := wg.Decommission()
@@ -6,6 +6,7 @@ package llx
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/resources"
@@ -348,6 +349,20 @@ func init() {
 			string("lines"):     {f: stringLinesV2, Label: "lines"},
 			string("split"):     {f: stringSplitV2, Label: "split"},
 			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			// transformations, hashes and encodings
+			string("replace"):      {f: stringReplaceV2, Label: "replace"},
+			string("startsWith"):   {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):     {f: stringEndsWithV2, Label: "endsWith"},
+			string("padLeft"):      {f: stringPadLeftV2, Label: "padLeft"},
+			string("padRight"):     {f: stringPadRightV2, Label: "padRight"},
+			string("md5"):          {f: stringMd5V2, Label: "md5"},
+			string("sha1"):         {f: stringSha1V2, Label: "sha1"},
+			string("sha256"):       {f: stringSha256V2, Label: "sha256"},
+			string("sha512"):       {f: stringSha512V2, Label: "sha512"},
+			string("base64Encode"): {f: stringBase64EncodeV2, Label: "base64Encode"},
+			string("base64Decode"): {f: stringBase64DecodeV2, Label: "base64Decode"},
+			string("hexEncode"):    {f: stringHexEncodeV2, Label: "hexEncode"},
+			string("hexDecode"):    {f: stringHexDecodeV2, Label: "hexDecode"},
 		},
 		types.StringSlice: {
 			// TODO: implement the remaining calls for this type
@@ -551,6 +566,19 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			"replace":                         {f: dictReplaceV2, Label: "replace"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"padLeft":                         {f: dictPadLeftV2, Label: "padLeft"},
+			"padRight":                        {f: dictPadRightV2, Label: "padRight"},
+			"md5":                             {f: dictMd5V2, Label: "md5"},
+			"sha1":                            {f: dictSha1V2, Label: "sha1"},
+			"sha256":                          {f: dictSha256V2, Label: "sha256"},
+			"sha512":                          {f: dictSha512V2, Label: "sha512"},
+			"base64Encode":                    {f: dictBase64EncodeV2, Label: "base64Encode"},
+			"base64Decode":                    {f: dictBase64DecodeV2, Label: "base64Decode"},
+			"hexEncode":                       {f: dictHexEncodeV2, Label: "hexEncode"},
+			"hexDecode":                       {f: dictHexDecodeV2, Label: "hexDecode"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhere, Label: "where"},
@@ -637,6 +665,15 @@ func init() {
 			"flat":                     {f: arrayFlat},
 			"reverse":                  {f: arrayReverse},
 			"join":                     {f: arrayJoin},
+			"sort":                     {f: arraySort},
+			"sortBy":                   {f: arraySortByV2},
+			"groupBy":                  {f: arrayGroupByV2},
+			"min":                      {f: arrayMin},
+			"max":                      {f: arrayMax},
+			"sum":                      {f: arraySum},
+			"avg":                      {f: arrayAvg},
+			"take":                     {f: arrayTake},
+			"skip":                     {f: arraySkip},
 			"duplicates":               {f: arrayDuplicatesV2},
 			"fieldDuplicates":          {f: arrayFieldDuplicatesV2},
 			"unique":                   {f: arrayUniqueV2},
@@ -840,8 +877,11 @@ func runResourceFunction(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 	// log.Debug().Str("wid", wid).Msg("exec> add watcher id ")
 	e.watcherIds.Store(wid)
 
-	// watch this field in the resource
+	// watch this field in the resource, the time until the data arrives
+	// is tracked without the time spent in the chained calls
+	start := time.Now()
 	err := e.ctx.runtime.WatchAndUpdate(rr, chunk.Id, wid, func(fieldData any, fieldError error) {
+		e.ctx.profiler.ExecutorCall(rr.MqlName(), chunk.Id, time.Since(start))
 		data := &RawData{
 			Type:  types.Type(resource.Fields[chunk.Id].Type),
 			Value: fieldData,
@@ -864,6 +904,7 @@ func runResourceFunction(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 	})
 	if err != nil {
 		if _, ok := err.(resources.NotReadyError); !ok {
+			e.ctx.profiler.ExecutorCall(rr.MqlName(), chunk.Id, time.Since(start))
 			fieldType := types.Unset
 			if field := resource.Fields[chunk.Id]; field != nil {
 				fieldType = types.Type(field.Type)
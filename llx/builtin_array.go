@@ -4,10 +4,13 @@
 package llx
 
 import (
+	"cmp"
 	"errors"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.mondoo.com/mql/v13/types"
 	"go.mondoo.com/mql/v13/utils/multierr"
@@ -573,6 +576,464 @@ func arrayJoin(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawD
 	return &RawData{Type: types.String, Value: res.String()}, 0, nil
 }
 
+// compareDictValues orders two dict values. Numbers are compared numerically
+// (across int and float), strings lexically and bools with false < true.
+// Nil values sort before everything else.
+func compareDictValues(left any, right any) (int, error) {
+	if left == nil || right == nil {
+		return compareNil(left, right), nil
+	}
+
+	switch l := left.(type) {
+	case int64:
+		switch r := right.(type) {
+		case int64:
+			return cmp.Compare(l, r), nil
+		case float64:
+			return cmp.Compare(float64(l), r), nil
+		}
+	case float64:
+		switch r := right.(type) {
+		case int64:
+			return cmp.Compare(l, float64(r)), nil
+		case float64:
+			return cmp.Compare(l, r), nil
+		}
+	case string:
+		if r, ok := right.(string); ok {
+			return strings.Compare(l, r), nil
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			return compareBools(l, r), nil
+		}
+	}
+
+	return 0, errors.New("cannot compare dict values of different types")
+}
+
+func compareNil(left any, right any) int {
+	switch {
+	case left == nil && right == nil:
+		return 0
+	case left == nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareBools(left bool, right bool) int {
+	switch {
+	case left == right:
+		return 0
+	case !left:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareValues orders two values of the given type. It returns a negative
+// number if left sorts before right, 0 if they are equal and a positive
+// number otherwise. Nil values sort before everything else.
+func compareValues(typ types.Type, left any, right any) (int, error) {
+	if left == nil || right == nil {
+		return compareNil(left, right), nil
+	}
+
+	switch typ {
+	case types.Int:
+		return cmp.Compare(left.(int64), right.(int64)), nil
+	case types.Float:
+		return cmp.Compare(left.(float64), right.(float64)), nil
+	case types.String, types.Regex:
+		return strings.Compare(left.(string), right.(string)), nil
+	case types.Bool:
+		return compareBools(left.(bool), right.(bool)), nil
+	case types.Version:
+		return NewVersion(left.(string)).Compare(NewVersion(right.(string))), nil
+	case types.Time:
+		l := left.(*time.Time)
+		r := right.(*time.Time)
+		if l == nil || r == nil {
+			return compareNil(l, r), nil
+		}
+		return l.Compare(*r), nil
+	case types.Dict, types.Any:
+		return compareDictValues(left, right)
+	default:
+		return 0, errors.New("cannot compare values of type " + typ.Label())
+	}
+}
+
+// sortValues sorts a list of values in place, using the keys for ordering.
+// Keys and values must be of the same length. The sort is stable, so entries
+// with equal keys retain their original order.
+func sortValues(values []any, keys []any, keyType types.Type) error {
+	idx := make([]int, len(values))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	var sortErr error
+	slices.SortStableFunc(idx, func(a, b int) int {
+		res, err := compareValues(keyType, keys[a], keys[b])
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return res
+	})
+	if sortErr != nil {
+		return sortErr
+	}
+
+	sorted := make([]any, len(values))
+	for i := range idx {
+		sorted[i] = values[idx[i]]
+	}
+	copy(values, sorted)
+	return nil
+}
+
+func arraySort(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]any)
+	// this should not happen at this point
+	if !ok {
+		return &RawData{Type: bind.Type, Error: errors.New("incorrect type, no array data found")}, 0, nil
+	}
+
+	res := make([]any, len(list))
+	copy(res, list)
+	if err := sortValues(res, list, bind.Type.Child()); err != nil {
+		return &RawData{Type: bind.Type, Error: err}, 0, nil
+	}
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arraySortByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	// sortBy(array, function)
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return &RawData{Type: items.Type}, 0, nil
+	}
+
+	list := items.Value.([]any)
+	if len(list) == 0 {
+		return items, 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("failed to retrieve function reference of 'sortBy' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		var anyError multierr.Errors
+		anyError.Add(errs...)
+
+		f := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[f.Entrypoints[0]]
+
+		keyType := types.Unset
+		keys := make([]any, len(list))
+		for i, res := range results {
+			epVal, ok := res.entrypoints[epChecksum].(*RawData)
+			if !ok {
+				continue
+			}
+			if epVal.Error != nil {
+				anyError.Add(epVal.Error)
+				continue
+			}
+			if keyType == types.Unset && epVal.Value != nil {
+				keyType = epVal.Type
+			}
+			keys[i] = epVal.Value
+		}
+
+		resList := make([]any, len(list))
+		copy(resList, list)
+		if err := sortValues(resList, keys, keyType); err != nil && keyType != types.Unset {
+			anyError.Add(err)
+		}
+
+		data := &RawData{
+			Type:  items.Type,
+			Value: resList,
+			Error: anyError.Deduplicate(),
+		}
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+func arrayGroupByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	// groupBy(array, function)
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	typ := types.Type(chunk.Function.Type)
+	if items.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	list := items.Value.([]any)
+	if len(list) == 0 {
+		return &RawData{Type: typ, Value: map[string]any{}}, 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("failed to retrieve function reference of 'groupBy' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		var anyError multierr.Errors
+		anyError.Add(errs...)
+
+		f := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[f.Entrypoints[0]]
+
+		groups := map[string]any{}
+		for i, res := range results {
+			epVal, ok := res.entrypoints[epChecksum].(*RawData)
+			if !ok {
+				continue
+			}
+			if epVal.Error != nil {
+				anyError.Add(epVal.Error)
+				continue
+			}
+
+			key := StringifyValue(epVal.Value, epVal.Type)
+			group, _ := groups[key].([]any)
+			groups[key] = append(group, list[i])
+		}
+
+		data := &RawData{
+			Type:  typ,
+			Value: groups,
+			Error: anyError.Deduplicate(),
+		}
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+func arrayMinMax(bind *RawData, want int) (*RawData, uint64, error) {
+	ct := bind.Type.Child()
+	if bind.Value == nil {
+		return &RawData{Type: ct, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]any)
+	// this should not happen at this point
+	if !ok {
+		return &RawData{Type: ct, Error: errors.New("incorrect type, no array data found")}, 0, nil
+	}
+
+	var res any
+	for i := range list {
+		if list[i] == nil {
+			continue
+		}
+		if res == nil {
+			res = list[i]
+			continue
+		}
+		c, err := compareValues(ct, list[i], res)
+		if err != nil {
+			return &RawData{Type: ct, Error: err}, 0, nil
+		}
+		if c*want > 0 {
+			res = list[i]
+		}
+	}
+
+	return &RawData{Type: ct, Value: res}, 0, nil
+}
+
+func arrayMin(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayMinMax(bind, -1)
+}
+
+func arrayMax(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayMinMax(bind, 1)
+}
+
+// sumNumbers adds up all numbers in the list, ignoring nil entries. It
+// returns the float and int sums separately, plus the number of values
+// that were summed up.
+func sumNumbers(list []any) (float64, int64, int, error) {
+	var fsum float64
+	var isum int64
+	var cnt int
+	for i := range list {
+		switch v := list[i].(type) {
+		case nil:
+			continue
+		case int64:
+			isum += v
+			fsum += float64(v)
+		case float64:
+			fsum += v
+		default:
+			return 0, 0, 0, errors.New("cannot add up non-numeric value: " + StringifyValue(v, types.Any))
+		}
+		cnt++
+	}
+	return fsum, isum, cnt, nil
+}
+
+func arraySum(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]any)
+	// this should not happen at this point
+	if !ok {
+		return &RawData{Type: typ, Error: errors.New("incorrect type, no array data found")}, 0, nil
+	}
+
+	fsum, isum, _, err := sumNumbers(list)
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+
+	if typ == types.Int {
+		return IntData(isum), 0, nil
+	}
+	return FloatData(fsum), 0, nil
+}
+
+func arrayAvg(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Float, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]any)
+	// this should not happen at this point
+	if !ok {
+		return &RawData{Type: types.Float, Error: errors.New("incorrect type, no array data found")}, 0, nil
+	}
+
+	fsum, _, cnt, err := sumNumbers(list)
+	if err != nil {
+		return &RawData{Type: types.Float, Error: err}, 0, nil
+	}
+	if cnt == 0 {
+		return &RawData{Type: types.Float}, 0, nil
+	}
+
+	return FloatData(fsum / float64(cnt)), 0, nil
+}
+
+func arraySlice(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, name string, f func(list []any, n int) []any) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	list, ok := bind.Value.([]any)
+	if !ok {
+		return nil, 0, errors.New("can't run " + name + " on data, it's not a list")
+	}
+
+	cntRaw, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	cnt, ok := cntRaw.Value.(int64)
+	if !ok {
+		return nil, 0, errors.New("failed to get count for " + name + ", incorrect type of value")
+	}
+	if cnt < 0 {
+		return &RawData{Type: bind.Type, Error: errors.New("called " + name + " with a negative count")}, 0, nil
+	}
+
+	n := min(int(cnt), len(list))
+	return &RawData{Type: bind.Type, Value: f(list, n)}, 0, nil
+}
+
+func arrayTake(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arraySlice(e, bind, chunk, ref, "take", func(list []any, n int) []any {
+		return slices.Clone(list[:n])
+	})
+}
+
+func arraySkip(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arraySlice(e, bind, chunk, ref, "skip", func(list []any, n int) []any {
+		return slices.Clone(list[n:])
+	})
+}
+
 // Take an array and separate it into a list of unique entries and another
 // list of only duplicates. The latter list only has every entry appear only
 // once.
@@ -21,3 +21,35 @@ func TestArrayFlat(t *testing.T) {
 		require.Equal(t, ArrayData(nil, types.Any), res)
 	})
 }
+
+func TestCompareValues(t *testing.T) {
+	t.Run("ints with nil", func(t *testing.T) {
+		values := []any{int64(3), nil, int64(1)}
+		require.NoError(t, sortValues(values, append([]any{}, values...), types.Int))
+		require.Equal(t, []any{nil, int64(1), int64(3)}, values)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		values := []any{"1.10.0", "1.2.0", "1:0.1"}
+		require.NoError(t, sortValues(values, append([]any{}, values...), types.Version))
+		require.Equal(t, []any{"1.2.0", "1.10.0", "1:0.1"}, values)
+	})
+
+	t.Run("mixed dict numbers", func(t *testing.T) {
+		res, err := compareValues(types.Dict, int64(2), float64(1.5))
+		require.NoError(t, err)
+		require.Equal(t, 1, res)
+	})
+
+	t.Run("incompatible dict values", func(t *testing.T) {
+		_, err := compareValues(types.Dict, int64(2), "two")
+		require.Error(t, err)
+	})
+
+	t.Run("stable sort by keys", func(t *testing.T) {
+		values := []any{"a", "b", "c"}
+		keys := []any{int64(2), int64(1), int64(2)}
+		require.NoError(t, sortValues(values, keys, types.Int))
+		require.Equal(t, []any{"b", "a", "c"}, values)
+	})
+}
@@ -668,6 +668,123 @@ func dictTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return stringTrimV2(e, bind, chunk, ref)
 }
 
+func dictReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replace`")
+	}
+
+	return stringReplaceV2(e, bind, chunk, ref)
+}
+
+func dictStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `startsWith`")
+	}
+
+	return stringStartsWithV2(e, bind, chunk, ref)
+}
+
+func dictEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `endsWith`")
+	}
+
+	return stringEndsWithV2(e, bind, chunk, ref)
+}
+
+func dictPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padLeft`")
+	}
+
+	return stringPadLeftV2(e, bind, chunk, ref)
+}
+
+func dictPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padRight`")
+	}
+
+	return stringPadRightV2(e, bind, chunk, ref)
+}
+
+func dictMd5V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `md5`")
+	}
+
+	return stringMd5V2(e, bind, chunk, ref)
+}
+
+func dictSha1V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `sha1`")
+	}
+
+	return stringSha1V2(e, bind, chunk, ref)
+}
+
+func dictSha256V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `sha256`")
+	}
+
+	return stringSha256V2(e, bind, chunk, ref)
+}
+
+func dictSha512V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `sha512`")
+	}
+
+	return stringSha512V2(e, bind, chunk, ref)
+}
+
+func dictBase64EncodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `base64Encode`")
+	}
+
+	return stringBase64EncodeV2(e, bind, chunk, ref)
+}
+
+func dictBase64DecodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `base64Decode`")
+	}
+
+	return stringBase64DecodeV2(e, bind, chunk, ref)
+}
+
+func dictHexEncodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `hexEncode`")
+	}
+
+	return stringHexEncodeV2(e, bind, chunk, ref)
+}
+
+func dictHexDecodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `hexDecode`")
+	}
+
+	return stringHexDecodeV2(e, bind, chunk, ref)
+}
+
 func dictKeysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
 		return &RawData{
@@ -4,13 +4,21 @@
 package llx
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"hash"
 	"io"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"go.mondoo.com/mql/v13/types"
 )
@@ -2444,6 +2452,192 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+// stringArgV2 resolves the string argument at the given index of a call
+func stringArgV2(e *blockExecutor, chunk *Chunk, ref uint64, idx int, name string) (string, uint64, error) {
+	arg, rref, err := e.resolveValue(chunk.Function.Args[idx], ref)
+	if err != nil || rref > 0 {
+		return "", rref, err
+	}
+
+	if arg.Value == nil {
+		return "", 0, errors.New(name + " was null")
+	}
+	res, ok := arg.Value.(string)
+	if !ok {
+		return "", 0, errors.New(name + " must be a string")
+	}
+	return res, 0, nil
+}
+
+func stringReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	old, rref, err := stringArgV2(e, chunk, ref, 0, "string to replace")
+	if err != nil || rref > 0 {
+		return &RawData{Type: types.String, Error: err}, rref, nil
+	}
+	nu, rref, err := stringArgV2(e, chunk, ref, 1, "replacement")
+	if err != nil || rref > 0 {
+		return &RawData{Type: types.String, Error: err}, rref, nil
+	}
+
+	res := strings.ReplaceAll(bind.Value.(string), old, nu)
+	return StringData(res), 0, nil
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	prefix, rref, err := stringArgV2(e, chunk, ref, 0, "prefix")
+	if err != nil || rref > 0 {
+		return &RawData{Type: types.Bool, Error: err}, rref, nil
+	}
+
+	return BoolData(strings.HasPrefix(bind.Value.(string), prefix)), 0, nil
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	suffix, rref, err := stringArgV2(e, chunk, ref, 0, "suffix")
+	if err != nil || rref > 0 {
+		return &RawData{Type: types.Bool, Error: err}, rref, nil
+	}
+
+	return BoolData(strings.HasSuffix(bind.Value.(string), suffix)), 0, nil
+}
+
+// padString pads s with the given padding until it is width characters long.
+// The padding is repeated and cut off as needed. Strings that are already
+// long enough are returned unchanged.
+func padString(s string, width int, padding string, left bool) string {
+	missing := width - utf8.RuneCountInString(s)
+	if missing <= 0 || padding == "" {
+		return s
+	}
+
+	pad := []rune(strings.Repeat(padding, missing/utf8.RuneCountInString(padding)+1))[:missing]
+	if left {
+		return string(pad) + s
+	}
+	return s + string(pad)
+}
+
+func stringPadV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, left bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	widthRaw, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	width, ok := widthRaw.Value.(int64)
+	if !ok {
+		return &RawData{Type: types.String, Error: errors.New("width for padding must be an int")}, 0, nil
+	}
+
+	padding := " "
+	if len(chunk.Function.Args) > 1 {
+		padding, rref, err = stringArgV2(e, chunk, ref, 1, "padding")
+		if err != nil || rref > 0 {
+			return &RawData{Type: types.String, Error: err}, rref, nil
+		}
+	}
+
+	return StringData(padString(bind.Value.(string), int(width), padding, left)), 0, nil
+}
+
+func stringPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringPadV2(e, bind, chunk, ref, true)
+}
+
+func stringPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringPadV2(e, bind, chunk, ref, false)
+}
+
+// hashes and encodings work on the raw bytes of a string
+
+func stringHashV2(bind *RawData, h hash.Hash) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	h.Write([]byte(bind.Value.(string)))
+	return StringData(hex.EncodeToString(h.Sum(nil))), 0, nil
+}
+
+func stringMd5V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringHashV2(bind, md5.New())
+}
+
+func stringSha1V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringHashV2(bind, sha1.New())
+}
+
+func stringSha256V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringHashV2(bind, sha256.New())
+}
+
+func stringSha512V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return stringHashV2(bind, sha512.New())
+}
+
+func stringBase64EncodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	return StringData(base64.StdEncoding.EncodeToString([]byte(bind.Value.(string)))), 0, nil
+}
+
+// decodeBase64 decodes standard and URL-safe base64, with or without padding
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, "-_") {
+		return base64.URLEncoding.WithPadding(base64.NoPadding).DecodeString(strings.TrimRight(s, "="))
+	}
+	return base64.StdEncoding.WithPadding(base64.NoPadding).DecodeString(strings.TrimRight(s, "="))
+}
+
+func stringBase64DecodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	res, err := decodeBase64(bind.Value.(string))
+	if err != nil {
+		return &RawData{Type: types.String, Error: errors.New("failed to decode base64: " + err.Error())}, 0, nil
+	}
+	return StringData(string(res)), 0, nil
+}
+
+func stringHexEncodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	return StringData(hex.EncodeToString([]byte(bind.Value.(string)))), 0, nil
+}
+
+func stringHexDecodeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	res, err := hex.DecodeString(strings.TrimSpace(bind.Value.(string)))
+	if err != nil {
+		return &RawData{Type: types.String, Error: errors.New("failed to decode hex: " + err.Error())}, 0, nil
+	}
+	return StringData(string(res)), 0, nil
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
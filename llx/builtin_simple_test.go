@@ -0,0 +1,78 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func TestPadString(t *testing.T) {
+	assert.Equal(t, "007", padString("7", 3, "0", true))
+	assert.Equal(t, "7  ", padString("7", 3, " ", false))
+	assert.Equal(t, "abab7", padString("7", 5, "ab", true))
+	assert.Equal(t, "7aba", padString("7", 4, "ab", false))
+	assert.Equal(t, "ü--", padString("ü", 3, "-", false))
+	assert.Equal(t, "hello", padString("hello", 3, "0", true))
+	assert.Equal(t, "7", padString("7", 3, "", true))
+}
+
+func TestDecodeBase64(t *testing.T) {
+	for _, in := range []string{"aGk/Pz4+", "aGk_Pz4-", "aGk/Pz4+\n", "aGk/Pz4"} {
+		res, err := decodeBase64(in)
+		require.NoError(t, err, in)
+		assert.Contains(t, string(res), "hi??")
+	}
+
+	_, err := decodeBase64("not base64!")
+	assert.Error(t, err)
+}
+
+func TestStringHashes(t *testing.T) {
+	tests := []struct {
+		f        func(*blockExecutor, *RawData, *Chunk, uint64) (*RawData, uint64, error)
+		expected string
+	}{
+		{stringMd5V2, "d41d8cd98f00b204e9800998ecf8427e"},
+		{stringSha1V2, "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+		{stringSha256V2, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{stringSha512V2, "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"},
+	}
+	for _, test := range tests {
+		res, _, err := test.f(nil, StringData(""), nil, 0)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, res.Value)
+	}
+
+	res, _, err := stringSha256V2(nil, &RawData{Type: types.String}, nil, 0)
+	require.NoError(t, err)
+	assert.Nil(t, res.Value)
+}
+
+func TestStringEncodings(t *testing.T) {
+	raw := string([]byte{0x00, 0xff, 'h', 'i'})
+
+	res, _, err := stringHexEncodeV2(nil, StringData(raw), nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "00ff6869", res.Value)
+
+	res, _, err = stringHexDecodeV2(nil, StringData("00ff6869"), nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, raw, res.Value)
+
+	res, _, err = stringBase64EncodeV2(nil, StringData(raw), nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "AP9oaQ==", res.Value)
+
+	res, _, err = stringBase64DecodeV2(nil, StringData("AP9oaQ=="), nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, raw, res.Value)
+
+	res, _, err = stringHexDecodeV2(nil, StringData("xyz"), nil, 0)
+	require.NoError(t, err)
+	assert.Error(t, res.Error)
+}
@@ -124,6 +124,8 @@ type MQLExecutorV2 struct {
 	runtime Runtime
 	code    *CodeV2
 	props   map[string]*Primitive
+	// profiler is set if the runtime collects a profile
+	profiler *Profiler
 
 	lock           sync.Mutex
 	blockExecutors []*blockExecutor
@@ -166,6 +168,9 @@ func NewExecutorV2(code *CodeV2, runtime Runtime, props map[string]*Primitive, c
 		props:          props,
 		blockExecutors: []*blockExecutor{},
 	}
+	if profiled, ok := runtime.(ProfiledRuntime); ok {
+		res.profiler = profiled.Profiler()
+	}
 
 	exec, err := res._newBlockExecutor(1<<32, callback, nil)
 	if err != nil {
@@ -709,7 +714,11 @@ func (b *blockExecutor) createResource(name string, binding uint64, f *Function,
 		return nil, rref, err
 	}
 
+	start := time.Now()
 	resource, err := runtime.CreateResource(name, args)
+	if _, ok := err.(resources.NotReadyError); !ok {
+		b.ctx.profiler.ExecutorCall(name, "", time.Since(start))
+	}
 	if err != nil {
 		// in case it's not something that requires later loading, store the error
 		// so that consecutive steps can retrieve it cached
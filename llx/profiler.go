@@ -0,0 +1,211 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// CallStats are the collected timings of one resource or resource field.
+// Calls and Duration are tracked by the executor, all other values are
+// tracked by the runtime that talks to providers.
+type CallStats struct {
+	// Calls is the number of times the executor requested this resource or field
+	Calls int64
+	// Duration is the time the executor waited for this resource or field
+	Duration time.Duration
+	// CacheHits is the number of requests answered from the recording
+	CacheHits int64
+	// ProviderCalls is the number of requests that were sent to a provider
+	ProviderCalls int64
+	// ProviderTime is the time spent in provider calls
+	ProviderTime time.Duration
+	// Bytes is the size of all data returned by providers
+	Bytes int64
+	// Errors is the number of calls that returned an error
+	Errors int64
+}
+
+// Add another set of stats to these stats
+func (c *CallStats) Add(other CallStats) {
+	c.Calls += other.Calls
+	c.Duration += other.Duration
+	c.CacheHits += other.CacheHits
+	c.ProviderCalls += other.ProviderCalls
+	c.ProviderTime += other.ProviderTime
+	c.Bytes += other.Bytes
+	c.Errors += other.Errors
+}
+
+// Time is the best known time spent on this call, i.e. the executor's time
+// if it was tracked and the provider's time otherwise
+func (c CallStats) Time() time.Duration {
+	if c.Duration > c.ProviderTime {
+		return c.Duration
+	}
+	return c.ProviderTime
+}
+
+// FieldProfile has the stats of one field of a resource
+type FieldProfile struct {
+	Name  string
+	Stats CallStats
+}
+
+// ResourceProfile has the stats of one resource. Init are the stats of the
+// resource's creation, Total combines them with the stats of all fields.
+type ResourceProfile struct {
+	Name   string
+	Init   CallStats
+	Total  CallStats
+	Fields []FieldProfile
+}
+
+// Profiler collects per-resource and per-field stats of resource calls.
+// All methods are safe to call on a nil profiler, which makes profiling
+// optional for executors and runtimes.
+type Profiler struct {
+	lock sync.Mutex
+	// resource => field => stats, where the empty field is the resource init
+	resources map[string]map[string]*CallStats
+}
+
+func NewProfiler() *Profiler {
+	return &Profiler{
+		resources: map[string]map[string]*CallStats{},
+	}
+}
+
+// ProfiledRuntime is implemented by runtimes that collect a profile. The
+// executor adds its timings to the same profiler.
+type ProfiledRuntime interface {
+	Profiler() *Profiler
+}
+
+func (p *Profiler) update(resource string, field string, f func(stats *CallStats)) {
+	if p == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	fields, ok := p.resources[resource]
+	if !ok {
+		fields = map[string]*CallStats{}
+		p.resources[resource] = fields
+	}
+	stats, ok := fields[field]
+	if !ok {
+		stats = &CallStats{}
+		fields[field] = stats
+	}
+	f(stats)
+}
+
+// ExecutorCall tracks a resource or field request of the executor. Use an
+// empty field for the creation of a resource.
+func (p *Profiler) ExecutorCall(resource string, field string, duration time.Duration) {
+	p.update(resource, field, func(stats *CallStats) {
+		stats.Calls++
+		stats.Duration += duration
+	})
+}
+
+// ProviderCall tracks a request that was sent to a provider
+func (p *Profiler) ProviderCall(resource string, field string, duration time.Duration, bytes int, err error) {
+	p.update(resource, field, func(stats *CallStats) {
+		stats.ProviderCalls++
+		stats.ProviderTime += duration
+		stats.Bytes += int64(bytes)
+		if err != nil {
+			stats.Errors++
+		}
+	})
+}
+
+// ProviderError tracks an error that a provider returned as data
+func (p *Profiler) ProviderError(resource string, field string) {
+	p.update(resource, field, func(stats *CallStats) {
+		stats.Errors++
+	})
+}
+
+// CacheHit tracks a request that didn't have to be sent to a provider
+func (p *Profiler) CacheHit(resource string, field string) {
+	p.update(resource, field, func(stats *CallStats) {
+		stats.CacheHits++
+	})
+}
+
+// Reset removes all collected stats
+func (p *Profiler) Reset() {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	p.resources = map[string]map[string]*CallStats{}
+	p.lock.Unlock()
+}
+
+// WalkSorted calls f for every resource and field in alphabetical order.
+// Resources are called with an empty field first.
+func (p *Profiler) WalkSorted(f func(resource string, field string, stats CallStats)) {
+	if p == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	resources := make([]string, 0, len(p.resources))
+	for name := range p.resources {
+		resources = append(resources, name)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		m := p.resources[resource]
+		fields := make([]string, 0, len(m))
+		for name := range m {
+			fields = append(fields, name)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			f(resource, field, *m[field])
+		}
+	}
+}
+
+// Profile returns all resources with their fields, starting with the
+// resources and fields that took the most time
+func (p *Profiler) Profile() []ResourceProfile {
+	var res []ResourceProfile
+	p.WalkSorted(func(resource string, field string, stats CallStats) {
+		if len(res) == 0 || res[len(res)-1].Name != resource {
+			res = append(res, ResourceProfile{Name: resource})
+		}
+		cur := &res[len(res)-1]
+		cur.Total.Add(stats)
+		if field == "" {
+			cur.Init = stats
+			return
+		}
+		cur.Fields = append(cur.Fields, FieldProfile{Name: field, Stats: stats})
+	})
+
+	for i := range res {
+		fields := res[i].Fields
+		sort.SliceStable(fields, func(a, b int) bool {
+			return fields[a].Stats.Time() > fields[b].Stats.Time()
+		})
+	}
+	sort.SliceStable(res, func(a, b int) bool {
+		return res[a].Total.Time() > res[b].Total.Time()
+	})
+	return res
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiler(t *testing.T) {
+	p := NewProfiler()
+	p.ExecutorCall("packages", "", time.Millisecond)
+	p.ProviderCall("packages", "", time.Millisecond, 10, nil)
+	p.ExecutorCall("packages", "list", 5*time.Second)
+	p.ProviderCall("packages", "list", 4*time.Second, 2048, nil)
+	p.ExecutorCall("packages", "list", time.Millisecond)
+	p.CacheHit("packages", "list")
+	p.ExecutorCall("os", "name", 2*time.Second)
+	p.ProviderCall("os", "name", 2*time.Second, 0, errors.New("failed"))
+	p.ExecutorCall("os", "hostname", 4*time.Second)
+	p.ProviderCall("os", "hostname", 4*time.Second, 8, nil)
+	p.ProviderError("os", "hostname")
+
+	profile := p.Profile()
+	require.Len(t, profile, 2)
+
+	// resources and fields with the most time come first
+	assert.Equal(t, "os", profile[0].Name)
+	assert.Equal(t, []string{"hostname", "name"}, []string{profile[0].Fields[0].Name, profile[0].Fields[1].Name})
+	assert.Equal(t, CallStats{
+		Calls:         2,
+		Duration:      6 * time.Second,
+		ProviderCalls: 2,
+		ProviderTime:  6 * time.Second,
+		Bytes:         8,
+		Errors:        2,
+	}, profile[0].Total)
+
+	packages := profile[1]
+	assert.Equal(t, "packages", packages.Name)
+	assert.Equal(t, int64(1), packages.Init.Calls)
+	assert.Equal(t, int64(10), packages.Init.Bytes)
+	require.Len(t, packages.Fields, 1)
+	assert.Equal(t, CallStats{
+		Calls:         2,
+		Duration:      5*time.Second + time.Millisecond,
+		CacheHits:     1,
+		ProviderCalls: 1,
+		ProviderTime:  4 * time.Second,
+		Bytes:         2048,
+	}, packages.Fields[0].Stats)
+	assert.Equal(t, 5*time.Second+2*time.Millisecond, packages.Total.Time())
+
+	p.Reset()
+	assert.Empty(t, p.Profile())
+}
+
+func TestProfiler_Nil(t *testing.T) {
+	var p *Profiler
+	p.ExecutorCall("os", "name", time.Second)
+	p.ProviderCall("os", "name", time.Second, 1, nil)
+	p.CacheHit("os", "name")
+	assert.Empty(t, p.Profile())
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// AssetsDiscovered counts the assets that were added to a scan by discovery
+	AssetsDiscovered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mql_assets_discovered_total",
+		Help: "Number of assets that were discovered",
+	}, []string{"platform"})
+
+	// ConnectionFailures counts the assets that couldn't be connected to
+	ConnectionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mql_connection_failures_total",
+		Help: "Number of assets that could not be connected to",
+	}, []string{"connector"})
+
+	// ProviderRestarts counts the providers that were restarted after a crash
+	ProviderRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mql_provider_restarts_total",
+		Help: "Number of provider restarts after a crash",
+	}, []string{"provider"})
+
+	// QueryDuration observes the time it takes to execute queries
+	QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mql_query_duration_seconds",
+		Help:    "Time it takes to execute a query",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	}, []string{"status"})
+
+	// ResourceFetchErrors counts the resources and fields that providers
+	// failed to fetch
+	ResourceFetchErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mql_resource_fetch_errors_total",
+		Help: "Number of resources and fields that providers failed to fetch",
+	}, []string{"provider", "resource"})
+)
+
+// Query statuses for QueryDuration
+const (
+	QuerySucceeded = "success"
+	QueryFailed    = "error"
+	QueryTimedOut  = "timeout"
+)
+
+func registerCollectors(registry *prometheus.Registry) {
+	registry.MustRegister(
+		AssetsDiscovered,
+		ConnectionFailures,
+		ProviderRestarts,
+		QueryDuration,
+		ResourceFetchErrors,
+		// the stats of all resource calls, see RegisterProfiler
+		profilers,
+	)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mondoo.com/mql/v13/llx"
+)
+
+var resourceLabels = []string{"resource", "field"}
+
+var (
+	resourceCallsDesc = prometheus.NewDesc("mql_resource_calls_total",
+		"Number of resource and field requests of the executor", resourceLabels, nil)
+	resourceDurationDesc = prometheus.NewDesc("mql_resource_duration_seconds_total",
+		"Time the executor waited for resources and fields", resourceLabels, nil)
+	resourceCacheHitsDesc = prometheus.NewDesc("mql_resource_cache_hits_total",
+		"Number of resource and field requests that were answered from the recording", resourceLabels, nil)
+	resourceProviderCallsDesc = prometheus.NewDesc("mql_resource_provider_calls_total",
+		"Number of resource and field requests that were sent to providers", resourceLabels, nil)
+	resourceProviderDurationDesc = prometheus.NewDesc("mql_resource_provider_duration_seconds_total",
+		"Time spent in provider calls for resources and fields", resourceLabels, nil)
+	resourceBytesDesc = prometheus.NewDesc("mql_resource_bytes_total",
+		"Size of the data that providers returned for resources and fields", resourceLabels, nil)
+	resourceErrorsDesc = prometheus.NewDesc("mql_resource_errors_total",
+		"Number of resource and field requests that returned an error", resourceLabels, nil)
+)
+
+type statsKey struct{ resource, field string }
+
+// profilerCollector exports the stats of all registered profilers
+type profilerCollector struct {
+	lock      sync.Mutex
+	profilers map[*llx.Profiler]struct{}
+	// stats of profilers that were unregistered, they are kept so that the
+	// exported counters never decrease
+	retired map[statsKey]*llx.CallStats
+}
+
+var profilers = &profilerCollector{
+	profilers: map[*llx.Profiler]struct{}{},
+	retired:   map[statsKey]*llx.CallStats{},
+}
+
+// RegisterProfiler adds the stats of a profiler to the metrics. Call the
+// returned function once the profiler is no longer used, e.g. at the end of a
+// run or when the shell is closed.
+func RegisterProfiler(profiler *llx.Profiler) (unregister func()) {
+	if profiler == nil {
+		return func() {}
+	}
+	profilers.lock.Lock()
+	profilers.profilers[profiler] = struct{}{}
+	profilers.lock.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			profilers.lock.Lock()
+			defer profilers.lock.Unlock()
+			delete(profilers.profilers, profiler)
+			addStats(profilers.retired, profiler)
+		})
+	}
+}
+
+func addStats(stats map[statsKey]*llx.CallStats, profiler *llx.Profiler) {
+	profiler.WalkSorted(func(resource string, field string, cur llx.CallStats) {
+		k := statsKey{resource, field}
+		if _, ok := stats[k]; !ok {
+			stats[k] = &llx.CallStats{}
+		}
+		stats[k].Add(cur)
+	})
+}
+
+func (c *profilerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resourceCallsDesc
+	ch <- resourceDurationDesc
+	ch <- resourceCacheHitsDesc
+	ch <- resourceProviderCallsDesc
+	ch <- resourceProviderDurationDesc
+	ch <- resourceBytesDesc
+	ch <- resourceErrorsDesc
+}
+
+func (c *profilerCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	stats := make(map[statsKey]*llx.CallStats, len(c.retired))
+	for k, v := range c.retired {
+		cur := *v
+		stats[k] = &cur
+	}
+	for profiler := range c.profilers {
+		addStats(stats, profiler)
+	}
+	c.lock.Unlock()
+
+	for k, v := range stats {
+		ch <- prometheus.MustNewConstMetric(resourceCallsDesc, prometheus.CounterValue, float64(v.Calls), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceDurationDesc, prometheus.CounterValue, v.Duration.Seconds(), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceCacheHitsDesc, prometheus.CounterValue, float64(v.CacheHits), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceProviderCallsDesc, prometheus.CounterValue, float64(v.ProviderCalls), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceProviderDurationDesc, prometheus.CounterValue, v.ProviderTime.Seconds(), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceBytesDesc, prometheus.CounterValue, float64(v.Bytes), k.resource, k.field)
+		ch <- prometheus.MustNewConstMetric(resourceErrorsDesc, prometheus.CounterValue, float64(v.Errors), k.resource, k.field)
+	}
+}
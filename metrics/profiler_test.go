@@ -0,0 +1,57 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+)
+
+func collectCalls(t *testing.T, c *profilerCollector) float64 {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(c))
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	res := 0.0
+	for _, family := range families {
+		if family.GetName() != "mql_resource_calls_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			res += m.GetCounter().GetValue()
+		}
+	}
+	return res
+}
+
+func TestRegisterProfiler(t *testing.T) {
+	c := &profilerCollector{
+		profilers: map[*llx.Profiler]struct{}{},
+		retired:   map[statsKey]*llx.CallStats{},
+	}
+	saved := profilers
+	profilers = c
+	defer func() { profilers = saved }()
+
+	first := llx.NewProfiler()
+	first.ExecutorCall("packages", "list", time.Second)
+	unregister := RegisterProfiler(first)
+	second := llx.NewProfiler()
+	second.ExecutorCall("packages", "list", time.Second)
+	RegisterProfiler(second)
+	assert.Equal(t, 2.0, collectCalls(t, c))
+
+	// counters keep the stats of unregistered profilers
+	unregister()
+	unregister()
+	assert.Len(t, c.profilers, 1)
+	first.ExecutorCall("packages", "list", time.Second)
+	assert.Equal(t, 2.0, collectCalls(t, c))
+}
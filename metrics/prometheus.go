@@ -4,9 +4,12 @@
 package metrics
 
 import (
+	"net"
 	"net/http"
 	"os"
+	"sync"
 
+	"github.com/cockroachdb/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -16,12 +19,50 @@ import (
 // This setting matches what we have inside 'prometheus.yml' config
 const prometheusDefaultAddr = ":2112"
 
-// Start starts a metrics server only when this app is run on debug mode. It has basic metric
-// collectors that captures things like number of goroutines or cpu and memory utilization.
+var (
+	serverLock sync.Mutex
+	serverAddr string
+)
+
+// Enabled is true if the metrics server is started
+func Enabled() bool {
+	serverLock.Lock()
+	defer serverLock.Unlock()
+	return serverAddr != ""
+}
+
+// Start starts a metrics server only when this app is run on debug mode.
 func Start() {
 	if os.Getenv("DEBUG") != "1" {
 		return // not in debug mode
 	}
+	if err := StartServer(prometheusDefaultAddr); err != nil {
+		log.Error().Err(err).Msg("failed to start metrics server")
+	}
+}
+
+// StartServer serves all metrics on the given address in the background. It
+// has basic metric collectors that capture things like the number of
+// goroutines or cpu and memory utilization, as well as the metrics of assets,
+// providers, queries and resource calls. Only one server is started, calls
+// with the address of the running server do nothing. An error is returned if
+// a server already runs on another address, e.g. because DEBUG=1 started it,
+// or if the address cannot be listened on.
+func StartServer(addr string) error {
+	serverLock.Lock()
+	defer serverLock.Unlock()
+	if serverAddr == addr {
+		return nil
+	}
+	if serverAddr != "" {
+		return errors.New("metrics server is already running on " + serverAddr + ", cannot start it on " + addr)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen on metrics address "+addr)
+	}
+	serverAddr = addr
 
 	// Create a custom Prometheus registry to avoid global conflicts
 	registry := prometheus.NewRegistry()
@@ -31,14 +72,17 @@ func Start() {
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
-
-	// Register custom metrics here, if needed
+	registerCollectors(registry)
 
 	// Serve metrics using the custom registry
-	http.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
 
-	log.Info().Str("addr", prometheusDefaultAddr).Msg("Starting prometheus metrics server")
-	if err := http.ListenAndServe(prometheusDefaultAddr, nil); err != nil {
-		log.Fatal().Err(err).Msg("Error starting HTTP server")
-	}
+	log.Info().Str("addr", addr).Msg("Starting prometheus metrics server")
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			log.Error().Err(err).Str("addr", addr).Msg("metrics server stopped")
+		}
+	}()
+	return nil
 }
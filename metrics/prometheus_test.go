@@ -0,0 +1,63 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metrics
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+)
+
+func TestStartServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+
+	// an address that is in use is reported to the caller
+	assert.Error(t, StartServer(addr))
+	assert.False(t, Enabled())
+	require.NoError(t, l.Close())
+	require.NoError(t, StartServer(addr))
+	assert.True(t, Enabled())
+	// the running server is kept, other addresses are rejected
+	assert.NoError(t, StartServer(addr))
+	assert.Error(t, StartServer("127.0.0.1:0"))
+
+	AssetsDiscovered.WithLabelValues("ubuntu").Inc()
+	ConnectionFailures.WithLabelValues("ssh").Inc()
+	ProviderRestarts.WithLabelValues("os").Inc()
+	QueryDuration.WithLabelValues(QuerySucceeded).Observe(0.5)
+	ResourceFetchErrors.WithLabelValues("os", "packages").Inc()
+
+	profiler := llx.NewProfiler()
+	profiler.ExecutorCall("packages", "list", time.Second)
+	profiler.ProviderCall("packages", "list", time.Second, 1024, nil)
+	RegisterProfiler(profiler)
+
+	var body string
+	require.Eventually(t, func() bool {
+		res, err := http.Get("http://" + addr + "/metrics")
+		if err != nil {
+			return false
+		}
+		defer res.Body.Close()
+		data, err := io.ReadAll(res.Body)
+		body = string(data)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.Contains(t, body, `mql_assets_discovered_total{platform="ubuntu"} 1`)
+	assert.Contains(t, body, `mql_connection_failures_total{connector="ssh"} 1`)
+	assert.Contains(t, body, `mql_provider_restarts_total{provider="os"} 1`)
+	assert.Contains(t, body, `mql_query_duration_seconds_count{status="success"} 1`)
+	assert.Contains(t, body, `mql_resource_fetch_errors_total{provider="os",resource="packages"} 1`)
+	assert.Contains(t, body, `mql_resource_calls_total{field="list",resource="packages"} 1`)
+	assert.Contains(t, body, `mql_resource_bytes_total{field="list",resource="packages"} 1024`)
+}
@@ -111,6 +111,58 @@ func init() {
 				typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}},
 				desc: "Remove all surrounding whitespaces (including newlines and tabs)",
 			},
+			"replace": {
+				typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}},
+				desc: "Replace all occurrences of a substring with another string",
+			},
+			"startsWith": {
+				typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}},
+				desc: "Checks if this string starts with the given prefix",
+			},
+			"endsWith": {
+				typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}},
+				desc: "Checks if this string ends with the given suffix",
+			},
+			"padLeft": {
+				typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}},
+				desc: "Pad the string on the left to the given length (with spaces by default)",
+			},
+			"padRight": {
+				typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}},
+				desc: "Pad the string on the right to the given length (with spaces by default)",
+			},
+			"md5": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Get the hex-encoded MD5 checksum of this string's bytes",
+			},
+			"sha1": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Get the hex-encoded SHA-1 checksum of this string's bytes",
+			},
+			"sha256": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Get the hex-encoded SHA-256 checksum of this string's bytes",
+			},
+			"sha512": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Get the hex-encoded SHA-512 checksum of this string's bytes",
+			},
+			"base64Encode": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Encode this string's bytes as base64",
+			},
+			"base64Decode": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Decode a base64 string (standard or URL-safe, padding is optional)",
+			},
+			"hexEncode": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Encode this string's bytes as hex",
+			},
+			"hexDecode": {
+				typ: stringType, signature: FunctionSignature{},
+				desc: "Decode a hex-encoded string",
+			},
 		},
 		types.Time: {
 			"seconds": {typ: intType, signature: FunctionSignature{}},
@@ -134,6 +186,20 @@ func init() {
 			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
 			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
 			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			// string transformations, hashes and encodings
+			"replace":      {typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+			"startsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":     {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"padLeft":      {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"padRight":     {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"md5":          {typ: stringType, signature: FunctionSignature{}},
+			"sha1":         {typ: stringType, signature: FunctionSignature{}},
+			"sha256":       {typ: stringType, signature: FunctionSignature{}},
+			"sha512":       {typ: stringType, signature: FunctionSignature{}},
+			"base64Encode": {typ: stringType, signature: FunctionSignature{}},
+			"base64Decode": {typ: stringType, signature: FunctionSignature{}},
+			"hexEncode":    {typ: stringType, signature: FunctionSignature{}},
+			"hexDecode":    {typ: stringType, signature: FunctionSignature{}},
 			// string / array
 			"in":    {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Array(types.String), types.Array(types.Dict)}}},
 			"notIn": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Array(types.String), types.Array(types.Dict)}}},
@@ -214,6 +280,15 @@ func init() {
 			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
 			"reverse":      {typHandler: &sameType, signature: FunctionSignature{}},
 			"join":         {compile: compileArrayJoin, signature: FunctionSignature{Args: []types.Type{types.String}}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{}},
+			"sortBy":       {compile: compileArraySortBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"sum":          {compile: compileArraySum, signature: FunctionSignature{}},
+			"avg":          {compile: compileArrayAvg, signature: FunctionSignature{}},
+			"take":         {typHandler: &sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typHandler: &sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
 		},
 		types.MapLike: {
 			"[]":       {typHandler: &childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
@@ -242,6 +317,10 @@ func init() {
 			"none":                     {compile: compileResourceNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"having":                   {compile: compileResourceHaving, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":                      {compile: compileResourceMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"sortBy":                   {compile: compileResourceListCall, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":                  {compile: compileResourceListCall, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"take":                     {compile: compileResourceListCall, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":                     {compile: compileResourceListCall, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
 			"==" + string(types.Empty): {compile: compileResourceCmpEmpty},
 			"!=" + string(types.Empty): {compile: compileResourceCmpEmpty},
 		},
@@ -590,3 +590,193 @@ func compileArrayJoin(c *compiler, typ types.Type, ref uint64, id string, call *
 
 	return types.String, nil
 }
+
+// orderedTypes are all the types whose values can be sorted and compared
+// against each other in arrays
+var orderedTypes = map[types.Type]struct{}{
+	types.Int:     {},
+	types.Float:   {},
+	types.String:  {},
+	types.Bool:    {},
+	types.Time:    {},
+	types.Version: {},
+	types.Dict:    {},
+	// empty arrays, e.g. []
+	types.Unset: {},
+}
+
+func isOrderedType(typ types.Type) bool {
+	_, ok := orderedTypes[typ]
+	return ok
+}
+
+func isNumberType(typ types.Type) bool {
+	return typ == types.Int || typ == types.Float || typ == types.Dict || typ == types.Unset
+}
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "', try using sortBy() instead")
+	}
+
+	if !isOrderedType(typ.Child()) {
+		return types.Nil, errors.New("cannot sort array of " + typ.Child().Label() + ", try using sortBy() with a field instead")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
+func compileArrayMinMax(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "'")
+	}
+
+	ct := typ.Child()
+	if !isOrderedType(ct) {
+		return types.Nil, errors.New("cannot call " + id + "() on array of " + ct.Label() + ", values can't be compared")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(ct),
+			Binding: ref,
+		},
+	})
+	return ct, nil
+}
+
+func compileArraySum(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "'")
+	}
+
+	ct := typ.Child()
+	if !isNumberType(ct) {
+		return types.Nil, errors.New("can only call " + id + "() on arrays of numbers (got: " + typ.Label() + ")")
+	}
+
+	resType := types.Float
+	if ct == types.Int {
+		resType = types.Int
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+		},
+	})
+	return resType, nil
+}
+
+func compileArrayAvg(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("no arguments supported for '" + id + "'")
+	}
+
+	if !isNumberType(typ.Child()) {
+		return types.Nil, errors.New("can only call " + id + "() on arrays of numbers (got: " + typ.Label() + ")")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.Float),
+			Binding: ref,
+		},
+	})
+	return types.Float, nil
+}
+
+// compileArrayKeyCall compiles calls which compute one key for every entry
+// of the array via a function block, e.g. sortBy(name) or groupBy(origin).
+// It calls resType with the key type to determine the type of the result.
+func compileArrayKeyCall(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call, resType func(keyType types.Type) (types.Type, error)) (types.Type, error) {
+	if call == nil || len(call.Function) == 0 {
+		return types.Nil, errors.New("missing argument for calling '" + id + "'")
+	}
+	if len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "', only 1 is supported")
+	}
+
+	arg := call.Function[0]
+	bindingName := "_"
+	if arg.Name != "" {
+		bindingName = arg.Name
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref, bindingName)
+	if err != nil {
+		return types.Nil, err
+	}
+	if refs.block == 0 {
+		return types.Nil, errors.New("called '" + id + "' without a function block")
+	}
+	ref = refs.binding
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return types.Nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+	keyType := c.Result.CodeV2.DereferencedBlockType(block)
+
+	res, err := resType(keyType)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(ref),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(res),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return res, nil
+}
+
+func compileArraySortBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayKeyCall(c, typ, ref, id, call, func(keyType types.Type) (types.Type, error) {
+		if !isOrderedType(keyType) {
+			return types.Nil, errors.New("cannot sort by values of type " + keyType.Label())
+		}
+		return typ, nil
+	})
+}
+
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	return compileArrayKeyCall(c, typ, ref, id, call, func(keyType types.Type) (types.Type, error) {
+		switch keyType {
+		case types.String, types.Dict, types.Float, types.Int, types.Bool, types.Regex, types.Version, types.Time, types.IP:
+			return types.Map(types.String, typ), nil
+		default:
+			return types.Nil, errors.New("cannot group by values of type " + keyType.Label() + ", keys must be turned into strings")
+		}
+	})
+}
@@ -311,6 +311,30 @@ func compileResourceMap(c *compiler, typ types.Type, ref uint64, id string, call
 	return types.Array(mappedType), nil
 }
 
+// compileResourceListCall compiles calls on list resources that are handled
+// by the array function of the same name, bound to the resource's list.
+func compileResourceListCall(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	resource, err := listResource(c, typ)
+	if err != nil {
+		return types.Nil, multierr.Wrap(err, "failed to compile "+id)
+	}
+
+	h, ok := builtinFunctions[types.ArrayLike][id]
+	if !ok {
+		return types.Nil, errors.New("cannot find function '" + id + "' for list resource '" + resource.Name + "'")
+	}
+
+	listType, err := compileResourceDefault(c, typ, ref, "list", nil)
+	if err != nil {
+		return listType, err
+	}
+
+	return c.compileBuiltinFunction(&h, id, &variable{
+		typ: types.Array(types.Type(resource.ListType)),
+		ref: c.tailRef(),
+	}, call)
+}
+
 func compileResourceContains(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	// resource.where
 	_, err := compileResourceWhere(c, typ, ref, "where", call)
@@ -952,6 +952,70 @@ func TestCompiler_ArrayWhereStatic(t *testing.T) {
 	})
 }
 
+func TestCompiler_ArraySortBy(t *testing.T) {
+	compileT(t, "[1,2,3].sortBy(_ * -1)", func(res *llx.CodeBundle) {
+		assertFunction(t, "sortBy", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 1),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "users.groupBy(shell)", func(res *llx.CodeBundle) {
+		assertFunction(t, "list", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "groupBy", &llx.Function{
+			Type:    string(types.Map(types.String, types.Array(types.Resource("user")))),
+			Binding: (1 << 32) | 2,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 2),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileErroneous(t, "[1,2].sortBy([_])", errors.New("cannot sort by values of type []int"), nil)
+}
+
+func TestCompiler_ArrayAggregations(t *testing.T) {
+	compileT(t, "[1,2,3].sum", func(res *llx.CodeBundle) {
+		assertFunction(t, "sum", &llx.Function{
+			Type:    string(types.Int),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "[1,2,3].avg", func(res *llx.CodeBundle) {
+		assertFunction(t, "avg", &llx.Function{
+			Type:    string(types.Float),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "['a','b'].max", func(res *llx.CodeBundle) {
+		assertFunction(t, "max", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "[1,2,3].take(2)", func(res *llx.CodeBundle) {
+		assertFunction(t, "take", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.IntPrimitive(2)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "['a'].sum", errors.New("can only call sum() on arrays of numbers (got: []string)"), nil)
+	compileErroneous(t, "[[1]].sort", errors.New("cannot sort array of []int, try using sortBy() with a field instead"), nil)
+}
+
 func TestCompiler_ArrayContains(t *testing.T) {
 	compileT(t, "[1,2,3].contains(_ == 2)", func(res *llx.CodeBundle) {
 		assertPrimitive(t, &llx.Primitive{
@@ -2130,7 +2194,7 @@ func TestSuggestions(t *testing.T) {
 		{
 			// list resource with empty field call
 			"users.",
-			[]string{"all", "any", "contains", "first", "having", "last", "length", "list", "map", "none", "one", "sample", "where"},
+			[]string{"all", "any", "contains", "first", "groupBy", "having", "last", "length", "list", "map", "none", "one", "sample", "skip", "sortBy", "take", "where"},
 			errors.New("incomplete query, missing identifier after '.' at <source>:1:7"),
 			nil,
 		},
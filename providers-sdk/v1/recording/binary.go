@@ -0,0 +1,375 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/llx"
+)
+
+// BinaryExtension selects the binary format when recordings are stored
+const BinaryExtension = ".mqlrec"
+
+// binaryMagic starts every binary recording. It is followed by a zstd stream
+// of BinaryEntry messages, each prefixed with its length as uvarint. The
+// stream is appended to while recording, so a recording that is interrupted
+// keeps all entries up to the last flush.
+var binaryMagic = []byte("MQLREC\x00\x01")
+
+// binaryFlushInterval is the number of entries after which the stream is
+// flushed to disk
+const binaryFlushInterval = 500
+
+// binaryFlushPeriod is the longest time that written entries stay in memory,
+// so that slow scans that produce few entries are still written to disk
+var binaryFlushPeriod = 5 * time.Second
+
+// IsBinaryPath returns true if recordings at this path are stored in the
+// binary format
+func IsBinaryPath(path string) bool {
+	return strings.HasSuffix(path, BinaryExtension)
+}
+
+func isBinaryRecording(raw []byte) bool {
+	return bytes.HasPrefix(raw, binaryMagic)
+}
+
+// binaryWriter appends entries to a binary recording
+type binaryWriter struct {
+	lock    sync.Mutex
+	file    *os.File
+	enc     *zstd.Encoder
+	assets  map[*Asset]uint32
+	pending int
+	done    chan struct{}
+}
+
+// createBinaryWriter writes a snapshot of the recording into a temporary file
+// and replaces the file at the given path with it once it is complete. An
+// existing recording thus stays intact until the new one has all its data.
+// The returned writer appends to the new file.
+func createBinaryWriter(path string, snapshot *recording) (*binaryWriter, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	assets := map[*Asset]uint32{}
+	if err := writeBinarySnapshot(tmp, assets, snapshot); err != nil {
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+
+	// zstd readers decode concatenated frames, so we can append new frames
+	// to the snapshot
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return nil, err
+	}
+	res, err := newBinaryWriter(file, assets)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	go res.flushPeriodically(binaryFlushPeriod)
+	return res, nil
+}
+
+// writeBinarySnapshot writes the magic and all data of the recording into
+// the file and closes it
+func writeBinarySnapshot(file *os.File, assets map[*Asset]uint32, snapshot *recording) error {
+	if _, err := file.Write(binaryMagic); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Chmod(0o644); err != nil {
+		file.Close()
+		return err
+	}
+	w, err := newBinaryWriter(file, assets)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	err = w.writeSnapshot(snapshot)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func newBinaryWriter(file *os.File, assets map[*Asset]uint32) (*binaryWriter, error) {
+	enc, err := zstd.NewWriter(file)
+	if err != nil {
+		return nil, err
+	}
+	return &binaryWriter{
+		file:   file,
+		enc:    enc,
+		assets: assets,
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// flushPeriodically writes pending entries to disk until the writer is closed
+func (w *binaryWriter) flushPeriodically(period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.lock.Lock()
+			if w.pending > 0 {
+				w.pending = 0
+				if err := w.enc.Flush(); err != nil {
+					log.Debug().Err(err).Msg("failed to flush recording")
+				}
+			}
+			w.lock.Unlock()
+		}
+	}
+}
+
+// write must be called with the lock held
+func (w *binaryWriter) write(e *BinaryEntry) error {
+	raw, err := e.MarshalVT()
+	if err != nil {
+		return err
+	}
+	if _, err := w.enc.Write(binary.AppendUvarint(nil, uint64(len(raw)))); err != nil {
+		return err
+	}
+	if _, err := w.enc.Write(raw); err != nil {
+		return err
+	}
+
+	w.pending++
+	if w.pending >= binaryFlushInterval {
+		w.pending = 0
+		return w.enc.Flush()
+	}
+	return nil
+}
+
+// assetIndex returns the index of the asset in the stream. Assets that are
+// new to the stream are written first.
+//
+// NOTE that this method must be called with the lock held
+func (w *binaryWriter) assetIndex(asset *Asset) (uint32, error) {
+	if idx, ok := w.assets[asset]; ok {
+		return idx, nil
+	}
+	idx := uint32(len(w.assets))
+	w.assets[asset] = idx
+	return idx, w.write(&BinaryEntry{Asset: idx, Info: asset.Asset})
+}
+
+// writeAsset writes the metadata and connections of an asset and flushes
+// them to disk right away
+func (w *binaryWriter) writeAsset(asset *Asset) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	idx, ok := w.assets[asset]
+	if ok {
+		// the metadata may have changed, e.g. if the asset got an MRN
+		if err := w.write(&BinaryEntry{Asset: idx, Info: asset.Asset}); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if idx, err = w.assetIndex(asset); err != nil {
+			return err
+		}
+	}
+
+	for _, conn := range asset.connections {
+		if err := w.write(&BinaryEntry{Asset: idx, Connection: binaryConnection(conn)}); err != nil {
+			return err
+		}
+	}
+	w.pending = 0
+	return w.enc.Flush()
+}
+
+// writeData writes fields of a resource and an optional ID lookup
+func (w *binaryWriter) writeData(asset *Asset, resource *llx.ResourceRecording, lookupKey string, lookupID string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	idx, err := w.assetIndex(asset)
+	if err != nil {
+		return err
+	}
+	return w.write(&BinaryEntry{
+		Asset:     idx,
+		Resource:  resource,
+		LookupKey: lookupKey,
+		LookupId:  lookupID,
+	})
+}
+
+// writeSnapshot writes the entire recording
+func (w *binaryWriter) writeSnapshot(r *recording) error {
+	for _, asset := range r.Assets {
+		if err := w.writeAsset(asset); err != nil {
+			return err
+		}
+		for _, resource := range asset.resources {
+			if err := w.writeData(asset, resourceRecording(resource), "", ""); err != nil {
+				return err
+			}
+		}
+		for key, id := range asset.IdsLookup {
+			if err := w.writeData(asset, nil, key, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *binaryWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	close(w.done)
+	err := w.enc.Close()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func binaryConnection(conn *connection) *BinaryConnection {
+	return &BinaryConnection{
+		Url:       conn.Url,
+		Provider:  conn.ProviderID,
+		Connector: conn.Connector,
+		Version:   conn.Version,
+		Id:        conn.Id,
+	}
+}
+
+func resourceRecording(resource *Resource) *llx.ResourceRecording {
+	fields := make(map[string]*llx.Result, len(resource.Fields))
+	for k, v := range resource.Fields {
+		fields[k] = v.Result()
+	}
+	return &llx.ResourceRecording{
+		Resource: resource.Resource,
+		Id:       resource.ID,
+		Fields:   fields,
+	}
+}
+
+// loadBinaryRecording reads all entries of a binary recording. Recordings
+// that were interrupted end with an incomplete entry, which is skipped.
+func loadBinaryRecording(raw []byte) (*recording, error) {
+	dec, err := zstd.NewReader(bytes.NewReader(raw[len(binaryMagic):]))
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+
+	res := &recording{binary: true}
+	reader := bufio.NewReader(dec)
+	cnt := 0
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			break
+		}
+		var buf []byte
+		if err == nil {
+			buf = make([]byte, size)
+			_, err = io.ReadFull(reader, buf)
+		}
+		if err != nil {
+			log.Warn().Err(err).Int("entries", cnt).Msg("recording is incomplete, using all entries up to this point")
+			break
+		}
+
+		e := &BinaryEntry{}
+		if err := e.UnmarshalVT(buf); err != nil {
+			return nil, errors.New("failed to read entry " + strconv.Itoa(cnt) + " of recording: " + err.Error())
+		}
+		if err := res.applyEntry(e); err != nil {
+			return nil, err
+		}
+		cnt++
+	}
+
+	res.finalize()
+	for _, asset := range res.Assets {
+		res.resyncAsset(asset)
+	}
+	return res, nil
+}
+
+func (r *recording) applyEntry(e *BinaryEntry) error {
+	if int(e.Asset) > len(r.Assets) {
+		return errors.New("invalid asset in recording: " + strconv.Itoa(int(e.Asset)))
+	}
+	if int(e.Asset) == len(r.Assets) {
+		if e.Info == nil {
+			return errors.New("missing metadata for asset in recording: " + strconv.Itoa(int(e.Asset)))
+		}
+		r.Assets = append(r.Assets, NewAssetRecording(e.Info))
+	}
+	asset := r.Assets[e.Asset]
+
+	if e.Info != nil {
+		asset.Asset = e.Info
+	}
+
+	if e.Connection != nil {
+		asset.connections[strconv.FormatUint(uint64(e.Connection.Id), 10)] = &connection{
+			Url:        e.Connection.Url,
+			ProviderID: e.Connection.Provider,
+			Connector:  e.Connection.Connector,
+			Version:    e.Connection.Version,
+			Id:         e.Connection.Id,
+		}
+	}
+
+	if e.Resource != nil {
+		key := e.Resource.Resource + "\x00" + e.Resource.Id
+		obj, ok := asset.resources[key]
+		if !ok {
+			obj = &Resource{
+				Resource: e.Resource.Resource,
+				ID:       e.Resource.Id,
+				Fields:   make(map[string]*llx.RawData, len(e.Resource.Fields)),
+			}
+			asset.resources[key] = obj
+		}
+		for field, data := range e.Resource.Fields {
+			obj.Fields[field] = data.RawData()
+		}
+	}
+
+	if e.LookupKey != "" {
+		asset.IdsLookup[e.LookupKey] = e.LookupId
+	}
+	return nil
+}
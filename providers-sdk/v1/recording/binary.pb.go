@@ -0,0 +1,269 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: providers-sdk/v1/recording/binary.proto
+
+package recording
+
+import (
+	llx "go.mondoo.com/mql/v13/llx"
+	inventory "go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BinaryEntry is a single entry in the stream of a binary recording
+type BinaryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index of the asset in the stream
+	Asset uint32 `protobuf:"varint,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// sets the asset's metadata
+	Info *inventory.Asset `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// adds a connection to the asset
+	Connection *BinaryConnection `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
+	// sets fields of a resource
+	Resource *llx.ResourceRecording `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	// adds an ID lookup
+	LookupKey     string `protobuf:"bytes,5,opt,name=lookup_key,json=lookupKey,proto3" json:"lookup_key,omitempty"`
+	LookupId      string `protobuf:"bytes,6,opt,name=lookup_id,json=lookupId,proto3" json:"lookup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryEntry) Reset() {
+	*x = BinaryEntry{}
+	mi := &file_providers_sdk_v1_recording_binary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryEntry) ProtoMessage() {}
+
+func (x *BinaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_providers_sdk_v1_recording_binary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryEntry.ProtoReflect.Descriptor instead.
+func (*BinaryEntry) Descriptor() ([]byte, []int) {
+	return file_providers_sdk_v1_recording_binary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BinaryEntry) GetAsset() uint32 {
+	if x != nil {
+		return x.Asset
+	}
+	return 0
+}
+
+func (x *BinaryEntry) GetInfo() *inventory.Asset {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *BinaryEntry) GetConnection() *BinaryConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+func (x *BinaryEntry) GetResource() *llx.ResourceRecording {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *BinaryEntry) GetLookupKey() string {
+	if x != nil {
+		return x.LookupKey
+	}
+	return ""
+}
+
+func (x *BinaryEntry) GetLookupId() string {
+	if x != nil {
+		return x.LookupId
+	}
+	return ""
+}
+
+type BinaryConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Connector     string                 `protobuf:"bytes,3,opt,name=connector,proto3" json:"connector,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Id            uint32                 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryConnection) Reset() {
+	*x = BinaryConnection{}
+	mi := &file_providers_sdk_v1_recording_binary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryConnection) ProtoMessage() {}
+
+func (x *BinaryConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_providers_sdk_v1_recording_binary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryConnection.ProtoReflect.Descriptor instead.
+func (*BinaryConnection) Descriptor() ([]byte, []int) {
+	return file_providers_sdk_v1_recording_binary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BinaryConnection) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BinaryConnection) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BinaryConnection) GetConnector() string {
+	if x != nil {
+		return x.Connector
+	}
+	return ""
+}
+
+func (x *BinaryConnection) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BinaryConnection) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_providers_sdk_v1_recording_binary_proto protoreflect.FileDescriptor
+
+const file_providers_sdk_v1_recording_binary_proto_rawDesc = "" +
+	"\n" +
+	"'providers-sdk/v1/recording/binary.proto\x12\x1amql.providers.v1.recording\x1a\rllx/llx.proto\x1a*providers-sdk/v1/inventory/inventory.proto\"\x96\x02\n" +
+	"\vBinaryEntry\x12\x14\n" +
+	"\x05asset\x18\x01 \x01(\rR\x05asset\x12/\n" +
+	"\x04info\x18\x02 \x01(\v2\x1b.cnquery.providers.v1.AssetR\x04info\x12L\n" +
+	"\n" +
+	"connection\x18\x03 \x01(\v2,.mql.providers.v1.recording.BinaryConnectionR\n" +
+	"connection\x126\n" +
+	"\bresource\x18\x04 \x01(\v2\x1a.mql.llx.ResourceRecordingR\bresource\x12\x1d\n" +
+	"\n" +
+	"lookup_key\x18\x05 \x01(\tR\tlookupKey\x12\x1b\n" +
+	"\tlookup_id\x18\x06 \x01(\tR\blookupId\"\x88\x01\n" +
+	"\x10BinaryConnection\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1c\n" +
+	"\tconnector\x18\x03 \x01(\tR\tconnector\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\rR\x02idB2Z0go.mondoo.com/mql/v13/providers-sdk/v1/recordingb\x06proto3"
+
+var (
+	file_providers_sdk_v1_recording_binary_proto_rawDescOnce sync.Once
+	file_providers_sdk_v1_recording_binary_proto_rawDescData []byte
+)
+
+func file_providers_sdk_v1_recording_binary_proto_rawDescGZIP() []byte {
+	file_providers_sdk_v1_recording_binary_proto_rawDescOnce.Do(func() {
+		file_providers_sdk_v1_recording_binary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_providers_sdk_v1_recording_binary_proto_rawDesc), len(file_providers_sdk_v1_recording_binary_proto_rawDesc)))
+	})
+	return file_providers_sdk_v1_recording_binary_proto_rawDescData
+}
+
+var file_providers_sdk_v1_recording_binary_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_providers_sdk_v1_recording_binary_proto_goTypes = []any{
+	(*BinaryEntry)(nil),           // 0: mql.providers.v1.recording.BinaryEntry
+	(*BinaryConnection)(nil),      // 1: mql.providers.v1.recording.BinaryConnection
+	(*inventory.Asset)(nil),       // 2: cnquery.providers.v1.Asset
+	(*llx.ResourceRecording)(nil), // 3: mql.llx.ResourceRecording
+}
+var file_providers_sdk_v1_recording_binary_proto_depIdxs = []int32{
+	2, // 0: mql.providers.v1.recording.BinaryEntry.info:type_name -> cnquery.providers.v1.Asset
+	1, // 1: mql.providers.v1.recording.BinaryEntry.connection:type_name -> mql.providers.v1.recording.BinaryConnection
+	3, // 2: mql.providers.v1.recording.BinaryEntry.resource:type_name -> mql.llx.ResourceRecording
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_providers_sdk_v1_recording_binary_proto_init() }
+func file_providers_sdk_v1_recording_binary_proto_init() {
+	if File_providers_sdk_v1_recording_binary_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_providers_sdk_v1_recording_binary_proto_rawDesc), len(file_providers_sdk_v1_recording_binary_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_providers_sdk_v1_recording_binary_proto_goTypes,
+		DependencyIndexes: file_providers_sdk_v1_recording_binary_proto_depIdxs,
+		MessageInfos:      file_providers_sdk_v1_recording_binary_proto_msgTypes,
+	}.Build()
+	File_providers_sdk_v1_recording_binary_proto = out.File
+	file_providers_sdk_v1_recording_binary_proto_goTypes = nil
+	file_providers_sdk_v1_recording_binary_proto_depIdxs = nil
+}
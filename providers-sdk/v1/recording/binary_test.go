@@ -0,0 +1,180 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+)
+
+func TestBinaryRecording_Convert(t *testing.T) {
+	rec, err := LoadRecordingFile("testdata/recording.json")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	binPath := filepath.Join(dir, "recording"+BinaryExtension)
+	require.NoError(t, rec.SaveTo(binPath, false))
+
+	raw, err := os.ReadFile(binPath)
+	require.NoError(t, err)
+	assert.True(t, isBinaryRecording(raw))
+
+	bin, err := LoadRecordingFile(binPath)
+	require.NoError(t, err)
+	require.Len(t, bin.Assets, len(rec.Assets))
+	assert.Equal(t, rec.Assets[0].Asset.Name, bin.Assets[0].Asset.Name)
+	assert.Equal(t, rec.Assets[0].Connections, bin.Assets[0].Connections)
+	assertSameResources(t, rec.Assets[0].Resources, bin.Assets[0].Resources)
+
+	// and back to JSON
+	jsonPath := filepath.Join(dir, "recording.json")
+	require.NoError(t, bin.SaveTo(jsonPath, false))
+	raw, err = os.ReadFile(jsonPath)
+	require.NoError(t, err)
+	assert.False(t, isBinaryRecording(raw))
+
+	back, err := LoadRecordingFile(jsonPath)
+	require.NoError(t, err)
+	assertSameResources(t, rec.Assets[0].Resources, back.Assets[0].Resources)
+}
+
+// assertSameResources compares recorded resources. Nil values may change
+// their type from unset to nil when they are converted.
+func assertSameResources(t *testing.T, expected []Resource, actual []Resource) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Resource, actual[i].Resource)
+		assert.Equal(t, expected[i].ID, actual[i].ID)
+		require.Len(t, actual[i].Fields, len(expected[i].Fields))
+		for field, data := range expected[i].Fields {
+			require.Contains(t, actual[i].Fields, field)
+			if data.Value == nil {
+				assert.Nil(t, actual[i].Fields[field].Value)
+				continue
+			}
+			assert.Equal(t, data, actual[i].Fields[field], expected[i].Resource+"."+field)
+		}
+	}
+}
+
+func TestBinaryRecording_Streaming(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scan"+BinaryExtension)
+	res, err := NewWithFile(path, RecordingOptions{DoRecord: true})
+	require.NoError(t, err)
+	r := res.(*recording)
+
+	asset := &inventory.Asset{
+		Mrn:         "asset-1",
+		PlatformIds: []string{"pid-1"},
+		Platform:    &inventory.Platform{Name: "debian"},
+	}
+	r.EnsureAsset(asset, "provider", 1, &inventory.Config{Type: "local", Id: 1})
+
+	// the asset is written right away
+	loaded, err := LoadRecordingFile(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Assets, 1)
+	assert.Equal(t, "asset-1", loaded.Assets[0].Asset.Mrn)
+	require.Len(t, loaded.Assets[0].Connections, 1)
+	assert.Equal(t, uint32(1), loaded.Assets[0].Connections[0].Id)
+
+	for i := 0; i < binaryFlushInterval; i++ {
+		id := strconv.Itoa(i)
+		r.AddData(llx.AddDataReq{
+			ConnectionID: 1, Resource: "package", ResourceID: id, RequestResourceId: id,
+			Field: "name", Data: llx.StringData("pkg-" + id),
+		})
+	}
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "os.base", ResourceID: "base", RequestResourceId: "",
+		Field: "hostname", Data: llx.StringData("debian"),
+	})
+
+	// data is flushed in batches, so an interrupted recording keeps the first
+	// batch
+	loaded, err = LoadRecordingFile(path)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Assets[0].Resources, binaryFlushInterval)
+
+	require.NoError(t, r.Save())
+	loaded, err = LoadRecordingFile(path)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Assets[0].Resources, binaryFlushInterval+1)
+	assert.Equal(t, map[string]string{"os.base\x00": "base"}, loaded.Assets[0].IdsLookup)
+
+	lookup := llx.AssetRecordingLookup{ConnectionId: 1}
+	data, ok := loaded.GetData(lookup, "package", "42", "name")
+	require.True(t, ok)
+	assert.Equal(t, "pkg-42", data.Value)
+	data, ok = loaded.GetData(lookup, "os.base", "", "hostname")
+	require.True(t, ok)
+	assert.Equal(t, "debian", data.Value)
+
+	// recording again extends the existing file
+	res, err = NewWithFile(path, RecordingOptions{DoRecord: true})
+	require.NoError(t, err)
+	r = res.(*recording)
+	r.EnsureAsset(asset, "provider", 1, &inventory.Config{Type: "local", Id: 1})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "package", ResourceID: "42", RequestResourceId: "42",
+		Field: "version", Data: llx.StringData("1.0"),
+	})
+	require.NoError(t, r.Save())
+
+	loaded, err = LoadRecordingFile(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Assets, 1)
+	fields, ok := loaded.GetResource(lookup, "package", "42")
+	require.True(t, ok)
+	assert.Equal(t, "pkg-42", fields["name"].Value)
+	assert.Equal(t, "1.0", fields["version"].Value)
+}
+
+func TestBinaryRecording_FlushPeriod(t *testing.T) {
+	prev := binaryFlushPeriod
+	binaryFlushPeriod = 10 * time.Millisecond
+	defer func() { binaryFlushPeriod = prev }()
+
+	path := filepath.Join(t.TempDir(), "scan"+BinaryExtension)
+	res, err := NewWithFile(path, RecordingOptions{DoRecord: true})
+	require.NoError(t, err)
+	r := res.(*recording)
+	r.EnsureAsset(&inventory.Asset{Mrn: "asset-1"}, "provider", 1, &inventory.Config{Type: "local", Id: 1})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "os.base", ResourceID: "base", RequestResourceId: "base",
+		Field: "hostname", Data: llx.StringData("debian"),
+	})
+
+	// a single entry is written to disk without waiting for a full batch
+	assert.Eventually(t, func() bool {
+		loaded, err := LoadRecordingFile(path)
+		return err == nil && len(loaded.Assets) == 1 && len(loaded.Assets[0].Resources) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Save())
+}
+
+func TestBinaryRecording_Truncated(t *testing.T) {
+	rec, err := LoadRecordingFile("testdata/recording.json")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "recording"+BinaryExtension)
+	require.NoError(t, rec.SaveTo(path, false))
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, raw[:len(raw)-20], 0o644))
+
+	loaded, err := LoadRecordingFile(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Assets, 1)
+	assert.Equal(t, rec.Assets[0].Asset.Name, loaded.Assets[0].Asset.Name)
+}
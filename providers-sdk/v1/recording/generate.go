@@ -4,3 +4,4 @@
 package recording
 
 //go:generate protoc --plugin=protoc-gen-go=../../../scripts/protoc/protoc-gen-go --plugin=protoc-gen-rangerrpc=../../../scripts/protoc/protoc-gen-rangerrpc --plugin=protoc-gen-go-vtproto=../../../scripts/protoc/protoc-gen-go-vtproto --proto_path=../../.. --go_out=../../.. --go_opt=paths=source_relative --rangerrpc_out=../../.. --go-vtproto_out=../../.. --go-vtproto_opt=paths=source_relative --go-vtproto_opt=features=marshal+unmarshal+size+clone providers-sdk/v1/recording/mql_resources_explorer.proto
+//go:generate protoc --plugin=protoc-gen-go=../../../scripts/protoc/protoc-gen-go --plugin=protoc-gen-go-vtproto=../../../scripts/protoc/protoc-gen-go-vtproto --proto_path=../../.. --go_out=../../.. --go_opt=paths=source_relative --go-vtproto_out=../../.. --go-vtproto_opt=paths=source_relative --go-vtproto_opt=features=marshal+unmarshal+size providers-sdk/v1/recording/binary.proto
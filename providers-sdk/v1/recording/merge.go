@@ -0,0 +1,76 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"maps"
+
+	"go.mondoo.com/mql/v13/llx"
+)
+
+// Merge adds all assets of the other recording to this one. Assets that are
+// in both recordings are combined, with data of the other recording taking
+// precedence.
+func (r *recording) Merge(other *recording) {
+	for _, asset := range other.Assets {
+		existing, ok := r.resolveAsset(llx.AssetRecordingLookup{
+			Mrn:         asset.Asset.Mrn,
+			PlatformIds: asset.Asset.PlatformIds,
+		})
+		if !ok && asset.Asset.Mrn == "" && len(asset.Asset.PlatformIds) == 0 {
+			existing, ok = r.findAssetByConnection(asset)
+		}
+		if !ok {
+			existing = NewAssetRecording(asset.Asset)
+			r.Assets = append(r.Assets, existing)
+		}
+
+		for key, resource := range asset.resources {
+			cur, ok := existing.resources[key]
+			if !ok {
+				existing.resources[key] = &Resource{
+					Resource: resource.Resource,
+					ID:       resource.ID,
+					Fields:   maps.Clone(resource.Fields),
+				}
+				continue
+			}
+			maps.Copy(cur.Fields, resource.Fields)
+		}
+
+		if len(asset.IdsLookup) != 0 && existing.IdsLookup == nil {
+			existing.IdsLookup = map[string]string{}
+		}
+		maps.Copy(existing.IdsLookup, asset.IdsLookup)
+
+		// connection IDs are only unique within one recording, so we only keep
+		// the ones that don't point to another asset already
+		for key, conn := range asset.connections {
+			if _, ok := existing.connections[key]; ok {
+				continue
+			}
+			if _, ok := r.assets.Get(connIdKey(conn.Id)); ok && conn.Id > 0 {
+				continue
+			}
+			existing.connections[key] = conn
+		}
+
+		r.resyncAsset(existing)
+	}
+}
+
+// findAssetByConnection finds assets without MRN or platform IDs, which
+// older recordings may have, by the URLs of their connections
+func (r *recording) findAssetByConnection(asset *Asset) (*Asset, bool) {
+	for _, cur := range r.Assets {
+		for _, conn := range cur.connections {
+			for _, other := range asset.connections {
+				if conn.Url != "" && conn.Url == other.Url {
+					return cur, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+)
+
+func TestMerge(t *testing.T) {
+	r := newTestRecording(t, "asset-1", []string{"pid-1"}, 1)
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "user", ResourceID: "root", RequestResourceId: "root",
+		Field: "name", Data: llx.StringData("root"),
+	})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "user", ResourceID: "root", RequestResourceId: "root",
+		Field: "shell", Data: llx.StringData("/bin/sh"),
+	})
+
+	// the same asset with a different connection ID and more data
+	other := newTestRecording(t, "asset-1", []string{"pid-1"}, 1)
+	other.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "user", ResourceID: "root", RequestResourceId: "root",
+		Field: "shell", Data: llx.StringData("/bin/bash"),
+	})
+	other.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "user", ResourceID: "bob", RequestResourceId: "bob",
+		Field: "name", Data: llx.StringData("bob"),
+	})
+
+	// another asset that uses a connection ID which is already taken
+	third := newTestRecording(t, "asset-2", []string{"pid-2"}, 1)
+	third.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "file", ResourceID: "/etc/hosts", RequestResourceId: "/etc/hosts",
+		Field: "size", Data: llx.IntData(42),
+	})
+
+	r.Merge(other)
+	r.Merge(third)
+	require.Len(t, r.Assets, 2)
+
+	lookup := llx.AssetRecordingLookup{Mrn: "asset-1"}
+	data, ok := r.GetData(lookup, "user", "root", "name")
+	require.True(t, ok)
+	assert.Equal(t, "root", data.Value)
+	data, ok = r.GetData(lookup, "user", "root", "shell")
+	require.True(t, ok)
+	assert.Equal(t, "/bin/bash", data.Value)
+	_, ok = r.GetData(lookup, "user", "bob", "name")
+	assert.True(t, ok)
+
+	data, ok = r.GetData(llx.AssetRecordingLookup{PlatformIds: []string{"pid-2"}}, "file", "/etc/hosts", "size")
+	require.True(t, ok)
+	assert.Equal(t, int64(42), data.Value)
+
+	// the connection ID still points to the first asset
+	asset, ok := r.resolveAsset(llx.AssetRecordingLookup{ConnectionId: 1})
+	require.True(t, ok)
+	assert.Equal(t, "asset-1", asset.Asset.Mrn)
+
+	// changes in the merged recording don't leak into the original ones
+	other.Assets[0].resources["user\x00bob"].Fields["name"] = llx.StringData("alice")
+	data, _ = r.GetData(lookup, "user", "bob", "name")
+	assert.Equal(t, "bob", data.Value)
+}
+
+func TestMerge_ByConnection(t *testing.T) {
+	r := newTestRecording(t, "", []string{"pid-1"}, 1)
+	other := newTestRecording(t, "", []string{"pid-1"}, 2)
+	other.AddData(llx.AddDataReq{
+		ConnectionID: 2, Resource: "user", ResourceID: "root", RequestResourceId: "root",
+		Field: "name", Data: llx.StringData("root"),
+	})
+	// older recordings may have assets without any IDs
+	other.Assets[0].Asset.PlatformIds = nil
+
+	r.Merge(other)
+	require.Len(t, r.Assets, 1)
+	_, ok := r.GetData(llx.AssetRecordingLookup{ConnectionId: 1}, "user", "root", "name")
+	assert.True(t, ok)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/mql/v13/llx"
@@ -31,6 +32,10 @@ type recording struct {
 	// this mode is used when we use the recording layer for data,
 	// but not for storing it on disk
 	doNotSave bool `json:"-"`
+	// binary recordings are streamed to disk while recording
+	binary     bool          `json:"-"`
+	stream     *binaryWriter `json:"-"`
+	streamLock sync.Mutex    `json:"-"`
 }
 
 // Creates a recording that holds only the specified asset
@@ -93,6 +98,10 @@ func NewWithFile(path string, opts RecordingOptions) (llx.Recording, error) {
 
 		if opts.DoRecord {
 			res.prettyPrintJSON = opts.PrettyPrintJSON
+			res.binary = res.binary || IsBinaryPath(path)
+			if err := res.startStream(); err != nil {
+				return nil, err
+			}
 			return res, nil
 		}
 		return &readOnly{res}, nil
@@ -103,9 +112,13 @@ func NewWithFile(path string, opts RecordingOptions) (llx.Recording, error) {
 				Path:            path,
 				prettyPrintJSON: opts.PrettyPrintJSON,
 				doNotSave:       opts.DoNotSave,
+				binary:          IsBinaryPath(path),
 				assets:          syncx.Map[*Asset]{},
 			}
 			res.refreshCache() // only for initialization
+			if err := res.startStream(); err != nil {
+				return nil, err
+			}
 			return res, nil
 		}
 		return nil, errors.New("failed to load recording: '" + path + "' does not exist")
@@ -132,12 +145,17 @@ func NewWithAsset(asset *inventory.Asset) *recording {
 	return rec
 }
 
+// LoadRecordingFile loads a recording in the JSON or the binary format
 func LoadRecordingFile(path string) (*recording, error) {
 	raw, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
+	if isBinaryRecording(raw) {
+		return loadBinaryRecording(raw)
+	}
+
 	var res recording
 	err = json.Unmarshal(raw, &res)
 	if err != nil {
@@ -160,6 +178,10 @@ func (r *recording) Save() error {
 		return nil
 	}
 
+	if r.binary {
+		return r.saveBinary()
+	}
+
 	var raw []byte
 	var err error
 	if r.prettyPrintJSON {
@@ -179,6 +201,72 @@ func (r *recording) Save() error {
 	return nil
 }
 
+// SaveTo stores the recording in the given file, e.g. after it was merged or
+// redacted
+func (r *recording) SaveTo(path string, prettyPrintJSON bool) error {
+	r.Path = path
+	r.prettyPrintJSON = prettyPrintJSON
+	r.binary = IsBinaryPath(path)
+	r.doNotSave = false
+	return r.Save()
+}
+
+// startStream writes everything that is already recorded and keeps the file
+// open to append all data as it is recorded
+func (r *recording) startStream() error {
+	if !r.binary || r.doNotSave {
+		return nil
+	}
+
+	r.streamLock.Lock()
+	defer r.streamLock.Unlock()
+
+	w, err := createBinaryWriter(r.Path, r)
+	if err != nil {
+		return multierr.Wrap(err, "failed to write recording")
+	}
+	r.stream = w
+	return nil
+}
+
+// streamData appends data to the recording file. If this fails, the recording
+// is kept in memory and written when it is saved.
+func (r *recording) streamData(f func(w *binaryWriter) error) {
+	r.streamLock.Lock()
+	defer r.streamLock.Unlock()
+
+	if r.stream == nil {
+		return
+	}
+	if err := f(r.stream); err != nil {
+		log.Warn().Err(err).Msg("failed to stream data into recording, it will be stored at the end")
+		r.stream.Close()
+		r.stream = nil
+	}
+}
+
+func (r *recording) saveBinary() error {
+	r.streamLock.Lock()
+	defer r.streamLock.Unlock()
+
+	if r.stream == nil {
+		w, err := createBinaryWriter(r.Path, r)
+		if err != nil {
+			return multierr.Wrap(err, "failed to store recording")
+		}
+		r.stream = w
+	}
+
+	err := r.stream.Close()
+	r.stream = nil
+	if err != nil {
+		return multierr.Wrap(err, "failed to store recording")
+	}
+
+	log.Info().Msg("stored recording in " + r.Path)
+	return nil
+}
+
 func mrnKey(mrn string) string {
 	return "mrn:" + mrn
 }
@@ -366,6 +454,9 @@ func (r *recording) EnsureAsset(asset *inventory.Asset, providerID string, conne
 	}
 
 	r.resyncAsset(recordingAsset)
+	r.streamData(func(w *binaryWriter) error {
+		return w.writeAsset(recordingAsset)
+	})
 }
 
 func (r *recording) resyncAsset(recordingAsset *Asset) {
@@ -393,8 +484,10 @@ func (r *recording) AddData(req llx.AddDataReq) {
 		asset.IdsLookup = map[string]string{}
 	}
 
+	var lookupKey, lookupID string
 	if req.RequestResourceId != req.ResourceID {
-		asset.IdsLookup[req.Resource+"\x00"+req.RequestResourceId] = req.ResourceID
+		lookupKey, lookupID = req.Resource+"\x00"+req.RequestResourceId, req.ResourceID
+		asset.IdsLookup[lookupKey] = lookupID
 	}
 
 	obj, exist := asset.resources[req.Resource+"\x00"+req.ResourceID]
@@ -410,6 +503,14 @@ func (r *recording) AddData(req llx.AddDataReq) {
 	if req.Field != "" {
 		obj.Fields[req.Field] = req.Data
 	}
+
+	r.streamData(func(w *binaryWriter) error {
+		data := &llx.ResourceRecording{Resource: req.Resource, Id: req.ResourceID}
+		if req.Field != "" {
+			data.Fields = map[string]*llx.Result{req.Field: req.Data.Result()}
+		}
+		return w.writeData(asset, data, lookupKey, lookupID)
+	})
 }
 
 func (r *recording) resolveResource(lookup llx.AssetRecordingLookup, resource string, id string) (*Resource, string, bool) {
@@ -0,0 +1,270 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+
+	"go.mondoo.com/mql/v13/llx"
+)
+
+// RedactedValue replaces redacted strings by default
+const RedactedValue = "[REDACTED]"
+
+// errRedacted replaces redacted values that are not strings
+var errRedacted = errors.New("value was redacted from the recording")
+
+// RedactOptions select the data that is removed from a recording
+type RedactOptions struct {
+	// Fields in the form of resource.field, e.g. user.sshkeys. Both parts may
+	// use wildcards, e.g. *.content. All strings in these fields are
+	// replaced. Values without strings (e.g. numbers) are turned into errors.
+	Fields []string
+	// Patterns are replaced in all strings of all fields
+	Patterns []*regexp.Regexp
+	// Replacement for redacted strings, defaults to RedactedValue
+	Replacement string
+}
+
+// Redact removes data from all resources in the recording and returns the
+// number of fields that were changed. The asset's own metadata is kept, since
+// it identifies the asset.
+//
+// Resource IDs often contain the data they were created from, e.g. the ID of
+// a command is the command itself. IDs that match a pattern or contain a
+// string of a redacted field of their resource are replaced by a hash, as are
+// references to these resources and their entries in the IDs lookup. Queries
+// that use the original ID will not find these resources during replay.
+func (r *recording) Redact(opts RedactOptions) (int, error) {
+	for _, pattern := range opts.Fields {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return 0, errors.New("invalid field pattern: " + pattern)
+		}
+	}
+	if opts.Replacement == "" {
+		opts.Replacement = RedactedValue
+	}
+
+	cnt := 0
+	for _, asset := range r.Assets {
+		// strings removed from the fields of every resource
+		secrets := map[string][]string{}
+		for key, resource := range asset.resources {
+			for field, data := range resource.Fields {
+				if data == nil || data.Error != nil {
+					continue
+				}
+
+				if matchesField(opts.Fields, resource.Resource+"."+field) {
+					secrets[key] = append(secrets[key], collectStrings(data.Value)...)
+					resource.Fields[field] = redactAll(data, opts.Replacement)
+					cnt++
+					continue
+				}
+
+				if len(opts.Patterns) == 0 {
+					continue
+				}
+				value, changed := redactPatterns(data.Value, opts.Patterns, opts.Replacement)
+				if changed {
+					resource.Fields[field] = &llx.RawData{Type: data.Type, Value: value}
+					cnt++
+				}
+			}
+		}
+		redactIDs(asset, secrets, opts.Patterns)
+	}
+	return cnt, nil
+}
+
+// redactIDs replaces resource IDs that contain secrets by their hash. It
+// updates the resources, references to them and the IDs lookup.
+func redactIDs(asset *Asset, secrets map[string][]string, patterns []*regexp.Regexp) {
+	ids := map[string]string{}
+	for key, resource := range asset.resources {
+		if containsSecret(resource.ID, secrets[key], patterns) {
+			ids[key] = redactedID(resource.ID)
+		}
+	}
+
+	if len(ids) != 0 {
+		resources := make(map[string]*Resource, len(asset.resources))
+		for key, resource := range asset.resources {
+			if id, ok := ids[key]; ok {
+				resource.ID = id
+				key = resource.Resource + "\x00" + id
+			}
+			for field, data := range resource.Fields {
+				if data == nil || data.Error != nil {
+					continue
+				}
+				if value, changed := replaceRefs(data.Value, ids); changed {
+					resource.Fields[field] = &llx.RawData{Type: data.Type, Value: value}
+				}
+			}
+			resources[key] = resource
+		}
+		asset.resources = resources
+	}
+
+	if len(asset.IdsLookup) == 0 {
+		return
+	}
+	lookup := make(map[string]string, len(asset.IdsLookup))
+	for key, id := range asset.IdsLookup {
+		name, requestID, _ := strings.Cut(key, "\x00")
+		target := name + "\x00" + id
+		if containsSecret(requestID, secrets[target], patterns) {
+			requestID = redactedID(requestID)
+		}
+		if newID, ok := ids[target]; ok {
+			id = newID
+		}
+		lookup[name+"\x00"+requestID] = id
+	}
+	asset.IdsLookup = lookup
+}
+
+func containsSecret(id string, secrets []string, patterns []*regexp.Regexp) bool {
+	for _, secret := range secrets {
+		if secret != "" && strings.Contains(id, secret) {
+			return true
+		}
+	}
+	for _, pattern := range patterns {
+		if pattern.MatchString(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactedID is deterministic, so the same ID is replaced by the same hash
+// wherever it is used
+func redactedID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return "redacted-" + hex.EncodeToString(sum[:8])
+}
+
+// replaceRefs replaces references to resources whose IDs were redacted
+func replaceRefs(value any, ids map[string]string) (any, bool) {
+	switch v := value.(type) {
+	case llx.Resource:
+		if id, ok := ids[v.MqlName()+"\x00"+v.MqlID()]; ok {
+			return &llx.MockResource{Name: v.MqlName(), ID: id}, true
+		}
+		return value, false
+	case []any:
+		changed := false
+		res := make([]any, len(v))
+		for i := range v {
+			var ok bool
+			res[i], ok = replaceRefs(v[i], ids)
+			changed = changed || ok
+		}
+		return res, changed
+	case map[string]any:
+		changed := false
+		res := make(map[string]any, len(v))
+		for k := range v {
+			var ok bool
+			res[k], ok = replaceRefs(v[k], ids)
+			changed = changed || ok
+		}
+		return res, changed
+	default:
+		return value, false
+	}
+}
+
+func collectStrings(value any) []string {
+	var res []string
+	replaceStrings(value, func(s string) string {
+		res = append(res, s)
+		return s
+	})
+	return res
+}
+
+func matchesField(patterns []string, field string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, field); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// redactAll replaces all strings in the value. Values that contain no strings
+// cannot be replaced without changing their meaning, so they become errors.
+func redactAll(data *llx.RawData, replacement string) *llx.RawData {
+	value, hasStrings := replaceStrings(data.Value, func(string) string {
+		return replacement
+	})
+	if !hasStrings && !isEmpty(value) {
+		return &llx.RawData{Type: data.Type, Error: errRedacted}
+	}
+	return &llx.RawData{Type: data.Type, Value: value}
+}
+
+func redactPatterns(value any, patterns []*regexp.Regexp, replacement string) (any, bool) {
+	changed := false
+	res, _ := replaceStrings(value, func(s string) string {
+		for _, pattern := range patterns {
+			if pattern.MatchString(s) {
+				s = pattern.ReplaceAllLiteralString(s, replacement)
+				changed = true
+			}
+		}
+		return s
+	})
+	return res, changed
+}
+
+// replaceStrings calls f for all strings in arrays, maps and dicts and
+// returns the new value and whether it found any strings. References to
+// other resources are kept.
+func replaceStrings(value any, f func(string) string) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		return f(v), true
+	case []any:
+		found := false
+		res := make([]any, len(v))
+		for i := range v {
+			var ok bool
+			res[i], ok = replaceStrings(v[i], f)
+			found = found || ok
+		}
+		return res, found
+	case map[string]any:
+		found := false
+		res := make(map[string]any, len(v))
+		for k := range v {
+			var ok bool
+			res[k], ok = replaceStrings(v[k], f)
+			found = found || ok
+		}
+		return res, found
+	default:
+		return value, false
+	}
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
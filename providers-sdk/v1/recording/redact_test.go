@@ -0,0 +1,102 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func TestRedact(t *testing.T) {
+	r := newTestRecording(t, "asset-1", []string{"pid-1"}, 1)
+	add := func(resource, id, field string, data *llx.RawData) {
+		r.AddData(llx.AddDataReq{
+			ConnectionID: 1, Resource: resource, ResourceID: id, RequestResourceId: id,
+			Field: field, Data: data,
+		})
+	}
+	add("file", "/etc/shadow", "content", llx.StringData("root:$6$hash:19000"))
+	add("file", "/etc/shadow", "size", llx.IntData(18))
+	add("file", "/etc/app.conf", "content", llx.StringData("key=AKIA0123456789ABCDEF\nport=80"))
+	add("env", "", "vars", llx.MapData(map[string]any{
+		"TOKEN": "AKIA0123456789ABCDEF",
+		"HOME":  "/root",
+	}, types.String))
+	add("user", "root", "uid", llx.IntData(0))
+
+	cnt, err := r.Redact(RedactOptions{
+		Fields:   []string{"file.size", "*.content"},
+		Patterns: []*regexp.Regexp{regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, cnt)
+
+	lookup := llx.AssetRecordingLookup{Mrn: "asset-1"}
+	data, _ := r.GetData(lookup, "file", "/etc/shadow", "content")
+	assert.Equal(t, RedactedValue, data.Value)
+	data, _ = r.GetData(lookup, "file", "/etc/app.conf", "content")
+	assert.Equal(t, RedactedValue, data.Value)
+	data, _ = r.GetData(lookup, "file", "/etc/shadow", "size")
+	assert.ErrorIs(t, data.Error, errRedacted)
+	data, _ = r.GetData(lookup, "env", "", "vars")
+	assert.Equal(t, map[string]any{"TOKEN": RedactedValue, "HOME": "/root"}, data.Value)
+	data, _ = r.GetData(lookup, "user", "root", "uid")
+	assert.Equal(t, int64(0), data.Value)
+
+	_, err = r.Redact(RedactOptions{Fields: []string{"file.[content"}})
+	assert.Error(t, err)
+}
+
+func TestRedactIDs(t *testing.T) {
+	r := newTestRecording(t, "asset-1", []string{"pid-1"}, 1)
+	const cmd = "curl -H 'Authorization: s3cr3t' example.com"
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "command", ResourceID: cmd, RequestResourceId: cmd,
+		Field: "command", Data: llx.StringData(cmd),
+	})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "command", ResourceID: cmd, RequestResourceId: cmd,
+		Field: "exitcode", Data: llx.IntData(0),
+	})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "app", ResourceID: "app", RequestResourceId: "app",
+		Field: "check", Data: llx.ResourceData(&llx.MockResource{Name: "command", ID: cmd}, "command"),
+	})
+	r.AddData(llx.AddDataReq{
+		ConnectionID: 1, Resource: "aws.iam.accessKey", ResourceID: "AKIA0123456789ABCDEF", RequestResourceId: "",
+		Field: "active", Data: llx.BoolData(true),
+	})
+
+	_, err := r.Redact(RedactOptions{
+		Fields:   []string{"command.command"},
+		Patterns: []*regexp.Regexp{regexp.MustCompile(`AKIA[0-9A-Z]{16}`)},
+	})
+	require.NoError(t, err)
+
+	asset := r.Assets[0]
+	cmdID := redactedID(cmd)
+	keyID := redactedID("AKIA0123456789ABCDEF")
+	for key := range asset.resources {
+		assert.NotContains(t, key, "s3cr3t")
+		assert.NotContains(t, key, "AKIA")
+	}
+	assert.Equal(t, map[string]string{"aws.iam.accessKey\x00": keyID}, asset.IdsLookup)
+
+	lookup := llx.AssetRecordingLookup{Mrn: "asset-1"}
+	data, _ := r.GetData(lookup, "command", cmdID, "exitcode")
+	assert.Equal(t, int64(0), data.Value)
+	data, _ = r.GetData(lookup, "app", "app", "check")
+	assert.Equal(t, &llx.MockResource{Name: "command", ID: cmdID}, data.Value)
+	data, _ = r.GetData(lookup, "aws.iam.accessKey", "", "active")
+	assert.Equal(t, true, data.Value)
+
+	// the same ID is always replaced by the same hash
+	assert.Equal(t, cmdID, redactedID(cmd))
+	assert.NotEqual(t, cmdID, keyID)
+}
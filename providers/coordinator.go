@@ -4,6 +4,7 @@
 package providers
 
 import (
+	"context"
 	"math"
 	"os"
 	"os/exec"
@@ -87,8 +88,17 @@ type ProviderVersions struct {
 }
 
 type ProviderVersion struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
+	Name    string         `json:"name"`
+	Version string         `json:"version"`
+	Files   []ProviderFile `json:"files,omitempty"`
+}
+
+// ProviderFile is a downloadable provider archive listed in the index
+type ProviderFile struct {
+	Filename  string `json:"filename"`
+	Platform  string `json:"platform"`            // e.g., "linux_amd64", "darwin_arm64"
+	Hash      string `json:"hash"`                // SHA256 hash of the archive
+	Signature string `json:"signature,omitempty"` // base64 ed25519 signature of the archive
 }
 
 func (c *coordinator) NextConnectionId() uint32 {
@@ -128,6 +138,7 @@ func (c *coordinator) NewRuntimeFrom(parent *Runtime) *Runtime {
 	res.UpstreamConfig = parent.UpstreamConfig
 	res.AutoUpdate = parent.AutoUpdate
 	res.recording = parent.Recording()
+	res.profiler = parent.Profiler()
 	for k, v := range parent.providers {
 		res.providers[k] = v
 	}
@@ -306,6 +317,19 @@ func (c *coordinator) unsafeStartProvider(id string, update UpdateProvidersConfi
 		} else {
 			provider = updated
 		}
+	} else if pinned := Lock.Version(provider.Name); pinned != "" && pinned != provider.Version {
+		// the lock file applies without auto-update too, we never run a
+		// version other than the pinned one
+		log.Info().
+			Str("installed", provider.Version).
+			Str("pinned", pinned).
+			Msg("installing pinned version of '" + provider.Name + "' provider")
+		installed, err := installVersion(context.Background(), provider.Name, pinned)
+		if err != nil {
+			return nil, errors.Wrap(err, "provider '"+provider.Name+"' "+provider.Version+" doesn't match the version "+pinned+" in the lock file")
+		}
+		PrintInstallResults([]*Provider{installed})
+		provider = installed
 	}
 
 	if provider.Schema == nil {
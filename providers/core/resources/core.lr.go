@@ -362,6 +362,24 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"vulnerability.exchange.source": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnerabilityExchange).GetSource()).ToDataRes(types.String)
 	},
+	"vulnerability.exchange.status": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetStatus()).ToDataRes(types.String)
+	},
+	"vulnerability.exchange.justification": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetJustification()).ToDataRes(types.String)
+	},
+	"vulnerability.exchange.impactStatement": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetImpactStatement()).ToDataRes(types.String)
+	},
+	"vulnerability.exchange.actionStatement": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetActionStatement()).ToDataRes(types.String)
+	},
+	"vulnerability.exchange.products": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetProducts()).ToDataRes(types.Array(types.String))
+	},
+	"vulnerability.exchange.timestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnerabilityExchange).GetTimestamp()).ToDataRes(types.Time)
+	},
 }
 
 func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
@@ -690,6 +708,30 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlVulnerabilityExchange).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"vulnerability.exchange.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vulnerability.exchange.justification": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).Justification, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vulnerability.exchange.impactStatement": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).ImpactStatement, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vulnerability.exchange.actionStatement": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).ActionStatement, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"vulnerability.exchange.products": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).Products, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"vulnerability.exchange.timestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnerabilityExchange).Timestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
 }
 
 func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
@@ -1541,8 +1583,14 @@ type mqlVulnerabilityExchange struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
 	// optional: if you define mqlVulnerabilityExchangeInternal it will be used here
-	Id     plugin.TValue[string]
-	Source plugin.TValue[string]
+	Id              plugin.TValue[string]
+	Source          plugin.TValue[string]
+	Status          plugin.TValue[string]
+	Justification   plugin.TValue[string]
+	ImpactStatement plugin.TValue[string]
+	ActionStatement plugin.TValue[string]
+	Products        plugin.TValue[[]any]
+	Timestamp       plugin.TValue[*time.Time]
 }
 
 // createVulnerabilityExchange creates a new instance of this resource
@@ -1589,3 +1637,27 @@ func (c *mqlVulnerabilityExchange) GetId() *plugin.TValue[string] {
 func (c *mqlVulnerabilityExchange) GetSource() *plugin.TValue[string] {
 	return &c.Source
 }
+
+func (c *mqlVulnerabilityExchange) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
+
+func (c *mqlVulnerabilityExchange) GetJustification() *plugin.TValue[string] {
+	return &c.Justification
+}
+
+func (c *mqlVulnerabilityExchange) GetImpactStatement() *plugin.TValue[string] {
+	return &c.ImpactStatement
+}
+
+func (c *mqlVulnerabilityExchange) GetActionStatement() *plugin.TValue[string] {
+	return &c.ActionStatement
+}
+
+func (c *mqlVulnerabilityExchange) GetProducts() *plugin.TValue[[]any] {
+	return &c.Products
+}
+
+func (c *mqlVulnerabilityExchange) GetTimestamp() *plugin.TValue[*time.Time] {
+	return &c.Timestamp
+}
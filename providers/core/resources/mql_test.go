@@ -495,6 +495,50 @@ func TestString_Methods(t *testing.T) {
 			Code:        "'1.2.3.4'.split('.').reverse.join(':')",
 			Expectation: "4:3:2:1",
 		},
+		{
+			Code:        "'a-b-c'.replace('-', '.')",
+			Expectation: "a.b.c",
+		},
+		{
+			Code:        "'hello'.startsWith('he')",
+			Expectation: true,
+		},
+		{
+			Code:        "'hello'.endsWith('he')",
+			Expectation: false,
+		},
+		{
+			Code:        "'7'.padLeft(3, '0')",
+			Expectation: "007",
+		},
+		{
+			Code:        "'ab'.padRight(4)",
+			Expectation: "ab  ",
+		},
+		{
+			Code:        "'hello'.sha256",
+			Expectation: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		},
+		{
+			Code:        "'hello'.md5",
+			Expectation: "5d41402abc4b2a76b9719d911017c592",
+		},
+		{
+			Code:        "'hello'.base64Encode",
+			Expectation: "aGVsbG8=",
+		},
+		{
+			Code:        "'aGVsbG8='.base64Decode",
+			Expectation: "hello",
+		},
+		{
+			Code:        "'hello'.hexEncode.hexDecode",
+			Expectation: "hello",
+		},
+		{
+			Code:        "{'data': 'cGFzc3dvcmQ='}['data'].base64Decode",
+			Expectation: "password",
+		},
 	})
 }
 
@@ -786,6 +830,78 @@ func TestArray(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("ordering and aggregation", func(t *testing.T) {
+		x.TestSimple(t, []testutils.SimpleTest{
+			{
+				Code:        "[3,1,2].sort",
+				Expectation: []any{int64(1), int64(2), int64(3)},
+			},
+			{
+				Code:        "['b','c','a'].sort.reverse",
+				Expectation: []any{"c", "b", "a"},
+			},
+			{
+				Code:        "[version('1.10'), version('1.2')].sort",
+				Expectation: []any{"1.2", "1.10"},
+			},
+			{
+				Code:        "[[1,9],[3,1],[2,5]].sortBy(_[1])",
+				Expectation: []any{[]any{int64(3), int64(1)}, []any{int64(2), int64(5)}, []any{int64(1), int64(9)}},
+			},
+			{
+				Code:        "[3,1,2].min",
+				Expectation: int64(1),
+			},
+			{
+				Code:        "[3,1,2].max",
+				Expectation: int64(3),
+			},
+			{
+				Code:        "['b','c','a'].max",
+				Expectation: "c",
+			},
+			{
+				Code:        "[].max",
+				Expectation: nil,
+			},
+			{
+				Code:        "[1,2,3].sum",
+				Expectation: int64(6),
+			},
+			{
+				Code:        "[1.5,2.5].sum",
+				Expectation: float64(4),
+			},
+			{
+				Code:        "[1,2,3,4].avg",
+				Expectation: float64(2.5),
+			},
+			{
+				Code:        "[1,2,3,4].take(2)",
+				Expectation: []any{int64(1), int64(2)},
+			},
+			{
+				Code:        "[1,2,3,4].take(10)",
+				Expectation: []any{int64(1), int64(2), int64(3), int64(4)},
+			},
+			{
+				Code:        "[1,2,3,4].skip(3)",
+				Expectation: []any{int64(4)},
+			},
+			{
+				Code:        "[3,1,2].sort.reverse.take(2)",
+				Expectation: []any{int64(3), int64(2)},
+			},
+			{
+				Code: "[1,2,3,4].groupBy(_ > 2)",
+				Expectation: map[string]any{
+					"false": []any{int64(1), int64(2)},
+					"true":  []any{int64(3), int64(4)},
+				},
+			},
+		})
+	})
 }
 
 func testSample(t *testing.T, mqlData string, sampleLen int, isMap bool) {
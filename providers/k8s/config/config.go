@@ -25,6 +25,7 @@ var Config = plugin.Provider{
 
 Requirement:
   To query or scan a Kubernetes cluster, you must install kubectl on your workstation. To learn how, read https://kubernetes.io/docs/tasks/tools/. 
+  To scan Helm charts in manifests, you must install helm. To scan Kustomize overlays, you must install kustomize or kubectl.
 
 Examples:
   cnspec shell k8s
@@ -79,6 +80,24 @@ Examples:
 					Default: "false",
 					Desc:    "Authenticate against a remote Azure AD enabled Kubernetes cluster using an Azure identity.",
 				},
+				{
+					Long:    "helm-values",
+					Type:    plugin.FlagType_List,
+					Default: "",
+					Desc:    "Values files used to render Helm charts in manifests",
+				},
+				{
+					Long:    "helm-release",
+					Type:    plugin.FlagType_String,
+					Default: "",
+					Desc:    "Release name used to render Helm charts in manifests",
+				},
+				{
+					Long:    "kustomize-overlays",
+					Type:    plugin.FlagType_List,
+					Default: "",
+					Desc:    "Kustomize overlays to render, relative to the manifest path",
+				},
 			},
 		},
 	},
@@ -94,7 +94,8 @@ func NewConnection(id uint32, asset *inventory.Asset, opts ...Option) (shared.Co
 		manifest = c.manifestContent
 		clusterName = "K8s Manifest"
 	} else if c.manifestFile != "" {
-		manifest, err = shared.LoadManifestFile(c.manifestFile)
+		renderOpts := shared.RenderOptionsFromConfig(asset.Connections[0].Options)
+		manifest, err = shared.LoadManifest(c.manifestFile, renderOpts)
 		if err != nil {
 			return nil, err
 		}
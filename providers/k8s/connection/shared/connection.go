@@ -17,16 +17,19 @@ import (
 )
 
 const (
-	OPTION_GIT_HTTP          = "http-url"
-	OPTION_MANIFEST          = "path"
-	OPTION_IMMEMORY_CONTENT  = "manifest-content"
-	OPTION_NAMESPACE         = "namespaces"
-	OPTION_NAMESPACE_EXCLUDE = "namespaces-exclude"
-	OPTION_ADMISSION         = "k8s-admission-review"
-	OPTION_OBJECT_KIND       = "object-kind"
-	OPTION_CONTEXT           = "context"
-	OPTION_KUBELOGIN         = "kubelogin"
-	IdPrefix                 = "//platformid.api.mondoo.app/runtime/k8s/uid/"
+	OPTION_GIT_HTTP           = "http-url"
+	OPTION_MANIFEST           = "path"
+	OPTION_IMMEMORY_CONTENT   = "manifest-content"
+	OPTION_NAMESPACE          = "namespaces"
+	OPTION_NAMESPACE_EXCLUDE  = "namespaces-exclude"
+	OPTION_ADMISSION          = "k8s-admission-review"
+	OPTION_OBJECT_KIND        = "object-kind"
+	OPTION_CONTEXT            = "context"
+	OPTION_KUBELOGIN          = "kubelogin"
+	OPTION_HELM_VALUES        = "helm-values"
+	OPTION_HELM_RELEASE       = "helm-release"
+	OPTION_KUSTOMIZE_OVERLAYS = "kustomize-overlays"
+	IdPrefix                  = "//platformid.api.mondoo.app/runtime/k8s/uid/"
 )
 
 type ConnectionType string
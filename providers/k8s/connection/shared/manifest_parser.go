@@ -263,56 +263,115 @@ func ProjectNameFromPath(file string) string {
 }
 
 func LoadManifestFile(manifestFile string) ([]byte, error) {
+	return LoadManifest(manifestFile, RenderOptions{})
+}
+
+// LoadManifest loads all manifests from a file or directory. Helm charts and
+// Kustomize overlays are rendered locally: the manifest path itself may be a
+// chart or kustomization, and charts and kustomizations found in a directory
+// are rendered in place of their files. Rendering requires helm for charts and
+// kustomize or kubectl for kustomizations in PATH. If the manifest path needs
+// a renderer that is missing, an error names it. Charts and kustomizations in
+// subdirectories that cannot be rendered are loaded as plain manifests.
+func LoadManifest(manifestFile string, opts RenderOptions) ([]byte, error) {
 	log.Debug().Str("filename", manifestFile).Msg("loading manifest file")
 	var input io.Reader
 
 	// return all resources from manifest
 	filenames := []string{}
+	rendered := [][]byte{}
 
 	fi, err := os.Stat(manifestFile)
 	if err != nil {
 		return nil, err
 	}
 
-	if fi.IsDir() {
-		yamlDecoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
-		filepath.WalkDir(manifestFile, func(path string, d fs.DirEntry, err error) error {
+	if fi.IsDir() && len(opts.KustomizeOverlays) > 0 {
+		res := []byte{}
+		for _, overlay := range opts.KustomizeOverlays {
+			if !filepath.IsAbs(overlay) {
+				overlay = filepath.Join(manifestFile, overlay)
+			}
+			data, err := RenderKustomization(manifestFile, overlay)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			res = append(res, data...)
+		}
+		return res, nil
+	}
+	if fi.IsDir() && IsHelmChart(manifestFile) {
+		return RenderHelmChart(manifestFile, manifestFile, opts)
+	}
+	if fi.IsDir() && IsKustomization(manifestFile) {
+		return RenderKustomization(manifestFile, manifestFile)
+	}
 
-			// only load yaml files for now
-			if !d.IsDir() {
-				ext := filepath.Ext(path)
-				if ext != ".yaml" && ext != ".yml" {
-					log.Debug().Str("file", path).Msg("ignore file, no .yaml or .yml ending")
-					return nil
-				}
-				// check whether this is valid k8s yaml
-				content, err := os.ReadFile(path)
+	if fi.IsDir() {
+		yamlDecoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+		kustomizations := []string{}
+		var loadDir func(dir string, detectKustomizations bool)
+		loadDir = func(dir string, detectKustomizations bool) {
+			filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 				if err != nil {
-					log.Debug().Str("file", path).Err(err).Msg("ignore file, could not read file")
-					return nil
+					return err
 				}
-				// At this point, we do not care about specific schemes, just whether the file is a valid k8s yaml
-				_, _, err = yamlDecoder.Decode(content, nil, nil)
-				if err != nil {
-					// the err contains the file content, which is not useful in the output
-					errorString := ""
-					if len(err.Error()) > 40 {
-						errorString = err.Error()[:40] + "..."
-					} else {
-						errorString = err.Error()
+
+				// templates of charts are not valid yaml before they are rendered
+				if d.IsDir() && path != manifestFile && IsHelmChart(path) {
+					data, err := RenderHelmChart(manifestFile, path, opts)
+					if err != nil {
+						log.Warn().Err(err).Str("chart", path).Msg("could not render helm chart, load its files as plain manifests")
+						return nil
 					}
-					log.Debug().Str("file", path).Str("error", errorString).Msg("ignore file, no valid kubernetes yaml")
-					return nil
+					rendered = append(rendered, data)
+					return filepath.SkipDir
 				}
-				log.Debug().Str("file", path).Msg("add file to manifest loading")
-				filenames = append(filenames, path)
-			}
 
-			return nil
-		})
+				// kustomizations are rendered once all of them are known, since
+				// overlays include their bases
+				if detectKustomizations && d.IsDir() && path != manifestFile && IsKustomization(path) {
+					kustomizations = append(kustomizations, path)
+					return filepath.SkipDir
+				}
+
+				// only load yaml files for now
+				if !d.IsDir() {
+					ext := filepath.Ext(path)
+					if ext != ".yaml" && ext != ".yml" {
+						log.Debug().Str("file", path).Msg("ignore file, no .yaml or .yml ending")
+						return nil
+					}
+					// check whether this is valid k8s yaml
+					content, err := os.ReadFile(path)
+					if err != nil {
+						log.Debug().Str("file", path).Err(err).Msg("ignore file, could not read file")
+						return nil
+					}
+					// At this point, we do not care about specific schemes, just whether the file is a valid k8s yaml
+					_, _, err = yamlDecoder.Decode(content, nil, nil)
+					if err != nil {
+						// the err contains the file content, which is not useful in the output
+						errorString := ""
+						if len(err.Error()) > 40 {
+							errorString = err.Error()[:40] + "..."
+						} else {
+							errorString = err.Error()
+						}
+						log.Debug().Str("file", path).Str("error", errorString).Msg("ignore file, no valid kubernetes yaml")
+						return nil
+					}
+					log.Debug().Str("file", path).Msg("add file to manifest loading")
+					filenames = append(filenames, path)
+				}
+
+				return nil
+			})
+		}
+		loadDir(manifestFile, true)
+		rendered = append(rendered, renderKustomizations(manifestFile, kustomizations, func(dir string) {
+			loadDir(dir, false)
+		})...)
 	} else {
 		filenames = append(filenames, manifestFile)
 	}
@@ -322,5 +381,58 @@ func LoadManifestFile(manifestFile string) ([]byte, error) {
 		return nil, err
 	}
 
-	return io.ReadAll(input)
+	manifest, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range rendered {
+		manifest = append(manifest, data...)
+	}
+	return manifest, nil
+}
+
+// renderKustomizations renders the kustomizations found in a directory.
+// Kustomizations that are used by others, like the bases of overlays, are
+// only rendered as part of them. If a kustomization cannot be rendered, the
+// files of it and of all kustomizations it uses are loaded with loadPlain.
+func renderKustomizations(root string, kustomizations []string, loadPlain func(dir string)) [][]byte {
+	refs := map[string][]string{}
+	referenced := map[string]bool{}
+	for _, dir := range kustomizations {
+		refs[dir] = kustomizationRefs(dir)
+		for _, ref := range refs[dir] {
+			referenced[ref] = true
+		}
+	}
+
+	res := [][]byte{}
+	loaded := map[string]bool{}
+	var loadWithRefs func(dir string)
+	loadWithRefs = func(dir string) {
+		if loaded[dir] {
+			return
+		}
+		loaded[dir] = true
+		loadPlain(dir)
+		for _, ref := range refs[dir] {
+			// only kustomizations in the directory are loaded
+			if _, ok := refs[ref]; ok {
+				loadWithRefs(ref)
+			}
+		}
+	}
+
+	for _, dir := range kustomizations {
+		if referenced[dir] {
+			continue
+		}
+		data, err := RenderKustomization(root, dir)
+		if err != nil {
+			log.Warn().Err(err).Str("kustomization", dir).Msg("could not render kustomization, load its files as plain manifests")
+			loadWithRefs(dir)
+			continue
+		}
+		res = append(res, data)
+	}
+	return res
 }
@@ -0,0 +1,332 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// SourceAnnotation links objects that were rendered from a Helm chart or a
+// Kustomize overlay back to the file that defines them
+const SourceAnnotation = "k8s.mondoo.com/source"
+
+// kustomizeOriginAnnotation is added by kustomize when the build metadata
+// includes originAnnotations
+const kustomizeOriginAnnotation = "config.kubernetes.io/origin"
+
+var (
+	documentSplit = regexp.MustCompile(`(?m)^---[ \t]*$`)
+	helmSource    = regexp.MustCompile(`(?m)^# Source: (.+)$`)
+)
+
+// RenderOptions configure how Helm charts and Kustomize overlays are rendered
+type RenderOptions struct {
+	// HelmValues are values files passed to helm template
+	HelmValues []string
+	// HelmRelease is the release name, it defaults to the name of the chart
+	HelmRelease string
+	// KustomizeOverlays are rendered instead of the manifest path, relative paths
+	// are resolved against the manifest path
+	KustomizeOverlays []string
+}
+
+// RenderOptionsFromConfig reads the render options from connection options
+func RenderOptionsFromConfig(options map[string]string) RenderOptions {
+	return RenderOptions{
+		HelmValues:        splitOption(options[OPTION_HELM_VALUES]),
+		HelmRelease:       options[OPTION_HELM_RELEASE],
+		KustomizeOverlays: splitOption(options[OPTION_KUSTOMIZE_OVERLAYS]),
+	}
+}
+
+func splitOption(value string) []string {
+	res := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// IsHelmChart returns true if the directory contains a Helm chart
+func IsHelmChart(dir string) bool {
+	return fileExists(filepath.Join(dir, "Chart.yaml"))
+}
+
+// IsKustomization returns true if the directory contains a kustomization
+func IsKustomization(dir string) bool {
+	return kustomizationFile(dir) != ""
+}
+
+func kustomizationFile(dir string) string {
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		if file := filepath.Join(dir, name); fileExists(file) {
+			return file
+		}
+	}
+	return ""
+}
+
+// kustomizationRefs returns the local directories that a kustomization uses
+// as resources, bases or components
+func kustomizationRefs(dir string) []string {
+	raw, err := os.ReadFile(kustomizationFile(dir))
+	if err != nil {
+		return nil
+	}
+	data, err := yamlutil.ToJSON(raw)
+	if err != nil {
+		return nil
+	}
+	var kustomization struct {
+		Resources  []string `json:"resources"`
+		Bases      []string `json:"bases"`
+		Components []string `json:"components"`
+	}
+	if err := json.Unmarshal(data, &kustomization); err != nil {
+		log.Debug().Err(err).Str("kustomization", dir).Msg("could not parse kustomization")
+		return nil
+	}
+
+	res := []string{}
+	for _, ref := range slices.Concat(kustomization.Resources, kustomization.Bases, kustomization.Components) {
+		if strings.Contains(ref, "://") {
+			continue
+		}
+		refDir := filepath.Join(dir, ref)
+		if fi, err := os.Stat(refDir); err == nil && fi.IsDir() {
+			res = append(res, refDir)
+		}
+	}
+	return res
+}
+
+func fileExists(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && !fi.IsDir()
+}
+
+// RenderHelmChart renders a chart with helm template. Every object is annotated
+// with the template it was rendered from, relative to root.
+func RenderHelmChart(root string, chartDir string, opts RenderOptions) ([]byte, error) {
+	helm, err := exec.LookPath("helm")
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering Helm charts requires helm, install it or add it to PATH")
+	}
+
+	release := opts.HelmRelease
+	if release == "" {
+		release = "release-name"
+	}
+	args := []string{"template", release, chartDir}
+	for _, values := range opts.HelmValues {
+		args = append(args, "--values", values)
+	}
+
+	log.Debug().Str("chart", chartDir).Strs("values", opts.HelmValues).Msg("render helm chart")
+	out, err := runRenderer(helm, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not render helm chart "+chartDir)
+	}
+	return annotateHelmSources(out, sourcePath(root, chartDir))
+}
+
+// RenderKustomization builds a kustomization with kustomize or kubectl. Every
+// object is annotated with the file it was defined in, relative to root.
+func RenderKustomization(root string, dir string) ([]byte, error) {
+	var cmd []string
+	if kustomize, err := exec.LookPath("kustomize"); err == nil {
+		cmd = []string{kustomize, "build"}
+	} else if kubectl, err := exec.LookPath("kubectl"); err == nil {
+		cmd = []string{kubectl, "kustomize"}
+	} else {
+		return nil, errors.Wrap(err, "rendering Kustomize overlays requires kustomize or kubectl, install one of them or add it to PATH")
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// kustomize only reports where objects come from when the build metadata asks
+	// for it, so the overlay is wrapped in a kustomization that enables it
+	wrapperDir, err := os.MkdirTemp("", "mql-kustomize")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(wrapperDir)
+
+	overlay, err := filepath.Rel(wrapperDir, absDir)
+	if err != nil {
+		return nil, err
+	}
+	wrapper := "apiVersion: kustomize.config.k8s.io/v1beta1\n" +
+		"kind: Kustomization\n" +
+		"resources:\n" +
+		"- " + filepath.ToSlash(overlay) + "\n" +
+		"buildMetadata:\n" +
+		"- originAnnotations\n"
+	if err := os.WriteFile(filepath.Join(wrapperDir, "kustomization.yaml"), []byte(wrapper), 0o600); err != nil {
+		return nil, err
+	}
+
+	log.Debug().Str("overlay", dir).Msg("render kustomization")
+	out, err := runRenderer(cmd[0], append(cmd[1:], wrapperDir)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not render kustomization "+dir)
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	return annotateKustomizeSources(out, wrapperDir, absRoot)
+}
+
+func runRenderer(name string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, msg)
+	}
+	return out, nil
+}
+
+// annotateHelmSources adds the source annotation to the objects of helm
+// template output, which prefixes every object with a "# Source:" comment
+// like "mychart/templates/deployment.yaml"
+func annotateHelmSources(output []byte, chartPath string) ([]byte, error) {
+	return annotateDocuments(output, func(doc []byte, obj *unstructured.Unstructured) string {
+		m := helmSource.FindSubmatch(doc)
+		if m == nil {
+			return ""
+		}
+		// the first segment is the chart name, which may differ from the directory
+		_, template, _ := strings.Cut(strings.TrimSpace(string(m[1])), "/")
+		return path.Join(chartPath, template)
+	})
+}
+
+// annotateKustomizeSources replaces the origin annotations of kustomize with
+// the source annotation. Origins are relative to the wrapper kustomization.
+func annotateKustomizeSources(output []byte, wrapperDir string, root string) ([]byte, error) {
+	return annotateDocuments(output, func(doc []byte, obj *unstructured.Unstructured) string {
+		annotations := obj.GetAnnotations()
+		origin, ok := annotations[kustomizeOriginAnnotation]
+		if !ok {
+			return ""
+		}
+		delete(annotations, kustomizeOriginAnnotation)
+		obj.SetAnnotations(annotations)
+
+		fields := parseOrigin(origin)
+		file := fields["path"]
+		if file == "" {
+			// generated objects only know the kustomization that configured them
+			file = fields["configuredIn"]
+		}
+		if file == "" {
+			return ""
+		}
+		if repo := fields["repo"]; repo != "" {
+			return repo + "//" + file
+		}
+		return sourcePath(root, filepath.Join(wrapperDir, file))
+	})
+}
+
+// parseOrigin reads the top-level string fields of an origin annotation, e.g.
+//
+//	path: ../base/deployment.yaml
+//	repo: https://github.com/example/repo
+func parseOrigin(origin string) map[string]string {
+	res := map[string]string{}
+	for _, line := range strings.Split(origin, "\n") {
+		if strings.HasPrefix(line, " ") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		res[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return res
+}
+
+// annotateDocuments sets the source annotation on every object of a multi-document
+// YAML stream. Objects are written back as JSON documents.
+func annotateDocuments(output []byte, source func(doc []byte, obj *unstructured.Unstructured) string) ([]byte, error) {
+	res := bytes.Buffer{}
+	for _, doc := range documentSplit.Split(string(output), -1) {
+		data, err := yamlutil.ToJSON([]byte(doc))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse rendered manifest")
+		}
+		if len(data) == 0 || string(data) == "null" {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(data); err != nil {
+			return nil, errors.Wrap(err, "could not parse rendered manifest")
+		}
+		if s := source([]byte(doc), obj); s != "" {
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[SourceAnnotation] = s
+			obj.SetAnnotations(annotations)
+		}
+
+		data, err = obj.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		res.Write(data)
+		res.WriteString("\n---\n")
+	}
+	return res.Bytes(), nil
+}
+
+// sourcePath returns the path of a file relative to the root of the scan
+func sourcePath(root string, file string) string {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	if fi, err := os.Stat(absRoot); err == nil && !fi.IsDir() {
+		absRoot = filepath.Dir(absRoot)
+	}
+	absFile, err := filepath.Abs(file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	rel, err := filepath.Rel(absRoot, absFile)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(absFile)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package shared
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/k8s/connection/shared/resources"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+const helmOutput = `---
+# Source: web/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-web
+spec:
+  selector:
+    app: test-web
+  ports:
+    - port: 80
+---
+# Source: web/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-web
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: test-web
+  template:
+    metadata:
+      labels:
+        app: test-web
+    spec:
+      containers:
+        - name: web
+          image: "nginx:1.27"
+`
+
+// fakeRenderer puts a shell script with the given name in front of PATH
+func fakeRenderer(t *testing.T, name string, script string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake renderers are shell scripts")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func sourcesOf(t *testing.T, manifest []byte) map[string]string {
+	objects, err := resources.ResourcesFromManifest(bytes.NewReader(manifest))
+	require.NoError(t, err)
+	res := map[string]string{}
+	for _, o := range objects {
+		m, err := meta.Accessor(o)
+		require.NoError(t, err)
+		kind, err := meta.TypeAccessor(o)
+		require.NoError(t, err)
+		res[kind.GetKind()+"/"+m.GetName()] = m.GetAnnotations()[SourceAnnotation]
+	}
+	return res
+}
+
+func TestDetectRenderers(t *testing.T) {
+	assert.True(t, IsHelmChart("./testdata/helm/web"))
+	assert.False(t, IsHelmChart("./testdata/helm"))
+	assert.True(t, IsKustomization("./testdata/kustomize/overlays/prod"))
+	assert.False(t, IsKustomization("./testdata/kustomize"))
+}
+
+func TestRenderOptionsFromConfig(t *testing.T) {
+	opts := RenderOptionsFromConfig(map[string]string{
+		OPTION_HELM_VALUES:        "values.yaml, prod.yaml",
+		OPTION_HELM_RELEASE:       "web",
+		OPTION_KUSTOMIZE_OVERLAYS: "overlays/prod",
+	})
+	assert.Equal(t, []string{"values.yaml", "prod.yaml"}, opts.HelmValues)
+	assert.Equal(t, "web", opts.HelmRelease)
+	assert.Equal(t, []string{"overlays/prod"}, opts.KustomizeOverlays)
+
+	assert.Empty(t, RenderOptionsFromConfig(map[string]string{}).HelmValues)
+}
+
+func TestAnnotateHelmSources(t *testing.T) {
+	out, err := annotateHelmSources([]byte(helmOutput), "charts/web")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Service/test-web":    "charts/web/templates/service.yaml",
+		"Deployment/test-web": "charts/web/templates/deployment.yaml",
+	}, sourcesOf(t, out))
+}
+
+func TestAnnotateKustomizeSources(t *testing.T) {
+	output := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  annotations:
+    config.kubernetes.io/origin: |
+      configuredIn: ../repo/overlays/prod/kustomization.yaml
+      configuredBy:
+        apiVersion: builtin
+        kind: ConfigMapGenerator
+  name: web-config-8g2h7hk9f6
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    config.kubernetes.io/origin: |
+      path: ../repo/overlays/prod/../../base/deployment.yaml
+    team: web
+  name: web
+`
+	out, err := annotateKustomizeSources([]byte(output), "/tmp/wrapper", "/tmp/repo")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"ConfigMap/web-config-8g2h7hk9f6": "overlays/prod/kustomization.yaml",
+		"Deployment/web":                  "base/deployment.yaml",
+	}, sourcesOf(t, out))
+
+	objects, err := resources.ResourcesFromManifest(bytes.NewReader(out))
+	require.NoError(t, err)
+	m, err := meta.Accessor(objects[1])
+	require.NoError(t, err)
+	assert.NotContains(t, m.GetAnnotations(), kustomizeOriginAnnotation)
+	assert.Equal(t, "web", m.GetAnnotations()["team"])
+}
+
+func TestLoadManifestHelmChart(t *testing.T) {
+	// prints the arguments to stderr so that they show up in failures
+	fakeRenderer(t, "helm", `echo "$@" >&2
+[ "$1" = "template" ] && [ "$2" = "test" ] && [ "$4" = "--values" ] || exit 1
+cat <<'EOF'
+`+helmOutput+`EOF
+`)
+
+	manifest, err := LoadManifest("./testdata/helm", RenderOptions{
+		HelmRelease: "test",
+		HelmValues:  []string{"./testdata/helm/web/values.yaml"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Service/test-web":    "web/templates/service.yaml",
+		"Deployment/test-web": "web/templates/deployment.yaml",
+	}, sourcesOf(t, manifest))
+}
+
+func TestLoadManifestKustomizeOverlay(t *testing.T) {
+	// resolves the overlay from the wrapper kustomization like kustomize does
+	fakeRenderer(t, "kustomize", `[ "$1" = "build" ] || exit 1
+overlay=$(sed -n 's/^- \(.*\)$/\1/p' "$2/kustomization.yaml" | head -n 1)
+cat <<EOF
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    config.kubernetes.io/origin: |
+      path: $overlay/../../base/deployment.yaml
+  name: web
+  namespace: prod
+EOF
+`)
+
+	manifest, err := LoadManifest("./testdata/kustomize", RenderOptions{
+		KustomizeOverlays: []string{"overlays/prod"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Deployment/web": "base/deployment.yaml",
+	}, sourcesOf(t, manifest))
+}
+
+func TestLoadManifestNestedKustomization(t *testing.T) {
+	// names objects after the overlay, so that every render can be told apart
+	fakeRenderer(t, "kustomize", `[ "$1" = "build" ] || exit 1
+overlay=$(sed -n 's/^- \(.*\)$/\1/p' "$2/kustomization.yaml" | head -n 1)
+cat <<EOF
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    config.kubernetes.io/origin: |
+      path: $overlay/../../base/deployment.yaml
+  name: web-$(basename $overlay)
+EOF
+`)
+
+	// the base is only rendered as part of the overlay
+	manifest, err := LoadManifest("./testdata/kustomize", RenderOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Deployment/web-prod": "base/deployment.yaml",
+	}, sourcesOf(t, manifest))
+}
+
+func TestLoadManifestMissingRenderer(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := LoadManifest("./testdata/kustomize/overlays/prod", RenderOptions{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, exec.ErrNotFound)
+	assert.Contains(t, err.Error(), "kubectl")
+
+	_, err = LoadManifest("./testdata/helm/web", RenderOptions{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, exec.ErrNotFound)
+	assert.Contains(t, err.Error(), "helm")
+
+	// kustomizations in subdirectories are loaded as plain manifests
+	manifest, err := LoadManifest("./testdata/kustomize", RenderOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), "nginx:1.27")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 
 	"go.mondoo.com/mql/v13"
 	"go.mondoo.com/mql/v13/llx"
@@ -50,6 +51,15 @@ func parseDiscover(flags map[string]*llx.Primitive) *inventory.Discovery {
 	return &inventory.Discovery{Targets: targets}
 }
 
+// joinListFlag joins the entries of a list flag into a comma-separated option
+func joinListFlag(flag *llx.Primitive) string {
+	entries := make([]string, 0, len(flag.Array))
+	for i := range flag.Array {
+		entries = append(entries, string(flag.Array[i].Value))
+	}
+	return strings.Join(entries, ",")
+}
+
 func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
 	flags := req.Flags
 	if flags == nil {
@@ -89,6 +99,20 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		}
 	}
 
+	if x, ok := flags[shared.OPTION_HELM_VALUES]; ok && len(x.Array) != 0 {
+		conf.Options[shared.OPTION_HELM_VALUES] = joinListFlag(x)
+	}
+
+	if x, ok := flags[shared.OPTION_HELM_RELEASE]; ok {
+		if release := string(x.Value); release != "" {
+			conf.Options[shared.OPTION_HELM_RELEASE] = release
+		}
+	}
+
+	if x, ok := flags[shared.OPTION_KUSTOMIZE_OVERLAYS]; ok && len(x.Array) != 0 {
+		conf.Options[shared.OPTION_KUSTOMIZE_OVERLAYS] = joinListFlag(x)
+	}
+
 	asset := &inventory.Asset{
 		Connections: []*inventory.Config{conf},
 	}
@@ -1007,6 +1007,10 @@ func addMondooAssetLabels(assetLabels map[string]string, objMeta metav1.Object,
 		assetLabels["k8s.mondoo.com/resource-version"] = objMeta.GetResourceVersion()
 	}
 	assetLabels["k8s.mondoo.com/cluster-id"] = clusterIdentifier
+	if source := objMeta.GetAnnotations()[shared.SourceAnnotation]; source != "" {
+		// objects rendered from Helm charts or Kustomize overlays
+		assetLabels["k8s.mondoo.com/source"] = source
+	}
 
 	owners := objMeta.GetOwnerReferences()
 	if len(owners) > 0 {
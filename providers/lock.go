@@ -0,0 +1,124 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DefaultLockFile is the name of the lock file that is looked up in projects
+// if no other lock file is configured
+const DefaultLockFile = "providers.lock.json"
+
+// LockFile pins providers to fixed versions. Installs and updates use the
+// pinned versions instead of the latest ones, so that all runs that share a
+// lock file use identical providers.
+type LockFile struct {
+	Providers []ProviderVersion `json:"providers"`
+}
+
+var (
+	// LockFilePath is where the lock file is loaded from and saved to
+	LockFilePath = DefaultLockFile
+	// Lock has the pinned versions for all installs and updates
+	Lock = &LockFile{}
+)
+
+// ResolveLockFile returns the lock file for runs in dir. That is the closest
+// lock file in dir or its parents up to the project root, which is the first
+// directory with a .git entry. Projects without a lock file get one in their
+// root. Outside of projects, the lock file is in fallbackDir.
+func ResolveLockFile(dir string, fallbackDir string) string {
+	for cur := filepath.Clean(dir); ; {
+		path := filepath.Join(cur, DefaultLockFile)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		if _, err := os.Stat(filepath.Join(cur, ".git")); err == nil {
+			return path
+		}
+
+		parent := filepath.Dir(cur)
+		if parent == cur {
+			return filepath.Join(fallbackDir, DefaultLockFile)
+		}
+		cur = parent
+	}
+}
+
+// LoadLockFile reads a lock file. A missing file is treated as empty.
+func LoadLockFile(path string) (*LockFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &LockFile{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to read providers lock file")
+	}
+
+	var res LockFile
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to parse providers lock file "+path)
+	}
+	for i := range res.Providers {
+		if res.Providers[i].Name == "" || res.Providers[i].Version == "" {
+			return nil, errors.New("providers lock file " + path + " has entries without name or version")
+		}
+	}
+	return &res, nil
+}
+
+// Save writes the lock file with its providers sorted by name
+func (l *LockFile) Save(path string) error {
+	sort.Slice(l.Providers, func(i, j int) bool {
+		return l.Providers[i].Name < l.Providers[j].Name
+	})
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Version returns the pinned version of a provider or an empty string if it
+// isn't pinned
+func (l *LockFile) Version(name string) string {
+	if l == nil {
+		return ""
+	}
+	for i := range l.Providers {
+		if l.Providers[i].Name == name {
+			return l.Providers[i].Version
+		}
+	}
+	return ""
+}
+
+// Pin sets the version of a provider
+func (l *LockFile) Pin(name string, version string) {
+	for i := range l.Providers {
+		if l.Providers[i].Name == name {
+			l.Providers[i] = ProviderVersion{Name: name, Version: version}
+			return
+		}
+	}
+	l.Providers = append(l.Providers, ProviderVersion{Name: name, Version: version})
+}
+
+// Unpin removes the pinned version of a provider and returns false if it
+// wasn't pinned
+func (l *LockFile) Unpin(name string) bool {
+	for i := range l.Providers {
+		if l.Providers[i].Name == name {
+			l.Providers = append(l.Providers[:i], l.Providers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
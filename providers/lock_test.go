@@ -0,0 +1,183 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultLockFile)
+
+	lock, err := LoadLockFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, lock.Providers)
+	assert.Equal(t, "", lock.Version("os"))
+
+	lock.Pin("os", "11.0.0")
+	lock.Pin("aws", "10.1.0")
+	lock.Pin("os", "11.0.1")
+	assert.Equal(t, "11.0.1", lock.Version("os"))
+	require.NoError(t, lock.Save(path))
+
+	loaded, err := LoadLockFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []ProviderVersion{
+		{Name: "aws", Version: "10.1.0"},
+		{Name: "os", Version: "11.0.1"},
+	}, loaded.Providers)
+
+	assert.True(t, loaded.Unpin("aws"))
+	assert.False(t, loaded.Unpin("aws"))
+	assert.Equal(t, "", loaded.Version("aws"))
+
+	var nilLock *LockFile
+	assert.Equal(t, "", nilLock.Version("os"))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"providers":[{"name":"os"}]}`), 0o644))
+	_, err = LoadLockFile(path)
+	assert.Error(t, err)
+}
+
+func TestResolveLockFile(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "project")
+	nested := filepath.Join(project, "envs", "prod")
+	other := filepath.Join(root, "other", "dir")
+	fallback := filepath.Join(root, "config")
+	require.NoError(t, os.MkdirAll(filepath.Join(project, ".git"), 0o755))
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+	require.NoError(t, os.MkdirAll(other, 0o755))
+
+	tests := []struct {
+		name     string
+		dir      string
+		lockDir  string
+		expected string
+	}{
+		{
+			name:     "project without lock file",
+			dir:      nested,
+			expected: filepath.Join(project, DefaultLockFile),
+		},
+		{
+			name:     "closest lock file in the project",
+			dir:      nested,
+			lockDir:  filepath.Join(project, "envs"),
+			expected: filepath.Join(project, "envs", DefaultLockFile),
+		},
+		{
+			name:     "outside of projects",
+			dir:      other,
+			expected: filepath.Join(fallback, DefaultLockFile),
+		},
+		{
+			name:     "lock file in a parent outside of projects",
+			dir:      other,
+			lockDir:  filepath.Join(root, "other"),
+			expected: filepath.Join(root, "other", DefaultLockFile),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.lockDir != "" {
+				path := filepath.Join(tt.lockDir, DefaultLockFile)
+				require.NoError(t, os.WriteFile(path, []byte(`{"providers":[]}`), 0o644))
+				defer os.Remove(path)
+			}
+			assert.Equal(t, tt.expected, ResolveLockFile(tt.dir, fallback))
+		})
+	}
+}
+
+// withTestRegistry serves provider packages for the given versions and
+// installs providers into a temporary directory
+func withTestRegistry(t *testing.T, latest string, packages map[string][]byte) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest.json" {
+			json.NewEncoder(w).Encode(ProviderVersions{ // nolint:errcheck
+				Providers: []ProviderVersion{{Name: "dummy", Version: latest}},
+			})
+			return
+		}
+		for version, pkg := range packages {
+			if r.URL.Path == "/dummy/"+version+"/"+providerFilename("dummy", version, runtime.GOOS, runtime.GOARCH) {
+				w.Write(pkg) // nolint:errcheck
+				return
+			}
+		}
+		http.NotFound(w, r)
+	}))
+
+	prevRegistry, prevPath, prevCustomPath, prevLock := registry, DefaultPath, CustomProviderPath, Lock
+	t.Cleanup(func() {
+		server.Close()
+		registry, DefaultPath, CustomProviderPath, Lock = prevRegistry, prevPath, prevCustomPath, prevLock
+		CachedProviders = nil
+	})
+	SetProviderRegistry(NewMondooProviderRegistry(WithBaseURL(server.URL)))
+	DefaultPath = t.TempDir()
+	CustomProviderPath = DefaultPath
+	CachedProviders = nil
+	Lock = &LockFile{}
+}
+
+func TestInstall_PinnedVersion(t *testing.T) {
+	withTestRegistry(t, "2.0.0", map[string][]byte{
+		"1.0.0": testProviderPackageVersion(t, "dummy", "1.0.0"),
+		"2.0.0": testProviderPackageVersion(t, "dummy", "2.0.0"),
+	})
+
+	installed, err := Install("dummy", "")
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", installed.Version)
+
+	// pinned versions are used for installs without a version and for
+	// updates, even if they are older
+	Lock.Pin("dummy", "1.0.0")
+	installed, err = Install("dummy", "")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", installed.Version)
+
+	Lock.Pin("dummy", "2.0.0")
+	updated, err := TryProviderUpdate(installed, UpdateProvidersConfig{Enabled: true, RefreshInterval: 60 * 60})
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", updated.Version)
+
+	updated, err = TryProviderUpdate(updated, UpdateProvidersConfig{Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", updated.Version)
+}
+
+func TestUninstall(t *testing.T) {
+	withTestRegistry(t, "1.0.0", nil)
+
+	installed, err := InstallIO(io.NopCloser(bytes.NewReader(testProviderPackage(t, "dummy"))), InstallConf{Dst: DefaultPath})
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+	require.DirExists(t, installed[0].Path)
+
+	removed, err := Uninstall("dummy")
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	assert.NoDirExists(t, installed[0].Path)
+
+	_, err = Uninstall("dummy")
+	assert.ErrorContains(t, err, "not installed")
+
+	_, err = Uninstall("core")
+	assert.ErrorContains(t, err, "builtin")
+}
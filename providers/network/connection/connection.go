@@ -86,3 +86,9 @@ func (p *HostConnection) Client(followRedirects bool) *http.Client {
 	}
 	return c
 }
+
+// Transport returns the shared transport of this connection. Callers that
+// need different TLS settings must clone it.
+func (p *HostConnection) Transport() *http.Transport {
+	return p.transport
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers/network/resources/dnsshake"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (d *mqlDns) caa() ([]any, error) {
+	dnsShaker, err := dnsshake.New(d.Fqdn.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := dnsShaker.Caa()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, 0, len(records))
+	for _, record := range records {
+		o, err := CreateResource(d.MqlRuntime, "dns.caaRecord", map[string]*llx.RawData{
+			"domain":     llx.StringData(record.Domain),
+			"flag":       llx.IntData(int64(record.Flag)),
+			"critical":   llx.BoolData(record.Critical()),
+			"tag":        llx.StringData(record.Tag),
+			"value":      llx.StringData(record.Value),
+			"issuer":     llx.StringData(record.Issuer()),
+			"parameters": llx.MapData(llx.TMap2Raw(record.Parameters()), types.String),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+
+func (d *mqlDnsCaaRecord) id() (string, error) {
+	hasher := sha256.New()
+	hasher.Write([]byte(strconv.FormatInt(d.Flag.Data, 10) + " " + d.Tag.Data + " " + d.Value.Data))
+	return "dns.caa/" + d.Domain.Data + "/" + hex.EncodeToString(hasher.Sum(nil)), nil
+}
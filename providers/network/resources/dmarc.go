@@ -0,0 +1,68 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/resources/dnsshake"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (d *mqlDns) dmarc() (*mqlDnsDmarcRecord, error) {
+	dnsShaker, err := dnsshake.New(d.Fqdn.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dmarc, err := dnsShaker.Dmarc()
+	if err != nil {
+		return nil, err
+	}
+	if dmarc == nil {
+		d.Dmarc.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.dmarcRecord", map[string]*llx.RawData{
+		"dnsTxt":              llx.StringData(dmarc.DnsTxt),
+		"domain":              llx.StringData(dmarc.Domain),
+		"version":             llx.StringData(dmarc.Version),
+		"policy":              llx.StringData(dmarc.Policy),
+		"subdomainPolicy":     llx.StringData(dmarc.SubdomainPolicy),
+		"percentage":          llx.IntData(int64(dmarc.Percentage)),
+		"aggregateReportUris": llx.ArrayData(llx.TArr2Raw(dmarc.AggregateReportURIs), types.String),
+		"failureReportUris":   llx.ArrayData(llx.TArr2Raw(dmarc.FailureReportURIs), types.String),
+		"dkimAlignment":       llx.StringData(dmarc.DkimAlignment),
+		"spfAlignment":        llx.StringData(dmarc.SpfAlignment),
+		"failureOptions":      llx.ArrayData(llx.TArr2Raw(dmarc.FailureOptions), types.String),
+		"reportFormats":       llx.ArrayData(llx.TArr2Raw(dmarc.ReportFormats), types.String),
+		"reportInterval":      llx.IntData(dmarc.ReportInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+	record := o.(*mqlDnsDmarcRecord)
+	record.dmarc = dmarc
+	return record, nil
+}
+
+type mqlDnsDmarcRecordInternal struct {
+	dmarc *dnsshake.DmarcRecord
+}
+
+func (d *mqlDnsDmarcRecord) id() (string, error) {
+	return "dns.dmarc/" + d.Domain.Data, nil
+}
+
+func (d *mqlDnsDmarcRecord) valid() (bool, error) {
+	if d.dmarc == nil {
+		return false, errors.New("could not load dmarc data")
+	}
+
+	ok, _ := d.dmarc.Valid()
+	return ok, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"strconv"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers/network/resources/dnsshake"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (d *mqlDns) dnssec() (*mqlDnsDnssec, error) {
+	dnsShaker, err := dnsshake.New(d.Fqdn.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := dnsShaker.Dnssec()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]any, 0, len(status.Keys))
+	for _, key := range status.Keys {
+		o, err := CreateResource(d.MqlRuntime, "dns.dnssec.key", map[string]*llx.RawData{
+			"keyTag":        llx.IntData(int64(key.KeyTag)),
+			"flags":         llx.IntData(int64(key.Flags)),
+			"protocol":      llx.IntData(int64(key.Protocol)),
+			"algorithm":     llx.StringData(key.Algorithm),
+			"keySigningKey": llx.BoolData(key.IsKeySigningKey()),
+			"publicKey":     llx.StringData(key.PublicKey),
+		})
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, o)
+	}
+
+	signers := make([]any, 0, len(status.DelegationSigners))
+	for _, ds := range status.DelegationSigners {
+		o, err := CreateResource(d.MqlRuntime, "dns.dnssec.ds", map[string]*llx.RawData{
+			"keyTag":     llx.IntData(int64(ds.KeyTag)),
+			"algorithm":  llx.StringData(ds.Algorithm),
+			"digestType": llx.StringData(ds.DigestType),
+			"digest":     llx.StringData(ds.Digest),
+		})
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, o)
+	}
+
+	expiration := llx.NilData
+	if status.SignatureExpiration != nil {
+		expiration = llx.TimeData(*status.SignatureExpiration)
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.dnssec", map[string]*llx.RawData{
+		"zone":                llx.StringData(status.Zone),
+		"signed":              llx.BoolData(status.Signed),
+		"delegationValidated": llx.BoolData(status.DelegationValidated),
+		"authenticatedData":   llx.BoolData(status.AuthenticatedData),
+		"keys":                llx.ArrayData(keys, types.Resource("dns.dnssec.key")),
+		"delegationSigners":   llx.ArrayData(signers, types.Resource("dns.dnssec.ds")),
+		"signatureExpiration": expiration,
+		"errors":              llx.ArrayData(llx.TArr2Raw(status.Errors), types.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlDnsDnssec), nil
+}
+
+func (d *mqlDnsDnssec) id() (string, error) {
+	return "dns.dnssec/" + d.Zone.Data, nil
+}
+
+func (d *mqlDnsDnssecKey) id() (string, error) {
+	return "dns.dnssec.key/" + strconv.FormatInt(d.KeyTag.Data, 10) + "/" + d.Algorithm.Data + "/" + d.PublicKey.Data, nil
+}
+
+func (d *mqlDnsDnssecDs) id() (string, error) {
+	return "dns.dnssec.ds/" + strconv.FormatInt(d.KeyTag.Data, 10) + "/" + d.DigestType.Data + "/" + d.Digest.Data, nil
+}
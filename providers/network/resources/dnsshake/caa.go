@@ -0,0 +1,94 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// CaaRecord represents a Certification Authority Authorization record
+// see https://datatracker.ietf.org/doc/html/rfc8659#section-4
+type CaaRecord struct {
+	// Domain the record was published for
+	Domain string
+	// Flags of the record
+	Flag uint8
+	// Property tag, e.g. issue, issuewild or iodef
+	Tag string
+	// Property value
+	Value string
+}
+
+// Critical returns true if the issuer critical flag is set, which means that
+// CAs must not issue certificates if they do not understand the tag
+func (r *CaaRecord) Critical() bool {
+	return r.Flag&128 != 0
+}
+
+// Issuer returns the domain name of the CA for issue and issuewild
+// properties. An empty issuer forbids the issuance of certificates.
+func (r *CaaRecord) Issuer() string {
+	if !r.isIssueTag() {
+		return ""
+	}
+	issuer, _, _ := strings.Cut(r.Value, ";")
+	return strings.TrimSpace(issuer)
+}
+
+// Parameters returns the key/value parameters of issue and issuewild
+// properties, e.g. accounturi or validationmethods
+func (r *CaaRecord) Parameters() map[string]string {
+	res := map[string]string{}
+	if !r.isIssueTag() {
+		return res
+	}
+	_, params, _ := strings.Cut(r.Value, ";")
+	for _, param := range strings.Split(params, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok {
+			res[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return res
+}
+
+func (r *CaaRecord) isIssueTag() bool {
+	tag := strings.ToLower(r.Tag)
+	return tag == "issue" || tag == "issuewild" || tag == "issuemail"
+}
+
+// Caa returns the CAA records that apply to the domain. If the domain has no
+// CAA records, the records of the closest parent domain apply.
+// see https://datatracker.ietf.org/doc/html/rfc8659#section-3
+func (d *DnsClient) Caa() ([]*CaaRecord, error) {
+	labels := dns.SplitDomainName(d.fqdn)
+	for i := range labels {
+		name := strings.Join(labels[i:], ".")
+		rrs, err := d.lookup(name, dns.TypeCAA)
+		if err != nil {
+			return nil, err
+		}
+		if len(rrs) == 0 {
+			continue
+		}
+
+		res := make([]*CaaRecord, 0, len(rrs))
+		for j := range rrs {
+			caa, ok := rrs[j].(*dns.CAA)
+			if !ok {
+				continue
+			}
+			res = append(res, &CaaRecord{
+				Domain: name,
+				Flag:   caa.Flag,
+				Tag:    caa.Tag,
+				Value:  caa.Value,
+			})
+		}
+		return res, nil
+	}
+	return []*CaaRecord{}, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaaRecord(t *testing.T) {
+	r := &CaaRecord{Flag: 128, Tag: "issue", Value: "letsencrypt.org; validationmethods=dns-01; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/1"}
+	assert.True(t, r.Critical())
+	assert.Equal(t, "letsencrypt.org", r.Issuer())
+	assert.Equal(t, map[string]string{
+		"validationmethods": "dns-01",
+		"accounturi":        "https://acme-v02.api.letsencrypt.org/acme/acct/1",
+	}, r.Parameters())
+
+	r = &CaaRecord{Tag: "issuewild", Value: ";"}
+	assert.False(t, r.Critical())
+	assert.Equal(t, "", r.Issuer())
+
+	r = &CaaRecord{Tag: "iodef", Value: "mailto:security@example.com"}
+	assert.Equal(t, "", r.Issuer())
+	assert.Empty(t, r.Parameters())
+}
+
+func TestCaaLookup(t *testing.T) {
+	config := startTestDnsServer(t, &testDnsServer{records: mustRRs(t,
+		"example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600",
+		`example.com. 300 IN CAA 0 issue "letsencrypt.org"`,
+		`example.com. 300 IN CAA 0 iodef "mailto:security@example.com"`,
+		`shop.example.com. 300 IN CAA 0 issue "digicert.com"`,
+		"www.shop.example.com. 300 IN A 192.0.2.1",
+	)})
+
+	t.Run("records of the domain", func(t *testing.T) {
+		records, err := NewWithConfig("shop.example.com", config).Caa()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "shop.example.com", records[0].Domain)
+		assert.Equal(t, "digicert.com", records[0].Issuer())
+	})
+
+	t.Run("records of the closest parent", func(t *testing.T) {
+		records, err := NewWithConfig("www.example.com", config).Caa()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "example.com", records[0].Domain)
+		assert.Equal(t, "letsencrypt.org", records[0].Issuer())
+		assert.Equal(t, "iodef", records[1].Tag)
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		records, err := NewWithConfig("example.net", config).Caa()
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/mql/v13/providers/network/resources/domain"
+)
+
+// DmarcRecord represents a parsed DMARC policy record
+// see https://datatracker.ietf.org/doc/html/rfc7489#section-6.3
+type DmarcRecord struct {
+	// Domain the record was published for, e.g. _dmarc.example.com
+	Domain string
+	// DNS text representation
+	DnsTxt string
+	// Version (plain-text; REQUIRED, must be "DMARC1")
+	Version string
+	// Requested mail receiver policy (plain-text; REQUIRED)
+	Policy string
+	// Requested mail receiver policy for all subdomains (plain-text; OPTIONAL, defaults to the policy)
+	SubdomainPolicy string
+	// Percentage of messages to which the policy is applied (plain-text integer; OPTIONAL, default is 100)
+	Percentage int
+	// Addresses to which aggregate feedback is sent (comma-separated list of DMARC URIs; OPTIONAL)
+	AggregateReportURIs []string
+	// Addresses to which failure reports are sent (comma-separated list of DMARC URIs; OPTIONAL)
+	FailureReportURIs []string
+	// DKIM identifier alignment mode (plain-text; OPTIONAL, default is "r")
+	DkimAlignment string
+	// SPF identifier alignment mode (plain-text; OPTIONAL, default is "r")
+	SpfAlignment string
+	// Failure reporting options (plain-text; OPTIONAL, default is "0")
+	FailureOptions []string
+	// Format of failure reports (plain-text; OPTIONAL, default is "afrf")
+	ReportFormats []string
+	// Interval between aggregate reports in seconds (plain-text 32-bit unsigned integer; OPTIONAL, default is 86400)
+	ReportInterval int64
+}
+
+// NewDmarcRecord parses a DNS DMARC record
+// https://datatracker.ietf.org/doc/html/rfc7489#section-6.4
+func NewDmarcRecord(dmarcRecord string) (*DmarcRecord, error) {
+	tags, err := parseTagList(dmarcRecord)
+	if err != nil {
+		return nil, err
+	}
+	// RFC: the version tag MUST be the first tag in the record
+	if len(tags) == 0 || tags[0].key != "v" || tags[0].value != "DMARC1" {
+		return nil, errors.New("invalid DMARC record")
+	}
+
+	r := &DmarcRecord{
+		DnsTxt:         dmarcRecord,
+		Percentage:     100,
+		DkimAlignment:  "r",
+		SpfAlignment:   "r",
+		FailureOptions: []string{"0"},
+		ReportFormats:  []string{"afrf"},
+		ReportInterval: 86400,
+	}
+	for _, tag := range tags {
+		switch tag.key {
+		case "v":
+			r.Version = tag.value
+		case "p":
+			r.Policy = strings.ToLower(tag.value)
+		case "sp":
+			r.SubdomainPolicy = strings.ToLower(tag.value)
+		case "pct":
+			pct, err := strconv.Atoi(tag.value)
+			if err != nil {
+				return nil, errors.New("invalid DMARC percentage: " + tag.value)
+			}
+			r.Percentage = pct
+		case "rua":
+			r.AggregateReportURIs = splitList(tag.value, ",")
+		case "ruf":
+			r.FailureReportURIs = splitList(tag.value, ",")
+		case "adkim":
+			r.DkimAlignment = strings.ToLower(tag.value)
+		case "aspf":
+			r.SpfAlignment = strings.ToLower(tag.value)
+		case "fo":
+			r.FailureOptions = splitList(tag.value, ":")
+		case "rf":
+			r.ReportFormats = splitList(strings.ToLower(tag.value), ":")
+		case "ri":
+			ri, err := strconv.ParseInt(tag.value, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid DMARC report interval: " + tag.value)
+			}
+			r.ReportInterval = ri
+		}
+	}
+
+	if r.SubdomainPolicy == "" {
+		r.SubdomainPolicy = r.Policy
+	}
+	return r, nil
+}
+
+var dmarcPolicies = map[string]struct{}{
+	"none":       {},
+	"quarantine": {},
+	"reject":     {},
+}
+
+// Valid checks the record against the requirements of RFC 7489 and returns
+// the reasons why it is not valid
+func (r *DmarcRecord) Valid() (bool, []string) {
+	errorMsg := []string{}
+	if _, ok := dmarcPolicies[r.Policy]; !ok {
+		errorMsg = append(errorMsg, "policy must be one of none, quarantine, or reject")
+	}
+	if _, ok := dmarcPolicies[r.SubdomainPolicy]; !ok {
+		errorMsg = append(errorMsg, "subdomain policy must be one of none, quarantine, or reject")
+	}
+	if r.Percentage < 0 || r.Percentage > 100 {
+		errorMsg = append(errorMsg, "percentage must be between 0 and 100")
+	}
+	if r.DkimAlignment != "r" && r.DkimAlignment != "s" {
+		errorMsg = append(errorMsg, "DKIM alignment must be r or s")
+	}
+	if r.SpfAlignment != "r" && r.SpfAlignment != "s" {
+		errorMsg = append(errorMsg, "SPF alignment must be r or s")
+	}
+	for _, uri := range append(append([]string{}, r.AggregateReportURIs...), r.FailureReportURIs...) {
+		if !strings.HasPrefix(strings.ToLower(uri), "mailto:") && !strings.HasPrefix(strings.ToLower(uri), "https:") {
+			errorMsg = append(errorMsg, "unsupported report URI "+uri)
+		}
+	}
+	return len(errorMsg) == 0, errorMsg
+}
+
+// Dmarc looks up the DMARC policy of the domain. If the domain has no policy,
+// the policy of the organizational domain applies.
+// see https://datatracker.ietf.org/doc/html/rfc7489#section-6.6.3
+func (d *DnsClient) Dmarc() (*DmarcRecord, error) {
+	fqdn := strings.TrimSuffix(d.fqdn, ".")
+	r, err := d.dmarc(fqdn)
+	if r != nil || err != nil {
+		return r, err
+	}
+
+	dn, err := domain.Parse(fqdn)
+	if err != nil || dn.EffectiveTLDPlusOne == "" || dn.EffectiveTLDPlusOne == fqdn {
+		return nil, nil
+	}
+	return d.dmarc(dn.EffectiveTLDPlusOne)
+}
+
+func (d *DnsClient) dmarc(fqdn string) (*DmarcRecord, error) {
+	name := "_dmarc." + fqdn
+	record, err := d.singleTxt(name, "v=DMARC1")
+	if record == "" || err != nil {
+		return nil, err
+	}
+
+	r, err := NewDmarcRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	r.Domain = name
+	return r, nil
+}
+
+type tag struct {
+	key   string
+	value string
+}
+
+// parseTagList parses the tag=value lists that are used by DMARC, MTA-STS,
+// and TLS-RPT records
+// see https://datatracker.ietf.org/doc/html/rfc6376#section-3.2
+func parseTagList(record string) ([]tag, error) {
+	res := []tag{}
+	for _, entry := range strings.Split(record, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, errors.New("invalid tag " + entry)
+		}
+		res = append(res, tag{
+			key:   strings.ToLower(strings.TrimSpace(key)),
+			value: strings.TrimSpace(value),
+		})
+	}
+	return res, nil
+}
+
+func splitList(value string, sep string) []string {
+	res := []string{}
+	for _, entry := range strings.Split(value, sep) {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
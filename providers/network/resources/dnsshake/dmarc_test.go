@@ -0,0 +1,153 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDmarcRecord(t *testing.T) {
+	type test struct {
+		Title       string
+		DnsTxt      string
+		Expected    *DmarcRecord
+		ParseErr    error
+		IsValid     bool
+		ValidErrors []string
+	}
+
+	testCases := []test{
+		{
+			Title:  "minimal dmarc record",
+			DnsTxt: "v=DMARC1; p=none",
+			Expected: &DmarcRecord{
+				DnsTxt:          "v=DMARC1; p=none",
+				Version:         "DMARC1",
+				Policy:          "none",
+				SubdomainPolicy: "none",
+				Percentage:      100,
+				DkimAlignment:   "r",
+				SpfAlignment:    "r",
+				FailureOptions:  []string{"0"},
+				ReportFormats:   []string{"afrf"},
+				ReportInterval:  86400,
+			},
+			IsValid:     true,
+			ValidErrors: []string{},
+		},
+		{
+			Title:  "full dmarc record",
+			DnsTxt: "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:agg@example.net; ruf=mailto:forensic@example.com; adkim=s; aspf=s; fo=1:d; rf=afrf; ri=3600",
+			Expected: &DmarcRecord{
+				DnsTxt:              "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:agg@example.net; ruf=mailto:forensic@example.com; adkim=s; aspf=s; fo=1:d; rf=afrf; ri=3600",
+				Version:             "DMARC1",
+				Policy:              "reject",
+				SubdomainPolicy:     "quarantine",
+				Percentage:          50,
+				AggregateReportURIs: []string{"mailto:dmarc@example.com", "mailto:agg@example.net"},
+				FailureReportURIs:   []string{"mailto:forensic@example.com"},
+				DkimAlignment:       "s",
+				SpfAlignment:        "s",
+				FailureOptions:      []string{"1", "d"},
+				ReportFormats:       []string{"afrf"},
+				ReportInterval:      3600,
+			},
+			IsValid:     true,
+			ValidErrors: []string{},
+		},
+		{
+			Title:    "version must be the first tag",
+			DnsTxt:   "p=none; v=DMARC1",
+			ParseErr: errors.New("invalid DMARC record"),
+		},
+		{
+			Title:    "invalid percentage",
+			DnsTxt:   "v=DMARC1; p=none; pct=all",
+			ParseErr: errors.New("invalid DMARC percentage: all"),
+		},
+		{
+			Title:  "invalid policy and report uri",
+			DnsTxt: "v=DMARC1; p=block; rua=dmarc@example.com",
+			Expected: &DmarcRecord{
+				DnsTxt:              "v=DMARC1; p=block; rua=dmarc@example.com",
+				Version:             "DMARC1",
+				Policy:              "block",
+				SubdomainPolicy:     "block",
+				Percentage:          100,
+				AggregateReportURIs: []string{"dmarc@example.com"},
+				DkimAlignment:       "r",
+				SpfAlignment:        "r",
+				FailureOptions:      []string{"0"},
+				ReportFormats:       []string{"afrf"},
+				ReportInterval:      86400,
+			},
+			IsValid: false,
+			ValidErrors: []string{
+				"policy must be one of none, quarantine, or reject",
+				"subdomain policy must be one of none, quarantine, or reject",
+				"unsupported report URI dmarc@example.com",
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Title, func(t *testing.T) {
+			r, err := NewDmarcRecord(tc.DnsTxt)
+			if tc.ParseErr != nil {
+				assert.Equal(t, tc.ParseErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, r)
+
+			valid, errs := r.Valid()
+			assert.Equal(t, tc.IsValid, valid)
+			assert.Equal(t, tc.ValidErrors, errs)
+		})
+	}
+}
+
+func TestDmarcLookup(t *testing.T) {
+	config := startTestDnsServer(t, &testDnsServer{records: mustRRs(t,
+		"example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600",
+		`_dmarc.example.com. 300 IN TXT "v=DMARC1; p=reject; " "rua=mailto:dmarc@example.com"`,
+		`_dmarc.example.com. 300 IN TXT "some other record"`,
+		`_dmarc.news.example.com. 300 IN TXT "v=DMARC1; p=none"`,
+		`_dmarc.example.org. 300 IN TXT "v=DMARC1; p=none"`,
+		`_dmarc.example.org. 300 IN TXT "v=DMARC1; p=reject"`,
+	)})
+
+	t.Run("domain policy", func(t *testing.T) {
+		r, err := NewWithConfig("news.example.com", config).Dmarc()
+		require.NoError(t, err)
+		require.NotNil(t, r)
+		assert.Equal(t, "_dmarc.news.example.com", r.Domain)
+		assert.Equal(t, "none", r.Policy)
+	})
+
+	t.Run("organizational domain policy", func(t *testing.T) {
+		r, err := NewWithConfig("mail.example.com", config).Dmarc()
+		require.NoError(t, err)
+		require.NotNil(t, r)
+		assert.Equal(t, "_dmarc.example.com", r.Domain)
+		assert.Equal(t, "reject", r.Policy)
+		assert.Equal(t, []string{"mailto:dmarc@example.com"}, r.AggregateReportURIs)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		r, err := NewWithConfig("example.net", config).Dmarc()
+		require.NoError(t, err)
+		assert.Nil(t, r)
+	})
+
+	t.Run("multiple policies", func(t *testing.T) {
+		_, err := NewWithConfig("example.org", config).Dmarc()
+		assert.EqualError(t, err, "multiple records found for _dmarc.example.org")
+	})
+}
@@ -0,0 +1,230 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DNS Security Extensions (DNSSEC)
+// https://datatracker.ietf.org/doc/html/rfc4033
+//
+// The validation only covers the delegation to the zone that a domain belongs
+// to: the DS records of the parent zone must match a key signing key, the
+// DNSKEY set must be signed by that key, and the SOA of the zone must be
+// signed by a key of the set. The chain of trust is not followed further up
+// to the root, i.e. the DS records themselves are not validated. Whether the
+// full chain is trusted is reported by a validating resolver in the
+// authenticated data (AD) flag.
+
+// DnssecStatus describes the DNSSEC configuration of a zone
+type DnssecStatus struct {
+	// Zone that the domain belongs to
+	Zone string
+	// Whether the zone publishes DNSKEY records
+	Signed bool
+	// Whether the DS records of the parent zone match a key that signs the
+	// DNSKEY set, and the zone data is signed by that set. The parent zones are
+	// not validated.
+	DelegationValidated bool
+	// Whether the resolver validated the answers, see RFC 4035 section 3.2.3
+	AuthenticatedData bool
+	// Public keys of the zone
+	Keys []*DnssecKey
+	// Delegation signer records in the parent zone
+	DelegationSigners []*DnssecDelegationSigner
+	// Earliest expiration of the validated signatures
+	SignatureExpiration *time.Time
+	// Reasons why the chain of trust is not valid
+	Errors []string
+}
+
+// DnssecKey represents a DNSKEY record
+type DnssecKey struct {
+	KeyTag    uint16
+	Flags     uint16
+	Protocol  uint8
+	Algorithm string
+	PublicKey string
+}
+
+// IsKeySigningKey returns true if the secure entry point flag is set
+func (k *DnssecKey) IsKeySigningKey() bool {
+	return k.Flags&dns.SEP != 0
+}
+
+// DnssecDelegationSigner represents a DS record
+type DnssecDelegationSigner struct {
+	KeyTag     uint16
+	Algorithm  string
+	DigestType string
+	Digest     string
+}
+
+// Dnssec validates the DNSSEC delegation of the zone the domain belongs to
+func (d *DnsClient) Dnssec() (*DnssecStatus, error) {
+	zone, err := d.zone(d.fqdn)
+	if err != nil {
+		return nil, err
+	}
+	status := &DnssecStatus{
+		Zone:              zone,
+		Keys:              []*DnssecKey{},
+		DelegationSigners: []*DnssecDelegationSigner{},
+		Errors:            []string{},
+	}
+
+	keyMsg, err := d.exchange(zone, dns.TypeDNSKEY, true)
+	if err != nil {
+		return nil, err
+	}
+	status.AuthenticatedData = keyMsg.AuthenticatedData
+
+	keyRRs, keySigs := splitSignatures(keyMsg.Answer, dns.TypeDNSKEY)
+	keys := make([]*dns.DNSKEY, 0, len(keyRRs))
+	for i := range keyRRs {
+		key := keyRRs[i].(*dns.DNSKEY)
+		keys = append(keys, key)
+		status.Keys = append(status.Keys, &DnssecKey{
+			KeyTag:    key.KeyTag(),
+			Flags:     key.Flags,
+			Protocol:  key.Protocol,
+			Algorithm: dns.AlgorithmToString[key.Algorithm],
+			PublicKey: key.PublicKey,
+		})
+	}
+	if len(keys) == 0 {
+		return status, nil
+	}
+	status.Signed = true
+
+	dsMsg, err := d.exchange(zone, dns.TypeDS, true)
+	if err != nil {
+		return nil, err
+	}
+	dsRRs, _ := splitSignatures(dsMsg.Answer, dns.TypeDS)
+
+	// the DS records in the parent zone establish which keys are trusted
+	trusted := []*dns.DNSKEY{}
+	for i := range dsRRs {
+		ds := dsRRs[i].(*dns.DS)
+		status.DelegationSigners = append(status.DelegationSigners, &DnssecDelegationSigner{
+			KeyTag:     ds.KeyTag,
+			Algorithm:  dns.AlgorithmToString[ds.Algorithm],
+			DigestType: dns.HashToString[ds.DigestType],
+			Digest:     strings.ToLower(ds.Digest),
+		})
+		for _, key := range keys {
+			if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+				continue
+			}
+			if digest := key.ToDS(ds.DigestType); digest != nil && strings.EqualFold(digest.Digest, ds.Digest) {
+				trusted = append(trusted, key)
+			}
+		}
+	}
+	switch {
+	case len(dsRRs) == 0:
+		status.Errors = append(status.Errors, "no DS records found in the parent zone")
+	case len(trusted) == 0:
+		status.Errors = append(status.Errors, "no DNSKEY matches the DS records of the parent zone")
+	}
+
+	now := time.Now()
+	if len(trusted) > 0 {
+		expiration, err := verifySignatures(keySigs, keyRRs, trusted, now)
+		if err != nil {
+			status.Errors = append(status.Errors, "DNSKEY records: "+err.Error())
+		} else {
+			status.SignatureExpiration = &expiration
+		}
+	}
+
+	soaMsg, err := d.exchange(zone, dns.TypeSOA, true)
+	if err != nil {
+		return nil, err
+	}
+	soaRRs, soaSigs := splitSignatures(soaMsg.Answer, dns.TypeSOA)
+	expiration, err := verifySignatures(soaSigs, soaRRs, keys, now)
+	if err != nil {
+		status.Errors = append(status.Errors, "SOA record: "+err.Error())
+	} else if status.SignatureExpiration == nil || expiration.Before(*status.SignatureExpiration) {
+		status.SignatureExpiration = &expiration
+	}
+
+	status.DelegationValidated = len(status.Errors) == 0
+	return status, nil
+}
+
+// zone returns the apex of the zone that the name belongs to, which is the
+// owner of the SOA record in the answer or authority section
+func (d *DnsClient) zone(name string) (string, error) {
+	r, err := d.exchange(name, dns.TypeSOA, false)
+	if err != nil {
+		return "", err
+	}
+	for _, rrs := range [][]dns.RR{r.Answer, r.Ns} {
+		for i := range rrs {
+			if soa, ok := rrs[i].(*dns.SOA); ok {
+				return soa.Hdr.Name, nil
+			}
+		}
+	}
+	return "", errors.New("could not determine the zone of " + name)
+}
+
+// splitSignatures separates the records of a type from the signatures that
+// cover them
+func splitSignatures(rrs []dns.RR, dnsType uint16) ([]dns.RR, []*dns.RRSIG) {
+	records := []dns.RR{}
+	sigs := []*dns.RRSIG{}
+	for i := range rrs {
+		switch v := rrs[i].(type) {
+		case *dns.RRSIG:
+			if v.TypeCovered == dnsType {
+				sigs = append(sigs, v)
+			}
+		default:
+			if v.Header().Rrtype == dnsType {
+				records = append(records, v)
+			}
+		}
+	}
+	return records, sigs
+}
+
+// verifySignatures checks that at least one signature of the record set is
+// valid and made by one of the keys. It returns the expiration of the valid
+// signature.
+func verifySignatures(sigs []*dns.RRSIG, rrset []dns.RR, keys []*dns.DNSKEY, now time.Time) (time.Time, error) {
+	if len(rrset) == 0 {
+		return time.Time{}, errors.New("no records found")
+	}
+	if len(sigs) == 0 {
+		return time.Time{}, errors.New("no signatures found")
+	}
+
+	err := errors.New("no signature was made by a trusted key")
+	for _, sig := range sigs {
+		for _, key := range keys {
+			if sig.KeyTag != key.KeyTag() || sig.Algorithm != key.Algorithm {
+				continue
+			}
+			if verr := sig.Verify(key, rrset); verr != nil {
+				err = errors.New("invalid signature: " + verr.Error())
+				continue
+			}
+			if !sig.ValidityPeriod(now) {
+				err = errors.New("signature is expired or not yet valid")
+				continue
+			}
+			return time.Unix(int64(sig.Expiration), 0).UTC(), nil
+		}
+	}
+	return time.Time{}, err
+}
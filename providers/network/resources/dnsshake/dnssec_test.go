@@ -0,0 +1,132 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signedTestZone returns the records of a signed example.com zone and the
+// DS record that the parent zone publishes for it
+func signedTestZone(t *testing.T, expiration time.Time) ([]dns.RR, *dns.DS) {
+	ksk := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     dns.ZONE | dns.SEP,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	kskPriv, err := ksk.Generate(256)
+	require.NoError(t, err)
+
+	zsk := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     dns.ZONE,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	zskPriv, err := zsk.Generate(256)
+	require.NoError(t, err)
+
+	sign := func(key *dns.DNSKEY, priv crypto.PrivateKey, rrset []dns.RR) dns.RR {
+		sig := &dns.RRSIG{
+			Hdr:        dns.RR_Header{Name: rrset[0].Header().Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: 3600},
+			KeyTag:     key.KeyTag(),
+			SignerName: key.Hdr.Name,
+			Algorithm:  key.Algorithm,
+			Inception:  uint32(time.Now().Add(-time.Hour).Unix()),
+			Expiration: uint32(expiration.Unix()),
+		}
+		require.NoError(t, sig.Sign(priv.(crypto.Signer), rrset))
+		return sig
+	}
+
+	soa := mustRRs(t, "example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600")
+	keys := []dns.RR{ksk, zsk}
+
+	records := append([]dns.RR{}, soa...)
+	records = append(records, keys...)
+	records = append(records, sign(ksk, kskPriv, keys), sign(zsk, zskPriv, soa))
+	return records, ksk.ToDS(dns.SHA256)
+}
+
+func TestDnssec(t *testing.T) {
+	t.Run("valid chain of trust", func(t *testing.T) {
+		records, ds := signedTestZone(t, time.Now().Add(24*time.Hour))
+		config := startTestDnsServer(t, &testDnsServer{
+			records:           append(records, ds),
+			authenticatedData: true,
+		})
+
+		status, err := NewWithConfig("www.example.com", config).Dnssec()
+		require.NoError(t, err)
+		assert.Equal(t, "example.com.", status.Zone)
+		assert.True(t, status.Signed)
+		assert.True(t, status.AuthenticatedData)
+		assert.True(t, status.DelegationValidated)
+		assert.Empty(t, status.Errors)
+		require.NotNil(t, status.SignatureExpiration)
+
+		require.Len(t, status.Keys, 2)
+		assert.True(t, status.Keys[0].IsKeySigningKey())
+		assert.Equal(t, "ECDSAP256SHA256", status.Keys[0].Algorithm)
+		assert.False(t, status.Keys[1].IsKeySigningKey())
+		require.Len(t, status.DelegationSigners, 1)
+		assert.Equal(t, status.Keys[0].KeyTag, status.DelegationSigners[0].KeyTag)
+		assert.Equal(t, "SHA256", status.DelegationSigners[0].DigestType)
+	})
+
+	t.Run("missing DS record", func(t *testing.T) {
+		records, _ := signedTestZone(t, time.Now().Add(24*time.Hour))
+		config := startTestDnsServer(t, &testDnsServer{records: records})
+
+		status, err := NewWithConfig("example.com", config).Dnssec()
+		require.NoError(t, err)
+		assert.True(t, status.Signed)
+		assert.False(t, status.DelegationValidated)
+		assert.False(t, status.AuthenticatedData)
+		assert.Equal(t, []string{"no DS records found in the parent zone"}, status.Errors)
+	})
+
+	t.Run("DS record of another key", func(t *testing.T) {
+		records, _ := signedTestZone(t, time.Now().Add(24*time.Hour))
+		_, otherDs := signedTestZone(t, time.Now().Add(24*time.Hour))
+		config := startTestDnsServer(t, &testDnsServer{records: append(records, otherDs)})
+
+		status, err := NewWithConfig("example.com", config).Dnssec()
+		require.NoError(t, err)
+		assert.False(t, status.DelegationValidated)
+		assert.Equal(t, []string{"no DNSKEY matches the DS records of the parent zone"}, status.Errors)
+	})
+
+	t.Run("expired signatures", func(t *testing.T) {
+		records, ds := signedTestZone(t, time.Now().Add(-time.Minute))
+		config := startTestDnsServer(t, &testDnsServer{records: append(records, ds)})
+
+		status, err := NewWithConfig("example.com", config).Dnssec()
+		require.NoError(t, err)
+		assert.False(t, status.DelegationValidated)
+		assert.Equal(t, []string{
+			"DNSKEY records: signature is expired or not yet valid",
+			"SOA record: signature is expired or not yet valid",
+		}, status.Errors)
+	})
+
+	t.Run("unsigned zone", func(t *testing.T) {
+		config := startTestDnsServer(t, &testDnsServer{records: mustRRs(t,
+			"example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600",
+		)})
+
+		status, err := NewWithConfig("example.com", config).Dnssec()
+		require.NoError(t, err)
+		assert.False(t, status.Signed)
+		assert.False(t, status.DelegationValidated)
+		assert.Empty(t, status.Keys)
+	})
+}
@@ -59,10 +59,16 @@ func New(fqdn string) (*DnsClient, error) {
 		}
 	}
 
+	return NewWithConfig(fqdn, config), nil
+}
+
+// NewWithConfig returns a client that uses the servers of the given
+// configuration instead of the system resolver
+func NewWithConfig(fqdn string, config *dns.ClientConfig) *DnsClient {
 	return &DnsClient{
 		fqdn:   fqdn,
 		config: config,
-	}, nil
+	}
 }
 
 // stringToType is a map of strings to each RR type.
@@ -200,13 +206,7 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 
 	res := map[string]DnsRecord{}
 
-	c := &dns.Client{}
-	m := &dns.Msg{}
-	m.SetEdns0(4096, false)
-	m.SetQuestion(dns.Fqdn(fqdn), dnsType)
-	m.RecursionDesired = true
-
-	r, _, err := c.Exchange(m, net.JoinHostPort(d.config.Servers[0], d.config.Port))
+	r, err := d.exchange(fqdn, dnsType, false)
 	if err != nil {
 		res[dnsTypText] = DnsRecord{
 			Type:  dnsTypText,
@@ -292,3 +292,55 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 	}
 	return res, nil
 }
+
+// exchange sends a single question to the first configured server. If dnssec
+// is set, the DNSSEC records are requested as well.
+func (d *DnsClient) exchange(name string, dnsType uint16, dnssec bool) (*dns.Msg, error) {
+	c := &dns.Client{}
+	m := &dns.Msg{}
+	m.SetEdns0(4096, dnssec)
+	m.SetQuestion(dns.Fqdn(name), dnsType)
+	m.RecursionDesired = true
+
+	r, _, err := c.Exchange(m, net.JoinHostPort(d.config.Servers[0], d.config.Port))
+	return r, err
+}
+
+// lookup returns the answers for a name. Names that do not exist return no
+// answers instead of an error.
+func (d *DnsClient) lookup(name string, dnsType uint16) ([]dns.RR, error) {
+	r, err := d.exchange(name, dnsType, false)
+	if err != nil {
+		return nil, err
+	}
+	switch r.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, errors.New("dns query for " + name + " failed: " + dns.RcodeToString[r.Rcode])
+	}
+
+	res := []dns.RR{}
+	for i := range r.Answer {
+		if r.Answer[i].Header().Rrtype == dnsType {
+			res = append(res, r.Answer[i])
+		}
+	}
+	return res, nil
+}
+
+// lookupTxt returns the TXT records of a name. Records that are split into
+// multiple strings are joined.
+func (d *DnsClient) lookupTxt(name string) ([]string, error) {
+	rrs, err := d.lookup(name, dns.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rrs))
+	for i := range rrs {
+		if txt, ok := rrs[i].(*dns.TXT); ok {
+			res = append(res, strings.Join(txt.Txt, ""))
+		}
+	}
+	return res, nil
+}
@@ -4,8 +4,11 @@
 package dnsshake
 
 import (
+	"net"
+	"strings"
 	"testing"
 
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -18,3 +21,105 @@ func TestDnsShake(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, len(records) > 0)
 }
+
+func TestDnsShakeLocalServer(t *testing.T) {
+	config := startTestDnsServer(t, &testDnsServer{records: mustRRs(t,
+		"example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600",
+		"example.com. 300 IN A 192.0.2.1",
+		"example.com. 300 IN MX 10 mx.example.com.",
+	)})
+
+	records, err := NewWithConfig("example.com", config).Query("A", "MX", "TXT")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.1"}, records["A"].RData)
+	assert.Equal(t, []string{"10 mx.example.com."}, records["MX"].RData)
+	assert.Empty(t, records["TXT"].RData)
+}
+
+// testDnsServer is a stand-in for a recursive resolver that answers from a
+// fixed set of records
+type testDnsServer struct {
+	records []dns.RR
+	// authenticatedData sets the AD flag in DNSSEC responses
+	authenticatedData bool
+}
+
+func (s *testDnsServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := &dns.Msg{}
+	m.SetReply(req)
+	q := req.Question[0]
+	dnssec := req.IsEdns0() != nil && req.IsEdns0().Do()
+
+	nameExists := false
+	for _, rr := range s.records {
+		if !strings.EqualFold(rr.Header().Name, q.Name) {
+			continue
+		}
+		nameExists = true
+		if rr.Header().Rrtype == q.Qtype {
+			m.Answer = append(m.Answer, rr)
+		}
+		if sig, ok := rr.(*dns.RRSIG); ok && dnssec && sig.TypeCovered == q.Qtype {
+			m.Answer = append(m.Answer, rr)
+		}
+	}
+
+	if len(m.Answer) == 0 {
+		if !nameExists {
+			m.Rcode = dns.RcodeNameError
+		}
+		// add the SOA of the closest zone, like authoritative servers do
+		labels := dns.SplitDomainName(q.Name)
+		for i := range labels {
+			if soa := s.find(strings.Join(labels[i:], ".")+".", dns.TypeSOA); soa != nil {
+				m.Ns = append(m.Ns, soa)
+				break
+			}
+		}
+	}
+	m.AuthenticatedData = dnssec && s.authenticatedData
+	_ = w.WriteMsg(m)
+}
+
+func (s *testDnsServer) find(name string, dnsType uint16) dns.RR {
+	for _, rr := range s.records {
+		if strings.EqualFold(rr.Header().Name, name) && rr.Header().Rrtype == dnsType {
+			return rr
+		}
+	}
+	return nil
+}
+
+// startTestDnsServer runs the server on a local port and returns the client
+// configuration to reach it
+func startTestDnsServer(t *testing.T, handler *testDnsServer) *dns.ClientConfig {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{PacketConn: pc, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	<-started
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	_, port, err := net.SplitHostPort(pc.LocalAddr().String())
+	require.NoError(t, err)
+	return &dns.ClientConfig{
+		Servers: []string{"127.0.0.1"},
+		Port:    port,
+	}
+}
+
+func mustRRs(t *testing.T, records ...string) []dns.RR {
+	res := make([]dns.RR, len(records))
+	for i := range records {
+		rr, err := dns.NewRR(records[i])
+		require.NoError(t, err)
+		res[i] = rr
+	}
+	return res
+}
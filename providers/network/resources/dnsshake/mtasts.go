@@ -0,0 +1,256 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SMTP MTA Strict Transport Security (MTA-STS)
+// https://datatracker.ietf.org/doc/html/rfc8461
+//
+// SMTP TLS Reporting (TLS-RPT)
+// https://datatracker.ietf.org/doc/html/rfc8460
+
+// MtaStsRecord represents the TXT record that announces an MTA-STS policy
+// see https://datatracker.ietf.org/doc/html/rfc8461#section-3.1
+type MtaStsRecord struct {
+	// Domain the record was published for, e.g. _mta-sts.example.com
+	Domain string
+	// DNS text representation
+	DnsTxt string
+	// Version (plain-text; REQUIRED, must be "STSv1")
+	Version string
+	// Identifier of the current policy (plain-text; REQUIRED)
+	Id string
+}
+
+var reMtaStsId = regexp.MustCompile(`^[a-zA-Z0-9]{1,32}$`)
+
+// NewMtaStsRecord parses a DNS MTA-STS record
+func NewMtaStsRecord(record string) (*MtaStsRecord, error) {
+	tags, err := parseTagList(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 || tags[0].key != "v" || tags[0].value != "STSv1" {
+		return nil, errors.New("invalid MTA-STS record")
+	}
+
+	r := &MtaStsRecord{DnsTxt: record}
+	for _, tag := range tags {
+		switch tag.key {
+		case "v":
+			r.Version = tag.value
+		case "id":
+			r.Id = tag.value
+		}
+	}
+	if !reMtaStsId.MatchString(r.Id) {
+		return nil, errors.New("invalid MTA-STS policy id")
+	}
+	return r, nil
+}
+
+// MtaStsPolicy represents the policy that is served via HTTPS
+// see https://datatracker.ietf.org/doc/html/rfc8461#section-3.2
+type MtaStsPolicy struct {
+	// Version (REQUIRED, must be "STSv1")
+	Version string
+	// Policy mode: enforce, testing, or none (REQUIRED)
+	Mode string
+	// Allowed MX patterns (REQUIRED unless the mode is none)
+	Mx []string
+	// Maximum lifetime of the policy in seconds (REQUIRED)
+	MaxAge int64
+}
+
+// ParseMtaStsPolicy parses the key/value pairs of an MTA-STS policy file
+func ParseMtaStsPolicy(r io.Reader) (*MtaStsPolicy, error) {
+	policy := &MtaStsPolicy{MaxAge: -1}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, errors.New("invalid MTA-STS policy line: " + line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "version":
+			policy.Version = value
+		case "mode":
+			policy.Mode = value
+		case "mx":
+			policy.Mx = append(policy.Mx, value)
+		case "max_age":
+			maxAge, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid MTA-STS max_age: " + value)
+			}
+			policy.MaxAge = maxAge
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// maxMtaStsAge is the maximum lifetime of a policy, which is about one year
+const maxMtaStsAge = 31557600
+
+// Valid checks the policy against the requirements of RFC 8461 and returns
+// the reasons why it is not valid
+func (p *MtaStsPolicy) Valid() (bool, []string) {
+	errorMsg := []string{}
+	if p.Version != "STSv1" {
+		errorMsg = append(errorMsg, "version must be STSv1")
+	}
+	switch p.Mode {
+	case "enforce", "testing":
+		if len(p.Mx) == 0 {
+			errorMsg = append(errorMsg, "policy must list at least one mx")
+		}
+	case "none":
+	default:
+		errorMsg = append(errorMsg, "mode must be one of enforce, testing, or none")
+	}
+	if p.MaxAge < 0 || p.MaxAge > maxMtaStsAge {
+		errorMsg = append(errorMsg, "max_age must be between 0 and 31557600")
+	}
+	return len(errorMsg) == 0, errorMsg
+}
+
+// MtaStsPolicyUrl returns the location of the MTA-STS policy of a domain
+func MtaStsPolicyUrl(fqdn string) string {
+	return "https://mta-sts." + strings.TrimSuffix(fqdn, ".") + "/.well-known/mta-sts.txt"
+}
+
+// FetchMtaStsPolicy downloads the MTA-STS policy of a domain. Redirects are
+// not followed, as required by the RFC.
+func FetchMtaStsPolicy(client *http.Client, fqdn string) (*MtaStsPolicy, error) {
+	c := *client
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := c.Get(MtaStsPolicyUrl(fqdn))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch MTA-STS policy: " + resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		return nil, errors.New("MTA-STS policy has invalid content type " + ct)
+	}
+
+	// policies are small, the limit protects against misconfigured servers
+	return ParseMtaStsPolicy(io.LimitReader(resp.Body, 64*1024))
+}
+
+// MtaSts looks up the MTA-STS record of the domain
+func (d *DnsClient) MtaSts() (*MtaStsRecord, error) {
+	name := "_mta-sts." + strings.TrimSuffix(d.fqdn, ".")
+	record, err := d.singleTxt(name, "v=STSv1")
+	if record == "" || err != nil {
+		return nil, err
+	}
+
+	r, err := NewMtaStsRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	r.Domain = name
+	return r, nil
+}
+
+// TlsRptRecord represents a parsed SMTP TLS reporting record
+// see https://datatracker.ietf.org/doc/html/rfc8460#section-3
+type TlsRptRecord struct {
+	// Domain the record was published for, e.g. _smtp._tls.example.com
+	Domain string
+	// DNS text representation
+	DnsTxt string
+	// Version (plain-text; REQUIRED, must be "TLSRPTv1")
+	Version string
+	// Addresses to which reports are sent (comma-separated list of URIs; REQUIRED)
+	ReportURIs []string
+}
+
+// NewTlsRptRecord parses a DNS TLS-RPT record
+func NewTlsRptRecord(record string) (*TlsRptRecord, error) {
+	tags, err := parseTagList(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 || tags[0].key != "v" || tags[0].value != "TLSRPTv1" {
+		return nil, errors.New("invalid TLS-RPT record")
+	}
+
+	r := &TlsRptRecord{DnsTxt: record}
+	for _, tag := range tags {
+		switch tag.key {
+		case "v":
+			r.Version = tag.value
+		case "rua":
+			r.ReportURIs = splitList(tag.value, ",")
+		}
+	}
+	if len(r.ReportURIs) == 0 {
+		return nil, errors.New("TLS-RPT record has no report URIs")
+	}
+	return r, nil
+}
+
+// TlsRpt looks up the TLS-RPT record of the domain
+func (d *DnsClient) TlsRpt() (*TlsRptRecord, error) {
+	name := "_smtp._tls." + strings.TrimSuffix(d.fqdn, ".")
+	record, err := d.singleTxt(name, "v=TLSRPTv1")
+	if record == "" || err != nil {
+		return nil, err
+	}
+
+	r, err := NewTlsRptRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	r.Domain = name
+	return r, nil
+}
+
+// singleTxt returns the only TXT record of a name that starts with the
+// prefix. Other records are ignored, multiple matching records are an error.
+func (d *DnsClient) singleTxt(name string, prefix string) (string, error) {
+	txts, err := d.lookupTxt(name)
+	if err != nil {
+		return "", err
+	}
+
+	res := ""
+	for i := range txts {
+		txt := strings.TrimSpace(txts[i])
+		if !strings.HasPrefix(txt, prefix) {
+			continue
+		}
+		if res != "" {
+			return "", errors.New("multiple records found for " + name)
+		}
+		res = txt
+	}
+	return res, nil
+}
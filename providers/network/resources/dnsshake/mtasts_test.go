@@ -0,0 +1,118 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMtaStsRecord(t *testing.T) {
+	r, err := NewMtaStsRecord("v=STSv1; id=20240101T000000;")
+	require.NoError(t, err)
+	assert.Equal(t, "STSv1", r.Version)
+	assert.Equal(t, "20240101T000000", r.Id)
+
+	_, err = NewMtaStsRecord("id=20240101; v=STSv1")
+	assert.EqualError(t, err, "invalid MTA-STS record")
+
+	_, err = NewMtaStsRecord("v=STSv1; id=2024-01-01")
+	assert.EqualError(t, err, "invalid MTA-STS policy id")
+}
+
+func TestMtaStsPolicy(t *testing.T) {
+	policy, err := ParseMtaStsPolicy(strings.NewReader("version: STSv1\r\nmode: enforce\r\nmx: mail.example.com\r\nmx: *.example.net\r\nmax_age: 604800\r\n"))
+	require.NoError(t, err)
+	assert.Equal(t, &MtaStsPolicy{
+		Version: "STSv1",
+		Mode:    "enforce",
+		Mx:      []string{"mail.example.com", "*.example.net"},
+		MaxAge:  604800,
+	}, policy)
+	valid, errs := policy.Valid()
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	policy, err = ParseMtaStsPolicy(strings.NewReader("version: STSv1\nmode: enforce\n"))
+	require.NoError(t, err)
+	valid, errs = policy.Valid()
+	assert.False(t, valid)
+	assert.Equal(t, []string{"policy must list at least one mx", "max_age must be between 0 and 31557600"}, errs)
+}
+
+func TestFetchMtaStsPolicy(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "mta-sts.example.com":
+			assert.Equal(t, "/.well-known/mta-sts.txt", r.URL.Path)
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("version: STSv1\nmode: testing\nmx: mail.example.com\nmax_age: 86400\n"))
+		default:
+			http.Redirect(w, r, "https://mta-sts.example.com/.well-known/mta-sts.txt", http.StatusMovedPermanently)
+		}
+	}))
+	defer server.Close()
+
+	// resolve all policy hosts to the test server
+	client := server.Client()
+	transport := client.Transport.(*http.Transport)
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+
+	policy, err := FetchMtaStsPolicy(client, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "testing", policy.Mode)
+	assert.Equal(t, []string{"mail.example.com"}, policy.Mx)
+
+	// redirects must not be followed
+	_, err = FetchMtaStsPolicy(client, "example.net")
+	assert.EqualError(t, err, "failed to fetch MTA-STS policy: 301 Moved Permanently")
+}
+
+func TestTlsRptRecord(t *testing.T) {
+	r, err := NewTlsRptRecord("v=TLSRPTv1; rua=mailto:tlsrpt@example.com,https://reports.example.com/tlsrpt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mailto:tlsrpt@example.com", "https://reports.example.com/tlsrpt"}, r.ReportURIs)
+
+	_, err = NewTlsRptRecord("v=TLSRPTv1")
+	assert.EqualError(t, err, "TLS-RPT record has no report URIs")
+}
+
+func TestMtaStsLookup(t *testing.T) {
+	config := startTestDnsServer(t, &testDnsServer{records: mustRRs(t,
+		"example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 7200 3600 1209600 3600",
+		`_mta-sts.example.com. 300 IN TXT "v=STSv1; id=20240101"`,
+		`_smtp._tls.example.com. 300 IN TXT "v=TLSRPTv1; rua=mailto:tlsrpt@example.com"`,
+	)})
+
+	client := NewWithConfig("example.com", config)
+	mtaSts, err := client.MtaSts()
+	require.NoError(t, err)
+	require.NotNil(t, mtaSts)
+	assert.Equal(t, "_mta-sts.example.com", mtaSts.Domain)
+	assert.Equal(t, "20240101", mtaSts.Id)
+
+	tlsRpt, err := client.TlsRpt()
+	require.NoError(t, err)
+	require.NotNil(t, tlsRpt)
+	assert.Equal(t, "_smtp._tls.example.com", tlsRpt.Domain)
+	assert.Equal(t, []string{"mailto:tlsrpt@example.com"}, tlsRpt.ReportURIs)
+
+	client = NewWithConfig("example.net", config)
+	mtaSts, err = client.MtaSts()
+	require.NoError(t, err)
+	assert.Nil(t, mtaSts)
+	tlsRpt, err = client.TlsRpt()
+	require.NoError(t, err)
+	assert.Nil(t, tlsRpt)
+}
@@ -27,6 +27,20 @@ type mqlHttpGetInternal struct {
 }
 
 func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initHttpUrl(runtime, args, "http.get"); err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := args["followRedirects"]; !ok {
+		args["followRedirects"] = llx.BoolData(false)
+	}
+
+	return args, nil, nil
+}
+
+// initHttpUrl turns the rawUrl argument into a url resource. Without a URL,
+// the host of the connection is used.
+func initHttpUrl(runtime *plugin.Runtime, args map[string]*llx.RawData, resource string) error {
 	if rawUrl, ok := args["rawUrl"]; ok {
 		// We add a default prefix if it is missing. Otherwise the URL parser
 		// will return unintuitive results. For example: "mondoo.com" would
@@ -42,7 +56,7 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 			"scheme": llx.StringData("http"),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 
 		delete(args, "rawUrl")
@@ -52,7 +66,7 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 	if _, ok := args["url"]; !ok {
 		conn := runtime.Connection.(*connection.HostConnection)
 		if conn.Conf == nil {
-			return nil, nil, errors.New("missing URL for http.get")
+			return errors.New("missing URL for " + resource)
 		}
 
 		scheme := conn.Conf.Runtime
@@ -72,17 +86,13 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 			"scheme": llx.StringData(scheme),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 		args["url"] = llx.ResourceData(url, "url")
 		args["followRedirects"] = llx.BoolData(conn.FollowRedirects)
 	}
 
-	if _, ok := args["followRedirects"]; !ok {
-		args["followRedirects"] = llx.BoolData(false)
-	}
-
-	return args, nil, nil
+	return nil
 }
 
 func (x *mqlHttpGet) id() (string, error) {
@@ -123,7 +133,11 @@ func (x *mqlHttpGet) header() (*mqlHttpHeader, error) {
 		return nil, err
 	}
 
-	header := x.resp.Data.Header
+	return newHttpHeader(x.MqlRuntime, x.__id, x.resp.Data.Header)
+}
+
+// newHttpHeader creates the header resource of a response
+func newHttpHeader(runtime *plugin.Runtime, id string, header http.Header) (*mqlHttpHeader, error) {
 	params := make(map[string]any, len(header))
 	for key := range header {
 		mkey := textproto.CanonicalMIMEHeaderKey(key)
@@ -135,8 +149,8 @@ func (x *mqlHttpGet) header() (*mqlHttpHeader, error) {
 		params[normalizeHeaderKey(mkey)] = ivals
 	}
 
-	o, err := CreateResource(x.MqlRuntime, "http.header", map[string]*llx.RawData{
-		"__id":   llx.StringData(x.__id),
+	o, err := CreateResource(runtime, "http.header", map[string]*llx.RawData{
+		"__id":   llx.StringData(id),
 		"params": llx.MapData(params, types.Array(types.String)),
 	})
 	if err != nil {
@@ -0,0 +1,418 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/mql/v13/checksums"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/connection"
+	"go.mondoo.com/mql/v13/types"
+	"go.mondoo.com/mql/v13/utils/sortx"
+)
+
+// maxHttpBodySize limits how much of a response body is read into memory
+const maxHttpBodySize = 32 * 1024 * 1024
+
+// maxHttpRedirects matches the limit of the default Go HTTP client
+const maxHttpRedirects = 10
+
+type mqlHttpRequestInternal struct {
+	lock sync.Mutex
+	done bool
+	err  error
+	// the private key, header values and the request body are never stored
+	// in fields, so that credentials don't end up in results or recordings
+	clientKey    string
+	headers      map[string]string
+	requestBody  string
+	resp         *http.Response
+	respBody     []byte
+	phases       map[string]time.Duration
+	redirectHops []httpRedirect
+}
+
+type httpRedirect struct {
+	url        string
+	statusCode int
+	location   string
+	header     http.Header
+}
+
+func initHttpRequest(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initHttpUrl(runtime, args, "http.request"); err != nil {
+		return nil, nil, err
+	}
+
+	if method, ok := args["method"]; ok {
+		args["method"] = llx.StringData(strings.ToUpper(method.Value.(string)))
+	} else {
+		args["method"] = llx.StringData(http.MethodGet)
+	}
+	if _, ok := args["timeout"]; !ok {
+		args["timeout"] = llx.IntData(0)
+	}
+	if _, ok := args["followRedirects"]; !ok {
+		args["followRedirects"] = llx.BoolData(false)
+	}
+	if _, ok := args["insecure"]; !ok {
+		conn := runtime.Connection.(*connection.HostConnection)
+		args["insecure"] = llx.BoolData(conn.Conf != nil && conn.Conf.Insecure)
+	}
+	if _, ok := args["clientCertificate"]; !ok {
+		args["clientCertificate"] = llx.StringData("")
+	}
+
+	clientKey := ""
+	if raw, ok := args["clientKey"]; ok {
+		clientKey = raw.Value.(string)
+		delete(args, "clientKey")
+	}
+	if (clientKey == "") != (args["clientCertificate"].Value.(string) == "") {
+		return nil, nil, errors.New("clientCertificate and clientKey must be provided together")
+	}
+
+	headers := map[string]string{}
+	if raw, ok := args["headers"]; ok {
+		for key, value := range raw.Value.(map[string]any) {
+			headers[key] = value.(string)
+		}
+		delete(args, "headers")
+	}
+	headerNames := []any{}
+	for _, key := range sortx.Keys(headers) {
+		headerNames = append(headerNames, key)
+	}
+	args["headerNames"] = llx.ArrayData(headerNames, types.String)
+
+	requestBody := ""
+	if raw, ok := args["requestBody"]; ok {
+		requestBody = raw.Value.(string)
+		delete(args, "requestBody")
+	}
+
+	// the id covers the header values and the request body, which are not
+	// part of the fields, so it is computed here instead of on creation
+	req := &mqlHttpRequest{MqlRuntime: runtime}
+	if err := SetAllData(req, args); err != nil {
+		return nil, nil, err
+	}
+	req.headers = headers
+	req.requestBody = requestBody
+	id, err := req.id()
+	if err != nil {
+		return nil, nil, err
+	}
+	args["__id"] = llx.StringData(id)
+
+	res, err := CreateResource(runtime, "http.request", args)
+	if err != nil {
+		return nil, nil, err
+	}
+	// a cached request with the same id already has the same headers and body
+	req = res.(*mqlHttpRequest)
+	if req.headers == nil {
+		req.clientKey = clientKey
+		req.headers = headers
+		req.requestBody = requestBody
+	}
+	return nil, req, nil
+}
+
+func (x *mqlHttpRequest) id() (string, error) {
+	if x.Url.Data == nil {
+		return "", errors.New("missing URL for http.request")
+	}
+
+	res := checksums.New.
+		Add(x.Url.Data.__id).
+		Add(x.Method.Data).
+		Add(strconv.FormatInt(x.Timeout.Data, 10)).
+		Add(strconv.FormatBool(x.FollowRedirects.Data)).
+		Add(strconv.FormatBool(x.Insecure.Data)).
+		Add(x.ClientCertificate.Data)
+	for _, key := range x.HeaderNames.Data {
+		res = res.Add(key.(string))
+	}
+	// header values and the body may contain credentials, so only their
+	// hashes are added
+	res = res.Add(hashHttpHeaders(x.headers)).Add(hashString(x.requestBody))
+	return "http.request/" + x.Url.Data.__id + "/" + res.String(), nil
+}
+
+func hashHttpHeaders(headers map[string]string) string {
+	h := sha256.New()
+	for _, key := range sortx.Keys(headers) {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write([]byte(headers[key]))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hashString(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func (x *mqlHttpRequest) do() error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.done {
+		return x.err
+	}
+	x.done = true
+	x.err = x.send()
+	if x.err != nil {
+		return x.err
+	}
+
+	x.StatusCode.Data = int64(x.resp.StatusCode)
+	x.StatusCode.State = plugin.StateIsSet
+
+	x.Version.Data = strconv.Itoa(x.resp.ProtoMajor) + "." + strconv.Itoa(x.resp.ProtoMinor)
+	x.Version.State = plugin.StateIsSet
+	return nil
+}
+
+// send runs the request and reads the complete response
+//
+// NOTE that this method must be called with the lock held
+func (x *mqlHttpRequest) send() error {
+	if x.Url.Data == nil {
+		return errors.New("missing URL for http.request")
+	}
+
+	client, transport, err := x.client()
+	if err != nil {
+		return err
+	}
+	defer transport.CloseIdleConnections()
+
+	var reqBody io.Reader
+	if x.requestBody != "" {
+		reqBody = strings.NewReader(x.requestBody)
+	}
+	req, err := http.NewRequest(x.Method.Data, x.Url.Data.String.Data, reqBody)
+	if err != nil {
+		return err
+	}
+	for key, value := range x.headers {
+		if strings.EqualFold(key, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Set(key, value)
+	}
+
+	x.phases = map[string]time.Duration{}
+	start := time.Now()
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), x.trace(start)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	x.respBody, err = io.ReadAll(io.LimitReader(resp.Body, maxHttpBodySize))
+	if err != nil {
+		return err
+	}
+	x.phases["total"] = time.Since(start)
+	x.resp = resp
+	return nil
+}
+
+// client returns a client with the TLS options of this request. It records
+// every redirect that it follows.
+func (x *mqlHttpRequest) client() (*http.Client, *http.Transport, error) {
+	conn := x.MqlRuntime.Connection.(*connection.HostConnection)
+	transport := conn.Transport().Clone()
+
+	tlsConfig := &tls.Config{}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+	tlsConfig.InsecureSkipVerify = x.Insecure.Data
+	if x.ClientCertificate.Data != "" {
+		cert, err := tls.X509KeyPair([]byte(x.ClientCertificate.Data), []byte(x.clientKey))
+		if err != nil {
+			return nil, nil, errors.New("failed to load client certificate: " + err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(x.Timeout.Data) * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if !x.FollowRedirects.Data {
+				return http.ErrUseLastResponse
+			}
+			if len(via) >= maxHttpRedirects {
+				return errors.New("stopped after " + strconv.Itoa(maxHttpRedirects) + " redirects")
+			}
+
+			// the response that caused this redirect
+			prev := req.Response
+			x.redirectHops = append(x.redirectHops, httpRedirect{
+				url:        via[len(via)-1].URL.String(),
+				statusCode: prev.StatusCode,
+				location:   prev.Header.Get("Location"),
+				header:     prev.Header,
+			})
+			return nil
+		},
+	}
+	return client, transport, nil
+}
+
+// trace records the timings of the request phases. For redirects, the
+// timings of the last request are kept.
+func (x *mqlHttpRequest) trace(start time.Time) *httptrace.ClientTrace {
+	// connections may be attempted in parallel, e.g. for IPv4 and IPv6
+	var lock sync.Mutex
+	var dnsStart, connectStart, tlsStart time.Time
+	record := func(name string, since time.Time) {
+		lock.Lock()
+		x.phases[name] = time.Since(since)
+		lock.Unlock()
+	}
+
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			lock.Lock()
+			dnsStart = time.Now()
+			lock.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			record("dnsLookup", dnsStart)
+		},
+		ConnectStart: func(string, string) {
+			lock.Lock()
+			connectStart = time.Now()
+			lock.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			record("connect", connectStart)
+		},
+		TLSHandshakeStart: func() {
+			lock.Lock()
+			tlsStart = time.Now()
+			lock.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			record("tlsHandshake", tlsStart)
+		},
+		GotFirstResponseByte: func() {
+			record("firstByte", start)
+		},
+	}
+}
+
+func (x *mqlHttpRequest) header() (*mqlHttpHeader, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	return newHttpHeader(x.MqlRuntime, x.__id, x.resp.Header)
+}
+
+func (x *mqlHttpRequest) statusCode() (int64, error) {
+	return 0, x.do()
+}
+
+func (x *mqlHttpRequest) version() (string, error) {
+	return "", x.do()
+}
+
+func (x *mqlHttpRequest) body() (string, error) {
+	if err := x.do(); err != nil {
+		return "", err
+	}
+	return string(x.respBody), nil
+}
+
+func (x *mqlHttpRequest) json() (any, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	if len(x.respBody) == 0 {
+		x.Json.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	var res any
+	if err := json.Unmarshal(x.respBody, &res); err != nil {
+		return nil, errors.New("failed to parse response body as JSON: " + err.Error())
+	}
+	return res, nil
+}
+
+func (x *mqlHttpRequest) duration() (*time.Time, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	res := llx.DurationToTime(0).Add(x.phases["total"])
+	return &res, nil
+}
+
+func (x *mqlHttpRequest) timings() (map[string]any, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	res := make(map[string]any, len(x.phases))
+	for name, d := range x.phases {
+		res[name] = d.Milliseconds()
+	}
+	return res, nil
+}
+
+func (x *mqlHttpRequest) redirects() ([]any, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+
+	res := make([]any, 0, len(x.redirectHops))
+	for i, redirect := range x.redirectHops {
+		id := x.__id + "/redirect/" + strconv.Itoa(i)
+		header, err := newHttpHeader(x.MqlRuntime, id, redirect.header)
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := CreateResource(x.MqlRuntime, "http.redirect", map[string]*llx.RawData{
+			"__id":       llx.StringData(id),
+			"url":        llx.StringData(redirect.url),
+			"statusCode": llx.IntData(int64(redirect.statusCode)),
+			"location":   llx.StringData(redirect.location),
+			"header":     llx.ResourceData(header, "http.header"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+
+func (x *mqlHttpRedirect) id() (string, error) {
+	return "", errors.New("http redirect not initialized")
+}
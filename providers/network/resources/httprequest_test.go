@@ -0,0 +1,249 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/connection"
+	"go.mondoo.com/mql/v13/types"
+	"go.mondoo.com/mql/v13/utils/syncx"
+)
+
+func newTestHttpRuntime() *plugin.Runtime {
+	return &plugin.Runtime{
+		Resources:  &syncx.Map[plugin.Resource]{},
+		Connection: connection.NewHostConnection(1, &inventory.Asset{}, &inventory.Config{}),
+	}
+}
+
+func newTestHttpRequest(t *testing.T, runtime *plugin.Runtime, args map[string]*llx.RawData) *mqlHttpRequest {
+	res, err := NewResource(runtime, "http.request", args)
+	require.NoError(t, err)
+	return res.(*mqlHttpRequest)
+}
+
+func TestHttpRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"method":        r.Method,
+			"authorization": r.Header.Get("Authorization"),
+			"body":          string(body),
+		})
+	}))
+	defer server.Close()
+
+	runtime := newTestHttpRuntime()
+	req := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl": llx.StringData(server.URL + "/api"),
+		"method": llx.StringData("post"),
+		"headers": llx.MapData(map[string]any{
+			"Authorization": "Bearer secret",
+		}, types.String),
+		"requestBody": llx.StringData(`{"name":"mql"}`),
+		"timeout":     llx.IntData(5),
+	})
+	assert.Equal(t, "POST", req.Method.Data)
+	// header values and the body may contain credentials and are not stored
+	assert.Equal(t, []any{"Authorization"}, req.HeaderNames.Data)
+
+	statusCode := req.GetStatusCode()
+	require.NoError(t, statusCode.Error)
+	assert.Equal(t, int64(http.StatusCreated), statusCode.Data)
+	assert.Equal(t, "1.1", req.GetVersion().Data)
+
+	data := req.GetJson()
+	require.NoError(t, data.Error)
+	assert.Equal(t, map[string]any{
+		"method":        "POST",
+		"authorization": "Bearer secret",
+		"body":          `{"name":"mql"}`,
+	}, data.Data)
+
+	header := req.GetHeader()
+	require.NoError(t, header.Error)
+	assert.Equal(t, "nosniff", header.Data.GetXContentTypeOptions().Data)
+
+	timings := req.GetTimings()
+	require.NoError(t, timings.Error)
+	assert.Contains(t, timings.Data, "total")
+	assert.Contains(t, timings.Data, "firstByte")
+	require.NoError(t, req.GetDuration().Error)
+
+	redirects := req.GetRedirects()
+	require.NoError(t, redirects.Error)
+	assert.Empty(t, redirects.Data)
+
+	// the same request with other headers is a different resource
+	other := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl":      llx.StringData(server.URL + "/api"),
+		"method":      llx.StringData("POST"),
+		"requestBody": llx.StringData(`{"name":"mql"}`),
+		"timeout":     llx.IntData(5),
+	})
+	assert.NotEqual(t, req.MqlID(), other.MqlID())
+
+	// so is the same request with other header values or another body
+	otherHeader := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl": llx.StringData(server.URL + "/api"),
+		"method": llx.StringData("POST"),
+		"headers": llx.MapData(map[string]any{
+			"Authorization": "Bearer other",
+		}, types.String),
+		"requestBody": llx.StringData(`{"name":"mql"}`),
+		"timeout":     llx.IntData(5),
+	})
+	assert.NotEqual(t, req.MqlID(), otherHeader.MqlID())
+	data = otherHeader.GetJson()
+	require.NoError(t, data.Error)
+	assert.Equal(t, "Bearer other", data.Data.(map[string]any)["authorization"])
+
+	otherBody := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl": llx.StringData(server.URL + "/api"),
+		"method": llx.StringData("POST"),
+		"headers": llx.MapData(map[string]any{
+			"Authorization": "Bearer secret",
+		}, types.String),
+		"requestBody": llx.StringData(`{"name":"other"}`),
+		"timeout":     llx.IntData(5),
+	})
+	assert.NotEqual(t, req.MqlID(), otherBody.MqlID())
+
+	// the id doesn't contain the header values
+	assert.NotContains(t, req.MqlID(), "secret")
+}
+
+func TestHttpRequest_Redirects(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Strict-Transport-Security", "max-age=60")
+		http.Redirect(w, r, "/b", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/b", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/c", http.StatusFound)
+	})
+	mux.HandleFunc("/c", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "done")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	runtime := newTestHttpRuntime()
+
+	t.Run("follow redirects", func(t *testing.T) {
+		req := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+			"rawUrl":          llx.StringData(server.URL + "/a"),
+			"followRedirects": llx.BoolTrue,
+		})
+		assert.Equal(t, int64(http.StatusOK), req.GetStatusCode().Data)
+		assert.Equal(t, "done", req.GetBody().Data)
+
+		redirects := req.GetRedirects()
+		require.NoError(t, redirects.Error)
+		require.Len(t, redirects.Data, 2)
+
+		first := redirects.Data[0].(*mqlHttpRedirect)
+		assert.Equal(t, server.URL+"/a", first.Url.Data)
+		assert.Equal(t, int64(http.StatusMovedPermanently), first.StatusCode.Data)
+		assert.Equal(t, "/b", first.Location.Data)
+		sts := first.Header.Data.GetSts()
+		require.NoError(t, sts.Error)
+		assert.Equal(t, int64(60), llx.TimeToDuration(sts.Data.MaxAge.Data))
+
+		second := redirects.Data[1].(*mqlHttpRedirect)
+		assert.Equal(t, server.URL+"/b", second.Url.Data)
+		assert.Equal(t, int64(http.StatusFound), second.StatusCode.Data)
+	})
+
+	t.Run("without redirects", func(t *testing.T) {
+		req := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+			"rawUrl": llx.StringData(server.URL + "/a"),
+			"method": llx.StringData("HEAD"),
+		})
+		assert.Equal(t, int64(http.StatusMovedPermanently), req.GetStatusCode().Data)
+		assert.Empty(t, req.GetRedirects().Data)
+		assert.Equal(t, "", req.GetBody().Data)
+	})
+}
+
+func TestHttpRequest_ClientCertificate(t *testing.T) {
+	certPem, keyPem := testClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	runtime := newTestHttpRuntime()
+
+	_, err := NewResource(runtime, "http.request", map[string]*llx.RawData{
+		"rawUrl":            llx.StringData(server.URL),
+		"clientCertificate": llx.StringData(certPem),
+	})
+	require.Error(t, err)
+
+	req := newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl":            llx.StringData(server.URL),
+		"insecure":          llx.BoolTrue,
+		"clientCertificate": llx.StringData(certPem),
+		"clientKey":         llx.StringData(keyPem),
+	})
+	body := req.GetBody()
+	require.NoError(t, body.Error)
+	assert.Equal(t, "mql-client", body.Data)
+	assert.Contains(t, req.GetTimings().Data, "tlsHandshake")
+
+	// certificates are verified unless the request is insecure
+	req = newTestHttpRequest(t, runtime, map[string]*llx.RawData{
+		"rawUrl":            llx.StringData(server.URL),
+		"clientCertificate": llx.StringData(certPem),
+		"clientKey":         llx.StringData(keyPem),
+	})
+	assert.Error(t, req.GetStatusCode().Error)
+}
+
+func testClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mql-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPem), string(keyPem)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/resources/dnsshake"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (d *mqlDns) mtaSts() (*mqlDnsMtaStsPolicy, error) {
+	dnsShaker, err := dnsshake.New(d.Fqdn.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := dnsShaker.MtaSts()
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		d.MtaSts.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.mtaStsPolicy", map[string]*llx.RawData{
+		"dnsTxt": llx.StringData(record.DnsTxt),
+		"domain": llx.StringData(record.Domain),
+		"id":     llx.StringData(record.Id),
+		"url":    llx.StringData(dnsshake.MtaStsPolicyUrl(d.Fqdn.Data)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := o.(*mqlDnsMtaStsPolicy)
+	res.fqdn = d.Fqdn.Data
+	return res, nil
+}
+
+type mqlDnsMtaStsPolicyInternal struct {
+	fqdn      string
+	lock      sync.Mutex
+	fetched   bool
+	policy    *dnsshake.MtaStsPolicy
+	policyErr error
+}
+
+func (d *mqlDnsMtaStsPolicy) id() (string, error) {
+	return "dns.mtaSts/" + d.Domain.Data + "/" + d.Id.Data, nil
+}
+
+// fetchPolicy downloads the policy file once, DNS-only checks do not need it
+func (d *mqlDnsMtaStsPolicy) fetchPolicy() (*dnsshake.MtaStsPolicy, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.fetched {
+		return d.policy, d.policyErr
+	}
+	d.fetched = true
+
+	if d.fqdn == "" {
+		d.policyErr = errors.New("could not load MTA-STS policy")
+		return nil, d.policyErr
+	}
+	d.policy, d.policyErr = dnsshake.FetchMtaStsPolicy(&http.Client{Timeout: DefaultDialerTimeout}, d.fqdn)
+	return d.policy, d.policyErr
+}
+
+func (d *mqlDnsMtaStsPolicy) version() (string, error) {
+	policy, err := d.fetchPolicy()
+	if err != nil {
+		return "", err
+	}
+	return policy.Version, nil
+}
+
+func (d *mqlDnsMtaStsPolicy) mode() (string, error) {
+	policy, err := d.fetchPolicy()
+	if err != nil {
+		return "", err
+	}
+	return policy.Mode, nil
+}
+
+func (d *mqlDnsMtaStsPolicy) mx() ([]any, error) {
+	policy, err := d.fetchPolicy()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(policy.Mx), nil
+}
+
+func (d *mqlDnsMtaStsPolicy) maxAge() (int64, error) {
+	policy, err := d.fetchPolicy()
+	if err != nil {
+		return 0, err
+	}
+	return policy.MaxAge, nil
+}
+
+func (d *mqlDnsMtaStsPolicy) valid() (bool, error) {
+	policy, err := d.fetchPolicy()
+	if err != nil {
+		// a policy that cannot be retrieved is not valid
+		return false, nil
+	}
+	ok, _ := policy.Valid()
+	return ok, nil
+}
+
+func (d *mqlDns) tlsRpt() (*mqlDnsTlsRptRecord, error) {
+	dnsShaker, err := dnsshake.New(d.Fqdn.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := dnsShaker.TlsRpt()
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		d.TlsRpt.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	o, err := CreateResource(d.MqlRuntime, "dns.tlsRptRecord", map[string]*llx.RawData{
+		"dnsTxt":     llx.StringData(record.DnsTxt),
+		"domain":     llx.StringData(record.Domain),
+		"version":    llx.StringData(record.Version),
+		"reportUris": llx.ArrayData(llx.TArr2Raw(record.ReportURIs), types.String),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlDnsTlsRptRecord), nil
+}
+
+func (d *mqlDnsTlsRptRecord) id() (string, error) {
+	return "dns.tlsRpt/" + d.Domain.Data, nil
+}
@@ -7,13 +7,17 @@ package resources
 // Outside of this range, users will have to specify ports explicitly.
 // We could expand this to cover more of IANA.
 var CommonPorts = map[string]int{
-	"https":  443,
-	"http":   80,
-	"ssh":    22,
-	"ftp":    21,
-	"telnet": 23,
-	"smtp":   25,
-	"dns":    53,
-	"pop3":   110,
-	"imap4":  143,
+	"https":      443,
+	"http":       80,
+	"ssh":        22,
+	"ftp":        21,
+	"telnet":     23,
+	"smtp":       25,
+	"dns":        53,
+	"pop3":       110,
+	"imap4":      143,
+	"imap":       143,
+	"ldap":       389,
+	"postgres":   5432,
+	"postgresql": 5432,
 }
@@ -20,6 +20,8 @@ const (
 	ResourceSocket                  string = "socket"
 	ResourceHttp                    string = "http"
 	ResourceHttpGet                 string = "http.get"
+	ResourceHttpRequest             string = "http.request"
+	ResourceHttpRedirect            string = "http.redirect"
 	ResourceHttpHeader              string = "http.header"
 	ResourceHttpHeaderSts           string = "http.header.sts"
 	ResourceHttpHeaderXssProtection string = "http.header.xssProtection"
@@ -27,6 +29,8 @@ const (
 	ResourceHttpHeaderSetCookie     string = "http.header.setCookie"
 	ResourceUrl                     string = "url"
 	ResourceTls                     string = "tls"
+	ResourceSshServer               string = "sshServer"
+	ResourceSshServerHostkey        string = "sshServer.hostkey"
 	ResourceCertificates            string = "certificates"
 	ResourceCertificate             string = "certificate"
 	ResourcePkixName                string = "pkix.name"
@@ -42,6 +46,13 @@ const (
 	ResourceDnsRecord               string = "dns.record"
 	ResourceDnsMxRecord             string = "dns.mxRecord"
 	ResourceDnsDkimRecord           string = "dns.dkimRecord"
+	ResourceDnsDmarcRecord          string = "dns.dmarcRecord"
+	ResourceDnsMtaStsPolicy         string = "dns.mtaStsPolicy"
+	ResourceDnsTlsRptRecord         string = "dns.tlsRptRecord"
+	ResourceDnsCaaRecord            string = "dns.caaRecord"
+	ResourceDnsDnssec               string = "dns.dnssec"
+	ResourceDnsDnssecKey            string = "dns.dnssec.key"
+	ResourceDnsDnssecDs             string = "dns.dnssec.ds"
 )
 
 var resourceFactories map[string]plugin.ResourceFactory
@@ -60,6 +71,14 @@ func init() {
 			Init:   initHttpGet,
 			Create: createHttpGet,
 		},
+		"http.request": {
+			Init:   initHttpRequest,
+			Create: createHttpRequest,
+		},
+		"http.redirect": {
+			// to override args, implement: initHttpRedirect(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createHttpRedirect,
+		},
 		"http.header": {
 			// to override args, implement: initHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createHttpHeader,
@@ -88,6 +107,14 @@ func init() {
 			Init:   initTls,
 			Create: createTls,
 		},
+		"sshServer": {
+			Init:   initSshServer,
+			Create: createSshServer,
+		},
+		"sshServer.hostkey": {
+			// to override args, implement: initSshServerHostkey(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSshServerHostkey,
+		},
 		"certificates": {
 			// to override args, implement: initCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createCertificates,
@@ -148,6 +175,34 @@ func init() {
 			// to override args, implement: initDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createDnsDkimRecord,
 		},
+		"dns.dmarcRecord": {
+			// to override args, implement: initDnsDmarcRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDmarcRecord,
+		},
+		"dns.mtaStsPolicy": {
+			// to override args, implement: initDnsMtaStsPolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsMtaStsPolicy,
+		},
+		"dns.tlsRptRecord": {
+			// to override args, implement: initDnsTlsRptRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsTlsRptRecord,
+		},
+		"dns.caaRecord": {
+			// to override args, implement: initDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsCaaRecord,
+		},
+		"dns.dnssec": {
+			// to override args, implement: initDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDnssec,
+		},
+		"dns.dnssec.key": {
+			// to override args, implement: initDnsDnssecKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDnssecKey,
+		},
+		"dns.dnssec.ds": {
+			// to override args, implement: initDnsDnssecDs(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsDnssecDs,
+		},
 	}
 }
 
@@ -255,6 +310,63 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"http.get.body": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpGet).GetBody()).ToDataRes(types.String)
 	},
+	"http.request.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetUrl()).ToDataRes(types.Resource("url"))
+	},
+	"http.request.method": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetMethod()).ToDataRes(types.String)
+	},
+	"http.request.headerNames": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetHeaderNames()).ToDataRes(types.Array(types.String))
+	},
+	"http.request.timeout": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetTimeout()).ToDataRes(types.Int)
+	},
+	"http.request.followRedirects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetFollowRedirects()).ToDataRes(types.Bool)
+	},
+	"http.request.insecure": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetInsecure()).ToDataRes(types.Bool)
+	},
+	"http.request.clientCertificate": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetClientCertificate()).ToDataRes(types.String)
+	},
+	"http.request.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetHeader()).ToDataRes(types.Resource("http.header"))
+	},
+	"http.request.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.request.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetVersion()).ToDataRes(types.String)
+	},
+	"http.request.body": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetBody()).ToDataRes(types.String)
+	},
+	"http.request.json": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetJson()).ToDataRes(types.Dict)
+	},
+	"http.request.duration": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetDuration()).ToDataRes(types.Time)
+	},
+	"http.request.timings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetTimings()).ToDataRes(types.Map(types.String, types.Int))
+	},
+	"http.request.redirects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetRedirects()).ToDataRes(types.Array(types.Resource("http.redirect")))
+	},
+	"http.redirect.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetUrl()).ToDataRes(types.String)
+	},
+	"http.redirect.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.redirect.location": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetLocation()).ToDataRes(types.String)
+	},
+	"http.redirect.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetHeader()).ToDataRes(types.Resource("http.header"))
+	},
 	"http.header.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeader).GetParams()).ToDataRes(types.Map(types.String, types.Array(types.String)))
 	},
@@ -351,6 +463,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"tls.domainName": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetDomainName()).ToDataRes(types.String)
 	},
+	"tls.starttls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetStarttls()).ToDataRes(types.String)
+	},
 	"tls.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetParams()).ToDataRes(types.Dict)
 	},
@@ -369,6 +484,51 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"tls.nonSniCertificates": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetNonSniCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
+	"sshServer.socket": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetSocket()).ToDataRes(types.Resource("socket"))
+	},
+	"sshServer.banner": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetBanner()).ToDataRes(types.String)
+	},
+	"sshServer.protocolVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetProtocolVersion()).ToDataRes(types.String)
+	},
+	"sshServer.softwareVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetSoftwareVersion()).ToDataRes(types.String)
+	},
+	"sshServer.comments": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetComments()).ToDataRes(types.String)
+	},
+	"sshServer.kexs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetKexs()).ToDataRes(types.Array(types.String))
+	},
+	"sshServer.hostkeyAlgorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetHostkeyAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"sshServer.ciphers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetCiphers()).ToDataRes(types.Array(types.String))
+	},
+	"sshServer.macs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetMacs()).ToDataRes(types.Array(types.String))
+	},
+	"sshServer.compressions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetCompressions()).ToDataRes(types.Array(types.String))
+	},
+	"sshServer.hostkeys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetHostkeys()).ToDataRes(types.Array(types.Resource("sshServer.hostkey")))
+	},
+	"sshServer.hostkey.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostkey).GetType()).ToDataRes(types.String)
+	},
+	"sshServer.hostkey.bits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostkey).GetBits()).ToDataRes(types.Int)
+	},
+	"sshServer.hostkey.publicKey": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostkey).GetPublicKey()).ToDataRes(types.String)
+	},
+	"sshServer.hostkey.fingerprints": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostkey).GetFingerprints()).ToDataRes(types.Map(types.String, types.String))
+	},
 	"certificates.pem": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlCertificates).GetPem()).ToDataRes(types.String)
 	},
@@ -627,6 +787,21 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkim": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDns).GetDkim()).ToDataRes(types.Array(types.Resource("dns.dkimRecord")))
 	},
+	"dns.dmarc": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDmarc()).ToDataRes(types.Resource("dns.dmarcRecord"))
+	},
+	"dns.mtaSts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetMtaSts()).ToDataRes(types.Resource("dns.mtaStsPolicy"))
+	},
+	"dns.tlsRpt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetTlsRpt()).ToDataRes(types.Resource("dns.tlsRptRecord"))
+	},
+	"dns.caa": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetCaa()).ToDataRes(types.Array(types.Resource("dns.caaRecord")))
+	},
+	"dns.dnssec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDnssec()).ToDataRes(types.Resource("dns.dnssec"))
+	},
 	"dns.record.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsRecord).GetName()).ToDataRes(types.String)
 	},
@@ -681,6 +856,162 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkimRecord.valid": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsDkimRecord).GetValid()).ToDataRes(types.Bool)
 	},
+	"dns.dmarcRecord.dnsTxt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetDnsTxt()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.policy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.subdomainPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetSubdomainPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.percentage": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetPercentage()).ToDataRes(types.Int)
+	},
+	"dns.dmarcRecord.aggregateReportUris": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetAggregateReportUris()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.failureReportUris": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetFailureReportUris()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.dkimAlignment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetDkimAlignment()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.spfAlignment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetSpfAlignment()).ToDataRes(types.String)
+	},
+	"dns.dmarcRecord.failureOptions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetFailureOptions()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.reportFormats": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetReportFormats()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarcRecord.reportInterval": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetReportInterval()).ToDataRes(types.Int)
+	},
+	"dns.dmarcRecord.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarcRecord).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.mtaStsPolicy.dnsTxt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetDnsTxt()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetId()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetUrl()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetMode()).ToDataRes(types.String)
+	},
+	"dns.mtaStsPolicy.mx": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetMx()).ToDataRes(types.Array(types.String))
+	},
+	"dns.mtaStsPolicy.maxAge": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetMaxAge()).ToDataRes(types.Int)
+	},
+	"dns.mtaStsPolicy.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaStsPolicy).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.tlsRptRecord.dnsTxt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsTlsRptRecord).GetDnsTxt()).ToDataRes(types.String)
+	},
+	"dns.tlsRptRecord.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsTlsRptRecord).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.tlsRptRecord.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsTlsRptRecord).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.tlsRptRecord.reportUris": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsTlsRptRecord).GetReportUris()).ToDataRes(types.Array(types.String))
+	},
+	"dns.caaRecord.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetFlag()).ToDataRes(types.Int)
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetCritical()).ToDataRes(types.Bool)
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetTag()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetValue()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.issuer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetIssuer()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.parameters": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetParameters()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"dns.dnssec.zone": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetZone()).ToDataRes(types.String)
+	},
+	"dns.dnssec.signed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetSigned()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.delegationValidated": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetDelegationValidated()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.authenticatedData": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetAuthenticatedData()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.keys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetKeys()).ToDataRes(types.Array(types.Resource("dns.dnssec.key")))
+	},
+	"dns.dnssec.delegationSigners": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetDelegationSigners()).ToDataRes(types.Array(types.Resource("dns.dnssec.ds")))
+	},
+	"dns.dnssec.signatureExpiration": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetSignatureExpiration()).ToDataRes(types.Time)
+	},
+	"dns.dnssec.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dnssec.key.keyTag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetKeyTag()).ToDataRes(types.Int)
+	},
+	"dns.dnssec.key.flags": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetFlags()).ToDataRes(types.Int)
+	},
+	"dns.dnssec.key.protocol": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetProtocol()).ToDataRes(types.Int)
+	},
+	"dns.dnssec.key.algorithm": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetAlgorithm()).ToDataRes(types.String)
+	},
+	"dns.dnssec.key.keySigningKey": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetKeySigningKey()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.key.publicKey": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecKey).GetPublicKey()).ToDataRes(types.String)
+	},
+	"dns.dnssec.ds.keyTag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecDs).GetKeyTag()).ToDataRes(types.Int)
+	},
+	"dns.dnssec.ds.algorithm": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecDs).GetAlgorithm()).ToDataRes(types.String)
+	},
+	"dns.dnssec.ds.digestType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecDs).GetDigestType()).ToDataRes(types.String)
+	},
+	"dns.dnssec.ds.digest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssecDs).GetDigest()).ToDataRes(types.String)
+	},
 }
 
 func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
@@ -741,6 +1072,90 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlHttpGet).Body, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"http.request.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).__id, ok = v.Value.(string)
+		return
+	},
+	"http.request.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Url, ok = plugin.RawToTValue[*mqlUrl](v.Value, v.Error)
+		return
+	},
+	"http.request.method": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Method, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.headerNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).HeaderNames, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"http.request.timeout": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Timeout, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.request.followRedirects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).FollowRedirects, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.request.insecure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Insecure, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.request.clientCertificate": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).ClientCertificate, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Header, ok = plugin.RawToTValue[*mqlHttpHeader](v.Value, v.Error)
+		return
+	},
+	"http.request.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.request.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.body": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Body, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.json": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Json, ok = plugin.RawToTValue[any](v.Value, v.Error)
+		return
+	},
+	"http.request.duration": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Duration, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"http.request.timings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Timings, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"http.request.redirects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Redirects, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"http.redirect.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).__id, ok = v.Value.(string)
+		return
+	},
+	"http.redirect.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.redirect.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.redirect.location": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).Location, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.redirect.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).Header, ok = plugin.RawToTValue[*mqlHttpHeader](v.Value, v.Error)
+		return
+	},
 	"http.header.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlHttpHeader).__id, ok = v.Value.(string)
 		return
@@ -897,6 +1312,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlTls).DomainName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"tls.starttls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).Starttls, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"tls.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlTls).Params, ok = plugin.RawToTValue[any](v.Value, v.Error)
 		return
@@ -921,6 +1340,74 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlTls).NonSniCertificates, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
+	"sshServer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).__id, ok = v.Value.(string)
+		return
+	},
+	"sshServer.socket": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Socket, ok = plugin.RawToTValue[*mqlSocket](v.Value, v.Error)
+		return
+	},
+	"sshServer.banner": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Banner, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.protocolVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).ProtocolVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.softwareVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).SoftwareVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.comments": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Comments, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.kexs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Kexs, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkeyAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).HostkeyAlgorithms, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.ciphers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Ciphers, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.macs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Macs, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.compressions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Compressions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkeys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Hostkeys, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostkey).__id, ok = v.Value.(string)
+		return
+	},
+	"sshServer.hostkey.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostkey).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkey.bits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostkey).Bits, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkey.publicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostkey).PublicKey, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sshServer.hostkey.fingerprints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostkey).Fingerprints, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
 	"certificates.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlCertificates).__id, ok = v.Value.(string)
 		return
@@ -1313,6 +1800,26 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlDns).Dkim, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
+	"dns.dmarc": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dmarc, ok = plugin.RawToTValue[*mqlDnsDmarcRecord](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).MtaSts, ok = plugin.RawToTValue[*mqlDnsMtaStsPolicy](v.Value, v.Error)
+		return
+	},
+	"dns.tlsRpt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).TlsRpt, ok = plugin.RawToTValue[*mqlDnsTlsRptRecord](v.Value, v.Error)
+		return
+	},
+	"dns.caa": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Caa, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dnssec, ok = plugin.RawToTValue[*mqlDnsDnssec](v.Value, v.Error)
+		return
+	},
 	"dns.record.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlDnsRecord).__id, ok = v.Value.(string)
 		return
@@ -1397,71 +1904,307 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlDnsDkimRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-}
-
-func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
-	f, ok := setDataFields[resource.MqlName()+"."+field]
-	if !ok {
-		return errors.New("[network] cannot set '" + field + "' in resource '" + resource.MqlName() + "', field not found")
-	}
-
-	if ok := f(resource, val); !ok {
-		return errors.New("[network] cannot set '" + field + "' in resource '" + resource.MqlName() + "', type does not match")
-	}
-	return nil
-}
-
-func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
-	var err error
-	for k, v := range args {
-		if err = SetData(resource, k, v); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// mqlSocket for the socket resource
-type mqlSocket struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlSocketInternal it will be used here
-	Protocol plugin.TValue[string]
-	Port     plugin.TValue[int64]
-	Address  plugin.TValue[string]
-}
-
-// createSocket creates a new instance of this resource
-func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSocket{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("socket", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlSocket) MqlName() string {
-	return "socket"
+	"dns.dmarcRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.dmarcRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.policy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Policy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.subdomainPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).SubdomainPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.percentage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Percentage, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.aggregateReportUris": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).AggregateReportUris, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.failureReportUris": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).FailureReportUris, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.dkimAlignment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).DkimAlignment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.spfAlignment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).SpfAlignment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.failureOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).FailureOptions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.reportFormats": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).ReportFormats, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.reportInterval": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).ReportInterval, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dmarcRecord.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarcRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.mtaStsPolicy.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.mx": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Mx, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).MaxAge, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.mtaStsPolicy.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaStsPolicy).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.tlsRptRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsTlsRptRecord).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.tlsRptRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsTlsRptRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.tlsRptRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsTlsRptRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.tlsRptRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsTlsRptRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.tlsRptRecord.reportUris": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsTlsRptRecord).ReportUris, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.caaRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Flag, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Critical, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Tag, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.issuer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Issuer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.parameters": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Parameters, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.dnssec.zone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Zone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.signed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Signed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.delegationValidated": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).DelegationValidated, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.authenticatedData": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).AuthenticatedData, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.keys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Keys, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.delegationSigners": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).DelegationSigners, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.signatureExpiration": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).SignatureExpiration, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Errors, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.dnssec.key.keyTag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).KeyTag, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.flags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).Flags, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).Protocol, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.algorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).Algorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.keySigningKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).KeySigningKey, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.key.publicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecKey).PublicKey, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.ds.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecDs).__id, ok = v.Value.(string)
+		return
+	},
+	"dns.dnssec.ds.keyTag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecDs).KeyTag, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.ds.algorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecDs).Algorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.ds.digestType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecDs).DigestType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.ds.digest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssecDs).Digest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+}
+
+func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
+	f, ok := setDataFields[resource.MqlName()+"."+field]
+	if !ok {
+		return errors.New("[network] cannot set '" + field + "' in resource '" + resource.MqlName() + "', field not found")
+	}
+
+	if ok := f(resource, val); !ok {
+		return errors.New("[network] cannot set '" + field + "' in resource '" + resource.MqlName() + "', type does not match")
+	}
+	return nil
+}
+
+func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
+	var err error
+	for k, v := range args {
+		if err = SetData(resource, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mqlSocket for the socket resource
+type mqlSocket struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlSocketInternal it will be used here
+	Protocol plugin.TValue[string]
+	Port     plugin.TValue[int64]
+	Address  plugin.TValue[string]
+}
+
+// createSocket creates a new instance of this resource
+func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSocket{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("socket", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSocket) MqlName() string {
+	return "socket"
 }
 
 func (c *mqlSocket) MqlID() string {
@@ -1611,25 +2354,31 @@ func (c *mqlHttpGet) GetBody() *plugin.TValue[string] {
 	})
 }
 
-// mqlHttpHeader for the http.header resource
-type mqlHttpHeader struct {
+// mqlHttpRequest for the http.request resource
+type mqlHttpRequest struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlHttpHeaderInternal it will be used here
-	Params              plugin.TValue[map[string]any]
-	Sts                 plugin.TValue[*mqlHttpHeaderSts]
-	XFrameOptions       plugin.TValue[string]
-	XXssProtection      plugin.TValue[*mqlHttpHeaderXssProtection]
-	XContentTypeOptions plugin.TValue[string]
-	ReferrerPolicy      plugin.TValue[string]
-	ContentType         plugin.TValue[*mqlHttpHeaderContentType]
-	SetCookie           plugin.TValue[*mqlHttpHeaderSetCookie]
-	Csp                 plugin.TValue[map[string]any]
-}
-
-// createHttpHeader creates a new instance of this resource
-func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeader{
+	mqlHttpRequestInternal
+	Url               plugin.TValue[*mqlUrl]
+	Method            plugin.TValue[string]
+	HeaderNames       plugin.TValue[[]any]
+	Timeout           plugin.TValue[int64]
+	FollowRedirects   plugin.TValue[bool]
+	Insecure          plugin.TValue[bool]
+	ClientCertificate plugin.TValue[string]
+	Header            plugin.TValue[*mqlHttpHeader]
+	StatusCode        plugin.TValue[int64]
+	Version           plugin.TValue[string]
+	Body              plugin.TValue[string]
+	Json              plugin.TValue[any]
+	Duration          plugin.TValue[*time.Time]
+	Timings           plugin.TValue[map[string]any]
+	Redirects         plugin.TValue[[]any]
+}
+
+// createHttpRequest creates a new instance of this resource
+func createHttpRequest(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpRequest{
 		MqlRuntime: runtime,
 	}
 
@@ -1646,7 +2395,7 @@ func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header", res.__id)
+		args, err = runtime.ResourceFromRecording("http.request", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1656,65 +2405,278 @@ func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlHttpHeader) MqlName() string {
-	return "http.header"
+func (c *mqlHttpRequest) MqlName() string {
+	return "http.request"
 }
 
-func (c *mqlHttpHeader) MqlID() string {
+func (c *mqlHttpRequest) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpHeader) GetParams() *plugin.TValue[map[string]any] {
-	return &c.Params
+func (c *mqlHttpRequest) GetUrl() *plugin.TValue[*mqlUrl] {
+	return &c.Url
 }
 
-func (c *mqlHttpHeader) GetSts() *plugin.TValue[*mqlHttpHeaderSts] {
-	return plugin.GetOrCompute[*mqlHttpHeaderSts](&c.Sts, func() (*mqlHttpHeaderSts, error) {
+func (c *mqlHttpRequest) GetMethod() *plugin.TValue[string] {
+	return &c.Method
+}
+
+func (c *mqlHttpRequest) GetHeaderNames() *plugin.TValue[[]any] {
+	return &c.HeaderNames
+}
+
+func (c *mqlHttpRequest) GetTimeout() *plugin.TValue[int64] {
+	return &c.Timeout
+}
+
+func (c *mqlHttpRequest) GetFollowRedirects() *plugin.TValue[bool] {
+	return &c.FollowRedirects
+}
+
+func (c *mqlHttpRequest) GetInsecure() *plugin.TValue[bool] {
+	return &c.Insecure
+}
+
+func (c *mqlHttpRequest) GetClientCertificate() *plugin.TValue[string] {
+	return &c.ClientCertificate
+}
+
+func (c *mqlHttpRequest) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return plugin.GetOrCompute[*mqlHttpHeader](&c.Header, func() (*mqlHttpHeader, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "sts")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.request", c.__id, "header")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlHttpHeaderSts), nil
+				return d.Value.(*mqlHttpHeader), nil
 			}
 		}
 
-		return c.sts()
+		return c.header()
 	})
 }
 
-func (c *mqlHttpHeader) GetXFrameOptions() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.XFrameOptions, func() (string, error) {
-		return c.xFrameOptions()
+func (c *mqlHttpRequest) GetStatusCode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
+		return c.statusCode()
 	})
 }
 
-func (c *mqlHttpHeader) GetXXssProtection() *plugin.TValue[*mqlHttpHeaderXssProtection] {
-	return plugin.GetOrCompute[*mqlHttpHeaderXssProtection](&c.XXssProtection, func() (*mqlHttpHeaderXssProtection, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "xXssProtection")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlHttpHeaderXssProtection), nil
-			}
-		}
-
-		return c.xXssProtection()
+func (c *mqlHttpRequest) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
 	})
 }
 
-func (c *mqlHttpHeader) GetXContentTypeOptions() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.XContentTypeOptions, func() (string, error) {
-		return c.xContentTypeOptions()
+func (c *mqlHttpRequest) GetBody() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Body, func() (string, error) {
+		return c.body()
 	})
 }
 
-func (c *mqlHttpHeader) GetReferrerPolicy() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.ReferrerPolicy, func() (string, error) {
-		return c.referrerPolicy()
+func (c *mqlHttpRequest) GetJson() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Json, func() (any, error) {
+		return c.json()
+	})
+}
+
+func (c *mqlHttpRequest) GetDuration() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Duration, func() (*time.Time, error) {
+		return c.duration()
+	})
+}
+
+func (c *mqlHttpRequest) GetTimings() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Timings, func() (map[string]any, error) {
+		return c.timings()
+	})
+}
+
+func (c *mqlHttpRequest) GetRedirects() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Redirects, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.request", c.__id, "redirects")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.redirects()
+	})
+}
+
+// mqlHttpRedirect for the http.redirect resource
+type mqlHttpRedirect struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlHttpRedirectInternal it will be used here
+	Url        plugin.TValue[string]
+	StatusCode plugin.TValue[int64]
+	Location   plugin.TValue[string]
+	Header     plugin.TValue[*mqlHttpHeader]
+}
+
+// createHttpRedirect creates a new instance of this resource
+func createHttpRedirect(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpRedirect{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.redirect", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpRedirect) MqlName() string {
+	return "http.redirect"
+}
+
+func (c *mqlHttpRedirect) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpRedirect) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlHttpRedirect) GetStatusCode() *plugin.TValue[int64] {
+	return &c.StatusCode
+}
+
+func (c *mqlHttpRedirect) GetLocation() *plugin.TValue[string] {
+	return &c.Location
+}
+
+func (c *mqlHttpRedirect) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return &c.Header
+}
+
+// mqlHttpHeader for the http.header resource
+type mqlHttpHeader struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlHttpHeaderInternal it will be used here
+	Params              plugin.TValue[map[string]any]
+	Sts                 plugin.TValue[*mqlHttpHeaderSts]
+	XFrameOptions       plugin.TValue[string]
+	XXssProtection      plugin.TValue[*mqlHttpHeaderXssProtection]
+	XContentTypeOptions plugin.TValue[string]
+	ReferrerPolicy      plugin.TValue[string]
+	ContentType         plugin.TValue[*mqlHttpHeaderContentType]
+	SetCookie           plugin.TValue[*mqlHttpHeaderSetCookie]
+	Csp                 plugin.TValue[map[string]any]
+}
+
+// createHttpHeader creates a new instance of this resource
+func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeader{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeader) MqlName() string {
+	return "http.header"
+}
+
+func (c *mqlHttpHeader) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeader) GetParams() *plugin.TValue[map[string]any] {
+	return &c.Params
+}
+
+func (c *mqlHttpHeader) GetSts() *plugin.TValue[*mqlHttpHeaderSts] {
+	return plugin.GetOrCompute[*mqlHttpHeaderSts](&c.Sts, func() (*mqlHttpHeaderSts, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "sts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderSts), nil
+			}
+		}
+
+		return c.sts()
+	})
+}
+
+func (c *mqlHttpHeader) GetXFrameOptions() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.XFrameOptions, func() (string, error) {
+		return c.xFrameOptions()
+	})
+}
+
+func (c *mqlHttpHeader) GetXXssProtection() *plugin.TValue[*mqlHttpHeaderXssProtection] {
+	return plugin.GetOrCompute[*mqlHttpHeaderXssProtection](&c.XXssProtection, func() (*mqlHttpHeaderXssProtection, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "xXssProtection")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderXssProtection), nil
+			}
+		}
+
+		return c.xXssProtection()
+	})
+}
+
+func (c *mqlHttpHeader) GetXContentTypeOptions() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.XContentTypeOptions, func() (string, error) {
+		return c.xContentTypeOptions()
+	})
+}
+
+func (c *mqlHttpHeader) GetReferrerPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ReferrerPolicy, func() (string, error) {
+		return c.referrerPolicy()
 	})
 }
 
@@ -2090,6 +3052,7 @@ type mqlTls struct {
 	mqlTlsInternal
 	Socket             plugin.TValue[*mqlSocket]
 	DomainName         plugin.TValue[string]
+	Starttls           plugin.TValue[string]
 	Params             plugin.TValue[any]
 	Versions           plugin.TValue[[]any]
 	Ciphers            plugin.TValue[[]any]
@@ -2143,6 +3106,10 @@ func (c *mqlTls) GetDomainName() *plugin.TValue[string] {
 	return &c.DomainName
 }
 
+func (c *mqlTls) GetStarttls() *plugin.TValue[string] {
+	return &c.Starttls
+}
+
 func (c *mqlTls) GetParams() *plugin.TValue[any] {
 	return plugin.GetOrCompute[any](&c.Params, func() (any, error) {
 		vargSocket := c.GetSocket()
@@ -2244,18 +3211,27 @@ func (c *mqlTls) GetNonSniCertificates() *plugin.TValue[[]any] {
 	})
 }
 
-// mqlCertificates for the certificates resource
-type mqlCertificates struct {
+// mqlSshServer for the sshServer resource
+type mqlSshServer struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlCertificatesInternal it will be used here
-	Pem  plugin.TValue[string]
-	List plugin.TValue[[]any]
-}
-
-// createCertificates creates a new instance of this resource
-func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCertificates{
+	mqlSshServerInternal
+	Socket            plugin.TValue[*mqlSocket]
+	Banner            plugin.TValue[string]
+	ProtocolVersion   plugin.TValue[string]
+	SoftwareVersion   plugin.TValue[string]
+	Comments          plugin.TValue[string]
+	Kexs              plugin.TValue[[]any]
+	HostkeyAlgorithms plugin.TValue[[]any]
+	Ciphers           plugin.TValue[[]any]
+	Macs              plugin.TValue[[]any]
+	Compressions      plugin.TValue[[]any]
+	Hostkeys          plugin.TValue[[]any]
+}
+
+// createSshServer creates a new instance of this resource
+func createSshServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshServer{
 		MqlRuntime: runtime,
 	}
 
@@ -2272,7 +3248,7 @@ func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("certificates", res.__id)
+		args, err = runtime.ResourceFromRecording("sshServer", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2282,22 +3258,76 @@ func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlCertificates) MqlName() string {
-	return "certificates"
+func (c *mqlSshServer) MqlName() string {
+	return "sshServer"
 }
 
-func (c *mqlCertificates) MqlID() string {
+func (c *mqlSshServer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCertificates) GetPem() *plugin.TValue[string] {
-	return &c.Pem
+func (c *mqlSshServer) GetSocket() *plugin.TValue[*mqlSocket] {
+	return &c.Socket
 }
 
-func (c *mqlCertificates) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+func (c *mqlSshServer) GetBanner() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Banner, func() (string, error) {
+		return c.banner()
+	})
+}
+
+func (c *mqlSshServer) GetProtocolVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ProtocolVersion, func() (string, error) {
+		return c.protocolVersion()
+	})
+}
+
+func (c *mqlSshServer) GetSoftwareVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SoftwareVersion, func() (string, error) {
+		return c.softwareVersion()
+	})
+}
+
+func (c *mqlSshServer) GetComments() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Comments, func() (string, error) {
+		return c.comments()
+	})
+}
+
+func (c *mqlSshServer) GetKexs() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Kexs, func() ([]any, error) {
+		return c.kexs()
+	})
+}
+
+func (c *mqlSshServer) GetHostkeyAlgorithms() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.HostkeyAlgorithms, func() ([]any, error) {
+		return c.hostkeyAlgorithms()
+	})
+}
+
+func (c *mqlSshServer) GetCiphers() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Ciphers, func() ([]any, error) {
+		return c.ciphers()
+	})
+}
+
+func (c *mqlSshServer) GetMacs() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Macs, func() ([]any, error) {
+		return c.macs()
+	})
+}
+
+func (c *mqlSshServer) GetCompressions() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Compressions, func() ([]any, error) {
+		return c.compressions()
+	})
+}
+
+func (c *mqlSshServer) GetHostkeys() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Hostkeys, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("certificates", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sshServer", c.__id, "hostkeys")
 			if err != nil {
 				return nil, err
 			}
@@ -2306,45 +3336,24 @@ func (c *mqlCertificates) GetList() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.list()
+		return c.hostkeys()
 	})
 }
 
-// mqlCertificate for the certificate resource
-type mqlCertificate struct {
+// mqlSshServerHostkey for the sshServer.hostkey resource
+type mqlSshServerHostkey struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlCertificateInternal
-	Pem                   plugin.TValue[string]
-	Fingerprints          plugin.TValue[map[string]any]
-	Serial                plugin.TValue[string]
-	SubjectKeyID          plugin.TValue[string]
-	AuthorityKeyID        plugin.TValue[string]
-	Subject               plugin.TValue[*mqlPkixName]
-	Issuer                plugin.TValue[*mqlPkixName]
-	Version               plugin.TValue[int64]
-	NotBefore             plugin.TValue[*time.Time]
-	NotAfter              plugin.TValue[*time.Time]
-	ExpiresIn             plugin.TValue[*time.Time]
-	Signature             plugin.TValue[string]
-	SigningAlgorithm      plugin.TValue[string]
-	IsCA                  plugin.TValue[bool]
-	KeyUsage              plugin.TValue[[]any]
-	ExtendedKeyUsage      plugin.TValue[[]any]
-	Extensions            plugin.TValue[[]any]
-	PolicyIdentifier      plugin.TValue[[]any]
-	CrlDistributionPoints plugin.TValue[[]any]
-	OcspServer            plugin.TValue[[]any]
-	IssuingCertificateUrl plugin.TValue[[]any]
-	IsRevoked             plugin.TValue[bool]
-	RevokedAt             plugin.TValue[*time.Time]
-	IsVerified            plugin.TValue[bool]
-	SanExtension          plugin.TValue[*mqlPkixSanExtension]
+	// optional: if you define mqlSshServerHostkeyInternal it will be used here
+	Type         plugin.TValue[string]
+	Bits         plugin.TValue[int64]
+	PublicKey    plugin.TValue[string]
+	Fingerprints plugin.TValue[map[string]any]
 }
 
-// createCertificate creates a new instance of this resource
-func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCertificate{
+// createSshServerHostkey creates a new instance of this resource
+func createSshServerHostkey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshServerHostkey{
 		MqlRuntime: runtime,
 	}
 
@@ -2361,7 +3370,7 @@ func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("certificate", res.__id)
+		args, err = runtime.ResourceFromRecording("sshServer.hostkey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2371,16 +3380,167 @@ func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlCertificate) MqlName() string {
-	return "certificate"
+func (c *mqlSshServerHostkey) MqlName() string {
+	return "sshServer.hostkey"
 }
 
-func (c *mqlCertificate) MqlID() string {
+func (c *mqlSshServerHostkey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCertificate) GetPem() *plugin.TValue[string] {
-	return &c.Pem
+func (c *mqlSshServerHostkey) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlSshServerHostkey) GetBits() *plugin.TValue[int64] {
+	return &c.Bits
+}
+
+func (c *mqlSshServerHostkey) GetPublicKey() *plugin.TValue[string] {
+	return &c.PublicKey
+}
+
+func (c *mqlSshServerHostkey) GetFingerprints() *plugin.TValue[map[string]any] {
+	return &c.Fingerprints
+}
+
+// mqlCertificates for the certificates resource
+type mqlCertificates struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlCertificatesInternal it will be used here
+	Pem  plugin.TValue[string]
+	List plugin.TValue[[]any]
+}
+
+// createCertificates creates a new instance of this resource
+func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCertificates{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("certificates", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlCertificates) MqlName() string {
+	return "certificates"
+}
+
+func (c *mqlCertificates) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlCertificates) GetPem() *plugin.TValue[string] {
+	return &c.Pem
+}
+
+func (c *mqlCertificates) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificates", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlCertificate for the certificate resource
+type mqlCertificate struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlCertificateInternal
+	Pem                   plugin.TValue[string]
+	Fingerprints          plugin.TValue[map[string]any]
+	Serial                plugin.TValue[string]
+	SubjectKeyID          plugin.TValue[string]
+	AuthorityKeyID        plugin.TValue[string]
+	Subject               plugin.TValue[*mqlPkixName]
+	Issuer                plugin.TValue[*mqlPkixName]
+	Version               plugin.TValue[int64]
+	NotBefore             plugin.TValue[*time.Time]
+	NotAfter              plugin.TValue[*time.Time]
+	ExpiresIn             plugin.TValue[*time.Time]
+	Signature             plugin.TValue[string]
+	SigningAlgorithm      plugin.TValue[string]
+	IsCA                  plugin.TValue[bool]
+	KeyUsage              plugin.TValue[[]any]
+	ExtendedKeyUsage      plugin.TValue[[]any]
+	Extensions            plugin.TValue[[]any]
+	PolicyIdentifier      plugin.TValue[[]any]
+	CrlDistributionPoints plugin.TValue[[]any]
+	OcspServer            plugin.TValue[[]any]
+	IssuingCertificateUrl plugin.TValue[[]any]
+	IsRevoked             plugin.TValue[bool]
+	RevokedAt             plugin.TValue[*time.Time]
+	IsVerified            plugin.TValue[bool]
+	SanExtension          plugin.TValue[*mqlPkixSanExtension]
+}
+
+// createCertificate creates a new instance of this resource
+func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCertificate{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("certificate", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlCertificate) MqlName() string {
+	return "certificate"
+}
+
+func (c *mqlCertificate) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlCertificate) GetPem() *plugin.TValue[string] {
+	return &c.Pem
 }
 
 func (c *mqlCertificate) GetFingerprints() *plugin.TValue[map[string]any] {
@@ -3180,41 +4340,760 @@ func (c *mqlOpenpgpSignature) GetKeyAlgorithm() *plugin.TValue[string] {
 	return &c.KeyAlgorithm
 }
 
-func (c *mqlOpenpgpSignature) GetCreationTime() *plugin.TValue[*time.Time] {
-	return &c.CreationTime
+func (c *mqlOpenpgpSignature) GetCreationTime() *plugin.TValue[*time.Time] {
+	return &c.CreationTime
+}
+
+func (c *mqlOpenpgpSignature) GetLifetimeSecs() *plugin.TValue[int64] {
+	return &c.LifetimeSecs
+}
+
+func (c *mqlOpenpgpSignature) GetExpiresIn() *plugin.TValue[*time.Time] {
+	return &c.ExpiresIn
+}
+
+func (c *mqlOpenpgpSignature) GetKeyLifetimeSecs() *plugin.TValue[int64] {
+	return &c.KeyLifetimeSecs
+}
+
+func (c *mqlOpenpgpSignature) GetKeyExpiresIn() *plugin.TValue[*time.Time] {
+	return &c.KeyExpiresIn
+}
+
+// mqlDomainName for the domainName resource
+type mqlDomainName struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDomainNameInternal it will be used here
+	Fqdn                plugin.TValue[string]
+	EffectiveTLDPlusOne plugin.TValue[string]
+	Tld                 plugin.TValue[string]
+	TldIcannManaged     plugin.TValue[bool]
+	Labels              plugin.TValue[[]any]
+}
+
+// createDomainName creates a new instance of this resource
+func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDomainName{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("domainName", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDomainName) MqlName() string {
+	return "domainName"
+}
+
+func (c *mqlDomainName) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDomainName) GetFqdn() *plugin.TValue[string] {
+	return &c.Fqdn
+}
+
+func (c *mqlDomainName) GetEffectiveTLDPlusOne() *plugin.TValue[string] {
+	return &c.EffectiveTLDPlusOne
+}
+
+func (c *mqlDomainName) GetTld() *plugin.TValue[string] {
+	return &c.Tld
+}
+
+func (c *mqlDomainName) GetTldIcannManaged() *plugin.TValue[bool] {
+	return &c.TldIcannManaged
+}
+
+func (c *mqlDomainName) GetLabels() *plugin.TValue[[]any] {
+	return &c.Labels
+}
+
+// mqlDns for the dns resource
+type mqlDns struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDnsInternal it will be used here
+	Fqdn    plugin.TValue[string]
+	Params  plugin.TValue[any]
+	Records plugin.TValue[[]any]
+	Mx      plugin.TValue[[]any]
+	Dkim    plugin.TValue[[]any]
+	Dmarc   plugin.TValue[*mqlDnsDmarcRecord]
+	MtaSts  plugin.TValue[*mqlDnsMtaStsPolicy]
+	TlsRpt  plugin.TValue[*mqlDnsTlsRptRecord]
+	Caa     plugin.TValue[[]any]
+	Dnssec  plugin.TValue[*mqlDnsDnssec]
+}
+
+// createDns creates a new instance of this resource
+func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDns{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDns) MqlName() string {
+	return "dns"
+}
+
+func (c *mqlDns) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDns) GetFqdn() *plugin.TValue[string] {
+	return &c.Fqdn
+}
+
+func (c *mqlDns) GetParams() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Params, func() (any, error) {
+		vargFqdn := c.GetFqdn()
+		if vargFqdn.Error != nil {
+			return nil, vargFqdn.Error
+		}
+
+		return c.params(vargFqdn.Data)
+	})
+}
+
+func (c *mqlDns) GetRecords() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Records, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "records")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.records(vargParams.Data)
+	})
+}
+
+func (c *mqlDns) GetMx() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Mx, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mx")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.mx(vargParams.Data)
+	})
+}
+
+func (c *mqlDns) GetDkim() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Dkim, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dkim")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.dkim(vargParams.Data)
+	})
+}
+
+func (c *mqlDns) GetDmarc() *plugin.TValue[*mqlDnsDmarcRecord] {
+	return plugin.GetOrCompute[*mqlDnsDmarcRecord](&c.Dmarc, func() (*mqlDnsDmarcRecord, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dmarc")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDmarcRecord), nil
+			}
+		}
+
+		return c.dmarc()
+	})
+}
+
+func (c *mqlDns) GetMtaSts() *plugin.TValue[*mqlDnsMtaStsPolicy] {
+	return plugin.GetOrCompute[*mqlDnsMtaStsPolicy](&c.MtaSts, func() (*mqlDnsMtaStsPolicy, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mtaSts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsMtaStsPolicy), nil
+			}
+		}
+
+		return c.mtaSts()
+	})
+}
+
+func (c *mqlDns) GetTlsRpt() *plugin.TValue[*mqlDnsTlsRptRecord] {
+	return plugin.GetOrCompute[*mqlDnsTlsRptRecord](&c.TlsRpt, func() (*mqlDnsTlsRptRecord, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "tlsRpt")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsTlsRptRecord), nil
+			}
+		}
+
+		return c.tlsRpt()
+	})
+}
+
+func (c *mqlDns) GetCaa() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Caa, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "caa")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.caa()
+	})
+}
+
+func (c *mqlDns) GetDnssec() *plugin.TValue[*mqlDnsDnssec] {
+	return plugin.GetOrCompute[*mqlDnsDnssec](&c.Dnssec, func() (*mqlDnsDnssec, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dnssec")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDnssec), nil
+			}
+		}
+
+		return c.dnssec()
+	})
+}
+
+// mqlDnsRecord for the dns.record resource
+type mqlDnsRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDnsRecordInternal it will be used here
+	Name  plugin.TValue[string]
+	Ttl   plugin.TValue[int64]
+	Class plugin.TValue[string]
+	Type  plugin.TValue[string]
+	Rdata plugin.TValue[[]any]
+}
+
+// createDnsRecord creates a new instance of this resource
+func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsRecord) MqlName() string {
+	return "dns.record"
+}
+
+func (c *mqlDnsRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsRecord) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlDnsRecord) GetTtl() *plugin.TValue[int64] {
+	return &c.Ttl
+}
+
+func (c *mqlDnsRecord) GetClass() *plugin.TValue[string] {
+	return &c.Class
+}
+
+func (c *mqlDnsRecord) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlDnsRecord) GetRdata() *plugin.TValue[[]any] {
+	return &c.Rdata
+}
+
+// mqlDnsMxRecord for the dns.mxRecord resource
+type mqlDnsMxRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDnsMxRecordInternal it will be used here
+	Name       plugin.TValue[string]
+	Preference plugin.TValue[int64]
+	DomainName plugin.TValue[string]
+}
+
+// createDnsMxRecord creates a new instance of this resource
+func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsMxRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.mxRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsMxRecord) MqlName() string {
+	return "dns.mxRecord"
+}
+
+func (c *mqlDnsMxRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsMxRecord) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlDnsMxRecord) GetPreference() *plugin.TValue[int64] {
+	return &c.Preference
+}
+
+func (c *mqlDnsMxRecord) GetDomainName() *plugin.TValue[string] {
+	return &c.DomainName
+}
+
+// mqlDnsDkimRecord for the dns.dkimRecord resource
+type mqlDnsDkimRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlDnsDkimRecordInternal
+	DnsTxt         plugin.TValue[string]
+	Domain         plugin.TValue[string]
+	Version        plugin.TValue[string]
+	HashAlgorithms plugin.TValue[[]any]
+	KeyType        plugin.TValue[string]
+	Notes          plugin.TValue[string]
+	PublicKeyData  plugin.TValue[string]
+	ServiceTypes   plugin.TValue[[]any]
+	Flags          plugin.TValue[[]any]
+	Valid          plugin.TValue[bool]
+}
+
+// createDnsDkimRecord creates a new instance of this resource
+func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDkimRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.dkimRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsDkimRecord) MqlName() string {
+	return "dns.dkimRecord"
+}
+
+func (c *mqlDnsDkimRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsDkimRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
+}
+
+func (c *mqlDnsDkimRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsDkimRecord) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlDnsDkimRecord) GetHashAlgorithms() *plugin.TValue[[]any] {
+	return &c.HashAlgorithms
+}
+
+func (c *mqlDnsDkimRecord) GetKeyType() *plugin.TValue[string] {
+	return &c.KeyType
+}
+
+func (c *mqlDnsDkimRecord) GetNotes() *plugin.TValue[string] {
+	return &c.Notes
+}
+
+func (c *mqlDnsDkimRecord) GetPublicKeyData() *plugin.TValue[string] {
+	return &c.PublicKeyData
+}
+
+func (c *mqlDnsDkimRecord) GetServiceTypes() *plugin.TValue[[]any] {
+	return &c.ServiceTypes
+}
+
+func (c *mqlDnsDkimRecord) GetFlags() *plugin.TValue[[]any] {
+	return &c.Flags
+}
+
+func (c *mqlDnsDkimRecord) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+// mqlDnsDmarcRecord for the dns.dmarcRecord resource
+type mqlDnsDmarcRecord struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlDnsDmarcRecordInternal
+	DnsTxt              plugin.TValue[string]
+	Domain              plugin.TValue[string]
+	Version             plugin.TValue[string]
+	Policy              plugin.TValue[string]
+	SubdomainPolicy     plugin.TValue[string]
+	Percentage          plugin.TValue[int64]
+	AggregateReportUris plugin.TValue[[]any]
+	FailureReportUris   plugin.TValue[[]any]
+	DkimAlignment       plugin.TValue[string]
+	SpfAlignment        plugin.TValue[string]
+	FailureOptions      plugin.TValue[[]any]
+	ReportFormats       plugin.TValue[[]any]
+	ReportInterval      plugin.TValue[int64]
+	Valid               plugin.TValue[bool]
+}
+
+// createDnsDmarcRecord creates a new instance of this resource
+func createDnsDmarcRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDmarcRecord{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.dmarcRecord", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsDmarcRecord) MqlName() string {
+	return "dns.dmarcRecord"
+}
+
+func (c *mqlDnsDmarcRecord) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsDmarcRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
+}
+
+func (c *mqlDnsDmarcRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsDmarcRecord) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlDnsDmarcRecord) GetPolicy() *plugin.TValue[string] {
+	return &c.Policy
+}
+
+func (c *mqlDnsDmarcRecord) GetSubdomainPolicy() *plugin.TValue[string] {
+	return &c.SubdomainPolicy
+}
+
+func (c *mqlDnsDmarcRecord) GetPercentage() *plugin.TValue[int64] {
+	return &c.Percentage
+}
+
+func (c *mqlDnsDmarcRecord) GetAggregateReportUris() *plugin.TValue[[]any] {
+	return &c.AggregateReportUris
+}
+
+func (c *mqlDnsDmarcRecord) GetFailureReportUris() *plugin.TValue[[]any] {
+	return &c.FailureReportUris
+}
+
+func (c *mqlDnsDmarcRecord) GetDkimAlignment() *plugin.TValue[string] {
+	return &c.DkimAlignment
+}
+
+func (c *mqlDnsDmarcRecord) GetSpfAlignment() *plugin.TValue[string] {
+	return &c.SpfAlignment
+}
+
+func (c *mqlDnsDmarcRecord) GetFailureOptions() *plugin.TValue[[]any] {
+	return &c.FailureOptions
+}
+
+func (c *mqlDnsDmarcRecord) GetReportFormats() *plugin.TValue[[]any] {
+	return &c.ReportFormats
+}
+
+func (c *mqlDnsDmarcRecord) GetReportInterval() *plugin.TValue[int64] {
+	return &c.ReportInterval
+}
+
+func (c *mqlDnsDmarcRecord) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+// mqlDnsMtaStsPolicy for the dns.mtaStsPolicy resource
+type mqlDnsMtaStsPolicy struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlDnsMtaStsPolicyInternal
+	DnsTxt  plugin.TValue[string]
+	Domain  plugin.TValue[string]
+	Id      plugin.TValue[string]
+	Url     plugin.TValue[string]
+	Version plugin.TValue[string]
+	Mode    plugin.TValue[string]
+	Mx      plugin.TValue[[]any]
+	MaxAge  plugin.TValue[int64]
+	Valid   plugin.TValue[bool]
+}
+
+// createDnsMtaStsPolicy creates a new instance of this resource
+func createDnsMtaStsPolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsMtaStsPolicy{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("dns.mtaStsPolicy", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlDnsMtaStsPolicy) MqlName() string {
+	return "dns.mtaStsPolicy"
+}
+
+func (c *mqlDnsMtaStsPolicy) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDnsMtaStsPolicy) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
+}
+
+func (c *mqlDnsMtaStsPolicy) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsMtaStsPolicy) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlDnsMtaStsPolicy) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlDnsMtaStsPolicy) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetLifetimeSecs() *plugin.TValue[int64] {
-	return &c.LifetimeSecs
+func (c *mqlDnsMtaStsPolicy) GetMode() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Mode, func() (string, error) {
+		return c.mode()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetExpiresIn() *plugin.TValue[*time.Time] {
-	return &c.ExpiresIn
+func (c *mqlDnsMtaStsPolicy) GetMx() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Mx, func() ([]any, error) {
+		return c.mx()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetKeyLifetimeSecs() *plugin.TValue[int64] {
-	return &c.KeyLifetimeSecs
+func (c *mqlDnsMtaStsPolicy) GetMaxAge() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.MaxAge, func() (int64, error) {
+		return c.maxAge()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetKeyExpiresIn() *plugin.TValue[*time.Time] {
-	return &c.KeyExpiresIn
+func (c *mqlDnsMtaStsPolicy) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
 }
 
-// mqlDomainName for the domainName resource
-type mqlDomainName struct {
+// mqlDnsTlsRptRecord for the dns.tlsRptRecord resource
+type mqlDnsTlsRptRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDomainNameInternal it will be used here
-	Fqdn                plugin.TValue[string]
-	EffectiveTLDPlusOne plugin.TValue[string]
-	Tld                 plugin.TValue[string]
-	TldIcannManaged     plugin.TValue[bool]
-	Labels              plugin.TValue[[]any]
+	// optional: if you define mqlDnsTlsRptRecordInternal it will be used here
+	DnsTxt     plugin.TValue[string]
+	Domain     plugin.TValue[string]
+	Version    plugin.TValue[string]
+	ReportUris plugin.TValue[[]any]
 }
 
-// createDomainName creates a new instance of this resource
-func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDomainName{
+// createDnsTlsRptRecord creates a new instance of this resource
+func createDnsTlsRptRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsTlsRptRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -3231,7 +5110,7 @@ func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("domainName", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.tlsRptRecord", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3241,49 +5120,47 @@ func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlDomainName) MqlName() string {
-	return "domainName"
+func (c *mqlDnsTlsRptRecord) MqlName() string {
+	return "dns.tlsRptRecord"
 }
 
-func (c *mqlDomainName) MqlID() string {
+func (c *mqlDnsTlsRptRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDomainName) GetFqdn() *plugin.TValue[string] {
-	return &c.Fqdn
-}
-
-func (c *mqlDomainName) GetEffectiveTLDPlusOne() *plugin.TValue[string] {
-	return &c.EffectiveTLDPlusOne
+func (c *mqlDnsTlsRptRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
 }
 
-func (c *mqlDomainName) GetTld() *plugin.TValue[string] {
-	return &c.Tld
+func (c *mqlDnsTlsRptRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlDomainName) GetTldIcannManaged() *plugin.TValue[bool] {
-	return &c.TldIcannManaged
+func (c *mqlDnsTlsRptRecord) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlDomainName) GetLabels() *plugin.TValue[[]any] {
-	return &c.Labels
+func (c *mqlDnsTlsRptRecord) GetReportUris() *plugin.TValue[[]any] {
+	return &c.ReportUris
 }
 
-// mqlDns for the dns resource
-type mqlDns struct {
+// mqlDnsCaaRecord for the dns.caaRecord resource
+type mqlDnsCaaRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDnsInternal it will be used here
-	Fqdn    plugin.TValue[string]
-	Params  plugin.TValue[any]
-	Records plugin.TValue[[]any]
-	Mx      plugin.TValue[[]any]
-	Dkim    plugin.TValue[[]any]
+	// optional: if you define mqlDnsCaaRecordInternal it will be used here
+	Domain     plugin.TValue[string]
+	Flag       plugin.TValue[int64]
+	Critical   plugin.TValue[bool]
+	Tag        plugin.TValue[string]
+	Value      plugin.TValue[string]
+	Issuer     plugin.TValue[string]
+	Parameters plugin.TValue[map[string]any]
 }
 
-// createDns creates a new instance of this resource
-func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDns{
+// createDnsCaaRecord creates a new instance of this resource
+func createDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsCaaRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -3300,7 +5177,7 @@ func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.caaRecord", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3310,107 +5187,60 @@ func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlDns) MqlName() string {
-	return "dns"
+func (c *mqlDnsCaaRecord) MqlName() string {
+	return "dns.caaRecord"
 }
 
-func (c *mqlDns) MqlID() string {
+func (c *mqlDnsCaaRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDns) GetFqdn() *plugin.TValue[string] {
-	return &c.Fqdn
+func (c *mqlDnsCaaRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlDns) GetParams() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.Params, func() (any, error) {
-		vargFqdn := c.GetFqdn()
-		if vargFqdn.Error != nil {
-			return nil, vargFqdn.Error
-		}
-
-		return c.params(vargFqdn.Data)
-	})
+func (c *mqlDnsCaaRecord) GetFlag() *plugin.TValue[int64] {
+	return &c.Flag
 }
 
-func (c *mqlDns) GetRecords() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Records, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "records")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.records(vargParams.Data)
-	})
+func (c *mqlDnsCaaRecord) GetCritical() *plugin.TValue[bool] {
+	return &c.Critical
 }
 
-func (c *mqlDns) GetMx() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Mx, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mx")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.mx(vargParams.Data)
-	})
+func (c *mqlDnsCaaRecord) GetTag() *plugin.TValue[string] {
+	return &c.Tag
 }
 
-func (c *mqlDns) GetDkim() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Dkim, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dkim")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlDnsCaaRecord) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
 
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
+func (c *mqlDnsCaaRecord) GetIssuer() *plugin.TValue[string] {
+	return &c.Issuer
+}
 
-		return c.dkim(vargParams.Data)
-	})
+func (c *mqlDnsCaaRecord) GetParameters() *plugin.TValue[map[string]any] {
+	return &c.Parameters
 }
 
-// mqlDnsRecord for the dns.record resource
-type mqlDnsRecord struct {
+// mqlDnsDnssec for the dns.dnssec resource
+type mqlDnsDnssec struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDnsRecordInternal it will be used here
-	Name  plugin.TValue[string]
-	Ttl   plugin.TValue[int64]
-	Class plugin.TValue[string]
-	Type  plugin.TValue[string]
-	Rdata plugin.TValue[[]any]
-}
-
-// createDnsRecord creates a new instance of this resource
-func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsRecord{
+	// optional: if you define mqlDnsDnssecInternal it will be used here
+	Zone                plugin.TValue[string]
+	Signed              plugin.TValue[bool]
+	DelegationValidated plugin.TValue[bool]
+	AuthenticatedData   plugin.TValue[bool]
+	Keys                plugin.TValue[[]any]
+	DelegationSigners   plugin.TValue[[]any]
+	SignatureExpiration plugin.TValue[*time.Time]
+	Errors              plugin.TValue[[]any]
+}
+
+// createDnsDnssec creates a new instance of this resource
+func createDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDnssec{
 		MqlRuntime: runtime,
 	}
 
@@ -3427,7 +5257,7 @@ func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dnssec", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3437,47 +5267,62 @@ func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlDnsRecord) MqlName() string {
-	return "dns.record"
+func (c *mqlDnsDnssec) MqlName() string {
+	return "dns.dnssec"
 }
 
-func (c *mqlDnsRecord) MqlID() string {
+func (c *mqlDnsDnssec) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsRecord) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlDnsDnssec) GetZone() *plugin.TValue[string] {
+	return &c.Zone
 }
 
-func (c *mqlDnsRecord) GetTtl() *plugin.TValue[int64] {
-	return &c.Ttl
+func (c *mqlDnsDnssec) GetSigned() *plugin.TValue[bool] {
+	return &c.Signed
 }
 
-func (c *mqlDnsRecord) GetClass() *plugin.TValue[string] {
-	return &c.Class
+func (c *mqlDnsDnssec) GetDelegationValidated() *plugin.TValue[bool] {
+	return &c.DelegationValidated
 }
 
-func (c *mqlDnsRecord) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlDnsDnssec) GetAuthenticatedData() *plugin.TValue[bool] {
+	return &c.AuthenticatedData
 }
 
-func (c *mqlDnsRecord) GetRdata() *plugin.TValue[[]any] {
-	return &c.Rdata
+func (c *mqlDnsDnssec) GetKeys() *plugin.TValue[[]any] {
+	return &c.Keys
 }
 
-// mqlDnsMxRecord for the dns.mxRecord resource
-type mqlDnsMxRecord struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlDnsMxRecordInternal it will be used here
-	Name       plugin.TValue[string]
-	Preference plugin.TValue[int64]
-	DomainName plugin.TValue[string]
+func (c *mqlDnsDnssec) GetDelegationSigners() *plugin.TValue[[]any] {
+	return &c.DelegationSigners
 }
 
-// createDnsMxRecord creates a new instance of this resource
-func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsMxRecord{
+func (c *mqlDnsDnssec) GetSignatureExpiration() *plugin.TValue[*time.Time] {
+	return &c.SignatureExpiration
+}
+
+func (c *mqlDnsDnssec) GetErrors() *plugin.TValue[[]any] {
+	return &c.Errors
+}
+
+// mqlDnsDnssecKey for the dns.dnssec.key resource
+type mqlDnsDnssecKey struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDnsDnssecKeyInternal it will be used here
+	KeyTag        plugin.TValue[int64]
+	Flags         plugin.TValue[int64]
+	Protocol      plugin.TValue[int64]
+	Algorithm     plugin.TValue[string]
+	KeySigningKey plugin.TValue[bool]
+	PublicKey     plugin.TValue[string]
+}
+
+// createDnsDnssecKey creates a new instance of this resource
+func createDnsDnssecKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDnssecKey{
 		MqlRuntime: runtime,
 	}
 
@@ -3494,7 +5339,7 @@ func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.mxRecord", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dnssec.key", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3504,46 +5349,52 @@ func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlDnsMxRecord) MqlName() string {
-	return "dns.mxRecord"
+func (c *mqlDnsDnssecKey) MqlName() string {
+	return "dns.dnssec.key"
 }
 
-func (c *mqlDnsMxRecord) MqlID() string {
+func (c *mqlDnsDnssecKey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsMxRecord) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlDnsDnssecKey) GetKeyTag() *plugin.TValue[int64] {
+	return &c.KeyTag
 }
 
-func (c *mqlDnsMxRecord) GetPreference() *plugin.TValue[int64] {
-	return &c.Preference
+func (c *mqlDnsDnssecKey) GetFlags() *plugin.TValue[int64] {
+	return &c.Flags
 }
 
-func (c *mqlDnsMxRecord) GetDomainName() *plugin.TValue[string] {
-	return &c.DomainName
+func (c *mqlDnsDnssecKey) GetProtocol() *plugin.TValue[int64] {
+	return &c.Protocol
 }
 
-// mqlDnsDkimRecord for the dns.dkimRecord resource
-type mqlDnsDkimRecord struct {
+func (c *mqlDnsDnssecKey) GetAlgorithm() *plugin.TValue[string] {
+	return &c.Algorithm
+}
+
+func (c *mqlDnsDnssecKey) GetKeySigningKey() *plugin.TValue[bool] {
+	return &c.KeySigningKey
+}
+
+func (c *mqlDnsDnssecKey) GetPublicKey() *plugin.TValue[string] {
+	return &c.PublicKey
+}
+
+// mqlDnsDnssecDs for the dns.dnssec.ds resource
+type mqlDnsDnssecDs struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlDnsDkimRecordInternal
-	DnsTxt         plugin.TValue[string]
-	Domain         plugin.TValue[string]
-	Version        plugin.TValue[string]
-	HashAlgorithms plugin.TValue[[]any]
-	KeyType        plugin.TValue[string]
-	Notes          plugin.TValue[string]
-	PublicKeyData  plugin.TValue[string]
-	ServiceTypes   plugin.TValue[[]any]
-	Flags          plugin.TValue[[]any]
-	Valid          plugin.TValue[bool]
+	// optional: if you define mqlDnsDnssecDsInternal it will be used here
+	KeyTag     plugin.TValue[int64]
+	Algorithm  plugin.TValue[string]
+	DigestType plugin.TValue[string]
+	Digest     plugin.TValue[string]
 }
 
-// createDnsDkimRecord creates a new instance of this resource
-func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsDkimRecord{
+// createDnsDnssecDs creates a new instance of this resource
+func createDnsDnssecDs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDnssecDs{
 		MqlRuntime: runtime,
 	}
 
@@ -3560,7 +5411,7 @@ func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.dkimRecord", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dnssec.ds", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3570,52 +5421,26 @@ func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDnsDkimRecord) MqlName() string {
-	return "dns.dkimRecord"
+func (c *mqlDnsDnssecDs) MqlName() string {
+	return "dns.dnssec.ds"
 }
 
-func (c *mqlDnsDkimRecord) MqlID() string {
+func (c *mqlDnsDnssecDs) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsDkimRecord) GetDnsTxt() *plugin.TValue[string] {
-	return &c.DnsTxt
-}
-
-func (c *mqlDnsDkimRecord) GetDomain() *plugin.TValue[string] {
-	return &c.Domain
-}
-
-func (c *mqlDnsDkimRecord) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
-
-func (c *mqlDnsDkimRecord) GetHashAlgorithms() *plugin.TValue[[]any] {
-	return &c.HashAlgorithms
-}
-
-func (c *mqlDnsDkimRecord) GetKeyType() *plugin.TValue[string] {
-	return &c.KeyType
-}
-
-func (c *mqlDnsDkimRecord) GetNotes() *plugin.TValue[string] {
-	return &c.Notes
-}
-
-func (c *mqlDnsDkimRecord) GetPublicKeyData() *plugin.TValue[string] {
-	return &c.PublicKeyData
+func (c *mqlDnsDnssecDs) GetKeyTag() *plugin.TValue[int64] {
+	return &c.KeyTag
 }
 
-func (c *mqlDnsDkimRecord) GetServiceTypes() *plugin.TValue[[]any] {
-	return &c.ServiceTypes
+func (c *mqlDnsDnssecDs) GetAlgorithm() *plugin.TValue[string] {
+	return &c.Algorithm
 }
 
-func (c *mqlDnsDkimRecord) GetFlags() *plugin.TValue[[]any] {
-	return &c.Flags
+func (c *mqlDnsDnssecDs) GetDigestType() *plugin.TValue[string] {
+	return &c.DigestType
 }
 
-func (c *mqlDnsDkimRecord) GetValid() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
-		return c.valid()
-	})
+func (c *mqlDnsDnssecDs) GetDigest() *plugin.TValue[string] {
+	return &c.Digest
 }
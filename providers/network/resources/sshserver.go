@@ -0,0 +1,200 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/connection"
+	"go.mondoo.com/mql/v13/providers/network/resources/sshshake"
+	"go.mondoo.com/mql/v13/types"
+	"golang.org/x/crypto/ssh"
+)
+
+func initSshServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// if the socket is set already, we have nothing else to do
+	if _, ok := args["socket"]; ok {
+		return args, nil, nil
+	}
+
+	conn := runtime.Connection.(*connection.HostConnection)
+	// the port of the connection is for the host's primary service, e.g.
+	// https, so ssh always uses its default port unless a target is given
+	port := int64(CommonPorts["ssh"])
+	address := conn.Conf.Host
+
+	if target, ok := args["target"]; ok {
+		m := reTarget.FindStringSubmatch(target.Value.(string))
+		if len(m) == 0 {
+			return nil, nil, errors.New("target must be provided in the form of: ssh://target:port or target:port (defaults to port 22)")
+		}
+
+		port = int64(CommonPorts["ssh"])
+		if len(m[2]) != 0 {
+			rawPort, err := strconv.ParseUint(m[2][1:], 10, 64)
+			if err != nil {
+				return nil, nil, errors.New("failed to parse port: " + m[2])
+			}
+			port = int64(rawPort)
+		}
+		address = m[1]
+		delete(args, "target")
+	}
+
+	socket, err := CreateResource(runtime, "socket", map[string]*llx.RawData{
+		"protocol": llx.StringData("tcp"),
+		"port":     llx.IntData(port),
+		"address":  llx.StringData(address),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	args["socket"] = llx.ResourceData(socket, "socket")
+
+	return args, nil, nil
+}
+
+type mqlSshServerInternal struct {
+	// protects the server from running multiple handshakes at once
+	lock     sync.Mutex
+	scanned  bool
+	findings *sshshake.Findings
+	scanErr  error
+}
+
+func (s *mqlSshServer) id() (string, error) {
+	return "sshServer+" + s.Socket.Data.__id, nil
+}
+
+// scan runs the handshake once and shares the findings with all fields
+func (s *mqlSshServer) scan() (*sshshake.Findings, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.scanned {
+		return s.findings, s.scanErr
+	}
+	s.scanned = true
+
+	socket := s.Socket.Data
+	if socket == nil {
+		s.scanErr = errors.New("cannot scan SSH server without a socket")
+		return nil, s.scanErr
+	}
+	target := net.JoinHostPort(socket.Address.Data, strconv.FormatInt(socket.Port.Data, 10))
+
+	s.findings, s.scanErr = sshshake.New(socket.Protocol.Data, target).Test()
+	log.Debug().
+		Str("target", target).
+		Err(s.scanErr).
+		Msg("network.sshServer> handshake")
+	return s.findings, s.scanErr
+}
+
+func (s *mqlSshServer) banner() (string, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return "", err
+	}
+	return findings.Banner, nil
+}
+
+func (s *mqlSshServer) protocolVersion() (string, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return "", err
+	}
+	return findings.ProtocolVersion, nil
+}
+
+func (s *mqlSshServer) softwareVersion() (string, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return "", err
+	}
+	return findings.SoftwareVersion, nil
+}
+
+func (s *mqlSshServer) comments() (string, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return "", err
+	}
+	return findings.Comments, nil
+}
+
+func (s *mqlSshServer) kexs() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(findings.KexAlgorithms), nil
+}
+
+func (s *mqlSshServer) hostkeyAlgorithms() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(findings.HostKeyAlgorithms), nil
+}
+
+func (s *mqlSshServer) ciphers() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(findings.Ciphers()), nil
+}
+
+func (s *mqlSshServer) macs() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(findings.Macs()), nil
+}
+
+func (s *mqlSshServer) compressions() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(findings.Compressions()), nil
+}
+
+func (s *mqlSshServer) hostkeys() ([]any, error) {
+	findings, err := s.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, 0, len(findings.HostKeys))
+	for _, key := range findings.HostKeys {
+		o, err := CreateResource(s.MqlRuntime, "sshServer.hostkey", map[string]*llx.RawData{
+			"type":      llx.StringData(key.Type()),
+			"bits":      llx.IntData(int64(sshshake.KeyBits(key))),
+			"publicKey": llx.StringData(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))),
+			"fingerprints": llx.MapData(map[string]any{
+				"sha256": ssh.FingerprintSHA256(key),
+				"md5":    ssh.FingerprintLegacyMD5(key),
+			}, types.String),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+
+func (k *mqlSshServerHostkey) id() (string, error) {
+	return "sshServer.hostkey/" + k.PublicKey.Data, nil
+}
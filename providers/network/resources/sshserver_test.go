@@ -0,0 +1,53 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/network/connection"
+	"go.mondoo.com/mql/v13/providers/network/resources"
+	"go.mondoo.com/mql/v13/utils/syncx"
+)
+
+func TestResource_SshServerTarget(t *testing.T) {
+	testCases := []struct {
+		target     string
+		expectedId string
+	}{
+		{
+			target:     "bastion.example.com",
+			expectedId: "sshServer+tcp://bastion.example.com:22",
+		},
+		{
+			target:     "ssh://bastion.example.com:2222",
+			expectedId: "sshServer+tcp://bastion.example.com:2222",
+		},
+		{
+			target:     "10.0.0.1:22",
+			expectedId: "sshServer+tcp://10.0.0.1:22",
+		},
+	}
+
+	runtime := &plugin.Runtime{Resources: &syncx.Map[plugin.Resource]{}}
+	runtime.Connection = connection.NewHostConnection(1, &inventory.Asset{}, &inventory.Config{Host: "mondoo.com"})
+
+	for _, tc := range testCases {
+		t.Run(tc.target, func(t *testing.T) {
+			res, err := resources.NewResource(runtime, "sshServer", map[string]*llx.RawData{
+				"target": llx.StringData(tc.target),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedId, res.MqlID())
+		})
+	}
+
+	res, err := resources.NewResource(runtime, "sshServer", map[string]*llx.RawData{})
+	require.NoError(t, err)
+	require.Equal(t, "sshServer+tcp://mondoo.com:22", res.MqlID())
+}
@@ -0,0 +1,350 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// sshshake inspects SSH servers without authenticating
+
+package sshshake
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const DefaultTimeout = 5 * time.Second
+
+// ClientVersion is the identification string sent to servers
+const ClientVersion = "SSH-2.0-mql"
+
+var ErrFailedToConnect = errors.New("failed to connect")
+
+var ErrNoSSH = errors.New("failed to get an SSH response")
+
+const msgKexInit = 20
+
+// Findings are the results of an SSH handshake
+type Findings struct {
+	// Identification string of the server, e.g. SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13
+	Banner string
+	// Protocol version, e.g. 2.0
+	ProtocolVersion string
+	// Software version, e.g. OpenSSH_9.6p1
+	SoftwareVersion string
+	// Optional comments after the software version, e.g. Ubuntu-3ubuntu13
+	Comments string
+
+	KexAlgorithms           []string
+	HostKeyAlgorithms       []string
+	CiphersClientServer     []string
+	CiphersServerClient     []string
+	MacsClientServer        []string
+	MacsServerClient        []string
+	CompressionClientServer []string
+	CompressionServerClient []string
+
+	// Host keys offered by the server, one per key type
+	HostKeys []ssh.PublicKey
+}
+
+// Ciphers returns all ciphers the server supports in either direction
+func (f *Findings) Ciphers() []string {
+	return union(f.CiphersClientServer, f.CiphersServerClient)
+}
+
+// Macs returns all MACs the server supports in either direction
+func (f *Findings) Macs() []string {
+	return union(f.MacsClientServer, f.MacsServerClient)
+}
+
+// Compressions returns all compression algorithms the server supports in
+// either direction
+func (f *Findings) Compressions() []string {
+	return union(f.CompressionClientServer, f.CompressionServerClient)
+}
+
+// Tester runs the handshakes against one target
+type Tester struct {
+	proto   string
+	target  string
+	Timeout time.Duration
+}
+
+// New creates a new tester for the target (via proto and host:port)
+func New(proto string, target string) *Tester {
+	return &Tester{
+		proto:   proto,
+		target:  target,
+		Timeout: DefaultTimeout,
+	}
+}
+
+// Test reads the identification and the algorithms that the server offers,
+// and then collects its host keys. It never authenticates.
+func (t *Tester) Test() (*Findings, error) {
+	conn, err := net.DialTimeout(t.proto, t.target, t.Timeout)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToConnect, err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(t.Timeout)); err != nil {
+		return nil, err
+	}
+
+	res, err := handshake(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	res.HostKeys = t.hostKeys(res.HostKeyAlgorithms)
+	return res, nil
+}
+
+// handshake exchanges identification strings and reads the KEXINIT message
+// of the server
+// see https://datatracker.ietf.org/doc/html/rfc4253#section-4.2
+func handshake(conn io.ReadWriter) (*Findings, error) {
+	if _, err := io.WriteString(conn, ClientVersion+"\r\n"); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	banner, err := readBanner(r)
+	if err != nil {
+		return nil, err
+	}
+	res, err := parseBanner(banner)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := readPacket(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := parseKexInit(payload, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// readBanner returns the identification string. Servers may send other lines
+// before it.
+func readBanner(r *bufio.Reader) (string, error) {
+	// RFC 4253 limits the identification string to 255 characters, we allow
+	// a few other lines before it
+	for range 32 {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", ErrNoSSH
+			}
+			return "", err
+		}
+		if len(line) > 1024 {
+			return "", ErrNoSSH
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.HasPrefix(line, "SSH-") {
+			return line, nil
+		}
+	}
+	return "", ErrNoSSH
+}
+
+func parseBanner(banner string) (*Findings, error) {
+	// SSH-protoversion-softwareversion SP comments
+	ident, comments, _ := strings.Cut(banner, " ")
+	parts := strings.SplitN(ident, "-", 3)
+	if len(parts) != 3 {
+		return nil, errors.New("invalid SSH identification: " + banner)
+	}
+	return &Findings{
+		Banner:          banner,
+		ProtocolVersion: parts[1],
+		SoftwareVersion: parts[2],
+		Comments:        comments,
+	}, nil
+}
+
+// readPacket reads an unencrypted binary packet and returns its payload
+// see https://datatracker.ietf.org/doc/html/rfc4253#section-6
+func readPacket(r io.Reader) ([]byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header[0:4])
+	padding := uint32(header[4])
+	// the RFC requires support for packets of at least 35000 bytes
+	if length > 256*1024 || padding+1 > length {
+		return nil, errors.New("invalid SSH packet length")
+	}
+
+	data := make([]byte, length-1)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data[:length-1-padding], nil
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc4253#section-7.1
+func parseKexInit(payload []byte, res *Findings) error {
+	// message type and 16 bytes of random cookie
+	if len(payload) < 17 || payload[0] != msgKexInit {
+		return errors.New("expected SSH_MSG_KEXINIT from server")
+	}
+	data := payload[17:]
+
+	lists := []*[]string{
+		&res.KexAlgorithms,
+		&res.HostKeyAlgorithms,
+		&res.CiphersClientServer,
+		&res.CiphersServerClient,
+		&res.MacsClientServer,
+		&res.MacsServerClient,
+		&res.CompressionClientServer,
+		&res.CompressionServerClient,
+	}
+	for _, list := range lists {
+		if len(data) < 4 {
+			return errors.New("SSH_MSG_KEXINIT is too short")
+		}
+		n := binary.BigEndian.Uint32(data[0:4])
+		if uint32(len(data)-4) < n {
+			return errors.New("SSH_MSG_KEXINIT is too short")
+		}
+		*list = splitNameList(string(data[4 : 4+n]))
+		data = data[4+n:]
+	}
+	return nil
+}
+
+func splitNameList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+// hostKeys connects once per key type and records the key the server
+// presents. Failures are ignored, since servers may offer algorithms that
+// this client does not support.
+func (t *Tester) hostKeys(algorithms []string) []ssh.PublicKey {
+	res := []ssh.PublicKey{}
+	seen := map[string]struct{}{}
+	for _, algo := range algorithms {
+		keyType := hostKeyType(algo)
+		if _, ok := seen[keyType]; ok {
+			continue
+		}
+
+		key, err := t.hostKey(algo)
+		if err != nil || key == nil {
+			continue
+		}
+		seen[keyType] = struct{}{}
+		res = append(res, key)
+	}
+	return res
+}
+
+var errHostKeyReceived = errors.New("host key received")
+
+func (t *Tester) hostKey(algorithm string) (ssh.PublicKey, error) {
+	conn, err := net.DialTimeout(t.proto, t.target, t.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(t.Timeout)); err != nil {
+		return nil, err
+	}
+
+	// servers that only support legacy algorithms still present their host
+	// key, so we offer everything the ssh package implements
+	supported := ssh.SupportedAlgorithms()
+	insecure := ssh.InsecureAlgorithms()
+
+	var key ssh.PublicKey
+	_, _, _, err = ssh.NewClientConn(conn, t.target, &ssh.ClientConfig{
+		Config: ssh.Config{
+			KeyExchanges: append(supported.KeyExchanges, insecure.KeyExchanges...),
+			Ciphers:      append(supported.Ciphers, insecure.Ciphers...),
+			MACs:         append(supported.MACs, insecure.MACs...),
+		},
+		User:              "mql",
+		ClientVersion:     ClientVersion,
+		HostKeyAlgorithms: []string{algorithm},
+		Timeout:           t.Timeout,
+		HostKeyCallback: func(hostname string, remote net.Addr, k ssh.PublicKey) error {
+			key = k
+			// abort before we attempt to authenticate
+			return errHostKeyReceived
+		},
+	})
+	if key == nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// hostKeyType maps signature algorithms to the type of key they use, e.g.
+// rsa-sha2-512 uses ssh-rsa keys
+func hostKeyType(algorithm string) string {
+	switch algorithm {
+	case ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSASHA512:
+		return ssh.KeyAlgoRSA
+	case ssh.CertAlgoRSASHA256v01, ssh.CertAlgoRSASHA512v01:
+		return ssh.CertAlgoRSAv01
+	}
+	return algorithm
+}
+
+func union(a []string, b []string) []string {
+	res := make([]string, 0, len(a)+len(b))
+	seen := map[string]struct{}{}
+	for _, list := range [][]string{a, b} {
+		for _, s := range list {
+			if _, ok := seen[s]; ok {
+				continue
+			}
+			seen[s] = struct{}{}
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// KeyBits returns the size of the host key in bits or 0 if it is unknown
+func KeyBits(key ssh.PublicKey) int {
+	if cert, ok := key.(*ssh.Certificate); ok {
+		key = cert.Key
+	}
+	cryptoKey, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return 0
+	}
+
+	switch k := cryptoKey.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		return k.N.BitLen()
+	case *ecdsa.PublicKey:
+		return k.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return 256
+	}
+	// ssh-dss keys are limited to 1024 bits
+	if key.Type() == ssh.KeyAlgoDSA {
+		return 1024
+	}
+	return 0
+}
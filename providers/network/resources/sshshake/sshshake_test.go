@@ -0,0 +1,144 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshshake
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// startTestSshServer runs an SSH server with the given algorithms that
+// rejects all authentication attempts and returns its address
+func startTestSshServer(t *testing.T, algorithms ssh.Config, keys ...ssh.Signer) string {
+	config := &ssh.ServerConfig{
+		Config:        algorithms,
+		ServerVersion: "SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13",
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			return nil, errors.New("denied")
+		},
+	}
+	for _, key := range keys {
+		config.AddHostKey(key)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _, _, _ = ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestTester(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSigner, err := ssh.NewSignerFromKey(edKey)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ecSigner, err := ssh.NewSignerFromKey(ecKey)
+	require.NoError(t, err)
+
+	addr := startTestSshServer(t, ssh.Config{
+		Ciphers: []string{"aes128-gcm@openssh.com", "aes256-ctr"},
+		MACs:    []string{"hmac-sha2-256-etm@openssh.com", "hmac-sha2-256"},
+	}, edSigner, ecSigner)
+
+	res, err := New("tcp", addr).Test()
+	require.NoError(t, err)
+
+	assert.Equal(t, "SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13", res.Banner)
+	assert.Equal(t, "2.0", res.ProtocolVersion)
+	assert.Equal(t, "OpenSSH_9.6p1", res.SoftwareVersion)
+	assert.Equal(t, "Ubuntu-3ubuntu13", res.Comments)
+	assert.Contains(t, res.KexAlgorithms, "curve25519-sha256")
+	assert.ElementsMatch(t, []string{"ssh-ed25519", "ecdsa-sha2-nistp384"}, res.HostKeyAlgorithms)
+	assert.Equal(t, []string{"aes128-gcm@openssh.com", "aes256-ctr"}, res.Ciphers())
+	assert.Equal(t, []string{"hmac-sha2-256-etm@openssh.com", "hmac-sha2-256"}, res.Macs())
+	assert.Equal(t, []string{"none"}, res.Compressions())
+
+	require.Len(t, res.HostKeys, 2)
+	byType := map[string]ssh.PublicKey{}
+	for _, key := range res.HostKeys {
+		byType[key.Type()] = key
+	}
+	require.Contains(t, byType, "ssh-ed25519")
+	require.Contains(t, byType, "ecdsa-sha2-nistp384")
+	assert.True(t, bytes.Equal(edSigner.PublicKey().Marshal(), byType["ssh-ed25519"].Marshal()))
+	assert.Equal(t, ssh.FingerprintSHA256(ecSigner.PublicKey()), ssh.FingerprintSHA256(byType["ecdsa-sha2-nistp384"]))
+	assert.Equal(t, 256, KeyBits(byType["ssh-ed25519"]))
+	assert.Equal(t, 384, KeyBits(byType["ecdsa-sha2-nistp384"]))
+}
+
+func TestTester_LegacyAlgorithms(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSigner, err := ssh.NewSignerFromKey(edKey)
+	require.NoError(t, err)
+
+	addr := startTestSshServer(t, ssh.Config{
+		KeyExchanges: []string{"diffie-hellman-group1-sha1"},
+		Ciphers:      []string{"aes128-cbc"},
+		MACs:         []string{"hmac-sha1-96"},
+	}, edSigner)
+
+	res, err := New("tcp", addr).Test()
+	require.NoError(t, err)
+	assert.Contains(t, res.KexAlgorithms, "diffie-hellman-group1-sha1")
+	require.Len(t, res.HostKeys, 1)
+	assert.Equal(t, ssh.FingerprintSHA256(edSigner.PublicKey()), ssh.FingerprintSHA256(res.HostKeys[0]))
+}
+
+func TestTester_NoSSH(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = conn.Write([]byte("HTTP/1.1 400 Bad Request\r\n\r\n"))
+		_ = conn.Close()
+	}()
+
+	_, err = New("tcp", listener.Addr().String()).Test()
+	assert.ErrorIs(t, err, ErrNoSSH)
+}
+
+func TestParseBanner(t *testing.T) {
+	res, err := parseBanner("SSH-1.99-Cisco-1.25")
+	require.NoError(t, err)
+	assert.Equal(t, "1.99", res.ProtocolVersion)
+	assert.Equal(t, "Cisco-1.25", res.SoftwareVersion)
+	assert.Equal(t, "", res.Comments)
+
+	_, err = parseBanner("SSH-2.0")
+	assert.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 
 var reTarget = regexp.MustCompile(`([^/:]+?)(:\d+)?$`)
 
+var reTargetScheme = regexp.MustCompile(`^([a-zA-Z0-9]+)://`)
+
 var rexUrlDomain = regexp.MustCompile(regex.UrlDomain)
 
 var DefaultDialerTimeout = tlsshake.DefaultTimeout
@@ -52,6 +55,9 @@ func connTlsPort(conn *connection.HostConnection) int64 {
 func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
 	// if the socket is set already, we have nothing else to do
 	if _, ok := args["socket"]; ok {
+		if _, ok := args["starttls"]; !ok {
+			args["starttls"] = llx.StringData("")
+		}
 		return args, nil, nil
 	}
 
@@ -61,10 +67,20 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 	if target, ok := args["target"]; ok {
 		m := reTarget.FindStringSubmatch(target.Value.(string))
 		if len(m) == 0 {
-			return nil, nil, errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, or target:port (defaults to tcp)")
+			return nil, nil, errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, smtp://target:port (or another STARTTLS protocol), or target:port (defaults to tcp)")
 		}
 
 		proto := "tcp"
+		// protocols like smtp://mx:25 need an upgrade to TLS via STARTTLS,
+		// which also tells us their default port
+		starttls := ""
+		if s := reTargetScheme.FindStringSubmatch(target.Value.(string)); len(s) != 0 {
+			scheme := strings.ToLower(s[1])
+			if tlsshake.IsStartTLSProtocol(scheme) {
+				starttls = scheme
+				port = int64(tlsshake.StartTLSPorts[scheme])
+			}
+		}
 		// If the port is set as part of the target string, try to parse it
 		// from here.
 		if len(m[2]) != 0 {
@@ -92,6 +108,7 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 
 		args["socket"] = llx.ResourceData(socket, "socket")
 		args["domainName"] = llx.StringData(domainName)
+		args["starttls"] = llx.StringData(starttls)
 		delete(args, "target")
 
 	} else {
@@ -104,8 +121,14 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 			return nil, nil, err
 		}
 
+		starttls := ""
+		if tlsshake.IsStartTLSProtocol(conn.Conf.Runtime) {
+			starttls = conn.Conf.Runtime
+		}
+
 		args["socket"] = llx.ResourceData(socket, "socket")
 		args["domainName"] = llx.StringData(conn.Conf.Host)
+		args["starttls"] = llx.StringData(starttls)
 	}
 
 	return args, nil, nil
@@ -124,6 +147,9 @@ type mqlTlsInternal struct {
 }
 
 func (s *mqlTls) id() (string, error) {
+	if s.Starttls.Data != "" {
+		return "tls+" + s.Starttls.Data + "+" + s.Socket.Data.__id, nil
+	}
 	return "tls+" + s.Socket.Data.__id, nil
 }
 
@@ -311,16 +337,36 @@ func (s *mqlTls) extensions(params any) ([]any, error) {
 	return res, nil
 }
 
-func gatherTlsCertificates(proto, host, port, domainName string) ([]*x509.Certificate, []*x509.Certificate, error) {
+// dialTls connects to the address, runs the STARTTLS preamble if needed,
+// and completes the TLS handshake
+func dialTls(proto, addr, starttls string, conf *tls.Config) (*tls.Conn, error) {
+	rawConn, err := tlsshake.Dial(proto, addr, starttls, DefaultDialerTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := tls.Client(rawConn, conf)
+	if err := conn.SetDeadline(time.Now().Add(DefaultDialerTimeout)); err != nil {
+		_ = rawConn.Close()
+		return nil, err
+	}
+	if err := conn.Handshake(); err != nil {
+		_ = rawConn.Close()
+		return nil, err
+	}
+	return conn, conn.SetDeadline(time.Time{})
+}
+
+func gatherTlsCertificates(proto, host, port, domainName, starttls string) ([]*x509.Certificate, []*x509.Certificate, error) {
 	isSNIcert := map[string]struct{}{}
-	dialer := &net.Dialer{Timeout: DefaultDialerTimeout}
 	addr := net.JoinHostPort(host, port)
 	log.Trace().
 		Str("address", addr).
 		Str("domain_name", domainName).
+		Str("starttls", starttls).
 		Dur("timeout", DefaultDialerTimeout).
 		Msg("network.tls> gathering tls certificates")
-	conn, err := tls.DialWithDialer(dialer, proto, addr, &tls.Config{
+	conn, err := dialTls(proto, addr, starttls, &tls.Config{
 		InsecureSkipVerify: true,
 		ServerName:         domainName,
 	})
@@ -336,7 +382,7 @@ func gatherTlsCertificates(proto, host, port, domainName string) ([]*x509.Certif
 	}
 
 	nonSniCerts := []*x509.Certificate{}
-	nonSniConn, err := tls.DialWithDialer(dialer, proto, addr, &tls.Config{
+	nonSniConn, err := dialTls(proto, addr, starttls, &tls.Config{
 		InsecureSkipVerify: true,
 	})
 	if err != nil {
@@ -366,8 +412,11 @@ func (s *mqlTls) unsafeTLSTest(socket *mqlSocket, domainName string) error {
 	port := socket.Port.Data
 	proto := socket.Protocol.Data
 
+	conf := tlsshake.DefaultScanConfig()
+	conf.StartTLS = s.Starttls.Data
+
 	s.tester = tlsshake.New(proto, domainName, host, int(port))
-	if err := s.tester.Test(tlsshake.DefaultScanConfig()); err != nil {
+	if err := s.tester.Test(conf); err != nil {
 
 		log.Debug().
 			Str("host", host).
@@ -381,7 +430,8 @@ func (s *mqlTls) unsafeTLSTest(socket *mqlSocket, domainName string) error {
 		if errors.Is(err, tlsshake.ErrFailedToConnect) ||
 			errors.Is(err, tlsshake.ErrFailedToWrite) ||
 			errors.Is(err, tlsshake.ErrTimeout) ||
-			errors.Is(err, tlsshake.ErrFailedToTlsResponse) {
+			errors.Is(err, tlsshake.ErrFailedToTlsResponse) ||
+			errors.Is(err, tlsshake.ErrStartTLSFailed) {
 
 			s.Params.State = plugin.StateIsSet | plugin.StateIsNull
 			s.Certificates.State = plugin.StateIsSet | plugin.StateIsNull
@@ -439,7 +489,7 @@ func (s *mqlTls) populateCertificates(socket *mqlSocket, domainName string) erro
 	port := socket.Port.Data
 	proto := socket.Protocol.Data
 
-	certs, nonSniCerts, err := gatherTlsCertificates(proto, host, strconv.FormatInt(port, 10), domainName)
+	certs, nonSniCerts, err := gatherTlsCertificates(proto, host, strconv.FormatInt(port, 10), domainName, s.Starttls.Data)
 	if err != nil {
 		s.Certificates = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet}
 		s.NonSniCertificates = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet}
@@ -50,3 +50,44 @@ func TestResource_TlsFqdn(t *testing.T) {
 		require.Equal(t, tc.expectedId, dns.MqlID())
 	}
 }
+
+func TestResource_TlsStartTLSTarget(t *testing.T) {
+	testCases := []struct {
+		target     string
+		expectedId string
+	}{
+		{
+			target:     "smtp://mx.example.com",
+			expectedId: "tls+smtp+tcp://mx.example.com:25",
+		},
+		{
+			target:     "smtp://mx.example.com:587",
+			expectedId: "tls+smtp+tcp://mx.example.com:587",
+		},
+		{
+			target:     "postgres://db.example.com",
+			expectedId: "tls+postgres+tcp://db.example.com:5432",
+		},
+		{
+			target:     "ldap://ldap.example.com",
+			expectedId: "tls+ldap+tcp://ldap.example.com:389",
+		},
+		{
+			target:     "mail.example.com:993",
+			expectedId: "tls+tcp://mail.example.com:993",
+		},
+	}
+
+	runtime := &plugin.Runtime{Resources: &syncx.Map[plugin.Resource]{}}
+	runtime.Connection = connection.NewHostConnection(1, &inventory.Asset{}, &inventory.Config{})
+
+	for _, tc := range testCases {
+		t.Run(tc.target, func(t *testing.T) {
+			res, err := resources.NewResource(runtime, "tls", map[string]*llx.RawData{
+				"target": llx.StringData(tc.target),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedId, res.MqlID())
+		})
+	}
+}
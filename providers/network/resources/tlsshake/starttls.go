@@ -0,0 +1,303 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"bufio"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StartTLSPorts maps all protocols that can upgrade a plaintext connection
+// to TLS to their default port
+var StartTLSPorts = map[string]int{
+	"smtp":       25,
+	"imap":       143,
+	"imap4":      143,
+	"pop3":       110,
+	"ldap":       389,
+	"postgres":   5432,
+	"postgresql": 5432,
+}
+
+var ErrStartTLSFailed = errors.New("failed to negotiate STARTTLS")
+
+// IsStartTLSProtocol returns true if the connection for the protocol must be
+// upgraded to TLS before the handshake
+func IsStartTLSProtocol(protocol string) bool {
+	_, ok := StartTLSPorts[protocol]
+	return ok
+}
+
+// Dial connects to the target and runs the STARTTLS preamble of the
+// protocol. Once it returns, the connection is ready for a TLS handshake.
+// With an empty protocol it only connects.
+func Dial(proto string, target string, starttls string, timeout time.Duration) (net.Conn, error) {
+	conn, err := net.DialTimeout(proto, target, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if starttls == "" {
+		return conn, nil
+	}
+
+	if err := StartTLS(conn, starttls, timeout); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// StartTLS runs the plaintext preamble that upgrades a connection to TLS
+func StartTLS(conn net.Conn, protocol string, timeout time.Duration) error {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+
+	var err error
+	switch protocol {
+	case "smtp":
+		err = startTLSSmtp(conn)
+	case "imap", "imap4":
+		err = startTLSImap(conn)
+	case "pop3":
+		err = startTLSPop3(conn)
+	case "ldap":
+		err = startTLSLdap(conn)
+	case "postgres", "postgresql":
+		err = startTLSPostgres(conn)
+	default:
+		return errors.New("STARTTLS is not supported for protocol " + protocol)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s: %s", ErrStartTLSFailed, protocol, err.Error())
+	}
+
+	// callers set their own deadlines for the handshake
+	return conn.SetDeadline(time.Time{})
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc3207
+func startTLSSmtp(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	if code, _, err := readSmtpResponse(r); err != nil {
+		return err
+	} else if code != 220 {
+		return errors.New("unexpected greeting " + strconv.Itoa(code))
+	}
+
+	if _, err := io.WriteString(conn, "EHLO localhost\r\n"); err != nil {
+		return err
+	}
+	code, lines, err := readSmtpResponse(r)
+	if err != nil {
+		return err
+	}
+	if code != 250 {
+		return errors.New("EHLO was rejected with " + strconv.Itoa(code))
+	}
+	supported := false
+	for i := range lines {
+		if strings.EqualFold(strings.TrimSpace(lines[i]), "STARTTLS") {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return errors.New("server does not announce STARTTLS")
+	}
+
+	if _, err := io.WriteString(conn, "STARTTLS\r\n"); err != nil {
+		return err
+	}
+	code, _, err = readSmtpResponse(r)
+	if err != nil {
+		return err
+	}
+	if code != 220 {
+		return errors.New("STARTTLS was rejected with " + strconv.Itoa(code))
+	}
+	return nil
+}
+
+// readSmtpResponse reads a (multiline) reply and returns its code and the
+// text of all lines
+func readSmtpResponse(r *bufio.Reader) (int, []string, error) {
+	lines := []string{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return 0, nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) < 3 {
+			return 0, nil, errors.New("invalid response: " + line)
+		}
+		code, err := strconv.Atoi(line[0:3])
+		if err != nil {
+			return 0, nil, errors.New("invalid response: " + line)
+		}
+		if len(line) > 4 {
+			lines = append(lines, line[4:])
+		}
+		// the last line of a reply has a space after the code
+		if len(line) == 3 || line[3] != '-' {
+			return code, lines, nil
+		}
+	}
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc2595#section-3.1
+func startTLSImap(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	greeting, err := r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(greeting, "* OK") {
+		return errors.New("unexpected greeting " + strings.TrimSpace(greeting))
+	}
+
+	if _, err := io.WriteString(conn, "a001 STARTTLS\r\n"); err != nil {
+		return err
+	}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		// skip untagged responses
+		if !strings.HasPrefix(line, "a001 ") {
+			continue
+		}
+		if !strings.HasPrefix(line, "a001 OK") {
+			return errors.New("STARTTLS was rejected: " + strings.TrimSpace(line))
+		}
+		return nil
+	}
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc2595#section-4
+func startTLSPop3(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	greeting, err := r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(greeting, "+OK") {
+		return errors.New("unexpected greeting " + strings.TrimSpace(greeting))
+	}
+
+	if _, err := io.WriteString(conn, "STLS\r\n"); err != nil {
+		return err
+	}
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return errors.New("STLS was rejected: " + strings.TrimSpace(line))
+	}
+	return nil
+}
+
+// ldapStartTLSRequest is the BER encoded extended request with message id 1
+// and the StartTLS OID 1.3.6.1.4.1.1466.20037
+// see https://datatracker.ietf.org/doc/html/rfc4511#section-4.14.1
+var ldapStartTLSRequest = append([]byte{0x30, 0x1d, 0x02, 0x01, 0x01, 0x77, 0x18, 0x80, 0x16}, "1.3.6.1.4.1.1466.20037"...)
+
+func startTLSLdap(conn net.Conn) error {
+	if _, err := conn.Write(ldapStartTLSRequest); err != nil {
+		return err
+	}
+
+	data, err := readBerElement(bufio.NewReader(conn))
+	if err != nil {
+		return err
+	}
+
+	var msg struct {
+		MessageID int
+		Op        asn1.RawValue
+	}
+	if _, err := asn1.Unmarshal(data, &msg); err != nil {
+		return errors.New("invalid LDAP response: " + err.Error())
+	}
+	// ExtendedResponse ::= [APPLICATION 24]
+	if msg.Op.Class != asn1.ClassApplication || msg.Op.Tag != 24 {
+		return errors.New("unexpected LDAP response with tag " + strconv.Itoa(msg.Op.Tag))
+	}
+	var resultCode asn1.Enumerated
+	if _, err := asn1.Unmarshal(msg.Op.Bytes, &resultCode); err != nil {
+		return errors.New("invalid LDAP result: " + err.Error())
+	}
+	if resultCode != 0 {
+		return errors.New("StartTLS was rejected with result code " + strconv.Itoa(int(resultCode)))
+	}
+	return nil
+}
+
+// readBerElement reads one complete BER element with a definite length
+func readBerElement(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	length := int(header[1])
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 {
+			return nil, errors.New("unsupported BER length")
+		}
+		lengthBytes := make([]byte, n)
+		if _, err := io.ReadFull(r, lengthBytes); err != nil {
+			return nil, err
+		}
+		header = append(header, lengthBytes...)
+		length = 0
+		for _, b := range lengthBytes {
+			length = length<<8 | int(b)
+		}
+	}
+	if length > 64*1024 {
+		return nil, errors.New("BER element is too large")
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	return append(header, body...), nil
+}
+
+// postgresSSLRequest is the message length (8) followed by the SSLRequest
+// code 80877103
+// see https://www.postgresql.org/docs/current/protocol-flow.html#PROTOCOL-FLOW-SSL
+var postgresSSLRequest = []byte{0x00, 0x00, 0x00, 0x08, 0x04, 0xd2, 0x16, 0x2f}
+
+func startTLSPostgres(conn net.Conn) error {
+	if _, err := conn.Write(postgresSSLRequest); err != nil {
+		return err
+	}
+
+	resp := make([]byte, 1)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return err
+	}
+	switch resp[0] {
+	case 'S':
+		return nil
+	case 'N':
+		return errors.New("server does not accept SSL connections")
+	default:
+		return errors.New("unexpected response " + hexdump(resp))
+	}
+}
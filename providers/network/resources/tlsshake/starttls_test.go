@@ -0,0 +1,224 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSmtpPreamble is the server side of an SMTP session up to STARTTLS
+func fakeSmtpPreamble(conn net.Conn, starttls bool) {
+	r := bufio.NewReader(conn)
+	_, _ = io.WriteString(conn, "220 mx.example.com ESMTP\r\n")
+	_, _ = r.ReadString('\n')
+	if !starttls {
+		_, _ = io.WriteString(conn, "250-mx.example.com\r\n250 SIZE 1024\r\n")
+		return
+	}
+	_, _ = io.WriteString(conn, "250-mx.example.com\r\n250-SIZE 1024\r\n250 STARTTLS\r\n")
+	_, _ = r.ReadString('\n')
+	_, _ = io.WriteString(conn, "220 ready to start TLS\r\n")
+}
+
+func TestStartTLS(t *testing.T) {
+	tests := []struct {
+		protocol string
+		server   func(conn net.Conn)
+		err      string
+	}{
+		{
+			protocol: "smtp",
+			server:   func(conn net.Conn) { fakeSmtpPreamble(conn, true) },
+		},
+		{
+			protocol: "smtp",
+			server:   func(conn net.Conn) { fakeSmtpPreamble(conn, false) },
+			err:      "server does not announce STARTTLS",
+		},
+		{
+			protocol: "imap",
+			server: func(conn net.Conn) {
+				r := bufio.NewReader(conn)
+				_, _ = io.WriteString(conn, "* OK IMAP4rev1 ready\r\n")
+				_, _ = r.ReadString('\n')
+				_, _ = io.WriteString(conn, "* CAPABILITY IMAP4rev1\r\na001 OK Begin TLS negotiation now\r\n")
+			},
+		},
+		{
+			protocol: "imap",
+			server: func(conn net.Conn) {
+				r := bufio.NewReader(conn)
+				_, _ = io.WriteString(conn, "* OK IMAP4rev1 ready\r\n")
+				_, _ = r.ReadString('\n')
+				_, _ = io.WriteString(conn, "a001 BAD unknown command\r\n")
+			},
+			err: "STARTTLS was rejected",
+		},
+		{
+			protocol: "pop3",
+			server: func(conn net.Conn) {
+				r := bufio.NewReader(conn)
+				_, _ = io.WriteString(conn, "+OK POP3 ready\r\n")
+				_, _ = r.ReadString('\n')
+				_, _ = io.WriteString(conn, "+OK Begin TLS negotiation\r\n")
+			},
+		},
+		{
+			protocol: "ldap",
+			server: func(conn net.Conn) {
+				req := make([]byte, len(ldapStartTLSRequest))
+				_, _ = io.ReadFull(conn, req)
+				// ExtendedResponse with message id 1, resultCode success, and the StartTLS OID
+				resp := append([]byte{0x30, 0x24, 0x02, 0x01, 0x01, 0x78, 0x1f, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00, 0x8a, 0x16}, "1.3.6.1.4.1.1466.20037"...)
+				_, _ = conn.Write(resp)
+			},
+		},
+		{
+			protocol: "ldap",
+			server: func(conn net.Conn) {
+				req := make([]byte, len(ldapStartTLSRequest))
+				_, _ = io.ReadFull(conn, req)
+				// resultCode protocolError (2)
+				_, _ = conn.Write([]byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x02, 0x04, 0x00, 0x04, 0x00})
+			},
+			err: "result code 2",
+		},
+		{
+			protocol: "postgres",
+			server: func(conn net.Conn) {
+				req := make([]byte, len(postgresSSLRequest))
+				_, _ = io.ReadFull(conn, req)
+				_, _ = conn.Write([]byte{'S'})
+			},
+		},
+		{
+			protocol: "postgres",
+			server: func(conn net.Conn) {
+				req := make([]byte, len(postgresSSLRequest))
+				_, _ = io.ReadFull(conn, req)
+				_, _ = conn.Write([]byte{'N'})
+			},
+			err: "server does not accept SSL connections",
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.protocol+"/"+strconv.Itoa(i), func(t *testing.T) {
+			server, client := net.Pipe()
+			defer client.Close()
+			go func() {
+				defer server.Close()
+				test.server(server)
+			}()
+
+			err := StartTLS(client, test.protocol, time.Second)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrStartTLSFailed)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestStartTLS_UnsupportedProtocol(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	err := StartTLS(client, "gopher", time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not supported")
+}
+
+func TestTlsshake_StartTLS(t *testing.T) {
+	cert := selfSignedCertificate(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = conn.SetDeadline(time.Now().Add(time.Second))
+				fakeSmtpPreamble(conn, true)
+				_ = tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}}).Handshake()
+			}()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	conf := DefaultScanConfig()
+	conf.Versions = []string{"tls1.2"}
+	conf.FakeSNI = false
+	conf.StartTLS = "smtp"
+
+	tester := New("tcp", "mx.example.com", host, portNum)
+	require.NoError(t, tester.Test(conf))
+	assert.True(t, tester.Findings.Versions["tls1.2"])
+	assert.NotEmpty(t, tester.Findings.Ciphers)
+	require.NotEmpty(t, tester.Findings.Certificates)
+	assert.Equal(t, "mx.example.com", tester.Findings.Certificates[0].Subject.CommonName)
+
+	// the same endpoint does not speak TLS without the upgrade
+	tester = New("tcp", "mx.example.com", host, portNum)
+	conf.StartTLS = ""
+	err = tester.Test(conf)
+	require.Error(t, err)
+	assert.False(t, tester.Findings.Versions["tls1.2"])
+}
+
+func selfSignedCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mx.example.com"},
+		DNSNames:     []string{"mx.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestReadSmtpResponse(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("250-mx.example.com\r\n250-PIPELINING\r\n250 STARTTLS\r\n"))
+	code, lines, err := readSmtpResponse(r)
+	require.NoError(t, err)
+	assert.Equal(t, 250, code)
+	assert.Equal(t, []string{"mx.example.com", "PIPELINING", "STARTTLS"}, lines)
+}
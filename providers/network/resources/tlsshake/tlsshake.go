@@ -36,6 +36,9 @@ type ScanConfig struct {
 	// ConnectTimeout is used to check if a connection exists in the first place.
 	// It is only used for the initial verification OSI Layer 4
 	ConnectTimeout time.Duration
+	// StartTLS is the protocol that is used to upgrade plaintext connections
+	// before the handshake, e.g. smtp. Leave empty for direct TLS.
+	StartTLS string
 
 	// internal scan fields that users don't configure
 	version       string
@@ -70,6 +73,7 @@ type Tester struct {
 	proto      string
 	target     string
 	domainName string
+	starttls   string
 }
 
 // Findings tracks the current state of tested components and their findings
@@ -122,6 +126,13 @@ func (s *Tester) Test(conf ScanConfig) error {
 	if err != nil {
 		return ErrFailedToConnect
 	}
+	if conf.StartTLS != "" {
+		s.starttls = conf.StartTLS
+		if err := StartTLS(conn, conf.StartTLS, conf.ConnectTimeout); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
 	if conn != nil {
 		// we ignore the error here for now
 		_ = conn.Close()
@@ -231,7 +242,7 @@ func (s *Tester) Test(conf ScanConfig) error {
 // Returns the number of remaining ciphers to test (if so desired)
 // and any potential error
 func (s *Tester) testTLS(proto string, target string, conf *ScanConfig) (int, error) {
-	conn, err := net.Dial(proto, target)
+	conn, err := Dial(proto, target, s.starttls, 5*time.Second)
 	if err != nil {
 		return 0, multierr.Wrap(err, "failed to connect to target")
 	}
@@ -10,6 +10,22 @@ import (
 	"go.mondoo.com/mql/v13/providers/os/resources/discovery/docker_engine"
 )
 
+// vulnDbFlag is shared by all connectors, since vulnerability reports can
+// be generated for any asset that has packages
+var vulnDbFlag = plugin.Flag{
+	Long:    shared.VulnDbOption,
+	Type:    plugin.FlagType_String,
+	Default: "",
+	Desc:    "Local OSV, OVAL or secdb vulnerability database (directory or tarball) used instead of Mondoo Platform",
+}
+
+// vexFlag is shared by all connectors for the same reason as vulnDbFlag
+var vexFlag = plugin.Flag{
+	Long: shared.VexOption,
+	Type: plugin.FlagType_List,
+	Desc: "VEX documents (OpenVEX or CycloneDX) with statements about vulnerabilities of this asset",
+}
+
 var Config = plugin.Provider{
 	Name:    "os",
 	ID:      "go.mondoo.com/cnquery/v9/providers/os",
@@ -63,6 +79,8 @@ Examples:
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -120,6 +138,8 @@ Examples:
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -163,6 +183,8 @@ Examples:
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -191,6 +213,8 @@ Examples:
 					Desc:    "User override for platform ID detection mechanism",
 					Option:  plugin.FlagOption_Hidden,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -236,6 +260,8 @@ Examples:
 					Default: "",
 					Desc:    "HTTP proxy to use for container pulls",
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -285,6 +311,8 @@ Examples:
 					Default: "",
 					Desc:    "HTTP proxy to use for container pulls",
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -308,6 +336,8 @@ Examples:
 					Desc:    "Path to a local file or directory for the connection to use",
 					Option:  plugin.FlagOption_Deprecated,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 		{
@@ -377,6 +407,8 @@ Examples:
 					Desc:   "List of platform IDs to inject to the asset",
 					Option: plugin.FlagOption_Hidden,
 				},
+				vulnDbFlag,
+				vexFlag,
 			},
 		},
 	},
@@ -15,6 +15,7 @@ import (
 	"go.mondoo.com/mql/v13/llx"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/sbom/vex"
 )
 
 type ConnectionType string
@@ -45,6 +46,12 @@ const (
 	Type_Device            ConnectionType = "device"
 
 	ContainerProxyOption string = "container-proxy"
+	// VulnDbOption points to a local vulnerability database, which is used
+	// instead of Mondoo Platform to generate vulnerability reports
+	VulnDbOption string = "vuln-db"
+	// VexOption lists VEX documents with statements about the vulnerabilities
+	// of an asset, separated by commas
+	VexOption string = vex.Option
 )
 
 type OSFamily string
@@ -229,6 +229,25 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		}
 	}
 
+	if vulnDb, ok := flags[shared.VulnDbOption]; ok {
+		vulnDbVal := vulnDb.RawData().Value.(string)
+		if vulnDbVal != "" {
+			conf.Options[shared.VulnDbOption] = vulnDbVal
+		}
+	}
+
+	if vexFiles, ok := flags[shared.VexOption]; ok {
+		paths := []string{}
+		for _, path := range vexFiles.RawData().Value.([]any) {
+			if path, ok := path.(string); ok && path != "" {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) > 0 {
+			conf.Options[shared.VexOption] = strings.Join(paths, ",")
+		}
+	}
+
 	if lun, ok := flags["lun"]; ok {
 		conf.Options["lun"] = lun.RawData().Value.(string)
 	}
@@ -306,6 +306,13 @@ func (s *mqlApache2Conf) expandGlob(pattern string) ([]string, error) {
 		pattern = filepath.Join(serverRoot, pattern)
 	}
 
+	return expandFsGlob(conn.FileSystem(), pattern)
+}
+
+// expandFsGlob expands a glob pattern (e.g. "/etc/nginx/conf.d/*.conf") by
+// walking the connection's filesystem one path segment at a time. Patterns
+// without glob characters are returned as-is.
+func expandFsGlob(fs afero.Fs, pattern string) ([]string, error) {
 	if !reApacheGlob.MatchString(pattern) {
 		return []string{pattern}, nil
 	}
@@ -317,7 +324,7 @@ func (s *mqlApache2Conf) expandGlob(pattern string) ([]string, error) {
 		paths = []string{"/"}
 	}
 
-	afs := &afero.Afero{Fs: conn.FileSystem()}
+	afs := &afero.Afero{Fs: fs}
 
 	for _, segment := range segments[1:] {
 		if !reApacheGlob.MatchString(segment) {
@@ -0,0 +1,140 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/apparmor"
+)
+
+func (a *mqlApparmor) id() (string, error) {
+	return "apparmor", nil
+}
+
+func (a *mqlApparmor) runtime() (bool, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	return isLiveConnection(conn), nil
+}
+
+func (a *mqlApparmor) enabled(runtime bool) (bool, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	if runtime {
+		data, err := afs.ReadFile(apparmor.EnabledPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return strings.TrimSpace(string(data)) == "Y", nil
+	}
+
+	files, err := apparmorProfileFiles(afs)
+	if err != nil {
+		return false, err
+	}
+	return len(files) > 0, nil
+}
+
+// apparmorProfileFiles returns the names of all files in /etc/apparmor.d
+// that may define profiles. Subdirectories only hold abstractions, tunables
+// and local overrides, which are included by the profiles.
+func apparmorProfileFiles(afs *afero.Afero) ([]string, error) {
+	entries, err := afs.ReadDir(apparmor.ConfigDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var res []string
+	for _, entry := range entries {
+		if entry.IsDir() || !apparmor.IsProfileFile(entry.Name()) {
+			continue
+		}
+		res = append(res, entry.Name())
+	}
+	return res, nil
+}
+
+func (a *mqlApparmor) profiles(runtime bool, enabled bool) ([]any, error) {
+	conn := a.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	type profile struct {
+		apparmor.Profile
+		file string
+	}
+	var profiles []profile
+
+	if runtime {
+		if !enabled {
+			return []any{}, nil
+		}
+
+		data, err := afs.ReadFile(apparmor.ProfilesPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range apparmor.ParseLoadedProfiles(string(data)) {
+			profiles = append(profiles, profile{Profile: p})
+		}
+	} else {
+		files, err := apparmorProfileFiles(afs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range files {
+			path := apparmor.ConfigDir + "/" + name
+			data, err := afs.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			// profiles can be disabled or forced into complain mode by
+			// linking their file into the respective directory
+			disabled, _ := afs.Exists(apparmor.DisableDir + "/" + name)
+			complain, _ := afs.Exists(apparmor.ForceComplainDir + "/" + name)
+
+			for _, p := range apparmor.ParseProfiles(string(data)) {
+				switch {
+				case disabled:
+					p.Mode = "disabled"
+				case complain:
+					p.Mode = "complain"
+				}
+				profiles = append(profiles, profile{Profile: p, file: path})
+			}
+		}
+	}
+
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	res := make([]any, len(profiles))
+	for i, p := range profiles {
+		obj, err := CreateResource(a.MqlRuntime, "apparmor.profile", map[string]*llx.RawData{
+			"__id": llx.StringData("apparmor.profile/" + p.file + "/" + p.Name),
+			"name": llx.StringData(p.Name),
+			"mode": llx.StringData(p.Mode),
+			"file": llx.StringData(p.file),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package apparmor
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// EnabledPath reports whether AppArmor is enabled in the running kernel
+	EnabledPath = "/sys/module/apparmor/parameters/enabled"
+	// ProfilesPath lists all loaded profiles of the running kernel
+	ProfilesPath = "/sys/kernel/security/apparmor/profiles"
+	// ConfigDir contains the profiles that are loaded at boot
+	ConfigDir = "/etc/apparmor.d"
+	// DisableDir contains links to profiles that are not loaded at boot
+	DisableDir = ConfigDir + "/disable"
+	// ForceComplainDir contains links to profiles that are loaded in complain mode
+	ForceComplainDir = ConfigDir + "/force-complain"
+)
+
+// Profile is an AppArmor profile.
+type Profile struct {
+	Name string
+	Mode string
+}
+
+// ParseLoadedProfiles parses the loaded profiles of the running kernel. Each
+// line has the form `name (mode)`.
+func ParseLoadedProfiles(content string) []Profile {
+	var res []Profile
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		idx := strings.LastIndex(line, " (")
+		if idx < 0 || !strings.HasSuffix(line, ")") {
+			res = append(res, Profile{Name: line})
+			continue
+		}
+		res = append(res, Profile{
+			Name: line[:idx],
+			Mode: line[idx+2 : len(line)-1],
+		})
+	}
+	return res
+}
+
+var reFlags = regexp.MustCompile(`flags\s*=\s*\(([^)]*)\)`)
+
+// ParseProfiles parses the top-level profiles defined in an AppArmor policy
+// file. Hats and child profiles are not returned. Profiles without a mode
+// flag are in enforce mode.
+func ParseProfiles(content string) []Profile {
+	var res []Profile
+	depth := 0
+	for _, line := range strings.Split(content, "\n") {
+		line = stripComment(line)
+		if line == "" {
+			continue
+		}
+
+		if depth == 0 {
+			if name, ok := profileName(line); ok {
+				res = append(res, Profile{
+					Name: name,
+					Mode: profileMode(line),
+				})
+			}
+		}
+
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		if depth < 0 {
+			depth = 0
+		}
+	}
+	return res
+}
+
+// stripComment removes comments, which start with a # at the beginning of
+// the line or after whitespace
+func stripComment(line string) string {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "#") {
+		return ""
+	}
+	if idx := strings.Index(line, " #"); idx >= 0 {
+		line = strings.TrimSpace(line[:idx])
+	}
+	return line
+}
+
+// profileName returns the name of a profile declaration, e.g.
+// `profile foo /usr/bin/foo flags=(complain) {` or `/usr/{bin,sbin}/foo {`
+func profileName(line string) (string, bool) {
+	if !strings.HasSuffix(line, "{") {
+		return "", false
+	}
+
+	if rest, ok := strings.CutPrefix(line, "profile "); ok {
+		line = strings.TrimSpace(rest)
+	} else if !strings.HasPrefix(line, "/") && !strings.HasPrefix(line, "\"") && !strings.HasPrefix(line, "@{") {
+		return "", false
+	}
+
+	if strings.HasPrefix(line, "\"") {
+		end := strings.Index(line[1:], "\"")
+		if end < 0 {
+			return "", false
+		}
+		return line[1 : end+1], true
+	}
+
+	fields := strings.Fields(line)
+	name := fields[0]
+	if len(fields) == 1 {
+		name = strings.TrimSuffix(name, "{")
+	}
+	return name, name != ""
+}
+
+func profileMode(line string) string {
+	m := reFlags.FindStringSubmatch(line)
+	if m == nil {
+		return "enforce"
+	}
+	for _, flag := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || r == ' ' }) {
+		switch flag {
+		case "complain", "kill", "unconfined", "enforce":
+			return flag
+		}
+	}
+	return "enforce"
+}
+
+// IsProfileFile returns false for files in the AppArmor config directory
+// that never contain profiles, like package manager leftovers
+func IsProfileFile(name string) bool {
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+		return false
+	}
+	for _, suffix := range []string{".dpkg-new", ".dpkg-old", ".dpkg-dist", ".dpkg-bak", ".rpmnew", ".rpmsave", ".orig", ".rej"} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return name != "README"
+}
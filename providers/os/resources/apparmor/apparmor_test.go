@@ -0,0 +1,53 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package apparmor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLoadedProfiles(t *testing.T) {
+	profiles := ParseLoadedProfiles("/usr/sbin/cupsd (enforce)\nman_filter (complain)\n\nunknown\n")
+	assert.Equal(t, []Profile{
+		{Name: "/usr/sbin/cupsd", Mode: "enforce"},
+		{Name: "man_filter", Mode: "complain"},
+		{Name: "unknown"},
+	}, profiles)
+}
+
+func TestParseProfiles(t *testing.T) {
+	content := `
+# a comment {
+include <tunables/global>
+
+profile "my app" flags=(attach_disconnected, complain) {
+  ^hat {
+    /** r,
+  }
+  profile child {
+  }
+}
+
+/usr/{bin,sbin}/foo { # trailing comment
+  /etc/foo r,
+}
+
+@{HOME}/bin/bar flags=(kill) {
+}
+`
+	assert.Equal(t, []Profile{
+		{Name: "my app", Mode: "complain"},
+		{Name: "/usr/{bin,sbin}/foo", Mode: "enforce"},
+		{Name: "@{HOME}/bin/bar", Mode: "kill"},
+	}, ParseProfiles(content))
+}
+
+func TestIsProfileFile(t *testing.T) {
+	assert.True(t, IsProfileFile("usr.sbin.cupsd"))
+	assert.False(t, IsProfileFile("usr.sbin.cupsd.dpkg-old"))
+	assert.False(t, IsProfileFile("README"))
+	assert.False(t, IsProfileFile(".hidden"))
+}
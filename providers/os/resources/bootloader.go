@@ -0,0 +1,291 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/bootloader"
+	"go.mondoo.com/mql/v13/providers/os/resources/parsers"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (b *mqlBootloader) id() (string, error) {
+	return "bootloader", nil
+}
+
+func (b *mqlBootloader) compute_type(grub *mqlBootloaderGrub, systemdBoot *mqlBootloaderSystemdBoot) (string, error) {
+	switch {
+	case grub != nil:
+		return "grub2", nil
+	case systemdBoot != nil:
+		return "systemd-boot", nil
+	}
+	return "", nil
+}
+
+func (b *mqlBootloader) entries(typ string, grub *mqlBootloaderGrub, systemdBoot *mqlBootloaderSystemdBoot) ([]any, error) {
+	switch typ {
+	case "grub2":
+		return grub.Entries.Data, nil
+	case "systemd-boot":
+		return systemdBoot.Entries.Data, nil
+	}
+	return []any{}, nil
+}
+
+func (b *mqlBootloader) grub() (*mqlBootloaderGrub, error) {
+	conn := b.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	cfgPath := ""
+	for _, pattern := range bootloader.GrubConfigPaths {
+		paths, err := expandFsGlob(afs.Fs, pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range paths {
+			if ok, _ := afs.Exists(p); ok {
+				cfgPath = p
+				break
+			}
+		}
+		if cfgPath != "" {
+			break
+		}
+	}
+	if cfgPath == "" {
+		b.Grub.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	// grubenv holds saved variables like kernelopts, and user.cfg holds the
+	// GRUB2_PASSWORD set by grub2-setpassword
+	dir := path.Dir(cfgPath)
+	env := map[string]string{}
+	files := []any{}
+	for _, name := range []string{path.Base(cfgPath), "grubenv", "user.cfg"} {
+		p := path.Join(dir, name)
+		data, err := afs.ReadFile(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		f, err := CreateResource(b.MqlRuntime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(p),
+		})
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+
+		if name != path.Base(cfgPath) {
+			for k, v := range bootloader.ParseGrubEnv(bytes.NewReader(data)) {
+				env[k] = v
+			}
+		}
+	}
+
+	data, err := afs.ReadFile(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := bootloader.ParseGrubConfig(bytes.NewReader(data), env)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse "+cfgPath)
+	}
+
+	entries, err := bootloaderEntries2Resources(b.MqlRuntime, cfgPath, cfg.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.BLS {
+		for k, v := range cfg.Variables {
+			if _, ok := env[k]; !ok {
+				env[k] = v
+			}
+		}
+		blsEntries, err := bootloaderBLSEntries(b.MqlRuntime, afs, "/boot", env)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(blsEntries, entries...)
+	}
+
+	defaults, err := grubDefaults(afs)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := CreateResource(b.MqlRuntime, "bootloader.grub", map[string]*llx.RawData{
+		"__id":              llx.StringData("bootloader.grub/" + cfgPath),
+		"file":              llx.ResourceData(files[0].(*mqlFile), "file"),
+		"files":             llx.ArrayData(files, types.Resource("file")),
+		"defaults":          llx.MapData(defaults, types.String),
+		"superusers":        llx.ArrayData(llx.TArr2Raw(cfg.Superusers), types.String),
+		"passwordProtected": llx.BoolData(cfg.PasswordProtected()),
+		"bls":               llx.BoolData(cfg.BLS),
+		"entries":           llx.ArrayData(entries, types.Resource("bootloader.entry")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlBootloaderGrub), nil
+}
+
+// grubDefaults reads /etc/default/grub, which is a shell script that only
+// assigns variables
+func grubDefaults(afs *afero.Afero) (map[string]any, error) {
+	res := map[string]any{}
+	data, err := afs.ReadFile(bootloader.GrubDefaultsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	ini := parsers.ParseIni(string(data), "=")
+	if fields, ok := ini.Fields[""].(map[string]any); ok {
+		for k, v := range fields {
+			if str, ok := v.(string); ok {
+				res[k] = strings.Trim(str, "\"'")
+			}
+		}
+	}
+	return res, nil
+}
+
+func (b *mqlBootloader) systemdBoot() (*mqlBootloaderSystemdBoot, error) {
+	conn := b.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	for _, root := range bootloader.BootPartitions {
+		cfgPath := path.Join(root, bootloader.LoaderConfPath)
+		data, err := afs.ReadFile(cfgPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		cfg, err := bootloader.ParseLoaderConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse "+cfgPath)
+		}
+
+		entries, err := bootloaderBLSEntries(b.MqlRuntime, afs, root, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := CreateResource(b.MqlRuntime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(cfgPath),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := CreateResource(b.MqlRuntime, "bootloader.systemdBoot", map[string]*llx.RawData{
+			"__id":    llx.StringData("bootloader.systemdBoot/" + cfgPath),
+			"file":    llx.ResourceData(f, "file"),
+			"default": llx.StringData(cfg.Default),
+			"timeout": llx.IntData(cfg.Timeout),
+			"editor":  llx.BoolData(cfg.Editor),
+			"entries": llx.ArrayData(entries, types.Resource("bootloader.entry")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return o.(*mqlBootloaderSystemdBoot), nil
+	}
+
+	b.SystemdBoot.State = plugin.StateIsSet | plugin.StateIsNull
+	return nil, nil
+}
+
+// bootloaderBLSEntries reads the boot loader specification entries of the
+// given boot partition, sorted by their file name
+func bootloaderBLSEntries(runtime *plugin.Runtime, afs *afero.Afero, root string, env map[string]string) ([]any, error) {
+	dir := path.Join(root, bootloader.BLSEntriesDir)
+	files, err := afs.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []any{}, nil
+		}
+		return nil, err
+	}
+
+	names := []string{}
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".conf") {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+
+	res := []any{}
+	for _, name := range names {
+		p := path.Join(dir, name)
+		data, err := afs.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := bootloader.ParseBLSEntry(bytes.NewReader(data), env)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse "+p)
+		}
+		if entry.Title == "" {
+			entry.Title = strings.TrimSuffix(name, ".conf")
+		}
+
+		objs, err := bootloaderEntries2Resources(runtime, p, []bootloader.Entry{entry})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, objs...)
+	}
+	return res, nil
+}
+
+func bootloaderEntries2Resources(runtime *plugin.Runtime, source string, entries []bootloader.Entry) ([]any, error) {
+	res := make([]any, len(entries))
+	for i, entry := range entries {
+		params := map[string]any{}
+		for k, v := range bootloader.ParseCmdline(entry.Cmdline) {
+			params[k] = v
+		}
+
+		o, err := CreateResource(runtime, "bootloader.entry", map[string]*llx.RawData{
+			"__id":         llx.StringData("bootloader.entry/" + source + "/" + strconv.Itoa(i)),
+			"title":        llx.StringData(entry.Title),
+			"version":      llx.StringData(entry.Version),
+			"kernel":       llx.StringData(entry.Kernel),
+			"initrd":       llx.ArrayData(llx.TArr2Raw(entry.Initrd), types.String),
+			"cmdline":      llx.StringData(entry.Cmdline),
+			"parameters":   llx.MapData(params, types.String),
+			"unrestricted": llx.BoolData(entry.Unrestricted),
+			"source":       llx.StringData(source),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o
+	}
+	return res, nil
+}
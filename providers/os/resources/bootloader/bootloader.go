@@ -0,0 +1,332 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bootloader
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GrubDefaultsPath contains the settings grub2-mkconfig uses to generate grub.cfg
+	GrubDefaultsPath = "/etc/default/grub"
+	// BLSEntriesDir contains boot loader specification entries, which are
+	// used by systemd-boot and by GRUB on Fedora and RHEL (blscfg)
+	BLSEntriesDir = "loader/entries"
+	// LoaderConfPath is the systemd-boot configuration, relative to the ESP or XBOOTLDR partition
+	LoaderConfPath = "loader/loader.conf"
+)
+
+// GrubConfigPaths are the locations of the generated GRUB configuration, in
+// the order they are tried. On UEFI systems the file on the ESP may only
+// load the file in /boot.
+var GrubConfigPaths = []string{
+	"/boot/grub2/grub.cfg",
+	"/boot/grub/grub.cfg",
+	"/boot/efi/EFI/*/grub.cfg",
+}
+
+// BootPartitions are the mount points where systemd-boot and boot loader
+// specification entries can be found
+var BootPartitions = []string{"/boot", "/efi", "/boot/efi"}
+
+// Entry is a boot menu entry
+type Entry struct {
+	Title        string
+	Version      string
+	Kernel       string
+	Initrd       []string
+	Cmdline      string
+	Unrestricted bool
+}
+
+// GrubConfig is the result of parsing a grub.cfg
+type GrubConfig struct {
+	Entries []Entry
+	// Superusers that are allowed to edit entries and use the command line
+	Superusers []string
+	// Passwords by user; the value is the password hash or the plain password
+	Passwords map[string]string
+	// Variables set with `set` outside of menu entries
+	Variables map[string]string
+	// Whether menu entries are read from boot loader specification files
+	BLS bool
+}
+
+// ParseGrubConfig parses a generated grub.cfg. It does not evaluate
+// conditions, so all variable assignments and password settings are
+// collected no matter which branch they are in. Variables in password
+// settings are expanded with the given environment, which usually comes
+// from grubenv or user.cfg.
+func ParseGrubConfig(r io.Reader, env map[string]string) (*GrubConfig, error) {
+	cfg := &GrubConfig{
+		Passwords: map[string]string{},
+		Variables: map[string]string{},
+	}
+
+	// the stack of open blocks, e.g. submenu and menuentry
+	var blocks []string
+	var entry *Entry
+
+	lookup := func(name string) string {
+		if v, ok := cfg.Variables[name]; ok {
+			return v
+		}
+		return env[name]
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if line == "}" {
+			if len(blocks) > 0 {
+				if blocks[len(blocks)-1] == "menuentry" && entry != nil {
+					cfg.Entries = append(cfg.Entries, *entry)
+					entry = nil
+				}
+				blocks = blocks[:len(blocks)-1]
+			}
+			continue
+		}
+
+		words := splitWords(line)
+		if len(words) == 0 {
+			continue
+		}
+
+		if strings.HasSuffix(line, "{") {
+			switch words[0] {
+			case "menuentry":
+				entry = &Entry{}
+				if len(words) > 1 {
+					entry.Title = words[1]
+				}
+				for _, w := range words[2:] {
+					if w == "--unrestricted" {
+						entry.Unrestricted = true
+					}
+				}
+			}
+			blocks = append(blocks, words[0])
+			continue
+		}
+
+		if entry != nil {
+			switch words[0] {
+			case "linux", "linuxefi", "linux16":
+				if len(words) > 1 {
+					entry.Kernel = words[1]
+					entry.Cmdline = ExpandVariables(strings.Join(words[2:], " "), lookup)
+				}
+			case "initrd", "initrdefi", "initrd16":
+				entry.Initrd = append(entry.Initrd, strings.Fields(ExpandVariables(strings.Join(words[1:], " "), lookup))...)
+			}
+			continue
+		}
+
+		switch words[0] {
+		case "blscfg":
+			cfg.BLS = true
+		case "set":
+			if len(words) > 1 {
+				name, value, _ := strings.Cut(words[1], "=")
+				cfg.Variables[name] = ExpandVariables(value, lookup)
+				if name == "superusers" {
+					cfg.Superusers = strings.FieldsFunc(cfg.Variables[name], func(r rune) bool {
+						return r == ' ' || r == ',' || r == ';' || r == '|' || r == '&'
+					})
+				}
+			}
+		case "password", "password_pbkdf2":
+			if len(words) > 2 {
+				cfg.Passwords[words[1]] = ExpandVariables(words[2], lookup)
+			}
+		}
+	}
+
+	return cfg, scanner.Err()
+}
+
+// PasswordProtected returns true if at least one superuser has a password
+func (c *GrubConfig) PasswordProtected() bool {
+	for _, user := range c.Superusers {
+		if c.Passwords[user] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWords splits a line into words like the GRUB shell does, removing
+// quotes
+func splitWords(line string) []string {
+	var res []string
+	var cur strings.Builder
+	var quote rune
+	inWord := false
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				res = append(res, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		res = append(res, cur.String())
+	}
+	return res
+}
+
+var reVariable = regexp.MustCompile(`\$\{([A-Za-z0-9_]+)\}|\$([A-Za-z0-9_]+)`)
+
+// ExpandVariables replaces $var and ${var} with their values. Unknown
+// variables expand to an empty string, like they do in GRUB.
+func ExpandVariables(s string, lookup func(string) string) string {
+	if !strings.Contains(s, "$") {
+		return s
+	}
+	res := reVariable.ReplaceAllStringFunc(s, func(m string) string {
+		name := strings.Trim(m, "${}")
+		return lookup(name)
+	})
+	return strings.Join(strings.Fields(res), " ")
+}
+
+// ParseGrubEnv parses a grubenv block or user.cfg, which contain
+// `name=value` lines
+func ParseGrubEnv(r io.Reader) map[string]string {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		res[name] = value
+	}
+	return res
+}
+
+// ParseBLSEntry parses a boot loader specification entry, see
+// https://uapi-group.org/specifications/specs/boot_loader_specification/
+func ParseBLSEntry(r io.Reader, env map[string]string) (Entry, error) {
+	// entries without users can be booted by everyone
+	entry := Entry{Unrestricted: true}
+	var options []string
+	lookup := func(name string) string {
+		return env[name]
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		key, value := line, ""
+		if idx := strings.IndexAny(line, " \t"); idx >= 0 {
+			key, value = line[:idx], strings.TrimSpace(line[idx+1:])
+		}
+		switch key {
+		case "title":
+			entry.Title = value
+		case "version":
+			entry.Version = value
+		case "linux", "efi":
+			entry.Kernel = value
+		case "initrd":
+			entry.Initrd = append(entry.Initrd, strings.Fields(ExpandVariables(value, lookup))...)
+		case "options":
+			options = append(options, value)
+		case "grub_users":
+			entry.Unrestricted = ExpandVariables(value, lookup) == ""
+		}
+	}
+
+	entry.Cmdline = ExpandVariables(strings.Join(options, " "), lookup)
+	return entry, scanner.Err()
+}
+
+// LoaderConfig is the systemd-boot loader.conf
+type LoaderConfig struct {
+	Default string
+	// Timeout in seconds; -1 if not set
+	Timeout int
+	// Whether the kernel command line can be edited at boot; true by default
+	Editor bool
+}
+
+// ParseLoaderConfig parses a systemd-boot loader.conf
+func ParseLoaderConfig(r io.Reader) (LoaderConfig, error) {
+	cfg := LoaderConfig{Timeout: -1, Editor: true}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "default":
+			cfg.Default = fields[1]
+		case "timeout":
+			if t, err := strconv.Atoi(fields[1]); err == nil {
+				cfg.Timeout = t
+			} else if fields[1] == "menu-force" {
+				cfg.Timeout = 0
+			}
+		case "editor":
+			cfg.Editor = parseBool(fields[1])
+		}
+	}
+	return cfg, scanner.Err()
+}
+
+func parseBool(s string) bool {
+	switch strings.ToLower(s) {
+	case "1", "yes", "y", "true", "t", "on":
+		return true
+	}
+	return false
+}
+
+// ParseCmdline splits a kernel command line into its parameters. Flags
+// without a value, like `quiet`, have an empty value.
+func ParseCmdline(cmdline string) map[string]string {
+	res := map[string]string{}
+	for _, word := range splitWords(cmdline) {
+		key, value, _ := strings.Cut(word, "=")
+		res[key] = value
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bootloader
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ubuntuGrubCfg = `
+if [ -s $prefix/grubenv ]; then
+  set have_grubenv=true
+  load_env
+fi
+set superusers="admin"
+password_pbkdf2 admin grub.pbkdf2.sha512.10000.AAAA.BBBB
+if [ "${linux_gfx_mode}" != "text" ]; then set vt_handoff=vt.handoff=7; else set vt_handoff= ; fi
+set vt_handoff=vt.handoff=7
+menuentry 'Ubuntu' --class ubuntu --class gnu-linux --class gnu --class os --unrestricted $menuentry_id_option 'gnulinux-simple-1234' {
+	recordfail
+	load_video
+	linux	/boot/vmlinuz-5.15.0-91-generic root=UUID=1234 ro  quiet splash $vt_handoff
+	initrd	/boot/initrd.img-5.15.0-91-generic
+}
+submenu 'Advanced options for Ubuntu' $menuentry_id_option 'gnulinux-advanced-1234' {
+	menuentry 'Ubuntu, with Linux 5.15.0-91-generic (recovery mode)' --class ubuntu $menuentry_id_option 'gnulinux-5.15.0-91-generic-recovery-1234' {
+		linux	/boot/vmlinuz-5.15.0-91-generic root=UUID=1234 ro recovery nomodeset dis_ucode_ldr
+		initrd	/boot/initrd.img-5.15.0-91-generic
+	}
+}
+`
+
+func TestParseGrubConfig(t *testing.T) {
+	cfg, err := ParseGrubConfig(strings.NewReader(ubuntuGrubCfg), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"admin"}, cfg.Superusers)
+	assert.True(t, cfg.PasswordProtected())
+	assert.False(t, cfg.BLS)
+
+	assert.Equal(t, []Entry{
+		{
+			Title:        "Ubuntu",
+			Kernel:       "/boot/vmlinuz-5.15.0-91-generic",
+			Initrd:       []string{"/boot/initrd.img-5.15.0-91-generic"},
+			Cmdline:      "root=UUID=1234 ro quiet splash vt.handoff=7",
+			Unrestricted: true,
+		},
+		{
+			Title:   "Ubuntu, with Linux 5.15.0-91-generic (recovery mode)",
+			Kernel:  "/boot/vmlinuz-5.15.0-91-generic",
+			Initrd:  []string{"/boot/initrd.img-5.15.0-91-generic"},
+			Cmdline: "root=UUID=1234 ro recovery nomodeset dis_ucode_ldr",
+		},
+	}, cfg.Entries)
+}
+
+func TestPasswordProtected(t *testing.T) {
+	cfg, err := ParseGrubConfig(strings.NewReader(`
+set superusers="root"
+password_pbkdf2 root ${GRUB2_PASSWORD}
+`), map[string]string{})
+	require.NoError(t, err)
+	assert.False(t, cfg.PasswordProtected())
+}
+
+func TestParseCmdline(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"root":   "UUID=1234",
+		"ro":     "",
+		"audit":  "1",
+		"dyndbg": "file foo.c +p",
+		"lsm":    "yama,apparmor",
+	}, ParseCmdline(`root=UUID=1234 ro audit=1 dyndbg="file foo.c +p" lsm=yama,apparmor`))
+}
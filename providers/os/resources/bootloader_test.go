@@ -0,0 +1,119 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootloader(t *testing.T) {
+	// boot configuration is read from files only, so the tests use an
+	// offline connection
+	tests := []struct {
+		name     string
+		path     string
+		typ      string
+		cmdlines []string
+		noGrub   bool
+		noSdboot bool
+	}{
+		{
+			name:     "grub2 with BLS entries",
+			path:     "./testdata/bootloader_grub.toml",
+			typ:      "grub2",
+			noSdboot: true,
+			cmdlines: []string{
+				"root=/dev/mapper/rhel-root ro",
+				"root=/dev/mapper/rhel-root ro crashkernel=auto audit=1",
+				"",
+			},
+		},
+		{
+			name:   "systemd-boot",
+			path:   "./testdata/bootloader_systemdboot.toml",
+			typ:    "systemd-boot",
+			noGrub: true,
+			cmdlines: []string{
+				"root=UUID=1234 rw lsm=landlock,lockdown,yama,integrity,apparmor,bpf audit=1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := newMockConnection(t, nil, tt.path)
+			b := newTestResource[*mqlBootloader](t, &offlineConnection{Connection: conn}, "bootloader", nil)
+
+			assert.Equal(t, tt.typ, b.GetType().Data)
+			assert.Equal(t, tt.noGrub, b.GetGrub().IsNull())
+			assert.Equal(t, tt.noSdboot, b.GetSystemdBoot().IsNull())
+
+			entries := b.GetEntries()
+			require.NoError(t, entries.Error)
+			cmdlines := []string{}
+			for _, e := range entries.Data {
+				cmdlines = append(cmdlines, e.(*mqlBootloaderEntry).Cmdline.Data)
+			}
+			assert.Equal(t, tt.cmdlines, cmdlines)
+		})
+	}
+}
+
+func TestBootloaderGrub(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/bootloader_grub.toml")
+	b := newTestResource[*mqlBootloader](t, &offlineConnection{Connection: conn}, "bootloader", nil)
+
+	grub := b.GetGrub()
+	require.NoError(t, grub.Error)
+	require.NotNil(t, grub.Data)
+	assert.Equal(t, "/boot/grub2/grub.cfg", grub.Data.File.Data.Path.Data)
+	assert.Len(t, grub.Data.Files.Data, 3)
+	assert.True(t, grub.Data.PasswordProtected.Data)
+	assert.Equal(t, []any{"root"}, grub.Data.Superusers.Data)
+	assert.True(t, grub.Data.Bls.Data)
+	assert.Equal(t, "true", grub.Data.Defaults.Data["GRUB_ENABLE_BLSCFG"])
+
+	entries := b.GetEntries()
+	require.NoError(t, entries.Error)
+	require.Len(t, entries.Data, 3)
+
+	rescue := entries.Data[0].(*mqlBootloaderEntry)
+	assert.Equal(t, "0-rescue-6a9f2b", rescue.Version.Data)
+	assert.False(t, rescue.Unrestricted.Data)
+
+	entry := entries.Data[1].(*mqlBootloaderEntry)
+	assert.Equal(t, "Red Hat Enterprise Linux (5.14.0-362.el9.x86_64) 9.3 (Plow)", entry.Title.Data)
+	assert.Equal(t, "1", entry.Parameters.Data["audit"])
+	assert.Equal(t, "", entry.Parameters.Data["ro"])
+	assert.Equal(t, []any{"/initramfs-5.14.0-362.el9.x86_64.img"}, entry.Initrd.Data)
+	assert.True(t, entry.Unrestricted.Data)
+	assert.Equal(t, "/boot/loader/entries/6a9f2b-5.14.0-362.el9.x86_64.conf", entry.Source.Data)
+
+	firmware := entries.Data[2].(*mqlBootloaderEntry)
+	assert.Equal(t, "UEFI Firmware Settings", firmware.Title.Data)
+	assert.Equal(t, "/boot/grub2/grub.cfg", firmware.Source.Data)
+}
+
+func TestBootloaderSystemdBoot(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/bootloader_systemdboot.toml")
+	b := newTestResource[*mqlBootloader](t, &offlineConnection{Connection: conn}, "bootloader", nil)
+
+	sdboot := b.GetSystemdBoot()
+	require.NoError(t, sdboot.Error)
+	assert.Equal(t, "/efi/loader/loader.conf", sdboot.Data.File.Data.Path.Data)
+	assert.Equal(t, "arch.conf", sdboot.Data.Default.Data)
+	assert.Equal(t, int64(4), sdboot.Data.Timeout.Data)
+	assert.False(t, sdboot.Data.Editor.Data)
+
+	entries := b.GetEntries()
+	require.NoError(t, entries.Error)
+	require.Len(t, entries.Data, 1)
+	entry := entries.Data[0].(*mqlBootloaderEntry)
+	assert.Equal(t, "Arch Linux", entry.Title.Data)
+	assert.Equal(t, []any{"/intel-ucode.img", "/initramfs-linux.img"}, entry.Initrd.Data)
+	assert.Equal(t, "1", entry.Parameters.Data["audit"])
+}
@@ -0,0 +1,173 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+)
+
+func TestFirewalld(t *testing.T) {
+	tests := []struct {
+		name    string
+		offline bool
+		running bool
+		// zones maps the zone names to whether they are active
+		zones map[string]bool
+	}{
+		{
+			name:    "firewall-cmd",
+			running: true,
+			zones:   map[string]bool{"block": false, "internal": true},
+		},
+		{
+			// the zone in /etc/firewalld replaces the shipped zone of the
+			// same name, and zones with interfaces or sources are active
+			name:    "zone files",
+			offline: true,
+			zones:   map[string]bool{"internal": true, "public": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conn shared.Connection = newMockConnection(t, nil, "./testdata/firewall.toml")
+			if tt.offline {
+				conn = &offlineConnection{Connection: conn}
+			}
+			f := newTestResource[*mqlFirewalld](t, conn, "firewalld", nil)
+
+			assert.Equal(t, !tt.offline, f.GetRuntime().Data)
+			assert.Equal(t, tt.running, f.GetRunning().Data)
+			assert.Equal(t, "internal", f.GetDefaultZone().Data)
+
+			zones := f.GetZones()
+			require.NoError(t, zones.Error)
+			res := map[string]bool{}
+			for _, z := range zones.Data {
+				zone := z.(*mqlFirewalldZone)
+				res[zone.Name.Data] = zone.Active.Data
+			}
+			assert.Equal(t, tt.zones, res)
+		})
+	}
+}
+
+func TestFirewalldZone(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/firewall.toml")
+	zones := newTestResource[*mqlFirewalld](t, conn, "firewalld", nil).GetZones()
+	require.NoError(t, zones.Error)
+	require.Len(t, zones.Data, 2)
+
+	zone := zones.Data[1].(*mqlFirewalldZone)
+	assert.Equal(t, "internal", zone.Name.Data)
+	assert.Equal(t, []any{"eth0", "eth1"}, zone.Interfaces.Data)
+	assert.Equal(t, []any{"dhcpv6-client", "ssh"}, zone.Services.Data)
+	assert.True(t, zone.Masquerade.Data)
+	require.Len(t, zone.Ports.Data, 2)
+	port := zone.Ports.Data[1].(*mqlFirewalldPort)
+	assert.Equal(t, "9000-9100", port.Port.Data)
+	assert.Equal(t, "udp", port.Protocol.Data)
+	assert.Equal(t, []any{map[string]any{
+		"port": "22", "protocol": "tcp", "toPort": "2222", "toAddr": "",
+	}}, zone.ForwardPorts.Data)
+
+	require.Len(t, zone.RichRules.Data, 2)
+	rule := zone.RichRules.Data[1].(*mqlFirewalldRule)
+	assert.Equal(t, "ipv6", rule.Family.Data)
+	assert.Equal(t, int64(-10), rule.Priority.Data)
+	assert.Equal(t, "NOT fe80::/64", rule.Source.Data)
+	assert.Equal(t, "443", rule.Port.Data)
+	assert.Equal(t, "reject", rule.Action.Data)
+}
+
+func TestFirewalldZoneFiles(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/firewall.toml")
+	zones := newTestResource[*mqlFirewalld](t, &offlineConnection{Connection: conn}, "firewalld", nil).GetZones()
+	require.NoError(t, zones.Error)
+	require.Len(t, zones.Data, 2)
+
+	zone := zones.Data[0].(*mqlFirewalldZone)
+	assert.Equal(t, "internal", zone.Name.Data)
+	assert.Equal(t, "ACCEPT", zone.Target.Data)
+	assert.Equal(t, "For use on internal networks.", zone.Description.Data)
+
+	require.Len(t, zone.RichRules.Data, 2)
+	rule := zone.RichRules.Data[0].(*mqlFirewalldRule)
+	assert.Equal(t, `rule family="ipv4" source address="192.168.1.0/24" service name="http" log prefix="http: " level="info" accept`, rule.Rule.Data)
+	assert.Equal(t, "http", rule.Service.Data)
+	assert.True(t, rule.Log.Data)
+	assert.Equal(t, "accept", rule.Action.Data)
+	rule = zone.RichRules.Data[1].(*mqlFirewalldRule)
+	assert.Equal(t, "NOT fe80::/64", rule.Source.Data)
+
+	zone = zones.Data[1].(*mqlFirewalldZone)
+	assert.Equal(t, "public", zone.Name.Data)
+	assert.Equal(t, "default", zone.Target.Data)
+	assert.True(t, zone.Forward.Data)
+}
+
+func TestUfw(t *testing.T) {
+	tests := []struct {
+		name    string
+		offline bool
+	}{
+		{
+			name: "ufw status",
+		},
+		{
+			// rules are read from user.rules and user6.rules
+			name:    "config files",
+			offline: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conn shared.Connection = newMockConnection(t, nil, "./testdata/firewall.toml")
+			if tt.offline {
+				conn = &offlineConnection{Connection: conn}
+			}
+			u := newTestResource[*mqlUfw](t, conn, "ufw", nil)
+
+			assert.Equal(t, !tt.offline, u.GetRuntime().Data)
+			assert.Equal(t, "active", u.GetStatus().Data)
+			rules := u.GetRules()
+			require.NoError(t, rules.Error)
+			assert.Len(t, rules.Data, 5)
+		})
+	}
+}
+
+func TestUfwRules(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/firewall.toml")
+	u := newTestResource[*mqlUfw](t, conn, "ufw", nil)
+
+	assert.True(t, u.GetEnabled().Data)
+	assert.True(t, u.GetIpv6().Data)
+	assert.Equal(t, "low", u.GetLogLevel().Data)
+	assert.Equal(t, "deny", u.GetDefaultInputPolicy().Data)
+	assert.Equal(t, "allow", u.GetDefaultOutputPolicy().Data)
+	assert.Equal(t, "reject", u.GetDefaultForwardPolicy().Data)
+
+	rules := u.GetRules()
+	require.NoError(t, rules.Error)
+	require.Len(t, rules.Data, 5)
+
+	rule := rules.Data[1].(*mqlUfwRule)
+	assert.Equal(t, int64(2), rule.Number.Data)
+	assert.Equal(t, "limit", rule.Action.Data)
+	assert.Equal(t, "log", rule.Log.Data)
+	assert.Equal(t, "eth0", rule.Interface.Data)
+	assert.Equal(t, "10.0.0.0/8", rule.From.Data)
+	assert.Equal(t, "admin", rule.Comment.Data)
+
+	rule = rules.Data[4].(*mqlUfwRule)
+	assert.True(t, rule.Ipv6.Data)
+	assert.Equal(t, "deny", rule.Action.Data)
+	assert.Equal(t, "23", rule.ToPort.Data)
+}
@@ -0,0 +1,242 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/firewalld"
+	"go.mondoo.com/mql/v13/providers/os/resources/parsers"
+	"go.mondoo.com/mql/v13/types"
+)
+
+func (f *mqlFirewalld) id() (string, error) {
+	return "firewalld", nil
+}
+
+func (f *mqlFirewalld) runtime() (bool, error) {
+	conn := f.MqlRuntime.Connection.(shared.Connection)
+	return isLiveConnection(conn), nil
+}
+
+func (f *mqlFirewalld) running(runtime bool) (bool, error) {
+	if !runtime {
+		return false, nil
+	}
+
+	// firewall-cmd exits with 252 if the daemon is not running and fails
+	// entirely if firewalld is not installed
+	o, err := CreateResource(f.MqlRuntime, "command", map[string]*llx.RawData{
+		"command": llx.StringData("firewall-cmd --state"),
+	})
+	if err != nil {
+		return false, err
+	}
+	cmd := o.(*mqlCommand)
+	if exit := cmd.GetExitcode(); exit.Data != 0 {
+		return false, nil
+	}
+	return strings.TrimSpace(cmd.GetStdout().Data) == "running", nil
+}
+
+func (f *mqlFirewalld) defaultZone(running bool) (string, error) {
+	if running {
+		o, err := CreateResource(f.MqlRuntime, "command", map[string]*llx.RawData{
+			"command": llx.StringData("firewall-cmd --get-default-zone"),
+		})
+		if err != nil {
+			return "", err
+		}
+		cmd := o.(*mqlCommand)
+		if exit := cmd.GetExitcode(); exit.Data == 0 {
+			return strings.TrimSpace(cmd.GetStdout().Data), nil
+		}
+	}
+
+	conn := f.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+	data, err := afs.ReadFile(firewalld.ConfigPath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	ini := parsers.ParseIni(string(data), "=")
+	if fields, ok := ini.Fields[""].(map[string]any); ok {
+		if zone, ok := fields["DefaultZone"].(string); ok && zone != "" {
+			return zone, nil
+		}
+	}
+	return firewalld.FallbackDefaultZone, nil
+}
+
+func (f *mqlFirewalld) zones(running bool) ([]any, error) {
+	if !running {
+		permanent := f.GetPermanentZones()
+		return permanent.Data, permanent.Error
+	}
+
+	o, err := CreateResource(f.MqlRuntime, "command", map[string]*llx.RawData{
+		"command": llx.StringData("firewall-cmd --list-all-zones"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd := o.(*mqlCommand)
+	if exit := cmd.GetExitcode(); exit.Data != 0 {
+		return nil, errors.New("firewall-cmd failed: " + cmd.GetStderr().Data)
+	}
+
+	zones, _, err := firewalld.ParseListAllZones(strings.NewReader(cmd.GetStdout().Data))
+	if err != nil {
+		return nil, err
+	}
+	return firewalldZones2Resources(f.MqlRuntime, "runtime", zones)
+}
+
+func (f *mqlFirewalld) permanentZones() ([]any, error) {
+	conn := f.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	// zones in /etc/firewalld/zones replace the shipped zones with the same name
+	files := map[string]string{}
+	for _, dir := range []string{firewalld.ZonesDir, firewalld.DefaultZonesDir} {
+		entries, err := afs.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entry := range entries {
+			name, ok := strings.CutSuffix(entry.Name(), ".xml")
+			if !ok || entry.IsDir() {
+				continue
+			}
+			if _, ok := files[name]; !ok {
+				files[name] = path.Join(dir, entry.Name())
+			}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zones := make([]*firewalld.Zone, 0, len(names))
+	for _, name := range names {
+		file, err := afs.Open(files[name])
+		if err != nil {
+			return nil, err
+		}
+		zone, err := firewalld.ParseZone(name, file)
+		file.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse firewalld zone "+files[name])
+		}
+		zones = append(zones, zone)
+	}
+
+	return firewalldZones2Resources(f.MqlRuntime, "permanent", zones)
+}
+
+func firewalldZones2Resources(runtime *plugin.Runtime, kind string, zones []*firewalld.Zone) ([]any, error) {
+	res := make([]any, len(zones))
+	for i, zone := range zones {
+		id := "firewalld.zone/" + kind + "/" + zone.Name
+
+		ports, err := firewalldPorts2Resources(runtime, id+"/port", zone.Ports)
+		if err != nil {
+			return nil, err
+		}
+		sourcePorts, err := firewalldPorts2Resources(runtime, id+"/sourcePort", zone.SourcePorts)
+		if err != nil {
+			return nil, err
+		}
+
+		forwardPorts := make([]any, len(zone.ForwardPorts))
+		for j, fwd := range zone.ForwardPorts {
+			forwardPorts[j] = map[string]any{
+				"port":     fwd.Port,
+				"protocol": fwd.Protocol,
+				"toPort":   fwd.ToPort,
+				"toAddr":   fwd.ToAddr,
+			}
+		}
+
+		richRules := make([]any, len(zone.RichRules))
+		for j, rule := range zone.RichRules {
+			parsed := firewalld.ParseRichRule(rule)
+			priority, _ := strconv.ParseInt(parsed.Priority, 10, 64)
+			obj, err := CreateResource(runtime, "firewalld.rule", map[string]*llx.RawData{
+				"__id":        llx.StringData(id + "/rule/" + strconv.Itoa(j)),
+				"rule":        llx.StringData(rule),
+				"family":      llx.StringData(parsed.Family),
+				"priority":    llx.IntData(priority),
+				"source":      llx.StringData(parsed.Source),
+				"destination": llx.StringData(parsed.Destination),
+				"service":     llx.StringData(parsed.Service),
+				"port":        llx.StringData(parsed.Port),
+				"protocol":    llx.StringData(parsed.Protocol),
+				"log":         llx.BoolData(parsed.Log),
+				"action":      llx.StringData(parsed.Action),
+			})
+			if err != nil {
+				return nil, err
+			}
+			richRules[j] = obj
+		}
+
+		obj, err := CreateResource(runtime, "firewalld.zone", map[string]*llx.RawData{
+			"__id":               llx.StringData(id),
+			"name":               llx.StringData(zone.Name),
+			"short":              llx.StringData(zone.Short),
+			"description":        llx.StringData(zone.Description),
+			"target":             llx.StringData(zone.Target),
+			"active":             llx.BoolData(zone.Active),
+			"interfaces":         llx.ArrayData(llx.TArr2Raw(zone.Interfaces), types.String),
+			"sources":            llx.ArrayData(llx.TArr2Raw(zone.Sources), types.String),
+			"services":           llx.ArrayData(llx.TArr2Raw(zone.Services), types.String),
+			"ports":              llx.ArrayData(ports, types.Resource("firewalld.port")),
+			"sourcePorts":        llx.ArrayData(sourcePorts, types.Resource("firewalld.port")),
+			"protocols":          llx.ArrayData(llx.TArr2Raw(zone.Protocols), types.String),
+			"masquerade":         llx.BoolData(zone.Masquerade),
+			"forward":            llx.BoolData(zone.Forward),
+			"forwardPorts":       llx.ArrayData(forwardPorts, types.Dict),
+			"icmpBlocks":         llx.ArrayData(llx.TArr2Raw(zone.IcmpBlocks), types.String),
+			"icmpBlockInversion": llx.BoolData(zone.IcmpBlockInversion),
+			"richRules":          llx.ArrayData(richRules, types.Resource("firewalld.rule")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func firewalldPorts2Resources(runtime *plugin.Runtime, idPrefix string, ports []firewalld.Port) ([]any, error) {
+	res := make([]any, len(ports))
+	for i, p := range ports {
+		obj, err := CreateResource(runtime, "firewalld.port", map[string]*llx.RawData{
+			"__id":     llx.StringData(idPrefix + "/" + p.Port + "/" + p.Protocol),
+			"port":     llx.StringData(p.Port),
+			"protocol": llx.StringData(p.Protocol),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
@@ -0,0 +1,434 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package firewalld
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	// ConfigPath is the main firewalld configuration file
+	ConfigPath = "/etc/firewalld/firewalld.conf"
+	// ZonesDir contains zones that are created or customized by the administrator
+	ZonesDir = "/etc/firewalld/zones"
+	// DefaultZonesDir contains the zones that ship with firewalld
+	DefaultZonesDir = "/usr/lib/firewalld/zones"
+	// FallbackDefaultZone is used when firewalld.conf does not set a default zone
+	FallbackDefaultZone = "public"
+)
+
+// Port is a port or port range with its protocol, e.g. 8080/tcp
+type Port struct {
+	Port     string
+	Protocol string
+}
+
+// ForwardPort forwards a local port to another port or address
+type ForwardPort struct {
+	Port     string
+	Protocol string
+	ToPort   string
+	ToAddr   string
+}
+
+// Zone is a firewalld zone. Its fields are filled from the zone XML files or
+// from the output of `firewall-cmd --list-all-zones`.
+type Zone struct {
+	Name               string
+	Short              string
+	Description        string
+	Target             string
+	Interfaces         []string
+	Sources            []string
+	Services           []string
+	Ports              []Port
+	SourcePorts        []Port
+	Protocols          []string
+	Masquerade         bool
+	Forward            bool
+	ForwardPorts       []ForwardPort
+	IcmpBlocks         []string
+	IcmpBlockInversion bool
+	RichRules          []string
+	Active             bool
+}
+
+// xmlZone mirrors the zone XML format, see firewalld.zone(5)
+type xmlZone struct {
+	XMLName            xml.Name    `xml:"zone"`
+	Target             string      `xml:"target,attr"`
+	Short              string      `xml:"short"`
+	Description        string      `xml:"description"`
+	Interfaces         []xmlName   `xml:"interface"`
+	Sources            []xmlSource `xml:"source"`
+	Services           []xmlName   `xml:"service"`
+	Ports              []xmlPort   `xml:"port"`
+	SourcePorts        []xmlPort   `xml:"source-port"`
+	Protocols          []xmlValue  `xml:"protocol"`
+	Masquerade         *struct{}   `xml:"masquerade"`
+	Forward            *struct{}   `xml:"forward"`
+	ForwardPorts       []xmlFwd    `xml:"forward-port"`
+	IcmpBlocks         []xmlName   `xml:"icmp-block"`
+	IcmpBlockInversion *struct{}   `xml:"icmp-block-inversion"`
+	Rules              []xmlNode   `xml:"rule"`
+}
+
+type xmlName struct {
+	Name string `xml:"name,attr"`
+}
+
+type xmlValue struct {
+	Value string `xml:"value,attr"`
+}
+
+type xmlSource struct {
+	Address string `xml:"address,attr"`
+	Mac     string `xml:"mac,attr"`
+	Ipset   string `xml:"ipset,attr"`
+}
+
+type xmlPort struct {
+	Port     string `xml:"port,attr"`
+	Protocol string `xml:"protocol,attr"`
+}
+
+type xmlFwd struct {
+	Port     string `xml:"port,attr"`
+	Protocol string `xml:"protocol,attr"`
+	ToPort   string `xml:"to-port,attr"`
+	ToAddr   string `xml:"to-addr,attr"`
+}
+
+// xmlNode keeps a generic XML element, which is used for rich rules
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []xmlNode  `xml:",any"`
+}
+
+// ParseZone parses a zone XML file. The zone name is derived from the file
+// name by the caller.
+func ParseZone(name string, r io.Reader) (*Zone, error) {
+	var x xmlZone
+	if err := xml.NewDecoder(r).Decode(&x); err != nil {
+		return nil, err
+	}
+
+	zone := &Zone{
+		Name:               name,
+		Short:              strings.TrimSpace(x.Short),
+		Description:        strings.TrimSpace(x.Description),
+		Target:             x.Target,
+		Masquerade:         x.Masquerade != nil,
+		Forward:            x.Forward != nil,
+		IcmpBlockInversion: x.IcmpBlockInversion != nil,
+	}
+	if zone.Target == "" {
+		zone.Target = "default"
+	}
+
+	for _, i := range x.Interfaces {
+		zone.Interfaces = append(zone.Interfaces, i.Name)
+	}
+	for _, s := range x.Sources {
+		switch {
+		case s.Address != "":
+			zone.Sources = append(zone.Sources, s.Address)
+		case s.Mac != "":
+			zone.Sources = append(zone.Sources, s.Mac)
+		case s.Ipset != "":
+			zone.Sources = append(zone.Sources, "ipset:"+s.Ipset)
+		}
+	}
+	for _, s := range x.Services {
+		zone.Services = append(zone.Services, s.Name)
+	}
+	for _, p := range x.Ports {
+		zone.Ports = append(zone.Ports, Port{Port: p.Port, Protocol: p.Protocol})
+	}
+	for _, p := range x.SourcePorts {
+		zone.SourcePorts = append(zone.SourcePorts, Port{Port: p.Port, Protocol: p.Protocol})
+	}
+	for _, p := range x.Protocols {
+		zone.Protocols = append(zone.Protocols, p.Value)
+	}
+	for _, f := range x.ForwardPorts {
+		zone.ForwardPorts = append(zone.ForwardPorts, ForwardPort(f))
+	}
+	for _, b := range x.IcmpBlocks {
+		zone.IcmpBlocks = append(zone.IcmpBlocks, b.Name)
+	}
+	for _, r := range x.Rules {
+		zone.RichRules = append(zone.RichRules, renderRichRule(r))
+	}
+
+	// without runtime information, a zone is active if anything is bound to it
+	zone.Active = len(zone.Interfaces) > 0 || len(zone.Sources) > 0
+	return zone, nil
+}
+
+// renderRichRule converts a rule element into the rich rule language used
+// by firewall-cmd, e.g.
+// <rule family="ipv4"><source address="10.0.0.0/8"/><accept/></rule>
+// becomes: rule family="ipv4" source address="10.0.0.0/8" accept
+func renderRichRule(node xmlNode) string {
+	var sb strings.Builder
+	writeRichRuleNode(&sb, node)
+	return sb.String()
+}
+
+func writeRichRuleNode(sb *strings.Builder, node xmlNode) {
+	if sb.Len() > 0 {
+		sb.WriteByte(' ')
+	}
+	sb.WriteString(node.XMLName.Local)
+
+	for _, attr := range node.Attrs {
+		if attr.Name.Local == "invert" {
+			if strings.EqualFold(attr.Value, "true") || strings.EqualFold(attr.Value, "yes") {
+				sb.WriteString(" NOT")
+			}
+		}
+	}
+	for _, attr := range node.Attrs {
+		if attr.Name.Local == "invert" {
+			continue
+		}
+		sb.WriteString(" " + attr.Name.Local + "=\"" + attr.Value + "\"")
+	}
+	for _, child := range node.Children {
+		writeRichRuleNode(sb, child)
+	}
+}
+
+// RichRule is the parsed form of a rich rule
+type RichRule struct {
+	Family      string
+	Priority    string
+	Source      string
+	Destination string
+	Service     string
+	Port        string
+	Protocol    string
+	Log         bool
+	Action      string
+}
+
+var richRuleElements = map[string]bool{
+	"source": true, "destination": true, "service": true, "port": true,
+	"protocol": true, "icmp-block": true, "icmp-type": true, "masquerade": true,
+	"forward-port": true, "source-port": true, "log": true, "nflog": true,
+	"audit": true, "accept": true, "reject": true, "drop": true, "mark": true,
+	"limit": true,
+}
+
+// ParseRichRule parses the main properties of a rich rule, see
+// firewalld.richlanguage(5)
+func ParseRichRule(rule string) RichRule {
+	var res RichRule
+	element := "rule"
+	not := false
+	for _, token := range tokenizeRichRule(rule) {
+		key, value, hasValue := strings.Cut(token, "=")
+		if !hasValue {
+			switch {
+			case token == "NOT":
+				not = true
+			case richRuleElements[token]:
+				element = token
+				not = false
+				switch token {
+				case "log", "nflog", "audit":
+					res.Log = true
+				case "accept", "reject", "drop", "mark":
+					res.Action = token
+				}
+			}
+			continue
+		}
+
+		value = strings.Trim(value, "\"")
+		if not {
+			value = "NOT " + value
+		}
+		switch element {
+		case "rule":
+			switch key {
+			case "family":
+				res.Family = value
+			case "priority":
+				res.Priority = value
+			}
+		case "source":
+			res.Source = value
+		case "destination":
+			res.Destination = value
+		case "service":
+			res.Service = value
+		case "port":
+			switch key {
+			case "port":
+				res.Port = value
+			case "protocol":
+				res.Protocol = value
+			}
+		case "protocol":
+			res.Protocol = value
+		}
+	}
+	return res
+}
+
+// tokenizeRichRule splits a rich rule at spaces that are not quoted
+func tokenizeRichRule(rule string) []string {
+	var res []string
+	var cur strings.Builder
+	quoted := false
+	for _, r := range rule {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			cur.WriteRune(r)
+		case (r == ' ' || r == '\t') && !quoted:
+			if cur.Len() > 0 {
+				res = append(res, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		res = append(res, cur.String())
+	}
+	return res
+}
+
+// ParseListAllZones parses the output of `firewall-cmd --list-all-zones`
+// and returns the zones and the name of the default zone
+func ParseListAllZones(r io.Reader) ([]*Zone, string, error) {
+	var zones []*Zone
+	var cur *Zone
+	defaultZone := ""
+	listKey := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			listKey = ""
+			continue
+		}
+
+		// zone headers are not indented, e.g. `public (default, active)`
+		if line[0] != ' ' && line[0] != '\t' {
+			name, flags, _ := strings.Cut(strings.TrimSpace(line), " ")
+			cur = &Zone{Name: name}
+			zones = append(zones, cur)
+			listKey = ""
+			if strings.Contains(flags, "active") {
+				cur.Active = true
+			}
+			if strings.Contains(flags, "default") {
+				defaultZone = name
+			}
+			continue
+		}
+		if cur == nil {
+			return nil, "", errors.New("unexpected zone setting before the first zone: " + strings.TrimSpace(line))
+		}
+
+		// multi-line values like forward-ports and rich rules are listed
+		// below their key, indented with a tab
+		trimmed := strings.TrimSpace(line)
+		if line[0] == '\t' {
+			addZoneValue(cur, listKey, trimmed)
+			continue
+		}
+
+		key, value, _ := strings.Cut(trimmed, ":")
+		listKey = key
+		addZoneValue(cur, key, strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	return zones, defaultZone, nil
+}
+
+func addZoneValue(zone *Zone, key string, value string) {
+	if value == "" {
+		return
+	}
+
+	switch key {
+	case "target":
+		zone.Target = value
+	case "icmp-block-inversion":
+		zone.IcmpBlockInversion = value == "yes"
+	case "interfaces":
+		zone.Interfaces = append(zone.Interfaces, strings.Fields(value)...)
+	case "sources":
+		zone.Sources = append(zone.Sources, strings.Fields(value)...)
+	case "services":
+		zone.Services = append(zone.Services, strings.Fields(value)...)
+	case "ports":
+		zone.Ports = append(zone.Ports, parsePorts(value)...)
+	case "source-ports":
+		zone.SourcePorts = append(zone.SourcePorts, parsePorts(value)...)
+	case "protocols":
+		zone.Protocols = append(zone.Protocols, strings.Fields(value)...)
+	case "forward":
+		zone.Forward = value == "yes"
+	case "masquerade":
+		zone.Masquerade = value == "yes"
+	case "icmp-blocks":
+		zone.IcmpBlocks = append(zone.IcmpBlocks, strings.Fields(value)...)
+	case "summary":
+		zone.Short = value
+	case "description":
+		zone.Description = value
+	case "forward-ports":
+		for _, fwd := range strings.Fields(value) {
+			zone.ForwardPorts = append(zone.ForwardPorts, parseForwardPort(fwd))
+		}
+	case "rich rules":
+		zone.RichRules = append(zone.RichRules, value)
+	}
+}
+
+// parsePorts parses a list of ports like `8080/tcp 1000-2000/udp`
+func parsePorts(value string) []Port {
+	var res []Port
+	for _, p := range strings.Fields(value) {
+		port, proto, _ := strings.Cut(p, "/")
+		res = append(res, Port{Port: port, Protocol: proto})
+	}
+	return res
+}
+
+// parseForwardPort parses a forward port like
+// `port=22:proto=tcp:toport=2222:toaddr=`
+func parseForwardPort(value string) ForwardPort {
+	var res ForwardPort
+	for _, kv := range strings.Split(value, ":") {
+		k, v, _ := strings.Cut(kv, "=")
+		switch k {
+		case "port":
+			res.Port = v
+		case "proto":
+			res.Protocol = v
+		case "toport":
+			res.ToPort = v
+		case "toaddr":
+			res.ToAddr = v
+		}
+	}
+	return res
+}
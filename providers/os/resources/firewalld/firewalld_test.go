@@ -0,0 +1,83 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package firewalld
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZone(t *testing.T) {
+	zone, err := ParseZone("dmz", strings.NewReader(`<?xml version="1.0" encoding="utf-8"?>
+<zone target="DROP">
+  <short>DMZ</short>
+  <source mac="00:11:22:33:44:55"/>
+  <source ipset="blocklist"/>
+  <port protocol="tcp" port="443"/>
+  <source-port protocol="udp" port="53"/>
+  <icmp-block-inversion/>
+  <rule>
+    <service name="ssh"/>
+    <log prefix="ssh" level="notice">
+      <limit value="3/m"/>
+    </log>
+    <accept/>
+  </rule>
+</zone>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "dmz", zone.Name)
+	assert.Equal(t, "DROP", zone.Target)
+	assert.Equal(t, []string{"00:11:22:33:44:55", "ipset:blocklist"}, zone.Sources)
+	assert.Equal(t, []Port{{Port: "443", Protocol: "tcp"}}, zone.Ports)
+	assert.Equal(t, []Port{{Port: "53", Protocol: "udp"}}, zone.SourcePorts)
+	assert.True(t, zone.IcmpBlockInversion)
+	assert.True(t, zone.Active)
+	assert.Equal(t, []string{`rule service name="ssh" log prefix="ssh" level="notice" limit value="3/m" accept`}, zone.RichRules)
+}
+
+func TestParseRichRule(t *testing.T) {
+	rule := ParseRichRule(`rule family="ipv4" source address="10.0.0.0/8" destination address="10.1.0.1" protocol value="icmp" audit drop`)
+	assert.Equal(t, RichRule{
+		Family:      "ipv4",
+		Source:      "10.0.0.0/8",
+		Destination: "10.1.0.1",
+		Protocol:    "icmp",
+		Log:         true,
+		Action:      "drop",
+	}, rule)
+}
+
+func TestParseListAllZones(t *testing.T) {
+	zones, defaultZone, err := ParseListAllZones(strings.NewReader(`public (active)
+  target: default
+  interfaces: eth0
+  services: ssh
+  rich rules: 
+
+work
+  target: default
+  interfaces: 
+  services: 
+  rich rules: 
+	rule family="ipv4" source address="10.0.0.0/8" accept
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "", defaultZone)
+	require.Len(t, zones, 2)
+	assert.True(t, zones[0].Active)
+	assert.Equal(t, []string{"eth0"}, zones[0].Interfaces)
+	assert.False(t, zones[1].Active)
+	assert.Equal(t, []string{`rule family="ipv4" source address="10.0.0.0/8" accept`}, zones[1].RichRules)
+}
+
+func TestParseListAllZonesInvalid(t *testing.T) {
+	_, _, err := ParseListAllZones(strings.NewReader(`  target: default
+  interfaces: eth0
+`))
+	assert.Error(t, err)
+}
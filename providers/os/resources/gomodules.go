@@ -0,0 +1,82 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/golang/buildinfo"
+)
+
+// minGoBinarySize skips executables that are too small to be go binaries.
+// Go links the runtime statically, so even stripped binaries are larger,
+// while most system tools in /usr/bin are dynamically linked and smaller.
+const minGoBinarySize = 1 << 20
+
+var goModuleScanner = &languagePackageScanner{
+	resource: "go.module",
+	defaultPaths: []languageSearchPath{
+		{pattern: "/usr/bin", maxDepth: 0},
+		{pattern: "/usr/sbin", maxDepth: 0},
+		{pattern: "/usr/local/bin", maxDepth: 0},
+		{pattern: "/usr/local/sbin", maxDepth: 0},
+		{pattern: "/usr/libexec", maxDepth: 2},
+		{pattern: "/usr/local/go/bin", maxDepth: 0},
+		{pattern: "/opt", maxDepth: 4},
+		{pattern: "/app", maxDepth: 4},
+		{pattern: "/home/*/go/bin", maxDepth: 0},
+		{pattern: "/root/go/bin", maxDepth: 0},
+	},
+	// go binaries are executables, the extractor ignores all other formats
+	extractor: func(p string, fi os.FileInfo) languages.Extractor {
+		if fi.Mode().Perm()&0o111 != 0 && fi.Size() >= minGoBinarySize {
+			return &buildinfo.Extractor{}
+		}
+		return nil
+	},
+}
+
+func initGoModules(_ *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initLanguagePackages("go.modules", args)
+}
+
+type mqlGoModulesInternal struct {
+	lock sync.Mutex
+}
+
+func (r *mqlGoModules) id() (string, error) {
+	return languagePackagesId("go.modules", r.Path.Data), nil
+}
+
+func (r *mqlGoModules) gatherData() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.List.IsSet() {
+		return nil
+	}
+
+	pkgs, files, err := goModuleScanner.scan(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]any]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlGoModules) list() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlGoModules) files() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlGoModule) id() (string, error) {
+	return r.Id.Data, nil
+}
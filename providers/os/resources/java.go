@@ -0,0 +1,73 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/java/jar"
+)
+
+var javaPackageScanner = &languagePackageScanner{
+	resource: "java.package",
+	defaultPaths: []languageSearchPath{
+		{pattern: "/usr/share/java", maxDepth: 2},
+		{pattern: "/usr/local/share/java", maxDepth: 2},
+		{pattern: "/usr/local/tomcat/webapps", maxDepth: 2},
+		{pattern: "/var/lib/jenkins", maxDepth: 3},
+		{pattern: "/opt", maxDepth: defaultLanguageSearchDepth},
+		{pattern: "/app", maxDepth: defaultLanguageSearchDepth},
+		{pattern: "/srv", maxDepth: defaultLanguageSearchDepth},
+	},
+	extractor: func(p string, fi os.FileInfo) languages.Extractor {
+		if jar.IsArchive(p) {
+			return &jar.Extractor{}
+		}
+		return nil
+	},
+}
+
+func initJavaPackages(_ *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initLanguagePackages("java.packages", args)
+}
+
+type mqlJavaPackagesInternal struct {
+	lock sync.Mutex
+}
+
+func (r *mqlJavaPackages) id() (string, error) {
+	return languagePackagesId("java.packages", r.Path.Data), nil
+}
+
+func (r *mqlJavaPackages) gatherData() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.List.IsSet() {
+		return nil
+	}
+
+	pkgs, files, err := javaPackageScanner.scan(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]any]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlJavaPackages) list() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlJavaPackages) files() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlJavaPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
@@ -7,11 +7,41 @@ import (
 	"archive/tar"
 	"bufio"
 	"io"
+	"os"
+	"path"
+	"sort"
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
 )
 
+// SysctlConfigPath is the legacy sysctl configuration file, which is applied
+// after all sysctl.d files
+const SysctlConfigPath = "/etc/sysctl.conf"
+
+// SysctlProcPath is where the running kernel exposes its parameters
+const SysctlProcPath = "/proc/sys"
+
+// SysctlConfigDirs are the directories with sysctl configuration files, in
+// order of precedence. A file overrides files with the same name in all
+// directories listed after it (see sysctl.d(5)).
+var SysctlConfigDirs = []string{
+	"/etc/sysctl.d",
+	"/run/sysctl.d",
+	"/usr/local/lib/sysctl.d",
+	"/usr/lib/sysctl.d",
+	"/lib/sysctl.d",
+}
+
+// SysctlSetting is a kernel parameter that is persisted in a configuration file
+type SysctlSetting struct {
+	Name  string
+	Value string
+	File  string
+	Line  int
+}
+
 func ParseSysctl(r io.Reader, sep string) (map[string]string, error) {
 	kernelParameters := map[string]string{}
 
@@ -56,3 +86,205 @@ func ParseLinuxSysctlProc(sysctlRootPath string, reader io.Reader) (map[string]s
 
 	return kernelParameters, nil
 }
+
+// SysctlConfigFiles returns all sysctl configuration files in the order they
+// are applied: sysctl.d files sorted by their name, where files in
+// directories with a higher precedence replace files with the same name,
+// followed by /etc/sysctl.conf
+func SysctlConfigFiles(fs afero.Fs) ([]string, error) {
+	afs := &afero.Afero{Fs: fs}
+
+	files := map[string]string{}
+	for _, dir := range SysctlConfigDirs {
+		entries, err := afs.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasSuffix(name, ".conf") {
+				continue
+			}
+			if _, ok := files[name]; !ok {
+				files[name] = path.Join(dir, name)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		res = append(res, files[name])
+	}
+
+	if ok, err := afs.Exists(SysctlConfigPath); err != nil {
+		return nil, err
+	} else if ok {
+		res = append(res, SysctlConfigPath)
+	}
+	return res, nil
+}
+
+// ParseSysctlConfig parses a sysctl configuration file. Settings are returned
+// in the order they appear in the file.
+func ParseSysctlConfig(r io.Reader, file string) ([]SysctlSetting, error) {
+	var res []SysctlSetting
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			log.Debug().Str("file", file).Str("line", line).Msg("cannot parse sysctl config line")
+			continue
+		}
+
+		// a leading dash means errors for this key are ignored when it is applied
+		key = strings.TrimPrefix(strings.TrimSpace(key), "-")
+		res = append(res, SysctlSetting{
+			Name:  NormalizeSysctlKey(key),
+			Value: strings.TrimSpace(value),
+			File:  file,
+			Line:  lineNo,
+		})
+	}
+
+	return res, scanner.Err()
+}
+
+// NormalizeSysctlKey converts a key into its dotted form. Keys may use
+// slashes as separators instead, in which case dots are part of a name, e.g.
+// net/ipv4/conf/eth0.100/forwarding is net.ipv4.conf.eth0/100.forwarding
+func NormalizeSysctlKey(key string) string {
+	idx := strings.IndexAny(key, "./")
+	if idx < 0 || key[idx] == '.' {
+		return key
+	}
+	return swapSysctlSeparators(key)
+}
+
+// swapSysctlSeparators converts between the dotted form of a key and its path
+// below /proc/sys
+func swapSysctlSeparators(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/':
+			return '.'
+		case '.':
+			return '/'
+		}
+		return r
+	}, key)
+}
+
+func isSysctlGlob(key string) bool {
+	return strings.ContainsAny(key, "*?[")
+}
+
+// ExpandSysctlGlob returns the keys of all kernel parameters in /proc/sys
+// that match a glob key, e.g. net.ipv4.conf.*.rp_filter
+func ExpandSysctlGlob(fs afero.Fs, key string) ([]string, error) {
+	matches, err := afero.Glob(fs, path.Join(SysctlProcPath, swapSysctlSeparators(key)))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(matches))
+	for _, match := range matches {
+		stat, err := fs.Stat(match)
+		if err != nil || stat.IsDir() {
+			continue
+		}
+		res = append(res, swapSysctlSeparators(strings.TrimPrefix(match, SysctlProcPath+"/")))
+	}
+	return res, nil
+}
+
+// PersistedSysctl reads all sysctl configuration files and returns the
+// effective persisted settings, i.e. the last assignment for every key, and
+// the files that were read, in the order they are applied.
+//
+// Glob keys are expanded against the parameters in /proc/sys, and explicit
+// assignments take precedence over them regardless of their order, like
+// systemd-sysctl applies them. Globs are skipped if /proc/sys is not
+// available, e.g. on filesystem or image scans, since the parameters that
+// would match them are not known.
+func PersistedSysctl(fs afero.Fs) (map[string]SysctlSetting, []string, error) {
+	files, err := SysctlConfigFiles(fs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := map[string]SysctlSetting{}
+	var globs []SysctlSetting
+	for _, file := range files {
+		f, err := fs.Open(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		settings, err := ParseSysctlConfig(f, file)
+		f.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, setting := range settings {
+			if isSysctlGlob(setting.Name) {
+				globs = append(globs, setting)
+				continue
+			}
+			res[setting.Name] = setting
+		}
+	}
+
+	expanded := map[string]SysctlSetting{}
+	for _, glob := range globs {
+		keys, err := ExpandSysctlGlob(fs, glob.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, key := range keys {
+			if _, ok := res[key]; ok {
+				continue
+			}
+			setting := glob
+			setting.Name = key
+			expanded[key] = setting
+		}
+	}
+	for key, setting := range expanded {
+		res[key] = setting
+	}
+
+	return res, files, nil
+}
+
+// SysctlValuesEqual compares two kernel parameter values. Values with
+// multiple fields are equal if their fields match, regardless of the
+// whitespace that separates them, e.g. "4096 87380" and "4096\t87380".
+func SysctlValuesEqual(a, b string) bool {
+	fa := strings.Fields(a)
+	fb := strings.Fields(b)
+	if len(fa) != len(fb) {
+		return false
+	}
+	for i := range fa {
+		if fa[i] != fb[i] {
+			return false
+		}
+	}
+	return true
+}
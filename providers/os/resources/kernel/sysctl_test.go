@@ -4,8 +4,10 @@
 package kernel
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
@@ -81,3 +83,69 @@ func TestSysctlOpenBSD(t *testing.T) {
 	assert.Equal(t, 29, len(entries))
 	assert.Equal(t, "OpenBSD", entries["kern.ostype"])
 }
+
+func TestParseSysctlConfig(t *testing.T) {
+	content := `# comment
+; another comment
+net.ipv4.ip_forward = 1
+-net.ipv6.conf.all.forwarding=0
+net/ipv4/conf/eth0.100/rp_filter = 2
+invalid line
+`
+	settings, err := ParseSysctlConfig(strings.NewReader(content), "/etc/sysctl.d/99-test.conf")
+	require.NoError(t, err)
+	assert.Equal(t, []SysctlSetting{
+		{Name: "net.ipv4.ip_forward", Value: "1", File: "/etc/sysctl.d/99-test.conf", Line: 3},
+		{Name: "net.ipv6.conf.all.forwarding", Value: "0", File: "/etc/sysctl.d/99-test.conf", Line: 4},
+		{Name: "net.ipv4.conf.eth0/100.rp_filter", Value: "2", File: "/etc/sysctl.d/99-test.conf", Line: 5},
+	}, settings)
+}
+
+func TestPersistedSysctl(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/usr/lib/sysctl.d/10-default.conf", []byte("kernel.sysrq = 16\nvm.swappiness = 30\n"), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/sysctl.d/10-default.conf", []byte("kernel.sysrq = 0\n"), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "/run/sysctl.d/20-run.conf", []byte("vm.swappiness = 20\n"), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/sysctl.d/README", []byte("vm.swappiness = 1\n"), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "/etc/sysctl.conf", []byte("vm.swappiness = 10\n"), 0o644))
+
+	settings, files, err := PersistedSysctl(fs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/etc/sysctl.d/10-default.conf",
+		"/run/sysctl.d/20-run.conf",
+		"/etc/sysctl.conf",
+	}, files)
+	assert.Equal(t, "0", settings["kernel.sysrq"].Value)
+	assert.Equal(t, "10", settings["vm.swappiness"].Value)
+	assert.Equal(t, "/etc/sysctl.conf", settings["vm.swappiness"].File)
+}
+
+func TestPersistedSysctlGlob(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, iface := range []string{"all", "default", "eth0", "eth0.100"} {
+		require.NoError(t, afero.WriteFile(fs, "/proc/sys/net/ipv4/conf/"+iface+"/rp_filter", []byte("0\n"), 0o644))
+	}
+	require.NoError(t, afero.WriteFile(fs, "/etc/sysctl.d/10-network.conf", []byte("net.ipv4.conf.eth0.rp_filter = 2\nnet.ipv4.conf.*.rp_filter = 1\nkernel.*.missing = 1\n"), 0o644))
+
+	settings, _, err := PersistedSysctl(fs)
+	require.NoError(t, err)
+	values := map[string]string{}
+	for name, setting := range settings {
+		values[name] = setting.Value
+	}
+	assert.Equal(t, map[string]string{
+		"net.ipv4.conf.all.rp_filter":      "1",
+		"net.ipv4.conf.default.rp_filter":  "1",
+		"net.ipv4.conf.eth0/100.rp_filter": "1",
+		// explicit assignments take precedence over globs
+		"net.ipv4.conf.eth0.rp_filter": "2",
+	}, values)
+	assert.Equal(t, 2, settings["net.ipv4.conf.all.rp_filter"].Line)
+}
+
+func TestSysctlValuesEqual(t *testing.T) {
+	assert.True(t, SysctlValuesEqual("4096\t87380\t6291456", "4096 87380  6291456"))
+	assert.False(t, SysctlValuesEqual("1", "0"))
+	assert.False(t, SysctlValuesEqual("1 2", "1"))
+}
@@ -0,0 +1,245 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/types"
+)
+
+// languageSearchPath is a location that is scanned for language packages
+// when no path is provided. The pattern may contain globs.
+type languageSearchPath struct {
+	pattern string
+	// maxDepth limits how many directories deep the files may be
+	maxDepth int
+}
+
+// languagePackageScanner finds the files of a language ecosystem and parses
+// them with the matching extractor
+type languagePackageScanner struct {
+	// resource is the name of the package resource, e.g. java.package
+	resource string
+	// defaultPaths are scanned if no path is provided
+	defaultPaths []languageSearchPath
+	// extractor returns the extractor for a file or nil if the file is not
+	// relevant for this ecosystem
+	extractor func(p string, fi os.FileInfo) languages.Extractor
+}
+
+// skipLanguageDirs are never scanned for language packages
+var skipLanguageDirs = map[string]struct{}{
+	".git":         {},
+	"node_modules": {},
+}
+
+const defaultLanguageSearchDepth = 6
+
+// defaultProjectSearchPaths are the locations where applications are
+// usually deployed together with their lock files
+var defaultProjectSearchPaths = []languageSearchPath{
+	{pattern: "/app", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/srv", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/opt", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/var/www", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/usr/src", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/home/*", maxDepth: defaultLanguageSearchDepth},
+	{pattern: "/root", maxDepth: defaultLanguageSearchDepth},
+}
+
+func initLanguagePackages(resource string, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		if _, ok := x.Value.(string); !ok {
+			return nil, nil, errors.New("wrong type for 'path' in " + resource + " initialization, it must be a string")
+		}
+	} else {
+		// empty path means search through default locations
+		args["path"] = llx.StringData("")
+	}
+	return args, nil, nil
+}
+
+func languagePackagesId(resource string, path string) string {
+	if path == "" {
+		return resource
+	}
+	return resource + "/" + path
+}
+
+// scan returns the package resources and the files they were found in. A
+// package that is found in multiple files is only returned once.
+func (s *languagePackageScanner) scan(runtime *plugin.Runtime, p string) ([]any, []any, error) {
+	conn := runtime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	searchPaths := s.defaultPaths
+	if p != "" {
+		searchPaths = []languageSearchPath{{pattern: p, maxDepth: defaultLanguageSearchDepth}}
+	}
+
+	var filePaths []string
+	var packages []*languages.Package
+	byPurl := map[string]*languages.Package{}
+	for _, searchPath := range searchPaths {
+		roots, err := afero.Glob(afs, searchPath.pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, root := range roots {
+			err := s.walk(afs, root, searchPath.maxDepth, func(file string, extractor languages.Extractor) {
+				bom, err := s.parse(afs, file, extractor)
+				if err != nil {
+					log.Debug().Err(err).Str("file", file).Str("extractor", extractor.Name()).Msg("could not parse file")
+					return
+				}
+				filePaths = append(filePaths, file)
+				for _, pkg := range bom.Transitive() {
+					if existing, ok := byPurl[pkg.Purl]; ok {
+						existing.EvidenceList = append(existing.EvidenceList, pkg.EvidenceList...)
+						continue
+					}
+					byPurl[pkg.Purl] = pkg
+					packages = append(packages, pkg)
+				}
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	slices.SortFunc(packages, languages.SortFn)
+	sort.Strings(filePaths)
+
+	pkgs := make([]any, 0, len(packages))
+	for _, pkg := range packages {
+		o, err := newLanguagePackage(runtime, s.resource, pkg)
+		if err != nil {
+			return nil, nil, err
+		}
+		pkgs = append(pkgs, o)
+	}
+
+	files, err := newPkgFileInfos(runtime, filePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pkgs, files, nil
+}
+
+// walk calls fn for all relevant files below root. Directories that cannot
+// be read are skipped.
+func (s *languagePackageScanner) walk(afs *afero.Afero, root string, maxDepth int, fn func(string, languages.Extractor)) error {
+	baseDepth := strings.Count(path.Clean(root), "/")
+	err := afs.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if fi != nil && fi.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if fi.IsDir() {
+			if _, ok := skipLanguageDirs[fi.Name()]; ok && p != root {
+				return fs.SkipDir
+			}
+			if p != root && strings.Count(path.Clean(p), "/")-baseDepth > maxDepth {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		if extractor := s.extractor(p, fi); extractor != nil {
+			fn(p, extractor)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *languagePackageScanner) parse(afs *afero.Afero, p string, extractor languages.Extractor) (languages.Bom, error) {
+	f, err := afs.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return extractor.Parse(f, p)
+}
+
+// newLanguagePackage creates a package resource like java.package from a
+// package of a language extractor
+func newLanguagePackage(runtime *plugin.Runtime, resource string, pkg *languages.Package) (plugin.Resource, error) {
+	cpes := []any{}
+	for i := range pkg.Cpes {
+		cpe, err := runtime.CreateSharedResource("cpe", map[string]*llx.RawData{
+			"uri": llx.StringData(pkg.Cpes[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cpes = append(cpes, cpe)
+	}
+
+	filePaths := make([]string, 0, len(pkg.EvidenceList))
+	for _, evidence := range pkg.EvidenceList {
+		filePaths = append(filePaths, evidence.Value)
+	}
+	sort.Strings(filePaths)
+	files, err := newPkgFileInfos(runtime, slices.Compact(filePaths))
+	if err != nil {
+		return nil, err
+	}
+
+	args := map[string]*llx.RawData{
+		"__id":    llx.StringData(resource + "/" + pkg.Purl),
+		"name":    llx.StringData(pkg.Name),
+		"version": llx.StringData(pkg.Version),
+		"purl":    llx.StringData(pkg.Purl),
+		"cpes":    llx.ArrayData(cpes, types.Resource("cpe")),
+		"files":   llx.ArrayData(files, types.Resource("pkgFileInfo")),
+	}
+	args["id"] = args["__id"]
+
+	switch resource {
+	case "java.package":
+		args["groupId"] = llx.StringData(pkg.Vendor)
+	case "ruby.package":
+		args["platform"] = llx.StringData(pkg.Architecture)
+	case "php.package":
+		args["description"] = llx.StringData(pkg.Description)
+	}
+
+	return CreateResource(runtime, resource, args)
+}
+
+func newPkgFileInfos(runtime *plugin.Runtime, filePaths []string) ([]any, error) {
+	res := make([]any, 0, len(filePaths))
+	for _, p := range filePaths {
+		lf, err := CreateResource(runtime, "pkgFileInfo", map[string]*llx.RawData{
+			"path": llx.StringData(p),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, lf)
+	}
+	return res, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package buildinfo
+
+import (
+	"debug/buildinfo"
+	"errors"
+	"io"
+	"runtime/debug"
+	"strings"
+
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/golang"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*goBinary)(nil)
+)
+
+// StdlibModule is the module name that is used for the go standard library,
+// which is compiled into every binary
+const StdlibModule = "stdlib"
+
+// maxBuildInfoRead is the number of bytes that may be read from a file to
+// find its build info. The build info is in its own section or at the start
+// of the data segment, so only headers and a small part of the binary are
+// needed. Reading more means the file is not a go binary.
+const maxBuildInfoRead = 1 << 20
+
+var errReadLimit = errors.New("go build info not found within read limit")
+
+// Extractor reads the modules that a go binary was built with. The go
+// toolchain embeds them into ELF, Mach-O and PE binaries since go 1.13.
+// see https://pkg.go.dev/debug/buildinfo
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "gobuildinfo"
+}
+
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	// binaries are large, so we never read them completely. The build info
+	// is found by reading the headers and jumping to its section.
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		return nil, errors.New("cannot read go build info without random access to " + filename)
+	}
+
+	info, err := buildinfo.Read(&limitedReaderAt{r: ra, remaining: maxBuildInfoRead})
+	if err != nil {
+		return nil, err
+	}
+	return NewBom(info, filename), nil
+}
+
+// limitedReaderAt fails reads once more than the remaining bytes are requested
+type limitedReaderAt struct {
+	r         io.ReaderAt
+	remaining int64
+}
+
+func (l *limitedReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if int64(len(p)) > l.remaining {
+		return 0, errReadLimit
+	}
+	n, err := l.r.ReadAt(p, off)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// NewBom converts the build info of a go binary into a bom
+func NewBom(info *debug.BuildInfo, filename string) languages.Bom {
+	return &goBinary{info: info, evidence: filename}
+}
+
+type goBinary struct {
+	info     *debug.BuildInfo
+	evidence string
+}
+
+// Root returns the main module of the binary. Binaries that were built
+// outside of a module, e.g. with `go build main.go`, do not have one.
+func (b *goBinary) Root() *languages.Package {
+	if b.info.Main.Path == "" {
+		return nil
+	}
+	return b.newPackage(&b.info.Main)
+}
+
+// Direct returns the modules that were compiled into the binary. The build
+// info does not record which of them are direct dependencies.
+func (b *goBinary) Direct() languages.Packages {
+	res := languages.Packages{}
+	for _, dep := range b.info.Deps {
+		res = append(res, b.newPackage(dep))
+	}
+	return res
+}
+
+// Transitive returns the main module, all dependencies and the standard library
+func (b *goBinary) Transitive() languages.Packages {
+	res := languages.Packages{}
+	if root := b.Root(); root != nil {
+		res = append(res, root)
+	}
+	res = append(res, b.Direct()...)
+	// experiments are appended to the version, e.g. go1.21.0 X:boringcrypto
+	goVersion, _, _ := strings.Cut(b.info.GoVersion, " ")
+	if goVersion != "" {
+		res = append(res, &languages.Package{
+			Name:         StdlibModule,
+			Version:      goVersion,
+			Purl:         golang.NewPackageUrl(StdlibModule, goVersion),
+			Cpes:         languages.NewCpes("golang", "go", strings.TrimPrefix(goVersion, "go")),
+			EvidenceList: languages.NewEvidenceList(b.evidence),
+		})
+	}
+	return res
+}
+
+func (b *goBinary) newPackage(mod *debug.Module) *languages.Package {
+	// replaced modules are compiled from the replacement
+	if mod.Replace != nil {
+		mod = mod.Replace
+	}
+	version := mod.Version
+	// the main module is not versioned when it is built from a checkout
+	if version == "(devel)" {
+		version = ""
+	}
+	return &languages.Package{
+		Name:         mod.Path,
+		Version:      version,
+		Purl:         golang.NewPackageUrl(mod.Path, version),
+		Cpes:         golang.NewCpes(mod.Path, version),
+		EvidenceList: languages.NewEvidenceList(b.evidence),
+	}
+}
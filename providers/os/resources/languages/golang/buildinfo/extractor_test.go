@@ -0,0 +1,107 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package buildinfo
+
+import (
+	"debug/buildinfo"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestBuildInfoBom(t *testing.T) {
+	data, err := os.ReadFile("./testdata/buildinfo.txt")
+	require.NoError(t, err)
+	info, err := debug.ParseBuildInfo(string(data))
+	require.NoError(t, err)
+	// the text format does not include the go version
+	info.GoVersion = "go1.21.5 X:boringcrypto"
+
+	bom := NewBom(info, "/usr/local/bin/app")
+
+	assert.Equal(t, &languages.Package{
+		Name:    "github.com/example/app",
+		Version: "v1.4.2",
+		Purl:    "pkg:golang/github.com/example/app@v1.4.2",
+		Cpes:    []string{"cpe:2.3:a:example:app:1.4.2:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: "/usr/local/bin/app",
+		}},
+	}, bom.Root())
+
+	assert.Len(t, bom.Direct(), 3)
+
+	list := bom.Transitive()
+	assert.Len(t, list, 5)
+
+	chi := list.Find("github.com/go-chi/chi/v5")
+	require.NotNil(t, chi)
+	assert.Equal(t, "v5.0.10", chi.Version)
+	assert.Equal(t, "pkg:golang/github.com/go-chi/chi/v5@v5.0.10", chi.Purl)
+	assert.Equal(t, []string{"cpe:2.3:a:go-chi:chi:5.0.10:*:*:*:*:*:*:*"}, chi.Cpes)
+
+	// replaced modules report the replacement
+	assert.Nil(t, list.Find("github.com/old/yaml"))
+	yaml := list.Find("github.com/fork/yaml")
+	require.NotNil(t, yaml)
+	assert.Equal(t, "v1.0.1", yaml.Version)
+
+	stdlib := list.Find(StdlibModule)
+	require.NotNil(t, stdlib)
+	assert.Equal(t, "go1.21.5", stdlib.Version)
+	assert.Equal(t, "pkg:golang/stdlib@go1.21.5", stdlib.Purl)
+	assert.Contains(t, stdlib.Cpes, "cpe:2.3:a:golang:go:1.21.5:*:*:*:*:*:*:*")
+}
+
+func TestBuildInfoExtractor(t *testing.T) {
+	// the test binary is a go binary itself
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	f, err := os.Open(exe)
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, exe)
+	require.NoError(t, err)
+
+	list := bom.Transitive()
+	assert.NotNil(t, list.Find("github.com/stretchr/testify"))
+	stdlib := list.Find(StdlibModule)
+	require.NotNil(t, stdlib)
+	assert.Equal(t, runtime.Version(), stdlib.Version)
+}
+
+func TestBuildInfoExtractorNoGoBinary(t *testing.T) {
+	f, err := os.Open("./testdata/buildinfo.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = (&Extractor{}).Parse(f, "buildinfo.txt")
+	assert.Error(t, err)
+}
+
+func TestBuildInfoExtractorReadLimit(t *testing.T) {
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	f, err := os.Open(exe)
+	require.NoError(t, err)
+	defer f.Close()
+
+	ra := &limitedReaderAt{r: f, remaining: 16}
+	_, err = buildinfo.Read(ra)
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), ra.remaining)
+
+	// files without random access are not read at all
+	_, err = (&Extractor{}).Parse(io.LimitReader(f, 1<<20), exe)
+	assert.Error(t, err)
+}
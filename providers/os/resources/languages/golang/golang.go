@@ -0,0 +1,49 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package golang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+)
+
+// NewPackageUrl creates a golang package url for the given module path and version
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#golang
+func NewPackageUrl(path string, version string) string {
+	namespace := ""
+	name := path
+	if idx := strings.LastIndex(path, "/"); idx >= 0 {
+		namespace = path[:idx]
+		name = path[idx+1:]
+	}
+
+	return packageurl.NewPackageURL(
+		packageurl.TypeGolang,
+		namespace,
+		name,
+		version,
+		nil,
+		"").String()
+}
+
+var reMajorVersion = regexp.MustCompile(`^v\d+$`)
+
+// NewCpes creates the CPEs for a go module, e.g. github.com/gin-gonic/gin is
+// published by gin-gonic
+func NewCpes(path string, version string) []string {
+	parts := strings.Split(path, "/")
+	// drop the major version suffix, e.g. github.com/go-chi/chi/v5
+	if n := len(parts); n > 1 && reMajorVersion.MatchString(parts[n-1]) {
+		parts = parts[:n-1]
+	}
+	name := parts[len(parts)-1]
+	vendor := name
+	if len(parts) > 2 {
+		vendor = parts[1]
+	}
+	return languages.NewCpes(vendor, name, strings.TrimPrefix(version, "v"))
+}
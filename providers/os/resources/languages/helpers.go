@@ -0,0 +1,41 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package languages
+
+import (
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/providers/os/resources/cpe"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+// NewCpes creates the CPEs for a package. Packages without CPEs are still
+// valid, so errors are only logged.
+func NewCpes(vendor string, name string, version string) []string {
+	cpes := []string{}
+	if vendor == "" {
+		vendor = name
+	}
+	cpeEntries, err := cpe.NewPackage2Cpe(vendor, name, version, "", "")
+	if err != nil {
+		log.Debug().Str("name", name).Str("version", version).Err(err).Msg("failed to create cpe")
+	} else if len(cpeEntries) > 0 {
+		cpes = append(cpes, cpeEntries...)
+	}
+	return cpes
+}
+
+// NewEvidenceList returns file evidence for each of the given paths
+func NewEvidenceList(paths ...string) []*sbom.Evidence {
+	evidenceList := make([]*sbom.Evidence, 0, len(paths))
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		evidenceList = append(evidenceList, &sbom.Evidence{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: p,
+		})
+	}
+	return evidenceList
+}
@@ -0,0 +1,275 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jar
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/java"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*jarArchive)(nil)
+)
+
+const (
+	// maxNesting limits how deep archives inside of archives are read, e.g.
+	// a WAR that contains JARs in WEB-INF/lib
+	maxNesting = 2
+	// maxNestedSize limits the size of nested archives that are read into memory
+	maxNestedSize = 256 * 1024 * 1024
+)
+
+// archiveExtensions are the file extensions of java archives
+var archiveExtensions = []string{".jar", ".war", ".ear", ".jpi", ".hpi"}
+
+// IsArchive returns true if the file name has the extension of a java archive
+func IsArchive(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	for _, e := range archiveExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Extractor reads the maven metadata (pom.properties) and the manifest of
+// JAR, WAR and EAR archives, including the archives that are embedded in them.
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "jar"
+}
+
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &jarArchive{evidence: filename}
+	root, err := archive.read(data, path.Base(filename), 0)
+	if err != nil {
+		return nil, err
+	}
+	archive.root = root
+	return archive, nil
+}
+
+type jarArchive struct {
+	root     *languages.Package
+	packages languages.Packages
+	evidence string
+}
+
+func (a *jarArchive) Root() *languages.Package {
+	return a.root
+}
+
+// Direct returns the packages that are embedded in the archive, e.g. shaded
+// dependencies or the libraries of a web application
+func (a *jarArchive) Direct() languages.Packages {
+	res := languages.Packages{}
+	for _, pkg := range a.packages {
+		if pkg != a.root {
+			res = append(res, pkg)
+		}
+	}
+	return res
+}
+
+func (a *jarArchive) Transitive() languages.Packages {
+	return a.packages
+}
+
+// read collects all packages of an archive and returns the package that
+// represents the archive itself
+func (a *jarArchive) read(data []byte, name string, depth int) (*languages.Package, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest map[string]string
+	var poms []*languages.Package
+	for _, f := range zr.File {
+		switch {
+		case f.Name == "META-INF/MANIFEST.MF":
+			manifest, err = readZipFile(f, parseManifest)
+			if err != nil {
+				log.Debug().Err(err).Str("archive", name).Msg("could not read jar manifest")
+			}
+
+		case strings.HasPrefix(f.Name, "META-INF/maven/") && strings.HasSuffix(f.Name, "/pom.properties"):
+			props, err := readZipFile(f, parseProperties)
+			if err != nil {
+				log.Debug().Err(err).Str("archive", name).Str("file", f.Name).Msg("could not read pom.properties")
+				continue
+			}
+			if pkg := a.newPackage(props["groupId"], props["artifactId"], props["version"]); pkg != nil {
+				poms = append(poms, pkg)
+			}
+
+		case IsArchive(f.Name) && depth < maxNesting && f.UncompressedSize64 < maxNestedSize:
+			nested, err := readZipFile(f, io.ReadAll)
+			if err != nil {
+				log.Debug().Err(err).Str("archive", name).Str("file", f.Name).Msg("could not read nested archive")
+				continue
+			}
+			if _, err := a.read(nested, path.Base(f.Name), depth+1); err != nil {
+				log.Debug().Err(err).Str("archive", name).Str("file", f.Name).Msg("could not parse nested archive")
+			}
+		}
+	}
+
+	root := findRootPom(poms, name)
+	if root == nil {
+		groupId, artifactId, version := manifestCoordinates(manifest, name)
+		root = a.newPackage(groupId, artifactId, version)
+	}
+	return root, nil
+}
+
+// newPackage adds a package to the archive, packages that were already found
+// in another place are only added once
+func (a *jarArchive) newPackage(groupId string, artifactId string, version string) *languages.Package {
+	if artifactId == "" || version == "" {
+		return nil
+	}
+	purl := java.NewPackageUrl(groupId, artifactId, version)
+	for _, pkg := range a.packages {
+		if pkg.Purl == purl {
+			return pkg
+		}
+	}
+	pkg := &languages.Package{
+		Name:         artifactId,
+		Version:      version,
+		Vendor:       groupId,
+		Purl:         purl,
+		Cpes:         java.NewCpes(groupId, artifactId, version),
+		EvidenceList: languages.NewEvidenceList(a.evidence),
+	}
+	a.packages = append(a.packages, pkg)
+	return pkg
+}
+
+// findRootPom picks the pom.properties that describes the archive itself.
+// Fat JARs contain the metadata of all the libraries they include, so it
+// needs to match the archive's name if there is more than one.
+func findRootPom(poms []*languages.Package, name string) *languages.Package {
+	if len(poms) == 1 {
+		return poms[0]
+	}
+	base := strings.TrimSuffix(name, path.Ext(name))
+	for _, pkg := range poms {
+		if base == pkg.Name || base == pkg.Name+"-"+pkg.Version {
+			return pkg
+		}
+	}
+	return nil
+}
+
+var reArchiveName = regexp.MustCompile(`^(.+?)[-_]v?(\d[\w.\-+]*?)(?:\.RELEASE|\.Final)?\.[a-zA-Z]+$`)
+
+// manifestCoordinates determines the package of an archive without maven
+// metadata from its manifest or its file name, e.g. commons-io-2.11.0.jar
+func manifestCoordinates(manifest map[string]string, name string) (string, string, string) {
+	groupId := manifest["Implementation-Vendor-Id"]
+	artifactId := manifest["Implementation-Title"]
+	version := manifest["Implementation-Version"]
+
+	if symbolicName := manifest["Bundle-SymbolicName"]; artifactId == "" && symbolicName != "" {
+		// OSGi bundles may have directives like `;singleton:=true`
+		symbolicName, _, _ = strings.Cut(symbolicName, ";")
+		artifactId = strings.TrimSpace(symbolicName)
+	}
+	if version == "" {
+		version = manifest["Bundle-Version"]
+	}
+
+	if m := reArchiveName.FindStringSubmatch(name); m != nil {
+		// the file name is more reliable than titles like "Apache Commons IO"
+		if artifactId == "" || strings.Contains(artifactId, " ") {
+			artifactId = m[1]
+		}
+		if version == "" {
+			version = m[2]
+		}
+	}
+	if strings.Contains(artifactId, " ") {
+		return "", "", ""
+	}
+	return groupId, artifactId, version
+}
+
+func readZipFile[T any](f *zip.File, parse func(io.Reader) (T, error)) (T, error) {
+	var res T
+	rc, err := f.Open()
+	if err != nil {
+		return res, err
+	}
+	defer rc.Close()
+	return parse(rc)
+}
+
+// parseManifest parses a MANIFEST.MF, where long values continue on lines
+// that start with a single space
+// see https://docs.oracle.com/en/java/javase/17/docs/specs/jar/jar.html#jar-manifest
+func parseManifest(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	key := ""
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			// only the main section describes the archive
+			if len(res) > 0 {
+				break
+			}
+			continue
+		}
+		if line[0] == ' ' {
+			if key != "" {
+				res[key] += line[1:]
+			}
+			continue
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(k)
+		res[key] = strings.TrimSpace(v)
+	}
+	return res, scanner.Err()
+}
+
+// parseProperties parses a java properties file like pom.properties
+func parseProperties(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		idx := strings.IndexAny(line, "=:")
+		if idx < 0 {
+			continue
+		}
+		res[strings.TrimSpace(line[:idx])] = strings.TrimSpace(line[idx+1:])
+	}
+	return res, scanner.Err()
+}
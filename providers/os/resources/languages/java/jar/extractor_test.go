@@ -0,0 +1,105 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jar
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestJarExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/commons-io-2.11.0.jar")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/usr/share/java/commons-io-2.11.0.jar")
+	require.NoError(t, err)
+
+	assert.Equal(t, &languages.Package{
+		Name:    "commons-io",
+		Version: "2.11.0",
+		Vendor:  "commons-io",
+		Purl:    "pkg:maven/commons-io/commons-io@2.11.0",
+		Cpes:    []string{"cpe:2.3:a:commons-io:commons-io:2.11.0:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: "/usr/share/java/commons-io-2.11.0.jar",
+		}},
+	}, bom.Root())
+	assert.Len(t, bom.Transitive(), 1)
+	assert.Empty(t, bom.Direct())
+}
+
+func TestWarExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/app.war")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/opt/tomcat/webapps/app.war")
+	require.NoError(t, err)
+
+	// the title of the application has a space and its file name has no version
+	assert.Nil(t, bom.Root())
+
+	list := bom.Transitive()
+	assert.Len(t, list, 3)
+	assert.Equal(t, list, bom.Direct())
+
+	log4j := list.Find("log4j-core")
+	require.NotNil(t, log4j)
+	assert.Equal(t, "2.14.1", log4j.Version)
+	assert.Equal(t, "org.apache.logging.log4j", log4j.Vendor)
+	assert.Equal(t, "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", log4j.Purl)
+	assert.Contains(t, log4j.Cpes, "cpe:2.3:a:apache:log4j-core:2.14.1:*:*:*:*:*:*:*")
+	assert.Equal(t, "/opt/tomcat/webapps/app.war", log4j.EvidenceList[0].Value)
+
+	// no maven metadata, detected from the file name
+	slf4j := list.Find("slf4j-api")
+	require.NotNil(t, slf4j)
+	assert.Equal(t, "1.7.36", slf4j.Version)
+	assert.Equal(t, "pkg:maven/slf4j-api@1.7.36", slf4j.Purl)
+
+	assert.NotNil(t, list.Find("commons-io"))
+}
+
+func TestParseManifest(t *testing.T) {
+	manifest, err := parseManifest(strings.NewReader("Manifest-Version: 1.0\r\nImplementation-Title: spring-\r\n core\r\nBundle-Version: 5.3.20\r\n\r\nName: org/springframework/\r\nImplementation-Title: other\r\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Manifest-Version":     "1.0",
+		"Implementation-Title": "spring-core",
+		"Bundle-Version":       "5.3.20",
+	}, manifest)
+
+	groupId, artifactId, version := manifestCoordinates(manifest, "spring.jar")
+	assert.Equal(t, "", groupId)
+	assert.Equal(t, "spring-core", artifactId)
+	assert.Equal(t, "5.3.20", version)
+}
+
+func TestManifestCoordinatesFromFileName(t *testing.T) {
+	tests := []struct {
+		name       string
+		artifactId string
+		version    string
+	}{
+		{"guava-31.1-jre.jar", "guava", "31.1-jre"},
+		{"spring-core-5.3.20.RELEASE.jar", "spring-core", "5.3.20"},
+		{"hibernate-core-5.6.9.Final.jar", "hibernate-core", "5.6.9"},
+		{"jenkins.war", "", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, artifactId, version := manifestCoordinates(nil, tc.name)
+			assert.Equal(t, tc.artifactId, artifactId)
+			assert.Equal(t, tc.version, version)
+		})
+	}
+}
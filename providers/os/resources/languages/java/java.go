@@ -0,0 +1,40 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package java
+
+import (
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+)
+
+// NewPackageUrl creates a maven package url for the given group id, artifact id and version
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#maven
+func NewPackageUrl(groupId string, artifactId string, version string) string {
+	return packageurl.NewPackageURL(
+		packageurl.TypeMaven,
+		groupId,
+		artifactId,
+		version,
+		nil,
+		"").String()
+}
+
+// NewCpes creates the CPEs for a java package. The vendor is derived from the
+// group id, e.g. org.apache.commons is published by apache.
+func NewCpes(groupId string, artifactId string, version string) []string {
+	return languages.NewCpes(groupVendor(groupId), artifactId, version)
+}
+
+func groupVendor(groupId string) string {
+	parts := strings.Split(groupId, ".")
+	if len(parts) > 1 {
+		switch parts[0] {
+		case "org", "com", "io", "net", "dev", "de", "ch", "uk":
+			return parts[1]
+		}
+	}
+	return parts[0]
+}
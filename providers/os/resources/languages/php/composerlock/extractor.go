@@ -0,0 +1,138 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package composerlock
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/php"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*composerLock)(nil)
+)
+
+// Extractor is the parser for composer.lock files and the
+// vendor/composer/installed.json file of installed packages
+// see https://getcomposer.org/doc/01-basic-usage.md#commit-your-composer-lock-file-to-version-control
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "composerlock"
+}
+
+type composerPackage struct {
+	Name        string            `json:"name"`
+	Version     string            `json:"version"`
+	Description string            `json:"description"`
+	Require     map[string]string `json:"require"`
+}
+
+type composerLock struct {
+	Packages    []composerPackage `json:"packages"`
+	PackagesDev []composerPackage `json:"packages-dev"`
+	evidence    string
+}
+
+type composerInstalled struct {
+	Packages        []composerPackage `json:"packages"`
+	DevPackageNames []string          `json:"dev-package-names"`
+}
+
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &composerLock{evidence: filename}
+
+	// installed.json of composer 1 is a list of packages
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(data, &lock.Packages); err != nil {
+			return nil, err
+		}
+		return lock, nil
+	}
+
+	var installed composerInstalled
+	if err := json.Unmarshal(data, &installed); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, err
+	}
+
+	// installed.json of composer 2 marks dev packages by name instead of
+	// listing them separately
+	if len(installed.DevPackageNames) > 0 {
+		dev := map[string]struct{}{}
+		for _, name := range installed.DevPackageNames {
+			dev[name] = struct{}{}
+		}
+		lock.Packages = nil
+		for _, pkg := range installed.Packages {
+			if _, ok := dev[pkg.Name]; ok {
+				lock.PackagesDev = append(lock.PackagesDev, pkg)
+			} else {
+				lock.Packages = append(lock.Packages, pkg)
+			}
+		}
+	}
+	return lock, nil
+}
+
+// Root returns nil since the project itself is only described by composer.json
+func (l *composerLock) Root() *languages.Package {
+	return nil
+}
+
+// Direct returns the packages that no other package requires. The lock file
+// does not include the requirements of the project, so this approximates the
+// packages that are required in composer.json. Development packages are not
+// included.
+func (l *composerLock) Direct() languages.Packages {
+	required := map[string]struct{}{}
+	for _, list := range [][]composerPackage{l.Packages, l.PackagesDev} {
+		for _, pkg := range list {
+			for name := range pkg.Require {
+				required[name] = struct{}{}
+			}
+		}
+	}
+
+	res := languages.Packages{}
+	for i := range l.Packages {
+		if _, ok := required[l.Packages[i].Name]; !ok {
+			res = append(res, l.newPackage(&l.Packages[i]))
+		}
+	}
+	return res
+}
+
+// Transitive returns all packages including the development packages
+func (l *composerLock) Transitive() languages.Packages {
+	res := languages.Packages{}
+	for _, list := range [][]composerPackage{l.Packages, l.PackagesDev} {
+		for i := range list {
+			res = append(res, l.newPackage(&list[i]))
+		}
+	}
+	return res
+}
+
+func (l *composerLock) newPackage(pkg *composerPackage) *languages.Package {
+	return &languages.Package{
+		Name:         pkg.Name,
+		Version:      pkg.Version,
+		Description:  pkg.Description,
+		Purl:         php.NewPackageUrl(pkg.Name, pkg.Version),
+		Cpes:         php.NewCpes(pkg.Name, pkg.Version),
+		EvidenceList: languages.NewEvidenceList(l.evidence),
+	}
+}
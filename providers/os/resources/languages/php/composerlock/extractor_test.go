@@ -0,0 +1,74 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package composerlock
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestComposerLockExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/composer.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/var/www/html/composer.lock")
+	require.NoError(t, err)
+
+	assert.Nil(t, bom.Root())
+
+	direct := bom.Direct()
+	assert.Len(t, direct, 2)
+	assert.NotNil(t, direct.Find("monolog/monolog"))
+	assert.NotNil(t, direct.Find("symfony/console"))
+
+	list := bom.Transitive()
+	assert.Len(t, list, 5)
+	assert.NotNil(t, list.Find("phpunit/phpunit"))
+
+	assert.Equal(t, &languages.Package{
+		Name:        "symfony/console",
+		Version:     "v6.4.1",
+		Description: "Eases the creation of beautiful and testable command line interfaces",
+		Purl:        "pkg:composer/symfony/console@v6.4.1",
+		Cpes:        []string{"cpe:2.3:a:symfony:console:6.4.1:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: "/var/www/html/composer.lock",
+		}},
+	}, list.Find("symfony/console"))
+}
+
+func TestComposerInstalledExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/installed.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/var/www/html/vendor/composer/installed.json")
+	require.NoError(t, err)
+
+	list := bom.Transitive()
+	assert.Len(t, list, 2)
+	assert.Equal(t, "pkg:composer/psr/log@3.0.0", list.Find("psr/log").Purl)
+
+	// dev packages are not direct dependencies
+	direct := bom.Direct()
+	assert.Len(t, direct, 1)
+	assert.Nil(t, direct.Find("phpunit/phpunit"))
+}
+
+func TestComposer1InstalledExtractor(t *testing.T) {
+	bom, err := (&Extractor{}).Parse(strings.NewReader(`[{"name": "psr/log", "version": "1.1.4"}]`), "installed.json")
+	require.NoError(t, err)
+
+	list := bom.Transitive()
+	require.Len(t, list, 1)
+	assert.Equal(t, "1.1.4", list[0].Version)
+}
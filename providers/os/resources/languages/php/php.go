@@ -0,0 +1,38 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package php
+
+import (
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+)
+
+// NewPackageUrl creates a composer package url for a given package name,
+// which includes the vendor, e.g. symfony/console
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#composer
+func NewPackageUrl(name string, version string) string {
+	vendor, project := splitName(name)
+	return packageurl.NewPackageURL(
+		packageurl.TypeComposer,
+		vendor,
+		project,
+		version,
+		nil,
+		"").String()
+}
+
+func NewCpes(name string, version string) []string {
+	vendor, project := splitName(name)
+	return languages.NewCpes(vendor, project, strings.TrimPrefix(version, "v"))
+}
+
+func splitName(name string) (string, string) {
+	vendor, project, ok := strings.Cut(name, "/")
+	if !ok {
+		return "", name
+	}
+	return vendor, project
+}
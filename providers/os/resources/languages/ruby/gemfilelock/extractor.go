@@ -0,0 +1,158 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gemfilelock
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/ruby"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*gemfileLock)(nil)
+)
+
+// Extractor is the parser for Gemfile.lock files written by bundler
+// see https://bundler.io/guides/gemfile.html
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "gemfilelock"
+}
+
+type gemSpec struct {
+	name     string
+	version  string
+	platform string
+	// gems from a PATH source with the remote `.` are the gems of the project
+	local bool
+}
+
+type gemfileLock struct {
+	specs        []gemSpec
+	dependencies []string
+	evidence     string
+}
+
+// Parse reads the specs of all sources (GEM, GIT and PATH) and the
+// DEPENDENCIES section. Specs are indented by four spaces, the
+// requirements of a spec by six spaces:
+//
+//	GEM
+//	  remote: https://rubygems.org/
+//	  specs:
+//	    nokogiri (1.15.4-x86_64-linux)
+//	      racc (~> 1.4)
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	lock := &gemfileLock{evidence: filename}
+
+	section := ""
+	localSource := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if line[0] != ' ' {
+			section = strings.TrimSpace(line)
+			localSource = false
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		content := strings.TrimSpace(line)
+
+		switch section {
+		case "GEM", "GIT", "PATH":
+			if indent == 2 {
+				if remote, ok := strings.CutPrefix(content, "remote:"); ok {
+					localSource = section == "PATH" && strings.TrimSpace(remote) == "."
+				}
+				continue
+			}
+			if indent != 4 {
+				continue
+			}
+			name, version, ok := parseNameVersion(content)
+			if !ok {
+				continue
+			}
+			version, platform, _ := strings.Cut(version, "-")
+			lock.specs = append(lock.specs, gemSpec{
+				name:     name,
+				version:  version,
+				platform: platform,
+				local:    localSource,
+			})
+
+		case "DEPENDENCIES":
+			if indent != 2 {
+				continue
+			}
+			name, _, _ := parseNameVersion(content)
+			lock.dependencies = append(lock.dependencies, strings.TrimSuffix(name, "!"))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// parseNameVersion splits `name (version)` into its parts
+func parseNameVersion(s string) (string, string, bool) {
+	name, rest, ok := strings.Cut(s, " ")
+	if !ok {
+		return s, "", false
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return name, "", false
+	}
+	return name, rest[1 : len(rest)-1], true
+}
+
+// Root returns the gem of the project, which exists when the Gemfile
+// contains `gemspec`
+func (l *gemfileLock) Root() *languages.Package {
+	for i := range l.specs {
+		if l.specs[i].local {
+			return l.newPackage(&l.specs[i])
+		}
+	}
+	return nil
+}
+
+// Direct returns the gems of the DEPENDENCIES section, which are the gems
+// listed in the Gemfile
+func (l *gemfileLock) Direct() languages.Packages {
+	res := languages.Packages{}
+	for _, name := range l.dependencies {
+		for i := range l.specs {
+			spec := &l.specs[i]
+			if spec.name == name && !spec.local {
+				res = append(res, l.newPackage(spec))
+			}
+		}
+	}
+	return res
+}
+
+func (l *gemfileLock) Transitive() languages.Packages {
+	res := make(languages.Packages, len(l.specs))
+	for i := range l.specs {
+		res[i] = l.newPackage(&l.specs[i])
+	}
+	return res
+}
+
+func (l *gemfileLock) newPackage(spec *gemSpec) *languages.Package {
+	return ruby.NewPackage(spec.name, spec.version, spec.platform, l.evidence)
+}
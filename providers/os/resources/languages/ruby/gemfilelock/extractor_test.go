@@ -0,0 +1,57 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gemfilelock
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestGemfileLockExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/Gemfile.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/app/Gemfile.lock")
+	require.NoError(t, err)
+
+	root := bom.Root()
+	require.NotNil(t, root)
+	assert.Equal(t, "myapp", root.Name)
+	assert.Equal(t, "0.1.0", root.Version)
+
+	direct := bom.Direct()
+	assert.Len(t, direct, 3)
+	assert.NotNil(t, direct.Find("activesupport"))
+	assert.NotNil(t, direct.Find("nokogiri"))
+	assert.NotNil(t, direct.Find("rake"))
+
+	list := bom.Transitive()
+	assert.Len(t, list, 7)
+
+	assert.Equal(t, &languages.Package{
+		Name:    "rake",
+		Version: "13.0.6",
+		Purl:    "pkg:gem/rake@13.0.6",
+		Cpes:    []string{"cpe:2.3:a:rake:rake:13.0.6:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: "/app/Gemfile.lock",
+		}},
+	}, list.Find("rake"))
+
+	nokogiri := list.Find("nokogiri")
+	require.NotNil(t, nokogiri)
+	assert.Equal(t, "1.15.4", nokogiri.Version)
+	assert.Equal(t, "x86_64-linux", nokogiri.Architecture)
+	assert.Equal(t, "pkg:gem/nokogiri@1.15.4?platform=x86_64-linux", nokogiri.Purl)
+
+	// gems from git sources are included as well
+	assert.Equal(t, "7.1.0", list.Find("activesupport").Version)
+}
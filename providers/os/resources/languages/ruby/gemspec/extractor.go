@@ -0,0 +1,98 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gemspec
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/ruby"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*gemSpecification)(nil)
+)
+
+// Extractor reads the specification of an installed gem, which rubygems
+// stores in the `specifications` directory of the gem home, e.g.
+// /usr/lib/ruby/gems/3.1.0/specifications/rake-13.0.6.gemspec
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "gemspec"
+}
+
+type gemSpecification struct {
+	pkg *languages.Package
+}
+
+var (
+	reStub      = regexp.MustCompile(`^#\s*stub:\s+(\S+)\s+(\S+)\s+(\S+)`)
+	reAttribute = regexp.MustCompile(`^\s*s\.(name|version|platform|summary)\s*=\s*"((?:[^"\\]|\\.)*)"`)
+	reSpecFile  = regexp.MustCompile(`^(.+?)-(\d[^-]*)(?:-(.+))?\.gemspec$`)
+)
+
+// Parse reads the stub line that rubygems writes into generated
+// specifications and falls back to the attribute assignments and the file
+// name. The specification is ruby code that is not evaluated.
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	attrs := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := reStub.FindStringSubmatch(line); m != nil {
+			attrs["name"] = m[1]
+			attrs["version"] = m[2]
+			attrs["platform"] = m[3]
+			continue
+		}
+		if m := reAttribute.FindStringSubmatch(line); m != nil {
+			if _, ok := attrs[m[1]]; !ok {
+				attrs[m[1]] = m[2]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if m := reSpecFile.FindStringSubmatch(path.Base(filename)); m != nil {
+		if attrs["name"] == "" {
+			attrs["name"] = m[1]
+		}
+		if attrs["version"] == "" {
+			attrs["version"] = m[2]
+			attrs["platform"] = m[3]
+		}
+	}
+
+	if attrs["name"] == "" {
+		return &gemSpecification{}, nil
+	}
+
+	pkg := ruby.NewPackage(attrs["name"], attrs["version"], attrs["platform"], filename)
+	pkg.Description = strings.ReplaceAll(attrs["summary"], `\"`, `"`)
+	return &gemSpecification{pkg: pkg}, nil
+}
+
+func (s *gemSpecification) Root() *languages.Package {
+	return s.pkg
+}
+
+func (s *gemSpecification) Direct() languages.Packages {
+	return nil
+}
+
+func (s *gemSpecification) Transitive() languages.Packages {
+	if s.pkg == nil {
+		return nil
+	}
+	return languages.Packages{s.pkg}
+}
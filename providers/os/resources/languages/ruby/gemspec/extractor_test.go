@@ -0,0 +1,65 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gemspec
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestGemspecExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/nokogiri-1.15.4-x86_64-linux.gemspec")
+	require.NoError(t, err)
+	defer f.Close()
+
+	filename := "/usr/lib/ruby/gems/3.1.0/specifications/nokogiri-1.15.4-x86_64-linux.gemspec"
+	bom, err := (&Extractor{}).Parse(f, filename)
+	require.NoError(t, err)
+
+	assert.Equal(t, &languages.Package{
+		Name:         "nokogiri",
+		Version:      "1.15.4",
+		Architecture: "x86_64-linux",
+		Description:  "Nokogiri (鋸) makes it easy and painless to work with XML and HTML from Ruby.",
+		Purl:         "pkg:gem/nokogiri@1.15.4?platform=x86_64-linux",
+		Cpes:         []string{"cpe:2.3:a:nokogiri:nokogiri:1.15.4:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: filename,
+		}},
+	}, bom.Root())
+	assert.Len(t, bom.Transitive(), 1)
+}
+
+func TestGemspecExtractorWithoutStub(t *testing.T) {
+	f, err := os.Open("./testdata/rack-2.2.8.gemspec")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/var/lib/gems/3.0.0/specifications/rack-2.2.8.gemspec")
+	require.NoError(t, err)
+
+	root := bom.Root()
+	require.NotNil(t, root)
+	assert.Equal(t, "rack", root.Name)
+	assert.Equal(t, "2.2.8", root.Version)
+	assert.Equal(t, "", root.Architecture)
+	assert.Equal(t, "pkg:gem/rack@2.2.8", root.Purl)
+}
+
+func TestGemspecExtractorFileName(t *testing.T) {
+	bom, err := (&Extractor{}).Parse(strings.NewReader(""), "/usr/share/gems/specifications/net-http-persistent-4.0.2.gemspec")
+	require.NoError(t, err)
+
+	root := bom.Root()
+	require.NotNil(t, root)
+	assert.Equal(t, "net-http-persistent", root.Name)
+	assert.Equal(t, "4.0.2", root.Version)
+}
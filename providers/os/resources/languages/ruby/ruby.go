@@ -0,0 +1,45 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ruby
+
+import (
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+)
+
+// NewPackageUrl creates a gem package url for a given gem name, version and
+// platform. The platform is empty for gems that are not platform specific.
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#gem
+func NewPackageUrl(name string, version string, platform string) string {
+	var qualifiers packageurl.Qualifiers
+	if platform != "" && platform != "ruby" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{"platform": platform})
+	}
+	return packageurl.NewPackageURL(
+		packageurl.TypeGem,
+		"",
+		name,
+		version,
+		qualifiers,
+		"").String()
+}
+
+func NewCpes(name string, version string) []string {
+	return languages.NewCpes(name, name, version)
+}
+
+// NewPackage creates a gem package that was found in the given file
+func NewPackage(name string, version string, platform string, evidence string) *languages.Package {
+	if platform == "ruby" {
+		platform = ""
+	}
+	return &languages.Package{
+		Name:         name,
+		Version:      version,
+		Architecture: platform,
+		Purl:         NewPackageUrl(name, version, platform),
+		Cpes:         NewCpes(name, version),
+		EvidenceList: languages.NewEvidenceList(evidence),
+	}
+}
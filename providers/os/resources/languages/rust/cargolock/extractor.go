@@ -0,0 +1,150 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cargolock
+
+import (
+	"io"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/rust"
+)
+
+var (
+	_ languages.Extractor = (*Extractor)(nil)
+	_ languages.Bom       = (*cargoLock)(nil)
+)
+
+// Extractor is the parser for Cargo.lock files
+// see https://doc.rust-lang.org/cargo/guide/cargo-toml-vs-cargo-lock.html
+type Extractor struct{}
+
+func (e *Extractor) Name() string {
+	return "cargolock"
+}
+
+func (e *Extractor) Parse(r io.Reader, filename string) (languages.Bom, error) {
+	var lock cargoLock
+	if _, err := toml.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+	lock.evidence = filename
+	return &lock, nil
+}
+
+type cargoLock struct {
+	Version  int            `toml:"version"`
+	Packages []cargoPackage `toml:"package"`
+	evidence string
+}
+
+type cargoPackage struct {
+	Name         string   `toml:"name"`
+	Version      string   `toml:"version"`
+	Source       string   `toml:"source"`
+	Checksum     string   `toml:"checksum"`
+	Dependencies []string `toml:"dependencies"`
+}
+
+// isLocal returns true for the crates of the workspace, which have no source
+func (p *cargoPackage) isLocal() bool {
+	return p.Source == ""
+}
+
+// Root returns the crate of the workspace that no other crate of the
+// workspace depends on. Workspaces with multiple binaries have no root.
+func (l *cargoLock) Root() *languages.Package {
+	var root *cargoPackage
+	for i := range l.Packages {
+		pkg := &l.Packages[i]
+		if !pkg.isLocal() || l.isDependency(pkg) {
+			continue
+		}
+		if root != nil {
+			return nil
+		}
+		root = pkg
+	}
+	if root == nil {
+		return nil
+	}
+	return l.newPackage(root)
+}
+
+// Direct returns the dependencies of the crates in the workspace
+func (l *cargoLock) Direct() languages.Packages {
+	res := languages.Packages{}
+	seen := map[*cargoPackage]struct{}{}
+	for i := range l.Packages {
+		if !l.Packages[i].isLocal() {
+			continue
+		}
+		for _, dep := range l.Packages[i].Dependencies {
+			pkg := l.find(dep)
+			if pkg == nil || pkg.isLocal() {
+				continue
+			}
+			if _, ok := seen[pkg]; ok {
+				continue
+			}
+			seen[pkg] = struct{}{}
+			res = append(res, l.newPackage(pkg))
+		}
+	}
+	return res
+}
+
+func (l *cargoLock) Transitive() languages.Packages {
+	res := make(languages.Packages, len(l.Packages))
+	for i := range l.Packages {
+		res[i] = l.newPackage(&l.Packages[i])
+	}
+	return res
+}
+
+func (l *cargoLock) newPackage(pkg *cargoPackage) *languages.Package {
+	return &languages.Package{
+		Name:         pkg.Name,
+		Version:      pkg.Version,
+		Purl:         rust.NewPackageUrl(pkg.Name, pkg.Version),
+		Cpes:         rust.NewCpes(pkg.Name, pkg.Version),
+		EvidenceList: languages.NewEvidenceList(l.evidence),
+	}
+}
+
+// isDependency returns true if another crate depends on the given one
+func (l *cargoLock) isDependency(pkg *cargoPackage) bool {
+	for i := range l.Packages {
+		for _, dep := range l.Packages[i].Dependencies {
+			if l.find(dep) == pkg {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// find resolves a dependency, which is either `name`, `name version` or
+// `name version (source)` when the name alone is ambiguous
+func (l *cargoLock) find(dep string) *cargoPackage {
+	fields := strings.Fields(dep)
+	if len(fields) == 0 {
+		return nil
+	}
+	for i := range l.Packages {
+		pkg := &l.Packages[i]
+		if pkg.Name != fields[0] {
+			continue
+		}
+		if len(fields) > 1 && pkg.Version != fields[1] {
+			continue
+		}
+		if len(fields) > 2 && pkg.Source != strings.Trim(fields[2], "()") {
+			continue
+		}
+		return pkg
+	}
+	return nil
+}
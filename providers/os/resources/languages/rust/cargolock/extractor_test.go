@@ -0,0 +1,56 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cargolock
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestCargoLockExtractor(t *testing.T) {
+	f, err := os.Open("./testdata/Cargo.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	bom, err := (&Extractor{}).Parse(f, "/app/Cargo.lock")
+	require.NoError(t, err)
+
+	root := bom.Root()
+	require.NotNil(t, root)
+	assert.Equal(t, "cli", root.Name)
+	assert.Equal(t, "pkg:cargo/cli@0.1.0", root.Purl)
+
+	direct := bom.Direct()
+	assert.Len(t, direct, 3)
+	assert.Nil(t, direct.Find("core"))
+	assert.Nil(t, direct.Find("autocfg"))
+
+	list := bom.Transitive()
+	assert.Len(t, list, 6)
+
+	assert.Equal(t, &languages.Package{
+		Name:    "num-traits",
+		Version: "0.2.17",
+		Purl:    "pkg:cargo/num-traits@0.2.17",
+		Cpes:    []string{"cpe:2.3:a:num-traits:num-traits:0.2.17:*:*:*:*:*:*:*"},
+		EvidenceList: []*sbom.Evidence{{
+			Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+			Value: "/app/Cargo.lock",
+		}},
+	}, list.Find("num-traits"))
+
+	// both versions of serde are locked
+	versions := []string{}
+	for _, pkg := range list {
+		if pkg.Name == "serde" {
+			versions = append(versions, pkg.Version)
+		}
+	}
+	assert.ElementsMatch(t, []string{"1.0.190", "0.9.15"}, versions)
+}
@@ -0,0 +1,25 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rust
+
+import (
+	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+)
+
+// NewPackageUrl creates a cargo package url for a given crate name and version
+// see https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#cargo
+func NewPackageUrl(name string, version string) string {
+	return packageurl.NewPackageURL(
+		packageurl.TypeCargo,
+		"",
+		name,
+		version,
+		nil,
+		"").String()
+}
+
+func NewCpes(name string, version string) []string {
+	return languages.NewCpes(name, name, version)
+}
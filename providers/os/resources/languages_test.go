@@ -0,0 +1,174 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers/os/connection/fs"
+)
+
+// newLanguagesTestConnection returns a filesystem with package files of all
+// supported languages
+func newLanguagesTestConnection(t *testing.T) *fs.FileSystemConnection {
+	mockFS := afero.NewMemMapFs()
+
+	copyFile := func(src string, dst string, perm os.FileMode) {
+		data, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, mockFS.MkdirAll(path.Dir(dst), 0o755))
+		require.NoError(t, afero.WriteFile(mockFS, dst, data, perm))
+	}
+
+	copyFile("languages/java/jar/testdata/commons-io-2.11.0.jar", "/usr/share/java/commons-io-2.11.0.jar", 0o644)
+	copyFile("languages/java/jar/testdata/app.war", "/opt/tomcat/webapps/app.war", 0o644)
+	copyFile("languages/rust/cargolock/testdata/Cargo.lock", "/app/Cargo.lock", 0o644)
+	copyFile("languages/ruby/gemfilelock/testdata/Gemfile.lock", "/app/Gemfile.lock", 0o644)
+	copyFile("languages/ruby/gemspec/testdata/rack-2.2.8.gemspec", "/usr/lib/ruby/gems/3.1.0/specifications/rack-2.2.8.gemspec", 0o644)
+	copyFile("languages/php/composerlock/testdata/composer.lock", "/var/www/html/composer.lock", 0o644)
+	// lock files of node modules are never scanned
+	copyFile("languages/rust/cargolock/testdata/Cargo.lock", "/app/node_modules/wasm/Cargo.lock", 0o644)
+
+	// the test binary is a go binary
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	copyFile(exe, "/usr/local/bin/app", 0o755)
+	copyFile("languages/golang/buildinfo/testdata/buildinfo.txt", "/usr/local/bin/script", 0o755)
+
+	conn, err := fs.NewFileSystemConnectionWithFs(0, &inventory.Config{}, &inventory.Asset{}, "", nil, mockFS)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestJavaPackages(t *testing.T) {
+	r := newTestResource[*mqlJavaPackages](t, newLanguagesTestConnection(t), "java.packages", nil)
+
+	list := r.GetList()
+	require.NoError(t, list.Error)
+	require.Len(t, list.Data, 3)
+
+	// commons-io is installed and part of the web application
+	pkgs := map[string]*mqlJavaPackage{}
+	for _, o := range list.Data {
+		pkg := o.(*mqlJavaPackage)
+		pkgs[pkg.Name.Data] = pkg
+	}
+	commons := pkgs["commons-io"]
+	require.NotNil(t, commons)
+	assert.Equal(t, "commons-io", commons.GroupId.Data)
+	assert.Equal(t, "2.11.0", commons.Version.Data)
+	assert.Equal(t, "pkg:maven/commons-io/commons-io@2.11.0", commons.Purl.Data)
+	assert.Equal(t, []string{"/opt/tomcat/webapps/app.war", "/usr/share/java/commons-io-2.11.0.jar"}, pkgFilePaths(commons.Files.Data))
+
+	log4j := pkgs["log4j-core"]
+	require.NotNil(t, log4j)
+	assert.Equal(t, "org.apache.logging.log4j", log4j.GroupId.Data)
+
+	files := r.GetFiles()
+	require.NoError(t, files.Error)
+	assert.Equal(t, []string{"/opt/tomcat/webapps/app.war", "/usr/share/java/commons-io-2.11.0.jar"}, pkgFilePaths(files.Data))
+}
+
+func TestGoModules(t *testing.T) {
+	r := newTestResource[*mqlGoModules](t, newLanguagesTestConnection(t), "go.modules", map[string]*llx.RawData{
+		"path": llx.StringData("/usr/local/bin"),
+	})
+
+	list := r.GetList()
+	require.NoError(t, list.Error)
+
+	names := map[string]*mqlGoModule{}
+	for _, o := range list.Data {
+		mod := o.(*mqlGoModule)
+		names[mod.Name.Data] = mod
+	}
+	require.Contains(t, names, "stdlib")
+	require.Contains(t, names, "github.com/stretchr/testify")
+	assert.Equal(t, []string{"/usr/local/bin/app"}, pkgFilePaths(names["stdlib"].Files.Data))
+
+	// scripts and other executables are skipped
+	files := r.GetFiles()
+	require.NoError(t, files.Error)
+	assert.Equal(t, []string{"/usr/local/bin/app"}, pkgFilePaths(files.Data))
+}
+
+func TestRustPackages(t *testing.T) {
+	r := newTestResource[*mqlRustPackages](t, newLanguagesTestConnection(t), "rust.packages", nil)
+
+	list := r.GetList()
+	require.NoError(t, list.Error)
+	require.Len(t, list.Data, 6)
+
+	first := list.Data[0].(*mqlRustPackage)
+	assert.Equal(t, "autocfg", first.Name.Data)
+	assert.Equal(t, "1.1.0", first.Version.Data)
+	assert.Equal(t, "pkg:cargo/autocfg@1.1.0", first.Purl.Data)
+	assert.Equal(t, []string{"/app/Cargo.lock"}, pkgFilePaths(first.Files.Data))
+
+	files := r.GetFiles()
+	require.NoError(t, files.Error)
+	assert.Equal(t, []string{"/app/Cargo.lock"}, pkgFilePaths(files.Data))
+}
+
+func TestRubyPackages(t *testing.T) {
+	r := newTestResource[*mqlRubyPackages](t, newLanguagesTestConnection(t), "ruby.packages", nil)
+
+	list := r.GetList()
+	require.NoError(t, list.Error)
+	// 7 gems from Gemfile.lock and the installed rack gem
+	require.Len(t, list.Data, 8)
+
+	pkgs := map[string]*mqlRubyPackage{}
+	for _, o := range list.Data {
+		pkg := o.(*mqlRubyPackage)
+		pkgs[pkg.Name.Data] = pkg
+	}
+	nokogiri := pkgs["nokogiri"]
+	require.NotNil(t, nokogiri)
+	assert.Equal(t, "x86_64-linux", nokogiri.Platform.Data)
+	assert.Equal(t, "pkg:gem/nokogiri@1.15.4?platform=x86_64-linux", nokogiri.Purl.Data)
+
+	rack := pkgs["rack"]
+	require.NotNil(t, rack)
+	assert.Equal(t, []string{"/usr/lib/ruby/gems/3.1.0/specifications/rack-2.2.8.gemspec"}, pkgFilePaths(rack.Files.Data))
+}
+
+func TestPhpPackagesPath(t *testing.T) {
+	r := newTestResource[*mqlPhpPackages](t, newLanguagesTestConnection(t), "php.packages", map[string]*llx.RawData{
+		"path": llx.StringData("/var/www/html/composer.lock"),
+	})
+
+	list := r.GetList()
+	require.NoError(t, list.Error)
+	require.Len(t, list.Data, 5)
+
+	pkg := list.Data[3].(*mqlPhpPackage)
+	assert.Equal(t, "symfony/console", pkg.Name.Data)
+	assert.Equal(t, "v6.4.1", pkg.Version.Data)
+	assert.Equal(t, "Eases the creation of beautiful and testable command line interfaces", pkg.Description.Data)
+	assert.Equal(t, "pkg:composer/symfony/console@v6.4.1", pkg.Purl.Data)
+}
+
+func TestLanguagePackagesMissingPath(t *testing.T) {
+	list := newTestResource[*mqlJavaPackages](t, newLanguagesTestConnection(t), "java.packages", map[string]*llx.RawData{
+		"path": llx.StringData("/does/not/exist"),
+	}).GetList()
+	require.NoError(t, list.Error)
+	assert.Empty(t, list.Data)
+}
+
+func pkgFilePaths(files []any) []string {
+	res := make([]string, len(files))
+	for i := range files {
+		res[i] = files[i].(*mqlPkgFileInfo).Path.Data
+	}
+	return res
+}
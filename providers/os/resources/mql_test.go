@@ -170,6 +170,26 @@ func TestListResource(t *testing.T) {
 			Code:        "users.map(name)",
 			Expectation: []any([]any{"root", "bin", "chris", "christopher"}),
 		},
+		{
+			Code:        "users.sortBy(name).map(name)",
+			Expectation: []any{"bin", "chris", "christopher", "root"},
+		},
+		{
+			Code:        "users.sortBy(uid).reverse.take(1).map(name)",
+			Expectation: []any{"christopher"},
+		},
+		{
+			Code:        "users.skip(3).map(name)",
+			Expectation: []any{"christopher"},
+		},
+		{
+			Code:        "users.map(uid).max",
+			Expectation: int64(1001),
+		},
+		{
+			Code:        "users.groupBy(uid > 100).keys.sort",
+			Expectation: []any{"false", "true"},
+		},
 		{
 			// outside variables cause the block to be standalone
 			Code:        "n=false; users.contains(n)",
@@ -0,0 +1,319 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/nginx"
+	"go.mondoo.com/mql/v13/types"
+)
+
+type mqlNginxConfInternal struct {
+	lock sync.Mutex
+}
+
+// nginxConfPaths lists the well-known locations of the main nginx
+// configuration, in the order they are tried.
+var nginxConfPaths = []string{
+	"/etc/nginx/nginx.conf",
+	"/usr/local/etc/nginx/nginx.conf",
+	"/usr/local/nginx/conf/nginx.conf",
+	"/opt/homebrew/etc/nginx/nginx.conf",
+}
+
+func initNginxConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if x, ok := args["path"]; ok {
+		path, ok := x.Value.(string)
+		if !ok {
+			return nil, nil, errors.New("wrong type for 'path' in nginx.conf initialization, it must be a string")
+		}
+
+		f, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(path),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		args["file"] = llx.ResourceData(f, "file")
+
+		delete(args, "path")
+	}
+
+	return args, nil, nil
+}
+
+func (s *mqlNginxConf) id() (string, error) {
+	file := s.GetFile()
+	if file.Error != nil {
+		return "", file.Error
+	}
+
+	return file.Data.Path.Data, nil
+}
+
+// file is only called when nginx.conf is created without a path argument
+func (s *mqlNginxConf) file() (*mqlFile, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	path := nginxConfPaths[0]
+	for _, p := range nginxConfPaths {
+		if ok, _ := afs.Exists(p); ok {
+			path = p
+			break
+		}
+	}
+
+	f, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.(*mqlFile), nil
+}
+
+func (s *mqlNginxConf) setEmpty() {
+	s.Params = plugin.TValue[map[string]any]{Data: map[string]any{}, State: plugin.StateIsSet}
+	s.Directives = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+	s.Http = plugin.TValue[*mqlNginxConfHttp]{State: plugin.StateIsSet | plugin.StateIsNull}
+	s.Files = plugin.TValue[[]any]{Data: []any{}, State: plugin.StateIsSet}
+}
+
+func (s *mqlNginxConf) setError(err error) {
+	s.Params = plugin.TValue[map[string]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+	s.Directives = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+	s.Http = plugin.TValue[*mqlNginxConfHttp]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+	s.Files = plugin.TValue[[]any]{Error: err, State: plugin.StateIsSet | plugin.StateIsNull}
+}
+
+func (s *mqlNginxConf) parse(file *mqlFile) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.Params.State == plugin.StateIsSet {
+		return nil
+	}
+
+	if file == nil {
+		s.setEmpty()
+		return nil
+	}
+
+	// When the config file doesn't exist (e.g. nginx is not installed),
+	// return empty data instead of cascading errors.
+	if exists := file.GetExists(); exists.Error != nil || !exists.Data {
+		s.setEmpty()
+		return nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	// nginx resolves relative include paths against the configuration prefix,
+	// which is the directory of the main configuration file
+	prefix := filepath.Dir(file.Path.Data)
+
+	filesIdx := map[string]*mqlFile{
+		file.Path.Data: file,
+	}
+	files := []any{file}
+
+	fileContent := func(path string) (string, error) {
+		f, ok := filesIdx[path]
+		if !ok {
+			raw, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+				"path": llx.StringData(path),
+			})
+			if err != nil {
+				return "", err
+			}
+			f = raw.(*mqlFile)
+			filesIdx[path] = f
+			files = append(files, f)
+		}
+
+		content := f.GetContent()
+		if content.Error != nil {
+			return "", content.Error
+		}
+
+		return content.Data, nil
+	}
+
+	globExpand := func(pattern string) ([]string, error) {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(prefix, pattern)
+		}
+		return expandFsGlob(conn.FileSystem(), pattern)
+	}
+
+	cfg, err := nginx.ParseWithGlob(file.Path.Data, fileContent, globExpand)
+	if err != nil {
+		s.setError(err)
+		return err
+	}
+
+	s.Params = plugin.TValue[map[string]any]{Data: cfg.Params(), State: plugin.StateIsSet}
+
+	directives, err := nginxDirectives2Resources(cfg.Directives, s.MqlRuntime, s.__id+"/directive")
+	if err != nil {
+		s.setError(err)
+		return err
+	}
+	s.Directives = plugin.TValue[[]any]{Data: directives, State: plugin.StateIsSet}
+
+	if http := cfg.HTTP(); http != nil {
+		obj, err := nginxHTTP2Resource(http, s.MqlRuntime, s.__id+"/http")
+		if err != nil {
+			s.setError(err)
+			return err
+		}
+		s.Http = plugin.TValue[*mqlNginxConfHttp]{Data: obj, State: plugin.StateIsSet}
+	} else {
+		s.Http = plugin.TValue[*mqlNginxConfHttp]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+
+	s.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (s *mqlNginxConf) files(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlNginxConf) params(file *mqlFile) (map[string]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlNginxConf) directives(file *mqlFile) ([]any, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlNginxConf) http(file *mqlFile) (*mqlNginxConfHttp, error) {
+	return nil, s.parse(file)
+}
+
+func (s *mqlNginxConf) servers(http *mqlNginxConfHttp) ([]any, error) {
+	if http == nil {
+		return []any{}, nil
+	}
+	return http.Servers.Data, http.Servers.Error
+}
+
+func nginxDirectives2Resources(directives []*nginx.Directive, runtime *plugin.Runtime, ownerID string) ([]any, error) {
+	res := make([]any, len(directives))
+	for i, d := range directives {
+		id := ownerID + "/" + strconv.Itoa(i)
+		block, err := nginxDirectives2Resources(d.Block, runtime, id)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := CreateResource(runtime, "nginx.conf.directive", map[string]*llx.RawData{
+			"__id":  llx.StringData(id),
+			"name":  llx.StringData(d.Name),
+			"args":  llx.ArrayData(llx.TArr2Raw(d.Args), types.String),
+			"file":  llx.StringData(d.File),
+			"line":  llx.IntData(d.Line),
+			"block": llx.ArrayData(block, types.Resource("nginx.conf.directive")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func nginxHTTP2Resource(http *nginx.HTTP, runtime *plugin.Runtime, ownerID string) (*mqlNginxConfHttp, error) {
+	servers := make([]any, len(http.Servers))
+	for i := range http.Servers {
+		obj, err := nginxServer2Resource(http.Servers[i], runtime, ownerID+"/server/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		servers[i] = obj
+	}
+
+	obj, err := CreateResource(runtime, "nginx.conf.http", map[string]*llx.RawData{
+		"__id":         llx.StringData(ownerID),
+		"params":       llx.MapData(http.Params, types.String),
+		"sslProtocols": llx.ArrayData(llx.TArr2Raw(http.SSLProtocols), types.String),
+		"sslCiphers":   llx.StringData(http.SSLCiphers),
+		"headers":      llx.MapData(http.Headers, types.String),
+		"servers":      llx.ArrayData(servers, types.Resource("nginx.conf.server")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*mqlNginxConfHttp), nil
+}
+
+func nginxServer2Resource(server nginx.Server, runtime *plugin.Runtime, id string) (plugin.Resource, error) {
+	listen := make([]any, len(server.Listen))
+	for i, l := range server.Listen {
+		obj, err := CreateResource(runtime, "nginx.conf.listen", map[string]*llx.RawData{
+			"__id":          llx.StringData(id + "/listen/" + strconv.Itoa(i)),
+			"address":       llx.StringData(l.Address),
+			"port":          llx.IntData(l.Port),
+			"ssl":           llx.BoolData(l.SSL),
+			"http2":         llx.BoolData(l.HTTP2),
+			"defaultServer": llx.BoolData(l.DefaultServer),
+			"args":          llx.ArrayData(llx.TArr2Raw(l.Args), types.String),
+		})
+		if err != nil {
+			return nil, err
+		}
+		listen[i] = obj
+	}
+
+	locations, err := nginxLocations2Resources(server.Locations, runtime, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateResource(runtime, "nginx.conf.server", map[string]*llx.RawData{
+		"__id":         llx.StringData(id),
+		"serverNames":  llx.ArrayData(llx.TArr2Raw(server.ServerNames), types.String),
+		"listen":       llx.ArrayData(listen, types.Resource("nginx.conf.listen")),
+		"ssl":          llx.BoolData(server.SSL),
+		"sslProtocols": llx.ArrayData(llx.TArr2Raw(server.SSLProtocols), types.String),
+		"sslCiphers":   llx.StringData(server.SSLCiphers),
+		"headers":      llx.MapData(server.Headers, types.String),
+		"params":       llx.MapData(server.Params, types.String),
+		"locations":    llx.ArrayData(locations, types.Resource("nginx.conf.location")),
+	})
+}
+
+func nginxLocations2Resources(locations []nginx.Location, runtime *plugin.Runtime, ownerID string) ([]any, error) {
+	res := make([]any, len(locations))
+	for i, l := range locations {
+		id := ownerID + "/location/" + strconv.Itoa(i)
+		nested, err := nginxLocations2Resources(l.Locations, runtime, id)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := CreateResource(runtime, "nginx.conf.location", map[string]*llx.RawData{
+			"__id":      llx.StringData(id),
+			"modifier":  llx.StringData(l.Modifier),
+			"path":      llx.StringData(l.Path),
+			"headers":   llx.MapData(l.Headers, types.String),
+			"params":    llx.MapData(l.Params, types.String),
+			"locations": llx.ArrayData(nested, types.Resource("nginx.conf.location")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nginx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// HTTP represents the http block.
+type HTTP struct {
+	Params       map[string]any // all directives in this block
+	SSLProtocols []string       // ssl_protocols directive
+	SSLCiphers   string         // ssl_ciphers directive
+	Headers      map[string]any // add_header directives
+	Servers      []Server       // server blocks
+}
+
+// Server represents a server block inside http.
+type Server struct {
+	ServerNames  []string       // server_name directive
+	Listen       []Listen       // listen directives
+	SSL          bool           // any listen with ssl, or `ssl on`
+	SSLProtocols []string       // effective ssl_protocols (inherited from http)
+	SSLCiphers   string         // effective ssl_ciphers (inherited from http)
+	Headers      map[string]any // effective add_header directives
+	Params       map[string]any // all directives in this block
+	Locations    []Location     // location blocks
+}
+
+// Location represents a location block.
+type Location struct {
+	Modifier  string         // one of "", "=", "~", "~*", "^~" or "@" for named locations
+	Path      string         // location URI or regex
+	Headers   map[string]any // effective add_header directives
+	Params    map[string]any // all directives in this block
+	Locations []Location     // nested location blocks
+}
+
+// Listen represents a listen directive, e.g. `listen [::]:443 ssl http2;`.
+type Listen struct {
+	Address       string   // address or unix socket, empty if only a port is set
+	Port          int      // port, 0 for unix sockets
+	SSL           bool     // ssl parameter
+	HTTP2         bool     // http2 parameter
+	DefaultServer bool     // default_server parameter
+	Args          []string // all arguments of the directive
+}
+
+// HTTP returns the http block, or nil if the configuration has none.
+// Multiple http blocks are merged.
+func (c *Config) HTTP() *HTTP {
+	var blocks []*Directive
+	for _, d := range c.Directives {
+		if d.Name == "http" && d.IsBlock() {
+			blocks = append(blocks, d)
+		}
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	res := &HTTP{
+		Params:  map[string]any{},
+		Headers: map[string]any{},
+	}
+	var children []*Directive
+	for _, b := range blocks {
+		children = append(children, b.Block...)
+	}
+
+	setParams(res.Params, children)
+	res.Headers = headers(children, nil)
+	if d := find(children, "ssl_protocols"); d != nil {
+		res.SSLProtocols = d.Args
+	}
+	if d := find(children, "ssl_ciphers"); d != nil {
+		res.SSLCiphers = d.Value()
+	}
+
+	for _, d := range children {
+		if d.Name == "server" && d.IsBlock() {
+			res.Servers = append(res.Servers, parseServer(d, res))
+		}
+	}
+	return res
+}
+
+// Params returns all simple directives in the main context
+func (c *Config) Params() map[string]any {
+	res := map[string]any{}
+	setParams(res, c.Directives)
+	return res
+}
+
+func parseServer(d *Directive, http *HTTP) Server {
+	res := Server{
+		Params:       map[string]any{},
+		SSLProtocols: http.SSLProtocols,
+		SSLCiphers:   http.SSLCiphers,
+	}
+	setParams(res.Params, d.Block)
+	res.Headers = headers(d.Block, http.Headers)
+
+	for _, child := range d.Block {
+		switch child.Name {
+		case "server_name":
+			res.ServerNames = append(res.ServerNames, child.Args...)
+		case "listen":
+			l := ParseListen(child.Args)
+			res.SSL = res.SSL || l.SSL
+			res.Listen = append(res.Listen, l)
+		case "ssl":
+			res.SSL = res.SSL || (len(child.Args) > 0 && child.Args[0] == "on")
+		case "ssl_protocols":
+			res.SSLProtocols = child.Args
+		case "ssl_ciphers":
+			res.SSLCiphers = child.Value()
+		case "location":
+			if child.IsBlock() {
+				res.Locations = append(res.Locations, parseLocation(child, res.Headers))
+			}
+		}
+	}
+	return res
+}
+
+func parseLocation(d *Directive, parentHeaders map[string]any) Location {
+	res := Location{
+		Params: map[string]any{},
+	}
+	switch len(d.Args) {
+	case 0:
+	case 1:
+		res.Path = d.Args[0]
+		if strings.HasPrefix(res.Path, "@") {
+			res.Modifier = "@"
+		}
+	default:
+		res.Modifier = d.Args[0]
+		res.Path = d.Args[1]
+	}
+
+	setParams(res.Params, d.Block)
+	res.Headers = headers(d.Block, parentHeaders)
+	for _, child := range d.Block {
+		if child.Name == "location" && child.IsBlock() {
+			res.Locations = append(res.Locations, parseLocation(child, res.Headers))
+		}
+	}
+	return res
+}
+
+// ParseListen parses the arguments of a listen directive
+func ParseListen(args []string) Listen {
+	res := Listen{Args: args}
+	if len(args) == 0 {
+		return res
+	}
+
+	addr := args[0]
+	switch {
+	case strings.HasPrefix(addr, "unix:"):
+		res.Address = addr
+	case strings.HasPrefix(addr, "["):
+		// IPv6, e.g. [::]:443 or [::1]
+		end := strings.Index(addr, "]")
+		if end < 0 {
+			res.Address = addr
+			break
+		}
+		res.Address = addr[:end+1]
+		if rest := addr[end+1:]; strings.HasPrefix(rest, ":") {
+			res.Port, _ = strconv.Atoi(rest[1:])
+		} else {
+			res.Port = 80
+		}
+	default:
+		if port, err := strconv.Atoi(addr); err == nil {
+			res.Port = port
+		} else if idx := strings.LastIndex(addr, ":"); idx >= 0 {
+			res.Address = addr[:idx]
+			res.Port, _ = strconv.Atoi(addr[idx+1:])
+		} else {
+			res.Address = addr
+			res.Port = 80
+		}
+	}
+
+	for _, arg := range args[1:] {
+		switch arg {
+		case "ssl":
+			res.SSL = true
+		case "http2":
+			res.HTTP2 = true
+		case "default_server", "default":
+			res.DefaultServer = true
+		}
+	}
+	return res
+}
+
+// headers collects add_header directives. Just like nginx, headers are only
+// inherited from the parent level if the current level defines none.
+func headers(directives []*Directive, parent map[string]any) map[string]any {
+	res := map[string]any{}
+	for _, d := range directives {
+		if d.Name != "add_header" || len(d.Args) < 2 {
+			continue
+		}
+		res[d.Args[0]] = d.Args[1]
+	}
+	if len(res) == 0 {
+		for k, v := range parent {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func find(directives []*Directive, name string) *Directive {
+	var res *Directive
+	for _, d := range directives {
+		if d.Name == name {
+			res = d
+		}
+	}
+	return res
+}
+
+// setParams sets all simple directives as params. Directives that appear
+// multiple times (listen, add_header, etc.) are comma-concatenated.
+func setParams(m map[string]any, directives []*Directive) {
+	for _, d := range directives {
+		if d.IsBlock() {
+			continue
+		}
+		value := d.Value()
+		if v, ok := m[d.Name]; ok {
+			m[d.Name] = v.(string) + "," + value
+			continue
+		}
+		m[d.Name] = value
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nginx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Directive is a single nginx directive, e.g. `listen 443 ssl;`. Block
+// directives like `server { ... }` carry their children in Block.
+type Directive struct {
+	Name  string
+	Args  []string
+	File  string
+	Line  int
+	Block []*Directive // nil for simple directives
+}
+
+// IsBlock returns true if the directive opens a block
+func (d *Directive) IsBlock() bool {
+	return d.Block != nil
+}
+
+// Value returns all arguments joined by a space
+func (d *Directive) Value() string {
+	return strings.Join(d.Args, " ")
+}
+
+// Config is the parsed result of nginx configuration files.
+type Config struct {
+	Directives []*Directive // top-level (main context) directives, includes resolved in place
+	Includes   []string     // include patterns (unexpanded)
+}
+
+type (
+	fileContentFunc func(string) (string, error)
+	globExpandFunc  func(string) ([]string, error)
+)
+
+// Parse parses a single nginx config file content without following includes.
+func Parse(path string, content string) (*Config, error) {
+	cfg := &Config{}
+	directives, err := parseFile(cfg, path, content, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Directives = directives
+	return cfg, nil
+}
+
+// ParseWithGlob parses nginx config files, recursively expanding include
+// directives using the provided glob and file-content functions. Included
+// directives replace the include directive in the tree.
+func ParseWithGlob(rootPath string, fileContent fileContentFunc, globExpand globExpandFunc) (*Config, error) {
+	content, err := fileContent(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	directives, err := parseFile(cfg, rootPath, content, fileContent, globExpand, 0)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Directives = directives
+	return cfg, nil
+}
+
+// maxIncludeDepth protects against include loops
+const maxIncludeDepth = 16
+
+func parseFile(cfg *Config, path string, content string, fileContent fileContentFunc, globExpand globExpandFunc, depth int) ([]*Directive, error) {
+	tokens, err := tokenize(content)
+	if err != nil {
+		return nil, errors.New("failed to parse " + path + ": " + err.Error())
+	}
+
+	p := parser{
+		cfg:         cfg,
+		path:        path,
+		tokens:      tokens,
+		fileContent: fileContent,
+		globExpand:  globExpand,
+		depth:       depth,
+	}
+	res, err := p.parseBlock(false)
+	if err != nil {
+		return nil, errors.New("failed to parse " + path + ": " + err.Error())
+	}
+	return res, nil
+}
+
+type parser struct {
+	cfg         *Config
+	path        string
+	tokens      []token
+	pos         int
+	fileContent fileContentFunc
+	globExpand  globExpandFunc
+	depth       int
+}
+
+func (p *parser) parseBlock(nested bool) ([]*Directive, error) {
+	res := []*Directive{}
+	for p.pos < len(p.tokens) {
+		tok := p.tokens[p.pos]
+		p.pos++
+
+		if !tok.quoted {
+			switch tok.value {
+			case "}":
+				if !nested {
+					return nil, errors.New("unexpected '}' in line " + strconv.Itoa(tok.line))
+				}
+				return res, nil
+			case "{", ";":
+				return nil, errors.New("unexpected '" + tok.value + "' in line " + strconv.Itoa(tok.line))
+			}
+		}
+
+		d := &Directive{
+			Name: tok.value,
+			Args: []string{},
+			File: p.path,
+			Line: tok.line,
+		}
+
+		terminated := false
+		for p.pos < len(p.tokens) && !terminated {
+			cur := p.tokens[p.pos]
+			p.pos++
+
+			if cur.quoted {
+				d.Args = append(d.Args, cur.value)
+				continue
+			}
+
+			switch cur.value {
+			case ";":
+				terminated = true
+			case "{":
+				block, err := p.parseBlock(true)
+				if err != nil {
+					return nil, err
+				}
+				d.Block = block
+				terminated = true
+			case "}":
+				return nil, errors.New("unexpected '}' in line " + strconv.Itoa(cur.line))
+			default:
+				d.Args = append(d.Args, cur.value)
+			}
+		}
+		if !terminated {
+			return nil, errors.New("unexpected end of file, expecting ';' or '}'")
+		}
+
+		if d.Name == "include" && !d.IsBlock() {
+			res = append(res, p.include(d)...)
+			continue
+		}
+		res = append(res, d)
+	}
+
+	if nested {
+		return nil, errors.New("unexpected end of file, expecting '}'")
+	}
+	return res, nil
+}
+
+// include expands an include directive. If includes cannot be followed, the
+// include directive itself is kept in the tree.
+func (p *parser) include(d *Directive) []*Directive {
+	if len(d.Args) == 0 {
+		return []*Directive{d}
+	}
+	pattern := d.Args[0]
+	p.cfg.Includes = append(p.cfg.Includes, pattern)
+
+	if p.globExpand == nil || p.fileContent == nil {
+		return []*Directive{d}
+	}
+	if p.depth >= maxIncludeDepth {
+		log.Warn().Str("pattern", pattern).Msg("nginx> too many nested include directives")
+		return []*Directive{d}
+	}
+
+	paths, err := p.globExpand(pattern)
+	if err != nil {
+		log.Warn().Err(err).Str("pattern", pattern).Msg("nginx> unable to expand include directive")
+		return []*Directive{d}
+	}
+
+	var res []*Directive
+	for _, path := range paths {
+		content, err := p.fileContent(path)
+		if err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("nginx> unable to read included file")
+			continue
+		}
+		directives, err := parseFile(p.cfg, path, content, p.fileContent, p.globExpand, p.depth+1)
+		if err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("nginx> unable to parse included file")
+			continue
+		}
+		res = append(res, directives...)
+	}
+	return res
+}
+
+type token struct {
+	value  string
+	line   int
+	quoted bool
+}
+
+// tokenize splits nginx configuration into words, quoted strings and the
+// special characters `{`, `}` and `;`. Comments are dropped.
+func tokenize(content string) ([]token, error) {
+	var res []token
+	var word strings.Builder
+	line := 1
+	wordLine := 1
+
+	flush := func() {
+		if word.Len() > 0 {
+			res = append(res, token{value: word.String(), line: wordLine})
+			word.Reset()
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\n':
+			flush()
+			line++
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		case c == '#' && word.Len() == 0:
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '{' && strings.HasSuffix(word.String(), "$"):
+			// variables like ${host} are part of the word
+			for ; i < len(content) && content[i] != '}'; i++ {
+				word.WriteByte(content[i])
+			}
+			if i < len(content) {
+				word.WriteByte('}')
+			}
+		case c == ';' || c == '{' || c == '}':
+			flush()
+			res = append(res, token{value: string(c), line: line})
+		case (c == '"' || c == '\'') && word.Len() == 0:
+			quote := c
+			start := line
+			var str strings.Builder
+			i++
+			for ; i < len(content) && content[i] != quote; i++ {
+				if content[i] == '\\' && i+1 < len(content) && (content[i+1] == quote || content[i+1] == '\\') {
+					i++
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				str.WriteByte(content[i])
+			}
+			if i >= len(content) {
+				return nil, errors.New("unterminated string in line " + strconv.Itoa(start))
+			}
+			res = append(res, token{value: str.String(), line: start, quoted: true})
+		case c == '\\' && i+1 < len(content):
+			if word.Len() == 0 {
+				wordLine = line
+			}
+			word.WriteByte(c)
+			word.WriteByte(content[i+1])
+			i++
+		default:
+			if word.Len() == 0 {
+				wordLine = line
+			}
+			word.WriteByte(c)
+		}
+	}
+	flush()
+
+	return res, nil
+}
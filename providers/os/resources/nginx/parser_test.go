@@ -0,0 +1,216 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nginx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const basicConfig = `
+user www-data;
+worker_processes auto;
+pid /run/nginx.pid;
+
+events {
+    worker_connections 768; # inline comment
+}
+
+http {
+    sendfile on;
+    server_tokens off;
+    ssl_protocols TLSv1.2 TLSv1.3;
+    ssl_ciphers 'ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256';
+    add_header X-Frame-Options DENY;
+    log_format main '$remote_addr - $remote_user [$time_local] "$request"';
+
+    server {
+        listen 80 default_server;
+        listen [::]:80 default_server;
+        server_name _;
+        return 301 https://$host$request_uri;
+    }
+
+    server {
+        listen 443 ssl http2;
+        listen [::]:443 ssl http2;
+        server_name example.com www.example.com;
+        ssl_protocols TLSv1.3;
+        add_header Strict-Transport-Security "max-age=31536000; includeSubDomains" always;
+
+        location / {
+            root /var/www/html;
+        }
+
+        location ~* \.(png|jpg)$ {
+            expires 30d;
+            add_header Cache-Control public;
+
+            location = /logo.png {
+                access_log off;
+            }
+        }
+    }
+}
+`
+
+func TestParseDirectives(t *testing.T) {
+	cfg, err := Parse("/etc/nginx/nginx.conf", basicConfig)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Directives, 5)
+	assert.Equal(t, "user", cfg.Directives[0].Name)
+	assert.Equal(t, []string{"www-data"}, cfg.Directives[0].Args)
+	assert.Equal(t, 2, cfg.Directives[0].Line)
+	assert.Equal(t, "/etc/nginx/nginx.conf", cfg.Directives[0].File)
+	assert.False(t, cfg.Directives[0].IsBlock())
+
+	events := cfg.Directives[3]
+	assert.Equal(t, "events", events.Name)
+	require.True(t, events.IsBlock())
+	require.Len(t, events.Block, 1)
+	assert.Equal(t, []string{"768"}, events.Block[0].Args)
+
+	params := cfg.Params()
+	assert.Equal(t, "www-data", params["user"])
+	assert.Equal(t, "auto", params["worker_processes"])
+	assert.NotContains(t, params, "events")
+}
+
+func TestParseHTTP(t *testing.T) {
+	cfg, err := Parse("/etc/nginx/nginx.conf", basicConfig)
+	require.NoError(t, err)
+
+	http := cfg.HTTP()
+	require.NotNil(t, http)
+	assert.Equal(t, "off", http.Params["server_tokens"])
+	assert.Equal(t, "main $remote_addr - $remote_user [$time_local] \"$request\"", http.Params["log_format"])
+	assert.Equal(t, []string{"TLSv1.2", "TLSv1.3"}, http.SSLProtocols)
+	assert.Equal(t, "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256", http.SSLCiphers)
+	assert.Equal(t, map[string]any{"X-Frame-Options": "DENY"}, http.Headers)
+	require.Len(t, http.Servers, 2)
+
+	plain := http.Servers[0]
+	assert.Equal(t, []string{"_"}, plain.ServerNames)
+	assert.False(t, plain.SSL)
+	require.Len(t, plain.Listen, 2)
+	assert.Equal(t, 80, plain.Listen[0].Port)
+	assert.True(t, plain.Listen[0].DefaultServer)
+	assert.Equal(t, "[::]", plain.Listen[1].Address)
+	assert.Equal(t, "80 default_server,[::]:80 default_server", plain.Params["listen"])
+	// inherited from http
+	assert.Equal(t, []string{"TLSv1.2", "TLSv1.3"}, plain.SSLProtocols)
+	assert.Equal(t, map[string]any{"X-Frame-Options": "DENY"}, plain.Headers)
+
+	tls := http.Servers[1]
+	assert.Equal(t, []string{"example.com", "www.example.com"}, tls.ServerNames)
+	assert.True(t, tls.SSL)
+	assert.True(t, tls.Listen[0].HTTP2)
+	assert.Equal(t, []string{"TLSv1.3"}, tls.SSLProtocols)
+	assert.Equal(t, "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256", tls.SSLCiphers)
+	// add_header in the server replaces the headers from http
+	assert.Equal(t, map[string]any{"Strict-Transport-Security": "max-age=31536000; includeSubDomains"}, tls.Headers)
+
+	require.Len(t, tls.Locations, 2)
+	assert.Equal(t, "", tls.Locations[0].Modifier)
+	assert.Equal(t, "/", tls.Locations[0].Path)
+	assert.Equal(t, "/var/www/html", tls.Locations[0].Params["root"])
+	assert.Equal(t, tls.Headers, tls.Locations[0].Headers)
+
+	images := tls.Locations[1]
+	assert.Equal(t, "~*", images.Modifier)
+	assert.Equal(t, `\.(png|jpg)$`, images.Path)
+	assert.Equal(t, map[string]any{"Cache-Control": "public"}, images.Headers)
+	require.Len(t, images.Locations, 1)
+	assert.Equal(t, "=", images.Locations[0].Modifier)
+	assert.Equal(t, "/logo.png", images.Locations[0].Path)
+	assert.Equal(t, images.Headers, images.Locations[0].Headers)
+}
+
+func TestParseListen(t *testing.T) {
+	tests := []struct {
+		args []string
+		res  Listen
+	}{
+		{[]string{"80"}, Listen{Port: 80}},
+		{[]string{"127.0.0.1:8080"}, Listen{Address: "127.0.0.1", Port: 8080}},
+		{[]string{"*:443", "ssl", "default"}, Listen{Address: "*", Port: 443, SSL: true, DefaultServer: true}},
+		{[]string{"[::1]"}, Listen{Address: "[::1]", Port: 80}},
+		{[]string{"[::]:443", "ssl", "http2"}, Listen{Address: "[::]", Port: 443, SSL: true, HTTP2: true}},
+		{[]string{"localhost"}, Listen{Address: "localhost", Port: 80}},
+		{[]string{"unix:/var/run/nginx.sock"}, Listen{Address: "unix:/var/run/nginx.sock"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.args[0], func(t *testing.T) {
+			test.res.Args = test.args
+			assert.Equal(t, test.res, ParseListen(test.args))
+		})
+	}
+}
+
+func TestParseWithGlob(t *testing.T) {
+	files := map[string]string{
+		"/etc/nginx/nginx.conf": `
+http {
+    include /etc/nginx/conf.d/*.conf;
+    include /etc/nginx/missing.conf;
+}`,
+		"/etc/nginx/conf.d/a.conf":     `server { listen 80; server_name a; }`,
+		"/etc/nginx/conf.d/b.conf":     `server { listen 443 ssl; server_name b; include /etc/nginx/snippets/ssl.conf; }`,
+		"/etc/nginx/snippets/ssl.conf": `ssl_protocols TLSv1.2;`,
+	}
+	fileContent := func(path string) (string, error) {
+		if content, ok := files[path]; ok {
+			return content, nil
+		}
+		return "", errors.New("file not found")
+	}
+	globExpand := func(pattern string) ([]string, error) {
+		if pattern == "/etc/nginx/conf.d/*.conf" {
+			return []string{"/etc/nginx/conf.d/a.conf", "/etc/nginx/conf.d/b.conf"}, nil
+		}
+		return []string{pattern}, nil
+	}
+
+	cfg, err := ParseWithGlob("/etc/nginx/nginx.conf", fileContent, globExpand)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/etc/nginx/conf.d/*.conf", "/etc/nginx/snippets/ssl.conf", "/etc/nginx/missing.conf"}, cfg.Includes)
+
+	http := cfg.HTTP()
+	require.NotNil(t, http)
+	require.Len(t, http.Servers, 2)
+	assert.Equal(t, []string{"a"}, http.Servers[0].ServerNames)
+	assert.Equal(t, []string{"b"}, http.Servers[1].ServerNames)
+	assert.Equal(t, []string{"TLSv1.2"}, http.Servers[1].SSLProtocols)
+
+	// included directives remember where they came from
+	server := cfg.Directives[0].Block[0]
+	assert.Equal(t, "/etc/nginx/conf.d/a.conf", server.File)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("nginx.conf", "http {\n server {\n}")
+	assert.EqualError(t, err, "failed to parse nginx.conf: unexpected end of file, expecting '}'")
+
+	_, err = Parse("nginx.conf", "user nginx")
+	assert.EqualError(t, err, "failed to parse nginx.conf: unexpected end of file, expecting ';' or '}'")
+
+	_, err = Parse("nginx.conf", "}")
+	assert.EqualError(t, err, "failed to parse nginx.conf: unexpected '}' in line 1")
+
+	_, err = Parse("nginx.conf", "return 200 \"unterminated;\n")
+	assert.EqualError(t, err, "failed to parse nginx.conf: unterminated string in line 1")
+}
+
+func TestTokenizeVariables(t *testing.T) {
+	cfg, err := Parse("nginx.conf", `set $x "${host}_a"; return 200 ${scheme}://$host;`)
+	require.NoError(t, err)
+	require.Len(t, cfg.Directives, 2)
+	assert.Equal(t, []string{"$x", "${host}_a"}, cfg.Directives[0].Args)
+	assert.Equal(t, []string{"200", "${scheme}://$host"}, cfg.Directives[1].Args)
+}
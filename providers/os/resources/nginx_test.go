@@ -0,0 +1,76 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNginxConfIncludes(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/nginx.toml")
+	conf := newTestResource[*mqlNginxConf](t, conn, "nginx.conf", nil)
+
+	params := conf.GetParams()
+	require.NoError(t, params.Error)
+	assert.Equal(t, "www-data", params.Data["user"])
+
+	files := conf.GetFiles()
+	require.NoError(t, files.Error)
+	paths := []string{}
+	for _, f := range files.Data {
+		paths = append(paths, f.(*mqlFile).Path.Data)
+	}
+	assert.ElementsMatch(t, []string{
+		"/etc/nginx/nginx.conf",
+		"/etc/nginx/mime.types",
+		"/etc/nginx/sites-enabled/default",
+		"/etc/nginx/sites-enabled/example.com",
+	}, paths)
+
+	servers := conf.GetServers()
+	require.NoError(t, servers.Error)
+	require.Len(t, servers.Data, 2)
+
+	plain := servers.Data[0].(*mqlNginxConfServer)
+	assert.False(t, plain.Ssl.Data)
+	assert.Equal(t, []any{"TLSv1.2", "TLSv1.3"}, plain.SslProtocols.Data)
+	assert.Equal(t, map[string]any{"X-Content-Type-Options": "nosniff"}, plain.Headers.Data)
+
+	tls := servers.Data[1].(*mqlNginxConfServer)
+	assert.True(t, tls.Ssl.Data)
+	assert.Equal(t, []any{"example.com"}, tls.ServerNames.Data)
+	assert.Equal(t, []any{"TLSv1.3"}, tls.SslProtocols.Data)
+	require.Len(t, tls.Listen.Data, 1)
+	assert.Equal(t, int64(443), tls.Listen.Data[0].(*mqlNginxConfListen).Port.Data)
+	require.Len(t, tls.Locations.Data, 1)
+	assert.Equal(t, "/", tls.Locations.Data[0].(*mqlNginxConfLocation).Path.Data)
+
+	// the include directives are replaced by the included content
+	directives := conf.GetDirectives()
+	require.NoError(t, directives.Error)
+	require.Len(t, directives.Data, 3)
+	http := directives.Data[2].(*mqlNginxConfDirective)
+	assert.Equal(t, "http", http.Name.Data)
+	names := []string{}
+	for _, d := range http.Block.Data {
+		names = append(names, d.(*mqlNginxConfDirective).Name.Data)
+	}
+	assert.Equal(t, []string{"ssl_protocols", "add_header", "types", "server", "server"}, names)
+	assert.Equal(t, "/etc/nginx/sites-enabled/example.com", http.Block.Data[4].(*mqlNginxConfDirective).File.Data)
+}
+
+func TestNginxConfMissing(t *testing.T) {
+	conn := newMockConnection(t, nil, "")
+	conf := newTestResource[*mqlNginxConf](t, conn, "nginx.conf", nil)
+
+	servers := conf.GetServers()
+	require.NoError(t, servers.Error)
+	assert.Empty(t, servers.Data)
+	params := conf.GetParams()
+	require.NoError(t, params.Error)
+	assert.Empty(t, params.Data)
+}
@@ -80,6 +80,12 @@ const (
 	ResourceApache2ConfModule          string = "apache2.conf.module"
 	ResourceApache2ConfVirtualHost     string = "apache2.conf.virtualHost"
 	ResourceApache2ConfDirectory       string = "apache2.conf.directory"
+	ResourceNginxConf                  string = "nginx.conf"
+	ResourceNginxConfDirective         string = "nginx.conf.directive"
+	ResourceNginxConfHttp              string = "nginx.conf.http"
+	ResourceNginxConfServer            string = "nginx.conf.server"
+	ResourceNginxConfListen            string = "nginx.conf.listen"
+	ResourceNginxConfLocation          string = "nginx.conf.location"
 	ResourceJournaldConfig             string = "journald.config"
 	ResourceJournaldConfigSection      string = "journald.config.section"
 	ResourceJournaldConfigSectionParam string = "journald.config.section.param"
@@ -87,6 +93,18 @@ const (
 	ResourceServices                   string = "services"
 	ResourceKernel                     string = "kernel"
 	ResourceKernelModule               string = "kernel.module"
+	ResourceBootloader                 string = "bootloader"
+	ResourceBootloaderGrub             string = "bootloader.grub"
+	ResourceBootloaderSystemdBoot      string = "bootloader.systemdBoot"
+	ResourceBootloaderEntry            string = "bootloader.entry"
+	ResourceSysctl                     string = "sysctl"
+	ResourceSysctlEntry                string = "sysctl.entry"
+	ResourceSelinux                    string = "selinux"
+	ResourceSelinuxBoolean             string = "selinux.boolean"
+	ResourceSelinuxFileContext         string = "selinux.fileContext"
+	ResourceSelinuxModule              string = "selinux.module"
+	ResourceApparmor                   string = "apparmor"
+	ResourceApparmorProfile            string = "apparmor.profile"
 	ResourceDocker                     string = "docker"
 	ResourceDockerFile                 string = "docker.file"
 	ResourceDockerFileStage            string = "docker.file.stage"
@@ -113,6 +131,12 @@ const (
 	ResourceNftablesTable              string = "nftables.table"
 	ResourceNftablesChain              string = "nftables.chain"
 	ResourceNftablesRule               string = "nftables.rule"
+	ResourceFirewalld                  string = "firewalld"
+	ResourceFirewalldZone              string = "firewalld.zone"
+	ResourceFirewalldPort              string = "firewalld.port"
+	ResourceFirewalldRule              string = "firewalld.rule"
+	ResourceUfw                        string = "ufw"
+	ResourceUfwRule                    string = "ufw.rule"
 	ResourceFstab                      string = "fstab"
 	ResourceFstabEntry                 string = "fstab.entry"
 	ResourceProcess                    string = "process"
@@ -155,6 +179,16 @@ const (
 	ResourcePythonPackage              string = "python.package"
 	ResourceNpmPackages                string = "npm.packages"
 	ResourceNpmPackage                 string = "npm.package"
+	ResourceJavaPackages               string = "java.packages"
+	ResourceJavaPackage                string = "java.package"
+	ResourceGoModules                  string = "go.modules"
+	ResourceGoModule                   string = "go.module"
+	ResourceRustPackages               string = "rust.packages"
+	ResourceRustPackage                string = "rust.package"
+	ResourceRubyPackages               string = "ruby.packages"
+	ResourceRubyPackage                string = "ruby.package"
+	ResourcePhpPackages                string = "php.packages"
+	ResourcePhpPackage                 string = "php.package"
 	ResourceMacos                      string = "macos"
 	ResourceMacosHardware              string = "macos.hardware"
 	ResourceMacosAlf                   string = "macos.alf"
@@ -462,6 +496,30 @@ func init() {
 			// to override args, implement: initApache2ConfDirectory(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createApache2ConfDirectory,
 		},
+		"nginx.conf": {
+			Init:   initNginxConf,
+			Create: createNginxConf,
+		},
+		"nginx.conf.directive": {
+			// to override args, implement: initNginxConfDirective(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNginxConfDirective,
+		},
+		"nginx.conf.http": {
+			// to override args, implement: initNginxConfHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNginxConfHttp,
+		},
+		"nginx.conf.server": {
+			// to override args, implement: initNginxConfServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNginxConfServer,
+		},
+		"nginx.conf.listen": {
+			// to override args, implement: initNginxConfListen(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNginxConfListen,
+		},
+		"nginx.conf.location": {
+			// to override args, implement: initNginxConfLocation(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createNginxConfLocation,
+		},
 		"journald.config": {
 			Init:   initJournaldConfig,
 			Create: createJournaldConfig,
@@ -490,6 +548,54 @@ func init() {
 			Init:   initKernelModule,
 			Create: createKernelModule,
 		},
+		"bootloader": {
+			// to override args, implement: initBootloader(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createBootloader,
+		},
+		"bootloader.grub": {
+			// to override args, implement: initBootloaderGrub(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createBootloaderGrub,
+		},
+		"bootloader.systemdBoot": {
+			// to override args, implement: initBootloaderSystemdBoot(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createBootloaderSystemdBoot,
+		},
+		"bootloader.entry": {
+			// to override args, implement: initBootloaderEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createBootloaderEntry,
+		},
+		"sysctl": {
+			Init:   initSysctl,
+			Create: createSysctl,
+		},
+		"sysctl.entry": {
+			// to override args, implement: initSysctlEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSysctlEntry,
+		},
+		"selinux": {
+			// to override args, implement: initSelinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinux,
+		},
+		"selinux.boolean": {
+			// to override args, implement: initSelinuxBoolean(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinuxBoolean,
+		},
+		"selinux.fileContext": {
+			// to override args, implement: initSelinuxFileContext(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinuxFileContext,
+		},
+		"selinux.module": {
+			// to override args, implement: initSelinuxModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSelinuxModule,
+		},
+		"apparmor": {
+			// to override args, implement: initApparmor(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createApparmor,
+		},
+		"apparmor.profile": {
+			// to override args, implement: initApparmorProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createApparmorProfile,
+		},
 		"docker": {
 			// to override args, implement: initDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createDocker,
@@ -594,6 +700,30 @@ func init() {
 			// to override args, implement: initNftablesRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createNftablesRule,
 		},
+		"firewalld": {
+			// to override args, implement: initFirewalld(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createFirewalld,
+		},
+		"firewalld.zone": {
+			// to override args, implement: initFirewalldZone(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createFirewalldZone,
+		},
+		"firewalld.port": {
+			// to override args, implement: initFirewalldPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createFirewalldPort,
+		},
+		"firewalld.rule": {
+			// to override args, implement: initFirewalldRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createFirewalldRule,
+		},
+		"ufw": {
+			// to override args, implement: initUfw(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createUfw,
+		},
+		"ufw.rule": {
+			// to override args, implement: initUfwRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createUfwRule,
+		},
 		"fstab": {
 			Init:   initFstab,
 			Create: createFstab,
@@ -762,6 +892,46 @@ func init() {
 			// to override args, implement: initNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createNpmPackage,
 		},
+		"java.packages": {
+			Init:   initJavaPackages,
+			Create: createJavaPackages,
+		},
+		"java.package": {
+			// to override args, implement: initJavaPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createJavaPackage,
+		},
+		"go.modules": {
+			Init:   initGoModules,
+			Create: createGoModules,
+		},
+		"go.module": {
+			// to override args, implement: initGoModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createGoModule,
+		},
+		"rust.packages": {
+			Init:   initRustPackages,
+			Create: createRustPackages,
+		},
+		"rust.package": {
+			// to override args, implement: initRustPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRustPackage,
+		},
+		"ruby.packages": {
+			Init:   initRubyPackages,
+			Create: createRubyPackages,
+		},
+		"ruby.package": {
+			// to override args, implement: initRubyPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createRubyPackage,
+		},
+		"php.packages": {
+			Init:   initPhpPackages,
+			Create: createPhpPackages,
+		},
+		"php.package": {
+			// to override args, implement: initPhpPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createPhpPackage,
+		},
 		"macos": {
 			// to override args, implement: initMacos(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createMacos,
@@ -1068,6 +1238,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"vulnmgmt.cves": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnmgmt).GetCves()).ToDataRes(types.Array(types.Resource("vuln.cve")))
 	},
+	"vulnmgmt.suppressedCves": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnmgmt).GetSuppressedCves()).ToDataRes(types.Array(types.Resource("vuln.cve")))
+	},
 	"vulnmgmt.advisories": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnmgmt).GetAdvisories()).ToDataRes(types.Array(types.Resource("vuln.advisory")))
 	},
@@ -1101,6 +1274,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"vuln.cve.worstScore": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnCve).GetWorstScore()).ToDataRes(types.Resource("audit.cvss"))
 	},
+	"vuln.cve.vex": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlVulnCve).GetVex()).ToDataRes(types.Resource("vulnerability.exchange"))
+	},
 	"vuln.advisory.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlVulnAdvisory).GetId()).ToDataRes(types.String)
 	},
@@ -1899,6 +2075,111 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"apache2.conf.directory.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlApache2ConfDirectory).GetParams()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"nginx.conf.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"nginx.conf.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
+	"nginx.conf.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetParams()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.directives": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetDirectives()).ToDataRes(types.Array(types.Resource("nginx.conf.directive")))
+	},
+	"nginx.conf.http": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetHttp()).ToDataRes(types.Resource("nginx.conf.http"))
+	},
+	"nginx.conf.servers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConf).GetServers()).ToDataRes(types.Array(types.Resource("nginx.conf.server")))
+	},
+	"nginx.conf.directive.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfDirective).GetName()).ToDataRes(types.String)
+	},
+	"nginx.conf.directive.args": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfDirective).GetArgs()).ToDataRes(types.Array(types.String))
+	},
+	"nginx.conf.directive.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfDirective).GetFile()).ToDataRes(types.String)
+	},
+	"nginx.conf.directive.line": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfDirective).GetLine()).ToDataRes(types.Int)
+	},
+	"nginx.conf.directive.block": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfDirective).GetBlock()).ToDataRes(types.Array(types.Resource("nginx.conf.directive")))
+	},
+	"nginx.conf.http.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfHttp).GetParams()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.http.sslProtocols": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfHttp).GetSslProtocols()).ToDataRes(types.Array(types.String))
+	},
+	"nginx.conf.http.sslCiphers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfHttp).GetSslCiphers()).ToDataRes(types.String)
+	},
+	"nginx.conf.http.headers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfHttp).GetHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.http.servers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfHttp).GetServers()).ToDataRes(types.Array(types.Resource("nginx.conf.server")))
+	},
+	"nginx.conf.server.serverNames": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetServerNames()).ToDataRes(types.Array(types.String))
+	},
+	"nginx.conf.server.listen": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetListen()).ToDataRes(types.Array(types.Resource("nginx.conf.listen")))
+	},
+	"nginx.conf.server.ssl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetSsl()).ToDataRes(types.Bool)
+	},
+	"nginx.conf.server.sslProtocols": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetSslProtocols()).ToDataRes(types.Array(types.String))
+	},
+	"nginx.conf.server.sslCiphers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetSslCiphers()).ToDataRes(types.String)
+	},
+	"nginx.conf.server.headers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.server.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetParams()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.server.locations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfServer).GetLocations()).ToDataRes(types.Array(types.Resource("nginx.conf.location")))
+	},
+	"nginx.conf.listen.address": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetAddress()).ToDataRes(types.String)
+	},
+	"nginx.conf.listen.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetPort()).ToDataRes(types.Int)
+	},
+	"nginx.conf.listen.ssl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetSsl()).ToDataRes(types.Bool)
+	},
+	"nginx.conf.listen.http2": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetHttp2()).ToDataRes(types.Bool)
+	},
+	"nginx.conf.listen.defaultServer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetDefaultServer()).ToDataRes(types.Bool)
+	},
+	"nginx.conf.listen.args": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfListen).GetArgs()).ToDataRes(types.Array(types.String))
+	},
+	"nginx.conf.location.modifier": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfLocation).GetModifier()).ToDataRes(types.String)
+	},
+	"nginx.conf.location.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfLocation).GetPath()).ToDataRes(types.String)
+	},
+	"nginx.conf.location.headers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfLocation).GetHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.location.params": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfLocation).GetParams()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"nginx.conf.location.locations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlNginxConfLocation).GetLocations()).ToDataRes(types.Array(types.Resource("nginx.conf.location")))
+	},
 	"journald.config.file": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlJournaldConfig).GetFile()).ToDataRes(types.Resource("file"))
 	},
@@ -1968,6 +2249,177 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"kernel.module.loaded": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlKernelModule).GetLoaded()).ToDataRes(types.Bool)
 	},
+	"bootloader.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloader).GetType()).ToDataRes(types.String)
+	},
+	"bootloader.grub": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloader).GetGrub()).ToDataRes(types.Resource("bootloader.grub"))
+	},
+	"bootloader.systemdBoot": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloader).GetSystemdBoot()).ToDataRes(types.Resource("bootloader.systemdBoot"))
+	},
+	"bootloader.entries": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloader).GetEntries()).ToDataRes(types.Array(types.Resource("bootloader.entry")))
+	},
+	"bootloader.grub.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"bootloader.grub.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
+	"bootloader.grub.defaults": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetDefaults()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"bootloader.grub.superusers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetSuperusers()).ToDataRes(types.Array(types.String))
+	},
+	"bootloader.grub.passwordProtected": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetPasswordProtected()).ToDataRes(types.Bool)
+	},
+	"bootloader.grub.bls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetBls()).ToDataRes(types.Bool)
+	},
+	"bootloader.grub.entries": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderGrub).GetEntries()).ToDataRes(types.Array(types.Resource("bootloader.entry")))
+	},
+	"bootloader.systemdBoot.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderSystemdBoot).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"bootloader.systemdBoot.default": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderSystemdBoot).GetDefault()).ToDataRes(types.String)
+	},
+	"bootloader.systemdBoot.timeout": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderSystemdBoot).GetTimeout()).ToDataRes(types.Int)
+	},
+	"bootloader.systemdBoot.editor": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderSystemdBoot).GetEditor()).ToDataRes(types.Bool)
+	},
+	"bootloader.systemdBoot.entries": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderSystemdBoot).GetEntries()).ToDataRes(types.Array(types.Resource("bootloader.entry")))
+	},
+	"bootloader.entry.title": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetTitle()).ToDataRes(types.String)
+	},
+	"bootloader.entry.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetVersion()).ToDataRes(types.String)
+	},
+	"bootloader.entry.kernel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetKernel()).ToDataRes(types.String)
+	},
+	"bootloader.entry.initrd": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetInitrd()).ToDataRes(types.Array(types.String))
+	},
+	"bootloader.entry.cmdline": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetCmdline()).ToDataRes(types.String)
+	},
+	"bootloader.entry.parameters": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetParameters()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"bootloader.entry.unrestricted": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetUnrestricted()).ToDataRes(types.Bool)
+	},
+	"bootloader.entry.source": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlBootloaderEntry).GetSource()).ToDataRes(types.String)
+	},
+	"sysctl.runtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctl).GetRuntime()).ToDataRes(types.Bool)
+	},
+	"sysctl.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctl).GetFiles()).ToDataRes(types.Array(types.Resource("file")))
+	},
+	"sysctl.entries": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctl).GetEntries()).ToDataRes(types.Array(types.Resource("sysctl.entry")))
+	},
+	"sysctl.entry.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctlEntry).GetName()).ToDataRes(types.String)
+	},
+	"sysctl.entry.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctlEntry).GetValue()).ToDataRes(types.String)
+	},
+	"sysctl.entry.persistedValue": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctlEntry).GetPersistedValue()).ToDataRes(types.String)
+	},
+	"sysctl.entry.source": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctlEntry).GetSource()).ToDataRes(types.String)
+	},
+	"sysctl.entry.drift": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSysctlEntry).GetDrift()).ToDataRes(types.Bool)
+	},
+	"selinux.runtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetRuntime()).ToDataRes(types.Bool)
+	},
+	"selinux.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"selinux.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetMode()).ToDataRes(types.String)
+	},
+	"selinux.configMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetConfigMode()).ToDataRes(types.String)
+	},
+	"selinux.policyType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetPolicyType()).ToDataRes(types.String)
+	},
+	"selinux.policyVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetPolicyVersion()).ToDataRes(types.Int)
+	},
+	"selinux.booleans": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetBooleans()).ToDataRes(types.Array(types.Resource("selinux.boolean")))
+	},
+	"selinux.fileContexts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetFileContexts()).ToDataRes(types.Array(types.Resource("selinux.fileContext")))
+	},
+	"selinux.modules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinux).GetModules()).ToDataRes(types.Array(types.Resource("selinux.module")))
+	},
+	"selinux.boolean.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetName()).ToDataRes(types.String)
+	},
+	"selinux.boolean.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetValue()).ToDataRes(types.Bool)
+	},
+	"selinux.boolean.pending": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxBoolean).GetPending()).ToDataRes(types.Bool)
+	},
+	"selinux.fileContext.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetPath()).ToDataRes(types.String)
+	},
+	"selinux.fileContext.fileType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetFileType()).ToDataRes(types.String)
+	},
+	"selinux.fileContext.context": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetContext()).ToDataRes(types.String)
+	},
+	"selinux.fileContext.local": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxFileContext).GetLocal()).ToDataRes(types.Bool)
+	},
+	"selinux.module.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetName()).ToDataRes(types.String)
+	},
+	"selinux.module.priority": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetPriority()).ToDataRes(types.Int)
+	},
+	"selinux.module.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSelinuxModule).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"apparmor.runtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetRuntime()).ToDataRes(types.Bool)
+	},
+	"apparmor.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"apparmor.profiles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmor).GetProfiles()).ToDataRes(types.Array(types.Resource("apparmor.profile")))
+	},
+	"apparmor.profile.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProfile).GetName()).ToDataRes(types.String)
+	},
+	"apparmor.profile.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProfile).GetMode()).ToDataRes(types.String)
+	},
+	"apparmor.profile.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlApparmorProfile).GetFile()).ToDataRes(types.String)
+	},
 	"docker.images": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDocker).GetImages()).ToDataRes(types.Array(types.Resource("docker.image")))
 	},
@@ -2316,6 +2768,180 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"nftables.rule.comment": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNftablesRule).GetComment()).ToDataRes(types.String)
 	},
+	"firewalld.runtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalld).GetRuntime()).ToDataRes(types.Bool)
+	},
+	"firewalld.running": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalld).GetRunning()).ToDataRes(types.Bool)
+	},
+	"firewalld.defaultZone": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalld).GetDefaultZone()).ToDataRes(types.String)
+	},
+	"firewalld.zones": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalld).GetZones()).ToDataRes(types.Array(types.Resource("firewalld.zone")))
+	},
+	"firewalld.permanentZones": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalld).GetPermanentZones()).ToDataRes(types.Array(types.Resource("firewalld.zone")))
+	},
+	"firewalld.zone.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetName()).ToDataRes(types.String)
+	},
+	"firewalld.zone.short": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetShort()).ToDataRes(types.String)
+	},
+	"firewalld.zone.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetDescription()).ToDataRes(types.String)
+	},
+	"firewalld.zone.target": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetTarget()).ToDataRes(types.String)
+	},
+	"firewalld.zone.active": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetActive()).ToDataRes(types.Bool)
+	},
+	"firewalld.zone.interfaces": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetInterfaces()).ToDataRes(types.Array(types.String))
+	},
+	"firewalld.zone.sources": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetSources()).ToDataRes(types.Array(types.String))
+	},
+	"firewalld.zone.services": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetServices()).ToDataRes(types.Array(types.String))
+	},
+	"firewalld.zone.ports": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetPorts()).ToDataRes(types.Array(types.Resource("firewalld.port")))
+	},
+	"firewalld.zone.sourcePorts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetSourcePorts()).ToDataRes(types.Array(types.Resource("firewalld.port")))
+	},
+	"firewalld.zone.protocols": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetProtocols()).ToDataRes(types.Array(types.String))
+	},
+	"firewalld.zone.masquerade": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetMasquerade()).ToDataRes(types.Bool)
+	},
+	"firewalld.zone.forward": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetForward()).ToDataRes(types.Bool)
+	},
+	"firewalld.zone.forwardPorts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetForwardPorts()).ToDataRes(types.Array(types.Dict))
+	},
+	"firewalld.zone.icmpBlocks": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetIcmpBlocks()).ToDataRes(types.Array(types.String))
+	},
+	"firewalld.zone.icmpBlockInversion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetIcmpBlockInversion()).ToDataRes(types.Bool)
+	},
+	"firewalld.zone.richRules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldZone).GetRichRules()).ToDataRes(types.Array(types.Resource("firewalld.rule")))
+	},
+	"firewalld.port.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldPort).GetPort()).ToDataRes(types.String)
+	},
+	"firewalld.port.protocol": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldPort).GetProtocol()).ToDataRes(types.String)
+	},
+	"firewalld.rule.rule": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetRule()).ToDataRes(types.String)
+	},
+	"firewalld.rule.family": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetFamily()).ToDataRes(types.String)
+	},
+	"firewalld.rule.priority": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetPriority()).ToDataRes(types.Int)
+	},
+	"firewalld.rule.source": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetSource()).ToDataRes(types.String)
+	},
+	"firewalld.rule.destination": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetDestination()).ToDataRes(types.String)
+	},
+	"firewalld.rule.service": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetService()).ToDataRes(types.String)
+	},
+	"firewalld.rule.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetPort()).ToDataRes(types.String)
+	},
+	"firewalld.rule.protocol": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetProtocol()).ToDataRes(types.String)
+	},
+	"firewalld.rule.log": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetLog()).ToDataRes(types.Bool)
+	},
+	"firewalld.rule.action": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlFirewalldRule).GetAction()).ToDataRes(types.String)
+	},
+	"ufw.runtime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetRuntime()).ToDataRes(types.Bool)
+	},
+	"ufw.enabled": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetEnabled()).ToDataRes(types.Bool)
+	},
+	"ufw.status": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetStatus()).ToDataRes(types.String)
+	},
+	"ufw.logLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetLogLevel()).ToDataRes(types.String)
+	},
+	"ufw.ipv6": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetIpv6()).ToDataRes(types.Bool)
+	},
+	"ufw.defaultInputPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetDefaultInputPolicy()).ToDataRes(types.String)
+	},
+	"ufw.defaultOutputPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetDefaultOutputPolicy()).ToDataRes(types.String)
+	},
+	"ufw.defaultForwardPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetDefaultForwardPolicy()).ToDataRes(types.String)
+	},
+	"ufw.rules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfw).GetRules()).ToDataRes(types.Array(types.Resource("ufw.rule")))
+	},
+	"ufw.rule.number": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetNumber()).ToDataRes(types.Int)
+	},
+	"ufw.rule.action": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetAction()).ToDataRes(types.String)
+	},
+	"ufw.rule.log": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetLog()).ToDataRes(types.String)
+	},
+	"ufw.rule.route": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetRoute()).ToDataRes(types.Bool)
+	},
+	"ufw.rule.direction": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetDirection()).ToDataRes(types.String)
+	},
+	"ufw.rule.interface": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetInterface()).ToDataRes(types.String)
+	},
+	"ufw.rule.protocol": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetProtocol()).ToDataRes(types.String)
+	},
+	"ufw.rule.to": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetTo()).ToDataRes(types.String)
+	},
+	"ufw.rule.toPort": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetToPort()).ToDataRes(types.String)
+	},
+	"ufw.rule.toApp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetToApp()).ToDataRes(types.String)
+	},
+	"ufw.rule.from": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetFrom()).ToDataRes(types.String)
+	},
+	"ufw.rule.fromPort": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetFromPort()).ToDataRes(types.String)
+	},
+	"ufw.rule.fromApp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetFromApp()).ToDataRes(types.String)
+	},
+	"ufw.rule.ipv6": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetIpv6()).ToDataRes(types.Bool)
+	},
+	"ufw.rule.comment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlUfwRule).GetComment()).ToDataRes(types.String)
+	},
 	"fstab.path": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlFstab).GetPath()).ToDataRes(types.String)
 	},
@@ -2925,6 +3551,150 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"npm.package.files": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlNpmPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
 	},
+	"java.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetPath()).ToDataRes(types.String)
+	},
+	"java.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"java.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackages).GetList()).ToDataRes(types.Array(types.Resource("java.package")))
+	},
+	"java.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetId()).ToDataRes(types.String)
+	},
+	"java.package.groupId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetGroupId()).ToDataRes(types.String)
+	},
+	"java.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetName()).ToDataRes(types.String)
+	},
+	"java.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"java.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"java.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"java.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJavaPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"go.modules.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModules).GetPath()).ToDataRes(types.String)
+	},
+	"go.modules.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModules).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"go.modules.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModules).GetList()).ToDataRes(types.Array(types.Resource("go.module")))
+	},
+	"go.module.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetId()).ToDataRes(types.String)
+	},
+	"go.module.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetName()).ToDataRes(types.String)
+	},
+	"go.module.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetVersion()).ToDataRes(types.String)
+	},
+	"go.module.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetPurl()).ToDataRes(types.String)
+	},
+	"go.module.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"go.module.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlGoModule).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"rust.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetPath()).ToDataRes(types.String)
+	},
+	"rust.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"rust.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackages).GetList()).ToDataRes(types.Array(types.Resource("rust.package")))
+	},
+	"rust.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetId()).ToDataRes(types.String)
+	},
+	"rust.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetName()).ToDataRes(types.String)
+	},
+	"rust.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"rust.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"rust.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"rust.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRustPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"ruby.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetPath()).ToDataRes(types.String)
+	},
+	"ruby.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"ruby.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackages).GetList()).ToDataRes(types.Array(types.Resource("ruby.package")))
+	},
+	"ruby.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetId()).ToDataRes(types.String)
+	},
+	"ruby.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetName()).ToDataRes(types.String)
+	},
+	"ruby.package.platform": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetPlatform()).ToDataRes(types.String)
+	},
+	"ruby.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"ruby.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"ruby.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"ruby.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRubyPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"php.packages.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetPath()).ToDataRes(types.String)
+	},
+	"php.packages.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
+	"php.packages.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackages).GetList()).ToDataRes(types.Array(types.Resource("php.package")))
+	},
+	"php.package.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetId()).ToDataRes(types.String)
+	},
+	"php.package.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetName()).ToDataRes(types.String)
+	},
+	"php.package.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetDescription()).ToDataRes(types.String)
+	},
+	"php.package.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetVersion()).ToDataRes(types.String)
+	},
+	"php.package.purl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetPurl()).ToDataRes(types.String)
+	},
+	"php.package.cpes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetCpes()).ToDataRes(types.Array(types.Resource("cpe")))
+	},
+	"php.package.files": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlPhpPackage).GetFiles()).ToDataRes(types.Array(types.Resource("pkgFileInfo")))
+	},
 	"macos.computerName": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlMacos).GetComputerName()).ToDataRes(types.String)
 	},
@@ -4040,6 +4810,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlVulnmgmt).Cves, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
+	"vulnmgmt.suppressedCves": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnmgmt).SuppressedCves, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
 	"vulnmgmt.advisories": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlVulnmgmt).Advisories, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
@@ -4088,6 +4862,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlVulnCve).WorstScore, ok = plugin.RawToTValue[*mqlAuditCvss](v.Value, v.Error)
 		return
 	},
+	"vuln.cve.vex": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlVulnCve).Vex, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+		return
+	},
 	"vuln.advisory.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlVulnAdvisory).__id, ok = v.Value.(string)
 		return
@@ -5384,40 +6162,204 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlApache2ConfDirectory).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
 		return
 	},
-	"journald.config.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfig).__id, ok = v.Value.(string)
+	"nginx.conf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).__id, ok = v.Value.(string)
 		return
 	},
-	"journald.config.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfig).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+	"nginx.conf.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
 		return
 	},
-	"journald.config.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfig).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+	"nginx.conf.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"journald.config.sections": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfig).Sections, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"nginx.conf.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
 		return
 	},
-	"journald.config.section.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfigSection).__id, ok = v.Value.(string)
+	"nginx.conf.directives": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).Directives, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"journald.config.section.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfigSection).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"nginx.conf.http": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).Http, ok = plugin.RawToTValue[*mqlNginxConfHttp](v.Value, v.Error)
 		return
 	},
-	"journald.config.section.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfigSection).Params, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"nginx.conf.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConf).Servers, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"journald.config.section.param.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfigSectionParam).__id, ok = v.Value.(string)
+	"nginx.conf.directive.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).__id, ok = v.Value.(string)
 		return
 	},
-	"journald.config.section.param.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlJournaldConfigSectionParam).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"nginx.conf.directive.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.directive.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).Args, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.directive.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).File, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.directive.line": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).Line, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.directive.block": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfDirective).Block, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.http.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).__id, ok = v.Value.(string)
+		return
+	},
+	"nginx.conf.http.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.http.sslProtocols": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).SslProtocols, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.http.sslCiphers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).SslCiphers, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.http.headers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).Headers, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.http.servers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfHttp).Servers, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).__id, ok = v.Value.(string)
+		return
+	},
+	"nginx.conf.server.serverNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).ServerNames, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.listen": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).Listen, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.ssl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).Ssl, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.sslProtocols": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).SslProtocols, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.sslCiphers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).SslCiphers, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.headers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).Headers, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.server.locations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfServer).Locations, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).__id, ok = v.Value.(string)
+		return
+	},
+	"nginx.conf.listen.address": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).Address, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.ssl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).Ssl, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.http2": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).Http2, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.defaultServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).DefaultServer, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.listen.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfListen).Args, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.location.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).__id, ok = v.Value.(string)
+		return
+	},
+	"nginx.conf.location.modifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).Modifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.location.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.location.headers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).Headers, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.location.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"nginx.conf.location.locations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlNginxConfLocation).Locations, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"journald.config.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfig).__id, ok = v.Value.(string)
+		return
+	},
+	"journald.config.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfig).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"journald.config.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfig).Params, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"journald.config.sections": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfig).Sections, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"journald.config.section.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfigSection).__id, ok = v.Value.(string)
+		return
+	},
+	"journald.config.section.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfigSection).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"journald.config.section.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfigSection).Params, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"journald.config.section.param.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfigSectionParam).__id, ok = v.Value.(string)
+		return
+	},
+	"journald.config.section.param.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJournaldConfigSectionParam).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
 	"journald.config.section.param.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
@@ -5504,6 +6446,282 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlKernelModule).Loaded, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
+	"bootloader.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloader).__id, ok = v.Value.(string)
+		return
+	},
+	"bootloader.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloader).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloader).Grub, ok = plugin.RawToTValue[*mqlBootloaderGrub](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloader).SystemdBoot, ok = plugin.RawToTValue[*mqlBootloaderSystemdBoot](v.Value, v.Error)
+		return
+	},
+	"bootloader.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloader).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).__id, ok = v.Value.(string)
+		return
+	},
+	"bootloader.grub.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.defaults": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).Defaults, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.superusers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).Superusers, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.passwordProtected": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).PasswordProtected, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.bls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).Bls, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"bootloader.grub.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderGrub).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).__id, ok = v.Value.(string)
+		return
+	},
+	"bootloader.systemdBoot.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot.default": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).Default, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot.timeout": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).Timeout, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot.editor": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).Editor, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"bootloader.systemdBoot.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderSystemdBoot).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).__id, ok = v.Value.(string)
+		return
+	},
+	"bootloader.entry.title": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Title, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.kernel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Kernel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.initrd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Initrd, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.cmdline": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Cmdline, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.parameters": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Parameters, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.unrestricted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Unrestricted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"bootloader.entry.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlBootloaderEntry).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sysctl.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctl).__id, ok = v.Value.(string)
+		return
+	},
+	"sysctl.runtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctl).Runtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"sysctl.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctl).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sysctl.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctl).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"sysctl.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).__id, ok = v.Value.(string)
+		return
+	},
+	"sysctl.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sysctl.entry.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sysctl.entry.persistedValue": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).PersistedValue, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sysctl.entry.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"sysctl.entry.drift": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSysctlEntry).Drift, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).__id, ok = v.Value.(string)
+		return
+	},
+	"selinux.runtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Runtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.configMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).ConfigMode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.policyType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).PolicyType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.policyVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).PolicyVersion, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"selinux.booleans": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Booleans, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"selinux.fileContexts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).FileContexts, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"selinux.modules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinux).Modules, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"selinux.boolean.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).__id, ok = v.Value.(string)
+		return
+	},
+	"selinux.boolean.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.boolean.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Value, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.boolean.pending": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxBoolean).Pending, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.fileContext.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).__id, ok = v.Value.(string)
+		return
+	},
+	"selinux.fileContext.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.fileContext.fileType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).FileType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.fileContext.context": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).Context, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.fileContext.local": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxFileContext).Local, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"selinux.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).__id, ok = v.Value.(string)
+		return
+	},
+	"selinux.module.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"selinux.module.priority": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Priority, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"selinux.module.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSelinuxModule).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"apparmor.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).__id, ok = v.Value.(string)
+		return
+	},
+	"apparmor.runtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Runtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"apparmor.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"apparmor.profiles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmor).Profiles, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"apparmor.profile.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).__id, ok = v.Value.(string)
+		return
+	},
+	"apparmor.profile.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"apparmor.profile.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"apparmor.profile.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlApparmorProfile).File, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"docker.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlDocker).__id, ok = v.Value.(string)
 		return
@@ -6072,40 +7290,296 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlNftablesRule).Comment, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"fstab.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstab).__id, ok = v.Value.(string)
+	"firewalld.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).__id, ok = v.Value.(string)
 		return
 	},
-	"fstab.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstab).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"firewalld.runtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).Runtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"fstab.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstab).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"firewalld.running": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).Running, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"fstab.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).__id, ok = v.Value.(string)
+	"firewalld.defaultZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).DefaultZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"fstab.entry.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"firewalld.zones": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).Zones, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"fstab.entry.mountpoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).Mountpoint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"firewalld.permanentZones": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalld).PermanentZones, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"fstab.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"firewalld.zone.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).__id, ok = v.Value.(string)
 		return
 	},
-	"fstab.entry.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).Options, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"firewalld.zone.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"fstab.entry.dump": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlFstabEntry).Dump, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"firewalld.zone.short": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Short, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.target": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Target, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.active": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Active, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.interfaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Interfaces, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.sources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Sources, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.services": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Services, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.ports": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Ports, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.sourcePorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).SourcePorts, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.protocols": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Protocols, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.masquerade": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Masquerade, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.forward": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).Forward, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.forwardPorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).ForwardPorts, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.icmpBlocks": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).IcmpBlocks, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.icmpBlockInversion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).IcmpBlockInversion, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"firewalld.zone.richRules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldZone).RichRules, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"firewalld.port.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldPort).__id, ok = v.Value.(string)
+		return
+	},
+	"firewalld.port.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldPort).Port, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.port.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldPort).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).__id, ok = v.Value.(string)
+		return
+	},
+	"firewalld.rule.rule": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Rule, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.family": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Family, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.priority": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Priority, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.destination": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Destination, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.service": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Service, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Port, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.log": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Log, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"firewalld.rule.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFirewalldRule).Action, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).__id, ok = v.Value.(string)
+		return
+	},
+	"ufw.runtime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).Runtime, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ufw.enabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).Enabled, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ufw.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.logLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).LogLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.ipv6": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).Ipv6, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ufw.defaultInputPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).DefaultInputPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.defaultOutputPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).DefaultOutputPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.defaultForwardPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).DefaultForwardPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfw).Rules, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).__id, ok = v.Value.(string)
+		return
+	},
+	"ufw.rule.number": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Number, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.action": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Action, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.log": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Log, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.route": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Route, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.direction": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Direction, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.interface": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Interface, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.to": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).To, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.toPort": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).ToPort, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.toApp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).ToApp, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.from": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).From, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.fromPort": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).FromPort, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.fromApp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).FromApp, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.ipv6": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Ipv6, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ufw.rule.comment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlUfwRule).Comment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstab).__id, ok = v.Value.(string)
+		return
+	},
+	"fstab.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstab).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstab).Entries, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"fstab.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).__id, ok = v.Value.(string)
+		return
+	},
+	"fstab.entry.device": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).Device, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.entry.mountpoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).Mountpoint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.entry.fstype": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).Fstype, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.entry.options": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).Options, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"fstab.entry.dump": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlFstabEntry).Dump, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
 	"fstab.entry.fsck": func(r plugin.Resource, v *llx.RawData) (ok bool) {
@@ -7052,224 +8526,456 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlNpmPackage).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).__id, ok = v.Value.(string)
+	"java.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.userPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).UserPreferences, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+	"java.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.userHostPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).UserHostPreferences, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+	"java.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackages).List, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.globalAccountPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).GlobalAccountPolicies, ok = plugin.RawToTValue[any](v.Value, v.Error)
+	"java.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.systemExtensions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacos).SystemExtensions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"java.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).__id, ok = v.Value.(string)
+	"java.package.groupId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).GroupId, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.activationLockStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).ActivationLockStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.bootRomVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).BootRomVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.chipType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).ChipType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.machineModel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).MachineModel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Cpes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.machineName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).MachineName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"java.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJavaPackage).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.modelNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).ModelNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.modules.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModules).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.hardware.numberProcessors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).NumberProcessors, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.modules.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModules).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.osLoaderVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).OsLoaderVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.modules.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModules).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.physicalMemory": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).PhysicalMemory, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.modules.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModules).List, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.platformUUID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).PlatformUUID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.module.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.hardware.provisioningUDID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).ProvisioningUDID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.module.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.hardware.serialNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosHardware).SerialNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"go.module.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).__id, ok = v.Value.(string)
+	"go.module.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).AllowDownloadSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"go.module.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.allowSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).AllowSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"go.module.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Cpes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.alf.firewallUnload": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).FirewallUnload, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"go.module.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlGoModule).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.alf.globalState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).GlobalState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"rust.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.alf.loggingEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).LoggingEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"rust.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.loggingOption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).LoggingOption, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"rust.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.alf.stealthEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).StealthEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"rust.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackages).List, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.alf.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"rust.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.alf.exceptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Exceptions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"rust.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.explicitAuths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).ExplicitAuths, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"rust.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.alf.applications": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosAlf).Applications, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"rust.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.timemachine.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosTimemachine).__id, ok = v.Value.(string)
+	"rust.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.timemachine.preferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosTimemachine).Preferences, ok = plugin.RawToTValue[any](v.Value, v.Error)
+	"rust.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Cpes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).__id, ok = v.Value.(string)
+	"rust.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRustPackage).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.date": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Date, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.systemsetup.time": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Time, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.timeZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).TimeZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).UsingNetworkTime, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackages).List, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.networkTimeServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).NetworkTimeServer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.systemsetup.sleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).Sleep, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+	"ruby.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.displaySleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).DisplaySleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.harddiskSleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).HarddiskSleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.platform": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Platform, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.wakeOnModem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WakeOnModem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WakeOnNetworkAccess, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RestartPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Cpes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.restartFreeze": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RestartFreeze, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ruby.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRubyPackage).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).AllowPowerButtonToSleepComputer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.packages.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.systemsetup.remoteLogin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RemoteLogin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.packages.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).RemoteAppleEvents, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.packages.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.packages.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackages).List, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.localSubnetName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).LocalSubnetName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.package.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).__id, ok = v.Value.(string)
 		return
 	},
-	"macos.systemsetup.startupDisk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).StartupDisk, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.package.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).WaitForStartupAfterPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.package.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlMacosSystemsetup).DisableKeyboardWhenEnclosureLockIsEngaged, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"php.package.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openBSMAudit.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenBSMAudit).__id, ok = v.Value.(string)
+	"php.package.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.purl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Purl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"php.package.cpes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Cpes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"php.package.files": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPhpPackage).Files, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).__id, ok = v.Value.(string)
+		return
+	},
+	"macos.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.userPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserPreferences, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"macos.userHostPreferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).UserHostPreferences, ok = plugin.RawToTValue[map[string]any](v.Value, v.Error)
+		return
+	},
+	"macos.globalAccountPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).GlobalAccountPolicies, ok = plugin.RawToTValue[any](v.Value, v.Error)
+		return
+	},
+	"macos.systemExtensions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacos).SystemExtensions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).__id, ok = v.Value.(string)
+		return
+	},
+	"macos.hardware.activationLockStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).ActivationLockStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.bootRomVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).BootRomVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.chipType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).ChipType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.machineModel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).MachineModel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.machineName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).MachineName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.modelNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).ModelNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.numberProcessors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).NumberProcessors, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.osLoaderVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).OsLoaderVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.physicalMemory": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).PhysicalMemory, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.platformUUID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).PlatformUUID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.provisioningUDID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).ProvisioningUDID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.hardware.serialNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosHardware).SerialNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.alf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).__id, ok = v.Value.(string)
+		return
+	},
+	"macos.alf.allowDownloadSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowDownloadSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.allowSignedEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).AllowSignedEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.firewallUnload": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).FirewallUnload, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.globalState": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).GlobalState, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.loggingOption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).LoggingOption, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.stealthEnabled": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).StealthEnabled, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"macos.alf.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.alf.exceptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Exceptions, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.alf.explicitAuths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).ExplicitAuths, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.alf.applications": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosAlf).Applications, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.timemachine.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosTimemachine).__id, ok = v.Value.(string)
+		return
+	},
+	"macos.timemachine.preferences": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosTimemachine).Preferences, ok = plugin.RawToTValue[any](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).__id, ok = v.Value.(string)
+		return
+	},
+	"macos.systemsetup.date": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Date, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.time": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Time, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.timeZone": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).TimeZone, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.usingNetworkTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).UsingNetworkTime, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.networkTimeServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).NetworkTimeServer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.sleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).Sleep, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.displaySleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisplaySleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.harddiskSleep": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).HarddiskSleep, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnModem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnModem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.wakeOnNetworkAccess": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WakeOnNetworkAccess, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.restartFreeze": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RestartFreeze, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.allowPowerButtonToSleepComputer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).AllowPowerButtonToSleepComputer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteLogin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteLogin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.remoteAppleEvents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).RemoteAppleEvents, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.computerName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).ComputerName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.localSubnetName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).LocalSubnetName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.startupDisk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).StartupDisk, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.waitForStartupAfterPowerFailure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).WaitForStartupAfterPowerFailure, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"macos.systemsetup.disableKeyboardWhenEnclosureLockIsEngaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlMacosSystemsetup).DisableKeyboardWhenEnclosureLockIsEngaged, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openBSMAudit.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenBSMAudit).__id, ok = v.Value.(string)
 		return
 	},
 	"openBSMAudit.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
@@ -8859,6 +10565,7 @@ type mqlVulnmgmt struct {
 	__id       string
 	mqlVulnmgmtInternal
 	Cves           plugin.TValue[[]any]
+	SuppressedCves plugin.TValue[[]any]
 	Advisories     plugin.TValue[[]any]
 	Packages       plugin.TValue[[]any]
 	LastAssessment plugin.TValue[*time.Time]
@@ -8913,6 +10620,22 @@ func (c *mqlVulnmgmt) GetCves() *plugin.TValue[[]any] {
 	})
 }
 
+func (c *mqlVulnmgmt) GetSuppressedCves() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.SuppressedCves, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("vulnmgmt", c.__id, "suppressedCves")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.suppressedCves()
+	})
+}
+
 func (c *mqlVulnmgmt) GetAdvisories() *plugin.TValue[[]any] {
 	return plugin.GetOrCompute[[]any](&c.Advisories, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
@@ -8979,6 +10702,7 @@ type mqlVulnCve struct {
 	Published  plugin.TValue[*time.Time]
 	Modified   plugin.TValue[*time.Time]
 	WorstScore plugin.TValue[*mqlAuditCvss]
+	Vex        plugin.TValue[plugin.Resource]
 }
 
 // createVulnCve creates a new instance of this resource
@@ -9046,6 +10770,10 @@ func (c *mqlVulnCve) GetWorstScore() *plugin.TValue[*mqlAuditCvss] {
 	return &c.WorstScore
 }
 
+func (c *mqlVulnCve) GetVex() *plugin.TValue[plugin.Resource] {
+	return &c.Vex
+}
+
 // mqlVulnAdvisory for the vuln.advisory resource
 type mqlVulnAdvisory struct {
 	MqlRuntime *plugin.Runtime
@@ -13861,19 +15589,22 @@ func (c *mqlApache2ConfDirectory) GetParams() *plugin.TValue[map[string]any] {
 	return &c.Params
 }
 
-// mqlJournaldConfig for the journald.config resource
-type mqlJournaldConfig struct {
+// mqlNginxConf for the nginx.conf resource
+type mqlNginxConf struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlJournaldConfigInternal
-	File     plugin.TValue[*mqlFile]
-	Params   plugin.TValue[map[string]any]
-	Sections plugin.TValue[[]any]
+	mqlNginxConfInternal
+	File       plugin.TValue[*mqlFile]
+	Files      plugin.TValue[[]any]
+	Params     plugin.TValue[map[string]any]
+	Directives plugin.TValue[[]any]
+	Http       plugin.TValue[*mqlNginxConfHttp]
+	Servers    plugin.TValue[[]any]
 }
 
-// createJournaldConfig creates a new instance of this resource
-func createJournaldConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlJournaldConfig{
+// createNginxConf creates a new instance of this resource
+func createNginxConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConf{
 		MqlRuntime: runtime,
 	}
 
@@ -13890,7 +15621,7 @@ func createJournaldConfig(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("journald.config", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -13900,18 +15631,18 @@ func createJournaldConfig(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlJournaldConfig) MqlName() string {
-	return "journald.config"
+func (c *mqlNginxConf) MqlName() string {
+	return "nginx.conf"
 }
 
-func (c *mqlJournaldConfig) MqlID() string {
+func (c *mqlNginxConf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlJournaldConfig) GetFile() *plugin.TValue[*mqlFile] {
+func (c *mqlNginxConf) GetFile() *plugin.TValue[*mqlFile] {
 	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("journald.config", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nginx.conf", c.__id, "file")
 			if err != nil {
 				return nil, err
 			}
@@ -13924,7 +15655,28 @@ func (c *mqlJournaldConfig) GetFile() *plugin.TValue[*mqlFile] {
 	})
 }
 
-func (c *mqlJournaldConfig) GetParams() *plugin.TValue[map[string]any] {
+func (c *mqlNginxConf) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nginx.conf", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.files(vargFile.Data)
+	})
+}
+
+func (c *mqlNginxConf) GetParams() *plugin.TValue[map[string]any] {
 	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
 		vargFile := c.GetFile()
 		if vargFile.Error != nil {
@@ -13935,10 +15687,10 @@ func (c *mqlJournaldConfig) GetParams() *plugin.TValue[map[string]any] {
 	})
 }
 
-func (c *mqlJournaldConfig) GetSections() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Sections, func() ([]any, error) {
+func (c *mqlNginxConf) GetDirectives() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Directives, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("journald.config", c.__id, "sections")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nginx.conf", c.__id, "directives")
 			if err != nil {
 				return nil, err
 			}
@@ -13952,22 +15704,67 @@ func (c *mqlJournaldConfig) GetSections() *plugin.TValue[[]any] {
 			return nil, vargFile.Error
 		}
 
-		return c.sections(vargFile.Data)
+		return c.directives(vargFile.Data)
 	})
 }
 
-// mqlJournaldConfigSection for the journald.config.section resource
-type mqlJournaldConfigSection struct {
+func (c *mqlNginxConf) GetHttp() *plugin.TValue[*mqlNginxConfHttp] {
+	return plugin.GetOrCompute[*mqlNginxConfHttp](&c.Http, func() (*mqlNginxConfHttp, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nginx.conf", c.__id, "http")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlNginxConfHttp), nil
+			}
+		}
+
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.http(vargFile.Data)
+	})
+}
+
+func (c *mqlNginxConf) GetServers() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Servers, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nginx.conf", c.__id, "servers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargHttp := c.GetHttp()
+		if vargHttp.Error != nil {
+			return nil, vargHttp.Error
+		}
+
+		return c.servers(vargHttp.Data)
+	})
+}
+
+// mqlNginxConfDirective for the nginx.conf.directive resource
+type mqlNginxConfDirective struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlJournaldConfigSectionInternal it will be used here
-	Name   plugin.TValue[string]
-	Params plugin.TValue[[]any]
+	// optional: if you define mqlNginxConfDirectiveInternal it will be used here
+	Name  plugin.TValue[string]
+	Args  plugin.TValue[[]any]
+	File  plugin.TValue[string]
+	Line  plugin.TValue[int64]
+	Block plugin.TValue[[]any]
 }
 
-// createJournaldConfigSection creates a new instance of this resource
-func createJournaldConfigSection(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlJournaldConfigSection{
+// createNginxConfDirective creates a new instance of this resource
+func createNginxConfDirective(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConfDirective{
 		MqlRuntime: runtime,
 	}
 
@@ -13979,7 +15776,7 @@ func createJournaldConfigSection(runtime *plugin.Runtime, args map[string]*llx.R
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("journald.config.section", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf.directive", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -13989,34 +15786,49 @@ func createJournaldConfigSection(runtime *plugin.Runtime, args map[string]*llx.R
 	return res, nil
 }
 
-func (c *mqlJournaldConfigSection) MqlName() string {
-	return "journald.config.section"
+func (c *mqlNginxConfDirective) MqlName() string {
+	return "nginx.conf.directive"
 }
 
-func (c *mqlJournaldConfigSection) MqlID() string {
+func (c *mqlNginxConfDirective) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlJournaldConfigSection) GetName() *plugin.TValue[string] {
+func (c *mqlNginxConfDirective) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlJournaldConfigSection) GetParams() *plugin.TValue[[]any] {
-	return &c.Params
+func (c *mqlNginxConfDirective) GetArgs() *plugin.TValue[[]any] {
+	return &c.Args
 }
 
-// mqlJournaldConfigSectionParam for the journald.config.section.param resource
-type mqlJournaldConfigSectionParam struct {
+func (c *mqlNginxConfDirective) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
+
+func (c *mqlNginxConfDirective) GetLine() *plugin.TValue[int64] {
+	return &c.Line
+}
+
+func (c *mqlNginxConfDirective) GetBlock() *plugin.TValue[[]any] {
+	return &c.Block
+}
+
+// mqlNginxConfHttp for the nginx.conf.http resource
+type mqlNginxConfHttp struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlJournaldConfigSectionParamInternal it will be used here
-	Name  plugin.TValue[string]
-	Value plugin.TValue[string]
+	// optional: if you define mqlNginxConfHttpInternal it will be used here
+	Params       plugin.TValue[map[string]any]
+	SslProtocols plugin.TValue[[]any]
+	SslCiphers   plugin.TValue[string]
+	Headers      plugin.TValue[map[string]any]
+	Servers      plugin.TValue[[]any]
 }
 
-// createJournaldConfigSectionParam creates a new instance of this resource
-func createJournaldConfigSectionParam(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlJournaldConfigSectionParam{
+// createNginxConfHttp creates a new instance of this resource
+func createNginxConfHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConfHttp{
 		MqlRuntime: runtime,
 	}
 
@@ -14028,7 +15840,7 @@ func createJournaldConfigSectionParam(runtime *plugin.Runtime, args map[string]*
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("journald.config.section.param", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf.http", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14038,40 +15850,52 @@ func createJournaldConfigSectionParam(runtime *plugin.Runtime, args map[string]*
 	return res, nil
 }
 
-func (c *mqlJournaldConfigSectionParam) MqlName() string {
-	return "journald.config.section.param"
+func (c *mqlNginxConfHttp) MqlName() string {
+	return "nginx.conf.http"
 }
 
-func (c *mqlJournaldConfigSectionParam) MqlID() string {
+func (c *mqlNginxConfHttp) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlJournaldConfigSectionParam) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlNginxConfHttp) GetParams() *plugin.TValue[map[string]any] {
+	return &c.Params
 }
 
-func (c *mqlJournaldConfigSectionParam) GetValue() *plugin.TValue[string] {
-	return &c.Value
+func (c *mqlNginxConfHttp) GetSslProtocols() *plugin.TValue[[]any] {
+	return &c.SslProtocols
 }
 
-// mqlService for the service resource
-type mqlService struct {
+func (c *mqlNginxConfHttp) GetSslCiphers() *plugin.TValue[string] {
+	return &c.SslCiphers
+}
+
+func (c *mqlNginxConfHttp) GetHeaders() *plugin.TValue[map[string]any] {
+	return &c.Headers
+}
+
+func (c *mqlNginxConfHttp) GetServers() *plugin.TValue[[]any] {
+	return &c.Servers
+}
+
+// mqlNginxConfServer for the nginx.conf.server resource
+type mqlNginxConfServer struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlServiceInternal it will be used here
-	Name        plugin.TValue[string]
-	Description plugin.TValue[string]
-	Installed   plugin.TValue[bool]
-	Running     plugin.TValue[bool]
-	Enabled     plugin.TValue[bool]
-	Type        plugin.TValue[string]
-	Masked      plugin.TValue[bool]
-	Static      plugin.TValue[bool]
+	// optional: if you define mqlNginxConfServerInternal it will be used here
+	ServerNames  plugin.TValue[[]any]
+	Listen       plugin.TValue[[]any]
+	Ssl          plugin.TValue[bool]
+	SslProtocols plugin.TValue[[]any]
+	SslCiphers   plugin.TValue[string]
+	Headers      plugin.TValue[map[string]any]
+	Params       plugin.TValue[map[string]any]
+	Locations    plugin.TValue[[]any]
 }
 
-// createService creates a new instance of this resource
-func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlService{
+// createNginxConfServer creates a new instance of this resource
+func createNginxConfServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConfServer{
 		MqlRuntime: runtime,
 	}
 
@@ -14080,15 +15904,10 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("service", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf.server", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14098,57 +15917,62 @@ func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlService) MqlName() string {
-	return "service"
+func (c *mqlNginxConfServer) MqlName() string {
+	return "nginx.conf.server"
 }
 
-func (c *mqlService) MqlID() string {
+func (c *mqlNginxConfServer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlService) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlNginxConfServer) GetServerNames() *plugin.TValue[[]any] {
+	return &c.ServerNames
 }
 
-func (c *mqlService) GetDescription() *plugin.TValue[string] {
-	return &c.Description
+func (c *mqlNginxConfServer) GetListen() *plugin.TValue[[]any] {
+	return &c.Listen
 }
 
-func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
-	return &c.Installed
+func (c *mqlNginxConfServer) GetSsl() *plugin.TValue[bool] {
+	return &c.Ssl
 }
 
-func (c *mqlService) GetRunning() *plugin.TValue[bool] {
-	return &c.Running
+func (c *mqlNginxConfServer) GetSslProtocols() *plugin.TValue[[]any] {
+	return &c.SslProtocols
 }
 
-func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
+func (c *mqlNginxConfServer) GetSslCiphers() *plugin.TValue[string] {
+	return &c.SslCiphers
 }
 
-func (c *mqlService) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlNginxConfServer) GetHeaders() *plugin.TValue[map[string]any] {
+	return &c.Headers
 }
 
-func (c *mqlService) GetMasked() *plugin.TValue[bool] {
-	return &c.Masked
+func (c *mqlNginxConfServer) GetParams() *plugin.TValue[map[string]any] {
+	return &c.Params
 }
 
-func (c *mqlService) GetStatic() *plugin.TValue[bool] {
-	return &c.Static
+func (c *mqlNginxConfServer) GetLocations() *plugin.TValue[[]any] {
+	return &c.Locations
 }
 
-// mqlServices for the services resource
-type mqlServices struct {
+// mqlNginxConfListen for the nginx.conf.listen resource
+type mqlNginxConfListen struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlServicesInternal
-	List plugin.TValue[[]any]
+	// optional: if you define mqlNginxConfListenInternal it will be used here
+	Address       plugin.TValue[string]
+	Port          plugin.TValue[int64]
+	Ssl           plugin.TValue[bool]
+	Http2         plugin.TValue[bool]
+	DefaultServer plugin.TValue[bool]
+	Args          plugin.TValue[[]any]
 }
 
-// createServices creates a new instance of this resource
-func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlServices{
+// createNginxConfListen creates a new instance of this resource
+func createNginxConfListen(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConfListen{
 		MqlRuntime: runtime,
 	}
 
@@ -14160,7 +15984,7 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("services", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf.listen", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14170,44 +15994,53 @@ func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlServices) MqlName() string {
-	return "services"
+func (c *mqlNginxConfListen) MqlName() string {
+	return "nginx.conf.listen"
 }
 
-func (c *mqlServices) MqlID() string {
+func (c *mqlNginxConfListen) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlServices) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlNginxConfListen) GetAddress() *plugin.TValue[string] {
+	return &c.Address
+}
 
-		return c.list()
-	})
+func (c *mqlNginxConfListen) GetPort() *plugin.TValue[int64] {
+	return &c.Port
 }
 
-// mqlKernel for the kernel resource
-type mqlKernel struct {
+func (c *mqlNginxConfListen) GetSsl() *plugin.TValue[bool] {
+	return &c.Ssl
+}
+
+func (c *mqlNginxConfListen) GetHttp2() *plugin.TValue[bool] {
+	return &c.Http2
+}
+
+func (c *mqlNginxConfListen) GetDefaultServer() *plugin.TValue[bool] {
+	return &c.DefaultServer
+}
+
+func (c *mqlNginxConfListen) GetArgs() *plugin.TValue[[]any] {
+	return &c.Args
+}
+
+// mqlNginxConfLocation for the nginx.conf.location resource
+type mqlNginxConfLocation struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlKernelInternal
-	Info       plugin.TValue[any]
-	Parameters plugin.TValue[map[string]any]
-	Modules    plugin.TValue[[]any]
-	Installed  plugin.TValue[[]any]
+	// optional: if you define mqlNginxConfLocationInternal it will be used here
+	Modifier  plugin.TValue[string]
+	Path      plugin.TValue[string]
+	Headers   plugin.TValue[map[string]any]
+	Params    plugin.TValue[map[string]any]
+	Locations plugin.TValue[[]any]
 }
 
-// createKernel creates a new instance of this resource
-func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernel{
+// createNginxConfLocation creates a new instance of this resource
+func createNginxConfLocation(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNginxConfLocation{
 		MqlRuntime: runtime,
 	}
 
@@ -14219,7 +16052,7 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel", res.__id)
+		args, err = runtime.ResourceFromRecording("nginx.conf.location", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14229,61 +16062,47 @@ func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlKernel) MqlName() string {
-	return "kernel"
+func (c *mqlNginxConfLocation) MqlName() string {
+	return "nginx.conf.location"
 }
 
-func (c *mqlKernel) MqlID() string {
+func (c *mqlNginxConfLocation) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernel) GetInfo() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.Info, func() (any, error) {
-		return c.info()
-	})
+func (c *mqlNginxConfLocation) GetModifier() *plugin.TValue[string] {
+	return &c.Modifier
 }
 
-func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Parameters, func() (map[string]any, error) {
-		return c.parameters()
-	})
+func (c *mqlNginxConfLocation) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlKernel) GetModules() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Modules, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlNginxConfLocation) GetHeaders() *plugin.TValue[map[string]any] {
+	return &c.Headers
+}
 
-		return c.modules()
-	})
+func (c *mqlNginxConfLocation) GetParams() *plugin.TValue[map[string]any] {
+	return &c.Params
 }
 
-func (c *mqlKernel) GetInstalled() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Installed, func() ([]any, error) {
-		return c.installed()
-	})
+func (c *mqlNginxConfLocation) GetLocations() *plugin.TValue[[]any] {
+	return &c.Locations
 }
 
-// mqlKernelModule for the kernel.module resource
-type mqlKernelModule struct {
+// mqlJournaldConfig for the journald.config resource
+type mqlJournaldConfig struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlKernelModuleInternal it will be used here
-	Name   plugin.TValue[string]
-	Size   plugin.TValue[string]
-	Loaded plugin.TValue[bool]
+	mqlJournaldConfigInternal
+	File     plugin.TValue[*mqlFile]
+	Params   plugin.TValue[map[string]any]
+	Sections plugin.TValue[[]any]
 }
 
-// createKernelModule creates a new instance of this resource
-func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKernelModule{
+// createJournaldConfig creates a new instance of this resource
+func createJournaldConfig(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJournaldConfig{
 		MqlRuntime: runtime,
 	}
 
@@ -14300,7 +16119,7 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
+		args, err = runtime.ResourceFromRecording("journald.config", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14310,38 +16129,74 @@ func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlKernelModule) MqlName() string {
-	return "kernel.module"
+func (c *mqlJournaldConfig) MqlName() string {
+	return "journald.config"
 }
 
-func (c *mqlKernelModule) MqlID() string {
+func (c *mqlJournaldConfig) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlJournaldConfig) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("journald.config", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
 }
 
-func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
-	return &c.Size
+func (c *mqlJournaldConfig) GetParams() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.params(vargFile.Data)
+	})
 }
 
-func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
-	return &c.Loaded
+func (c *mqlJournaldConfig) GetSections() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Sections, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("journald.config", c.__id, "sections")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.sections(vargFile.Data)
+	})
 }
 
-// mqlDocker for the docker resource
-type mqlDocker struct {
+// mqlJournaldConfigSection for the journald.config.section resource
+type mqlJournaldConfigSection struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerInternal it will be used here
-	Images     plugin.TValue[[]any]
-	Containers plugin.TValue[[]any]
+	// optional: if you define mqlJournaldConfigSectionInternal it will be used here
+	Name   plugin.TValue[string]
+	Params plugin.TValue[[]any]
 }
 
-// createDocker creates a new instance of this resource
-func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDocker{
+// createJournaldConfigSection creates a new instance of this resource
+func createJournaldConfigSection(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJournaldConfigSection{
 		MqlRuntime: runtime,
 	}
 
@@ -14353,7 +16208,7 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker", res.__id)
+		args, err = runtime.ResourceFromRecording("journald.config.section", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14363,59 +16218,34 @@ func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlDocker) MqlName() string {
-	return "docker"
+func (c *mqlJournaldConfigSection) MqlName() string {
+	return "journald.config.section"
 }
 
-func (c *mqlDocker) MqlID() string {
+func (c *mqlJournaldConfigSection) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDocker) GetImages() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Images, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.images()
-	})
+func (c *mqlJournaldConfigSection) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDocker) GetContainers() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Containers, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.containers()
-	})
+func (c *mqlJournaldConfigSection) GetParams() *plugin.TValue[[]any] {
+	return &c.Params
 }
 
-// mqlDockerFile for the docker.file resource
-type mqlDockerFile struct {
+// mqlJournaldConfigSectionParam for the journald.config.section.param resource
+type mqlJournaldConfigSectionParam struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlDockerFileInternal
-	File         plugin.TValue[*mqlFile]
-	Instructions plugin.TValue[any]
-	Stages       plugin.TValue[[]any]
+	// optional: if you define mqlJournaldConfigSectionParamInternal it will be used here
+	Name  plugin.TValue[string]
+	Value plugin.TValue[string]
 }
 
-// createDockerFile creates a new instance of this resource
-func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFile{
+// createJournaldConfigSectionParam creates a new instance of this resource
+func createJournaldConfigSectionParam(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJournaldConfigSectionParam{
 		MqlRuntime: runtime,
 	}
 
@@ -14424,15 +16254,10 @@ func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file", res.__id)
+		args, err = runtime.ResourceFromRecording("journald.config.section.param", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14442,88 +16267,40 @@ func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlDockerFile) MqlName() string {
-	return "docker.file"
+func (c *mqlJournaldConfigSectionParam) MqlName() string {
+	return "journald.config.section.param"
 }
 
-func (c *mqlDockerFile) MqlID() string {
+func (c *mqlJournaldConfigSectionParam) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFile) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "file")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlFile), nil
-			}
-		}
-
-		return c.file()
-	})
-}
-
-func (c *mqlDockerFile) GetInstructions() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.Instructions, func() (any, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		return c.instructions(vargFile.Data)
-	})
+func (c *mqlJournaldConfigSectionParam) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerFile) GetStages() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Stages, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "stages")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return nil, vargFile.Error
-		}
-
-		return c.stages(vargFile.Data)
-	})
+func (c *mqlJournaldConfigSectionParam) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// mqlDockerFileStage for the docker.file.stage resource
-type mqlDockerFileStage struct {
+// mqlService for the service resource
+type mqlService struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileStageInternal it will be used here
-	From        plugin.TValue[*mqlDockerFileFrom]
-	File        plugin.TValue[*mqlDockerFile]
-	Env         plugin.TValue[[]any]
-	Arg         plugin.TValue[[]any]
-	Labels      plugin.TValue[map[string]any]
-	Run         plugin.TValue[[]any]
-	Cmd         plugin.TValue[*mqlDockerFileRun]
-	User        plugin.TValue[*mqlDockerFileUser]
-	Entrypoint  plugin.TValue[*mqlDockerFileRun]
-	Add         plugin.TValue[[]any]
-	Copy        plugin.TValue[[]any]
-	Expose      plugin.TValue[[]any]
-	Healthcheck plugin.TValue[*mqlDockerFileHealthcheck]
-	Volumes     plugin.TValue[[]any]
-	Shell       plugin.TValue[*mqlDockerFileShell]
-	Workdir     plugin.TValue[[]any]
+	// optional: if you define mqlServiceInternal it will be used here
+	Name        plugin.TValue[string]
+	Description plugin.TValue[string]
+	Installed   plugin.TValue[bool]
+	Running     plugin.TValue[bool]
+	Enabled     plugin.TValue[bool]
+	Type        plugin.TValue[string]
+	Masked      plugin.TValue[bool]
+	Static      plugin.TValue[bool]
 }
 
-// createDockerFileStage creates a new instance of this resource
-func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileStage{
+// createService creates a new instance of this resource
+func createService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlService{
 		MqlRuntime: runtime,
 	}
 
@@ -14532,10 +16309,15 @@ func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.stage", res.__id)
+		args, err = runtime.ResourceFromRecording("service", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14545,90 +16327,57 @@ func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlDockerFileStage) MqlName() string {
-	return "docker.file.stage"
+func (c *mqlService) MqlName() string {
+	return "service"
 }
 
-func (c *mqlDockerFileStage) MqlID() string {
+func (c *mqlService) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileStage) GetFrom() *plugin.TValue[*mqlDockerFileFrom] {
-	return &c.From
+func (c *mqlService) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerFileStage) GetFile() *plugin.TValue[*mqlDockerFile] {
-	return &c.File
+func (c *mqlService) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlDockerFileStage) GetEnv() *plugin.TValue[[]any] {
-	return &c.Env
+func (c *mqlService) GetInstalled() *plugin.TValue[bool] {
+	return &c.Installed
 }
 
-func (c *mqlDockerFileStage) GetArg() *plugin.TValue[[]any] {
-	return &c.Arg
+func (c *mqlService) GetRunning() *plugin.TValue[bool] {
+	return &c.Running
 }
 
-func (c *mqlDockerFileStage) GetLabels() *plugin.TValue[map[string]any] {
-	return &c.Labels
-}
-
-func (c *mqlDockerFileStage) GetRun() *plugin.TValue[[]any] {
-	return &c.Run
-}
-
-func (c *mqlDockerFileStage) GetCmd() *plugin.TValue[*mqlDockerFileRun] {
-	return &c.Cmd
-}
-
-func (c *mqlDockerFileStage) GetUser() *plugin.TValue[*mqlDockerFileUser] {
-	return &c.User
-}
-
-func (c *mqlDockerFileStage) GetEntrypoint() *plugin.TValue[*mqlDockerFileRun] {
-	return &c.Entrypoint
-}
-
-func (c *mqlDockerFileStage) GetAdd() *plugin.TValue[[]any] {
-	return &c.Add
-}
-
-func (c *mqlDockerFileStage) GetCopy() *plugin.TValue[[]any] {
-	return &c.Copy
-}
-
-func (c *mqlDockerFileStage) GetExpose() *plugin.TValue[[]any] {
-	return &c.Expose
-}
-
-func (c *mqlDockerFileStage) GetHealthcheck() *plugin.TValue[*mqlDockerFileHealthcheck] {
-	return &c.Healthcheck
+func (c *mqlService) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
 }
 
-func (c *mqlDockerFileStage) GetVolumes() *plugin.TValue[[]any] {
-	return &c.Volumes
+func (c *mqlService) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlDockerFileStage) GetShell() *plugin.TValue[*mqlDockerFileShell] {
-	return &c.Shell
+func (c *mqlService) GetMasked() *plugin.TValue[bool] {
+	return &c.Masked
 }
 
-func (c *mqlDockerFileStage) GetWorkdir() *plugin.TValue[[]any] {
-	return &c.Workdir
+func (c *mqlService) GetStatic() *plugin.TValue[bool] {
+	return &c.Static
 }
 
-// mqlDockerFileArg for the docker.file.arg resource
-type mqlDockerFileArg struct {
+// mqlServices for the services resource
+type mqlServices struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileArgInternal it will be used here
-	Name    plugin.TValue[string]
-	Default plugin.TValue[string]
+	mqlServicesInternal
+	List plugin.TValue[[]any]
 }
 
-// createDockerFileArg creates a new instance of this resource
-func createDockerFileArg(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileArg{
+// createServices creates a new instance of this resource
+func createServices(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlServices{
 		MqlRuntime: runtime,
 	}
 
@@ -14640,7 +16389,7 @@ func createDockerFileArg(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.arg", res.__id)
+		args, err = runtime.ResourceFromRecording("services", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14650,34 +16399,44 @@ func createDockerFileArg(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileArg) MqlName() string {
-	return "docker.file.arg"
+func (c *mqlServices) MqlName() string {
+	return "services"
 }
 
-func (c *mqlDockerFileArg) MqlID() string {
+func (c *mqlServices) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileArg) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
+func (c *mqlServices) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("services", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlDockerFileArg) GetDefault() *plugin.TValue[string] {
-	return &c.Default
+		return c.list()
+	})
 }
 
-// mqlDockerFileEnv for the docker.file.env resource
-type mqlDockerFileEnv struct {
+// mqlKernel for the kernel resource
+type mqlKernel struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileEnvInternal it will be used here
-	Name  plugin.TValue[string]
-	Value plugin.TValue[string]
+	mqlKernelInternal
+	Info       plugin.TValue[any]
+	Parameters plugin.TValue[map[string]any]
+	Modules    plugin.TValue[[]any]
+	Installed  plugin.TValue[[]any]
 }
 
-// createDockerFileEnv creates a new instance of this resource
-func createDockerFileEnv(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileEnv{
+// createKernel creates a new instance of this resource
+func createKernel(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernel{
 		MqlRuntime: runtime,
 	}
 
@@ -14689,7 +16448,7 @@ func createDockerFileEnv(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.env", res.__id)
+		args, err = runtime.ResourceFromRecording("kernel", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14699,83 +16458,61 @@ func createDockerFileEnv(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileEnv) MqlName() string {
-	return "docker.file.env"
+func (c *mqlKernel) MqlName() string {
+	return "kernel"
 }
 
-func (c *mqlDockerFileEnv) MqlID() string {
+func (c *mqlKernel) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileEnv) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlDockerFileEnv) GetValue() *plugin.TValue[string] {
-	return &c.Value
+func (c *mqlKernel) GetInfo() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Info, func() (any, error) {
+		return c.info()
+	})
 }
 
-// mqlDockerFileUser for the docker.file.user resource
-type mqlDockerFileUser struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlDockerFileUserInternal it will be used here
-	User  plugin.TValue[string]
-	Group plugin.TValue[string]
+func (c *mqlKernel) GetParameters() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Parameters, func() (map[string]any, error) {
+		return c.parameters()
+	})
 }
 
-// createDockerFileUser creates a new instance of this resource
-func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileUser{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.user", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlKernel) GetModules() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Modules, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("kernel", c.__id, "modules")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
 		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
-}
-
-func (c *mqlDockerFileUser) MqlName() string {
-	return "docker.file.user"
-}
-
-func (c *mqlDockerFileUser) MqlID() string {
-	return c.__id
-}
 
-func (c *mqlDockerFileUser) GetUser() *plugin.TValue[string] {
-	return &c.User
+		return c.modules()
+	})
 }
 
-func (c *mqlDockerFileUser) GetGroup() *plugin.TValue[string] {
-	return &c.Group
+func (c *mqlKernel) GetInstalled() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Installed, func() ([]any, error) {
+		return c.installed()
+	})
 }
 
-// mqlDockerFileExpose for the docker.file.expose resource
-type mqlDockerFileExpose struct {
+// mqlKernelModule for the kernel.module resource
+type mqlKernelModule struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileExposeInternal it will be used here
-	Port     plugin.TValue[int64]
-	Protocol plugin.TValue[string]
+	// optional: if you define mqlKernelModuleInternal it will be used here
+	Name   plugin.TValue[string]
+	Size   plugin.TValue[string]
+	Loaded plugin.TValue[bool]
 }
 
-// createDockerFileExpose creates a new instance of this resource
-func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileExpose{
+// createKernelModule creates a new instance of this resource
+func createKernelModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKernelModule{
 		MqlRuntime: runtime,
 	}
 
@@ -14784,10 +16521,15 @@ func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawDat
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.expose", res.__id)
+		args, err = runtime.ResourceFromRecording("kernel.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14797,37 +16539,40 @@ func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlDockerFileExpose) MqlName() string {
-	return "docker.file.expose"
+func (c *mqlKernelModule) MqlName() string {
+	return "kernel.module"
 }
 
-func (c *mqlDockerFileExpose) MqlID() string {
+func (c *mqlKernelModule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileExpose) GetPort() *plugin.TValue[int64] {
-	return &c.Port
+func (c *mqlKernelModule) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerFileExpose) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlKernelModule) GetSize() *plugin.TValue[string] {
+	return &c.Size
 }
 
-// mqlDockerFileFrom for the docker.file.from resource
-type mqlDockerFileFrom struct {
+func (c *mqlKernelModule) GetLoaded() *plugin.TValue[bool] {
+	return &c.Loaded
+}
+
+// mqlBootloader for the bootloader resource
+type mqlBootloader struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileFromInternal it will be used here
-	Platform plugin.TValue[string]
-	Image    plugin.TValue[string]
-	Tag      plugin.TValue[string]
-	Digest   plugin.TValue[string]
-	Name     plugin.TValue[string]
+	// optional: if you define mqlBootloaderInternal it will be used here
+	Type        plugin.TValue[string]
+	Grub        plugin.TValue[*mqlBootloaderGrub]
+	SystemdBoot plugin.TValue[*mqlBootloaderSystemdBoot]
+	Entries     plugin.TValue[[]any]
 }
 
-// createDockerFileFrom creates a new instance of this resource
-func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileFrom{
+// createBootloader creates a new instance of this resource
+func createBootloader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlBootloader{
 		MqlRuntime: runtime,
 	}
 
@@ -14836,10 +16581,15 @@ func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.from", res.__id)
+		args, err = runtime.ResourceFromRecording("bootloader", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14849,45 +16599,110 @@ func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileFrom) MqlName() string {
-	return "docker.file.from"
+func (c *mqlBootloader) MqlName() string {
+	return "bootloader"
 }
 
-func (c *mqlDockerFileFrom) MqlID() string {
+func (c *mqlBootloader) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileFrom) GetPlatform() *plugin.TValue[string] {
-	return &c.Platform
-}
+func (c *mqlBootloader) GetType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
+		vargGrub := c.GetGrub()
+		if vargGrub.Error != nil {
+			return "", vargGrub.Error
+		}
 
-func (c *mqlDockerFileFrom) GetImage() *plugin.TValue[string] {
-	return &c.Image
+		vargSystemdBoot := c.GetSystemdBoot()
+		if vargSystemdBoot.Error != nil {
+			return "", vargSystemdBoot.Error
+		}
+
+		return c.compute_type(vargGrub.Data, vargSystemdBoot.Data)
+	})
 }
 
-func (c *mqlDockerFileFrom) GetTag() *plugin.TValue[string] {
-	return &c.Tag
+func (c *mqlBootloader) GetGrub() *plugin.TValue[*mqlBootloaderGrub] {
+	return plugin.GetOrCompute[*mqlBootloaderGrub](&c.Grub, func() (*mqlBootloaderGrub, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("bootloader", c.__id, "grub")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlBootloaderGrub), nil
+			}
+		}
+
+		return c.grub()
+	})
 }
 
-func (c *mqlDockerFileFrom) GetDigest() *plugin.TValue[string] {
-	return &c.Digest
+func (c *mqlBootloader) GetSystemdBoot() *plugin.TValue[*mqlBootloaderSystemdBoot] {
+	return plugin.GetOrCompute[*mqlBootloaderSystemdBoot](&c.SystemdBoot, func() (*mqlBootloaderSystemdBoot, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("bootloader", c.__id, "systemdBoot")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlBootloaderSystemdBoot), nil
+			}
+		}
+
+		return c.systemdBoot()
+	})
 }
 
-func (c *mqlDockerFileFrom) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlBootloader) GetEntries() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("bootloader", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargType := c.GetType()
+		if vargType.Error != nil {
+			return nil, vargType.Error
+		}
+
+		vargGrub := c.GetGrub()
+		if vargGrub.Error != nil {
+			return nil, vargGrub.Error
+		}
+
+		vargSystemdBoot := c.GetSystemdBoot()
+		if vargSystemdBoot.Error != nil {
+			return nil, vargSystemdBoot.Error
+		}
+
+		return c.entries(vargType.Data, vargGrub.Data, vargSystemdBoot.Data)
+	})
 }
 
-// mqlDockerFileRun for the docker.file.run resource
-type mqlDockerFileRun struct {
+// mqlBootloaderGrub for the bootloader.grub resource
+type mqlBootloaderGrub struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileRunInternal it will be used here
-	Script plugin.TValue[string]
-}
-
-// createDockerFileRun creates a new instance of this resource
-func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileRun{
+	// optional: if you define mqlBootloaderGrubInternal it will be used here
+	File              plugin.TValue[*mqlFile]
+	Files             plugin.TValue[[]any]
+	Defaults          plugin.TValue[map[string]any]
+	Superusers        plugin.TValue[[]any]
+	PasswordProtected plugin.TValue[bool]
+	Bls               plugin.TValue[bool]
+	Entries           plugin.TValue[[]any]
+}
+
+// createBootloaderGrub creates a new instance of this resource
+func createBootloaderGrub(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlBootloaderGrub{
 		MqlRuntime: runtime,
 	}
 
@@ -14899,7 +16714,7 @@ func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.run", res.__id)
+		args, err = runtime.ResourceFromRecording("bootloader.grub", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14909,32 +16724,57 @@ func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileRun) MqlName() string {
-	return "docker.file.run"
+func (c *mqlBootloaderGrub) MqlName() string {
+	return "bootloader.grub"
 }
 
-func (c *mqlDockerFileRun) MqlID() string {
+func (c *mqlBootloaderGrub) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileRun) GetScript() *plugin.TValue[string] {
-	return &c.Script
+func (c *mqlBootloaderGrub) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-// mqlDockerFileAdd for the docker.file.add resource
-type mqlDockerFileAdd struct {
+func (c *mqlBootloaderGrub) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
+}
+
+func (c *mqlBootloaderGrub) GetDefaults() *plugin.TValue[map[string]any] {
+	return &c.Defaults
+}
+
+func (c *mqlBootloaderGrub) GetSuperusers() *plugin.TValue[[]any] {
+	return &c.Superusers
+}
+
+func (c *mqlBootloaderGrub) GetPasswordProtected() *plugin.TValue[bool] {
+	return &c.PasswordProtected
+}
+
+func (c *mqlBootloaderGrub) GetBls() *plugin.TValue[bool] {
+	return &c.Bls
+}
+
+func (c *mqlBootloaderGrub) GetEntries() *plugin.TValue[[]any] {
+	return &c.Entries
+}
+
+// mqlBootloaderSystemdBoot for the bootloader.systemdBoot resource
+type mqlBootloaderSystemdBoot struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileAddInternal it will be used here
-	Src   plugin.TValue[[]any]
-	Dst   plugin.TValue[string]
-	Chown plugin.TValue[string]
-	Chmod plugin.TValue[string]
+	// optional: if you define mqlBootloaderSystemdBootInternal it will be used here
+	File    plugin.TValue[*mqlFile]
+	Default plugin.TValue[string]
+	Timeout plugin.TValue[int64]
+	Editor  plugin.TValue[bool]
+	Entries plugin.TValue[[]any]
 }
 
-// createDockerFileAdd creates a new instance of this resource
-func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileAdd{
+// createBootloaderSystemdBoot creates a new instance of this resource
+func createBootloaderSystemdBoot(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlBootloaderSystemdBoot{
 		MqlRuntime: runtime,
 	}
 
@@ -14946,7 +16786,7 @@ func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.add", res.__id)
+		args, err = runtime.ResourceFromRecording("bootloader.systemdBoot", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -14956,44 +16796,52 @@ func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileAdd) MqlName() string {
-	return "docker.file.add"
+func (c *mqlBootloaderSystemdBoot) MqlName() string {
+	return "bootloader.systemdBoot"
 }
 
-func (c *mqlDockerFileAdd) MqlID() string {
+func (c *mqlBootloaderSystemdBoot) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileAdd) GetSrc() *plugin.TValue[[]any] {
-	return &c.Src
+func (c *mqlBootloaderSystemdBoot) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlDockerFileAdd) GetDst() *plugin.TValue[string] {
-	return &c.Dst
+func (c *mqlBootloaderSystemdBoot) GetDefault() *plugin.TValue[string] {
+	return &c.Default
 }
 
-func (c *mqlDockerFileAdd) GetChown() *plugin.TValue[string] {
-	return &c.Chown
+func (c *mqlBootloaderSystemdBoot) GetTimeout() *plugin.TValue[int64] {
+	return &c.Timeout
 }
 
-func (c *mqlDockerFileAdd) GetChmod() *plugin.TValue[string] {
-	return &c.Chmod
+func (c *mqlBootloaderSystemdBoot) GetEditor() *plugin.TValue[bool] {
+	return &c.Editor
 }
 
-// mqlDockerFileCopy for the docker.file.copy resource
-type mqlDockerFileCopy struct {
+func (c *mqlBootloaderSystemdBoot) GetEntries() *plugin.TValue[[]any] {
+	return &c.Entries
+}
+
+// mqlBootloaderEntry for the bootloader.entry resource
+type mqlBootloaderEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileCopyInternal it will be used here
-	Src   plugin.TValue[[]any]
-	Dst   plugin.TValue[string]
-	Chown plugin.TValue[string]
-	Chmod plugin.TValue[string]
+	// optional: if you define mqlBootloaderEntryInternal it will be used here
+	Title        plugin.TValue[string]
+	Version      plugin.TValue[string]
+	Kernel       plugin.TValue[string]
+	Initrd       plugin.TValue[[]any]
+	Cmdline      plugin.TValue[string]
+	Parameters   plugin.TValue[map[string]any]
+	Unrestricted plugin.TValue[bool]
+	Source       plugin.TValue[string]
 }
 
-// createDockerFileCopy creates a new instance of this resource
-func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileCopy{
+// createBootloaderEntry creates a new instance of this resource
+func createBootloaderEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlBootloaderEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -15005,7 +16853,7 @@ func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.copy", res.__id)
+		args, err = runtime.ResourceFromRecording("bootloader.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15015,47 +16863,59 @@ func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDockerFileCopy) MqlName() string {
-	return "docker.file.copy"
+func (c *mqlBootloaderEntry) MqlName() string {
+	return "bootloader.entry"
 }
 
-func (c *mqlDockerFileCopy) MqlID() string {
+func (c *mqlBootloaderEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileCopy) GetSrc() *plugin.TValue[[]any] {
-	return &c.Src
+func (c *mqlBootloaderEntry) GetTitle() *plugin.TValue[string] {
+	return &c.Title
 }
 
-func (c *mqlDockerFileCopy) GetDst() *plugin.TValue[string] {
-	return &c.Dst
+func (c *mqlBootloaderEntry) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlDockerFileCopy) GetChown() *plugin.TValue[string] {
-	return &c.Chown
+func (c *mqlBootloaderEntry) GetKernel() *plugin.TValue[string] {
+	return &c.Kernel
 }
 
-func (c *mqlDockerFileCopy) GetChmod() *plugin.TValue[string] {
-	return &c.Chmod
+func (c *mqlBootloaderEntry) GetInitrd() *plugin.TValue[[]any] {
+	return &c.Initrd
 }
 
-// mqlDockerFileHealthcheck for the docker.file.healthcheck resource
-type mqlDockerFileHealthcheck struct {
+func (c *mqlBootloaderEntry) GetCmdline() *plugin.TValue[string] {
+	return &c.Cmdline
+}
+
+func (c *mqlBootloaderEntry) GetParameters() *plugin.TValue[map[string]any] {
+	return &c.Parameters
+}
+
+func (c *mqlBootloaderEntry) GetUnrestricted() *plugin.TValue[bool] {
+	return &c.Unrestricted
+}
+
+func (c *mqlBootloaderEntry) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+// mqlSysctl for the sysctl resource
+type mqlSysctl struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileHealthcheckInternal it will be used here
-	Test          plugin.TValue[[]any]
-	Interval      plugin.TValue[int64]
-	Timeout       plugin.TValue[int64]
-	StartPeriod   plugin.TValue[int64]
-	StartInterval plugin.TValue[int64]
-	Retries       plugin.TValue[int64]
-	None          plugin.TValue[bool]
+	mqlSysctlInternal
+	Runtime plugin.TValue[bool]
+	Files   plugin.TValue[[]any]
+	Entries plugin.TValue[[]any]
 }
 
-// createDockerFileHealthcheck creates a new instance of this resource
-func createDockerFileHealthcheck(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileHealthcheck{
+// createSysctl creates a new instance of this resource
+func createSysctl(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSysctl{
 		MqlRuntime: runtime,
 	}
 
@@ -15064,10 +16924,15 @@ func createDockerFileHealthcheck(runtime *plugin.Runtime, args map[string]*llx.R
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.healthcheck", res.__id)
+		args, err = runtime.ResourceFromRecording("sysctl", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15077,53 +16942,72 @@ func createDockerFileHealthcheck(runtime *plugin.Runtime, args map[string]*llx.R
 	return res, nil
 }
 
-func (c *mqlDockerFileHealthcheck) MqlName() string {
-	return "docker.file.healthcheck"
+func (c *mqlSysctl) MqlName() string {
+	return "sysctl"
 }
 
-func (c *mqlDockerFileHealthcheck) MqlID() string {
+func (c *mqlSysctl) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileHealthcheck) GetTest() *plugin.TValue[[]any] {
-	return &c.Test
-}
-
-func (c *mqlDockerFileHealthcheck) GetInterval() *plugin.TValue[int64] {
-	return &c.Interval
+func (c *mqlSysctl) GetRuntime() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Runtime, func() (bool, error) {
+		return c.runtime()
+	})
 }
 
-func (c *mqlDockerFileHealthcheck) GetTimeout() *plugin.TValue[int64] {
-	return &c.Timeout
-}
+func (c *mqlSysctl) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sysctl", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlDockerFileHealthcheck) GetStartPeriod() *plugin.TValue[int64] {
-	return &c.StartPeriod
+		return c.files()
+	})
 }
 
-func (c *mqlDockerFileHealthcheck) GetStartInterval() *plugin.TValue[int64] {
-	return &c.StartInterval
-}
+func (c *mqlSysctl) GetEntries() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sysctl", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlDockerFileHealthcheck) GetRetries() *plugin.TValue[int64] {
-	return &c.Retries
-}
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return nil, vargRuntime.Error
+		}
 
-func (c *mqlDockerFileHealthcheck) GetNone() *plugin.TValue[bool] {
-	return &c.None
+		return c.entries(vargRuntime.Data)
+	})
 }
 
-// mqlDockerFileVolume for the docker.file.volume resource
-type mqlDockerFileVolume struct {
+// mqlSysctlEntry for the sysctl.entry resource
+type mqlSysctlEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerFileVolumeInternal it will be used here
-	Path plugin.TValue[string]
+	// optional: if you define mqlSysctlEntryInternal it will be used here
+	Name           plugin.TValue[string]
+	Value          plugin.TValue[string]
+	PersistedValue plugin.TValue[string]
+	Source         plugin.TValue[string]
+	Drift          plugin.TValue[bool]
 }
 
-// createDockerFileVolume creates a new instance of this resource
-func createDockerFileVolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileVolume{
+// createSysctlEntry creates a new instance of this resource
+func createSysctlEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSysctlEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -15135,7 +17019,7 @@ func createDockerFileVolume(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.volume", res.__id)
+		args, err = runtime.ResourceFromRecording("sysctl.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15145,29 +17029,53 @@ func createDockerFileVolume(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlDockerFileVolume) MqlName() string {
-	return "docker.file.volume"
+func (c *mqlSysctlEntry) MqlName() string {
+	return "sysctl.entry"
 }
 
-func (c *mqlDockerFileVolume) MqlID() string {
+func (c *mqlSysctlEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileVolume) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlSysctlEntry) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-// mqlDockerFileShell for the docker.file.shell resource
-type mqlDockerFileShell struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlDockerFileShellInternal it will be used here
-	Command plugin.TValue[[]any]
+func (c *mqlSysctlEntry) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// createDockerFileShell creates a new instance of this resource
-func createDockerFileShell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileShell{
+func (c *mqlSysctlEntry) GetPersistedValue() *plugin.TValue[string] {
+	return &c.PersistedValue
+}
+
+func (c *mqlSysctlEntry) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlSysctlEntry) GetDrift() *plugin.TValue[bool] {
+	return &c.Drift
+}
+
+// mqlSelinux for the selinux resource
+type mqlSelinux struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlSelinuxInternal
+	Runtime       plugin.TValue[bool]
+	Enabled       plugin.TValue[bool]
+	Mode          plugin.TValue[string]
+	ConfigMode    plugin.TValue[string]
+	PolicyType    plugin.TValue[string]
+	PolicyVersion plugin.TValue[int64]
+	Booleans      plugin.TValue[[]any]
+	FileContexts  plugin.TValue[[]any]
+	Modules       plugin.TValue[[]any]
+}
+
+// createSelinux creates a new instance of this resource
+func createSelinux(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinux{
 		MqlRuntime: runtime,
 	}
 
@@ -15176,10 +17084,15 @@ func createDockerFileShell(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.shell", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15189,77 +17102,166 @@ func createDockerFileShell(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlDockerFileShell) MqlName() string {
-	return "docker.file.shell"
+func (c *mqlSelinux) MqlName() string {
+	return "selinux"
 }
 
-func (c *mqlDockerFileShell) MqlID() string {
+func (c *mqlSelinux) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerFileShell) GetCommand() *plugin.TValue[[]any] {
-	return &c.Command
+func (c *mqlSelinux) GetRuntime() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Runtime, func() (bool, error) {
+		return c.runtime()
+	})
 }
 
-// mqlDockerFileWorkdir for the docker.file.workdir resource
-type mqlDockerFileWorkdir struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlDockerFileWorkdirInternal it will be used here
-	Path plugin.TValue[string]
+func (c *mqlSelinux) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return false, vargRuntime.Error
+		}
+
+		return c.enabled(vargRuntime.Data)
+	})
 }
 
-// createDockerFileWorkdir creates a new instance of this resource
-func createDockerFileWorkdir(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerFileWorkdir{
-		MqlRuntime: runtime,
-	}
+func (c *mqlSelinux) GetMode() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Mode, func() (string, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return "", vargRuntime.Error
+		}
 
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+		vargEnabled := c.GetEnabled()
+		if vargEnabled.Error != nil {
+			return "", vargEnabled.Error
+		}
 
-	// to override __id implement: id() (string, error)
+		return c.mode(vargRuntime.Data, vargEnabled.Data)
+	})
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.file.workdir", res.__id)
-		if err != nil || args == nil {
-			return res, err
+func (c *mqlSelinux) GetConfigMode() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ConfigMode, func() (string, error) {
+		return c.configMode()
+	})
+}
+
+func (c *mqlSelinux) GetPolicyType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PolicyType, func() (string, error) {
+		return c.policyType()
+	})
+}
+
+func (c *mqlSelinux) GetPolicyVersion() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.PolicyVersion, func() (int64, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return 0, vargRuntime.Error
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
+		vargEnabled := c.GetEnabled()
+		if vargEnabled.Error != nil {
+			return 0, vargEnabled.Error
+		}
+
+		vargPolicyType := c.GetPolicyType()
+		if vargPolicyType.Error != nil {
+			return 0, vargPolicyType.Error
+		}
+
+		return c.policyVersion(vargRuntime.Data, vargEnabled.Data, vargPolicyType.Data)
+	})
 }
 
-func (c *mqlDockerFileWorkdir) MqlName() string {
-	return "docker.file.workdir"
+func (c *mqlSelinux) GetBooleans() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Booleans, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("selinux", c.__id, "booleans")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return nil, vargRuntime.Error
+		}
+
+		vargEnabled := c.GetEnabled()
+		if vargEnabled.Error != nil {
+			return nil, vargEnabled.Error
+		}
+
+		vargPolicyType := c.GetPolicyType()
+		if vargPolicyType.Error != nil {
+			return nil, vargPolicyType.Error
+		}
+
+		return c.booleans(vargRuntime.Data, vargEnabled.Data, vargPolicyType.Data)
+	})
 }
 
-func (c *mqlDockerFileWorkdir) MqlID() string {
-	return c.__id
+func (c *mqlSelinux) GetFileContexts() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.FileContexts, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("selinux", c.__id, "fileContexts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargPolicyType := c.GetPolicyType()
+		if vargPolicyType.Error != nil {
+			return nil, vargPolicyType.Error
+		}
+
+		return c.fileContexts(vargPolicyType.Data)
+	})
 }
 
-func (c *mqlDockerFileWorkdir) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlSelinux) GetModules() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Modules, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("selinux", c.__id, "modules")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargPolicyType := c.GetPolicyType()
+		if vargPolicyType.Error != nil {
+			return nil, vargPolicyType.Error
+		}
+
+		return c.modules(vargPolicyType.Data)
+	})
 }
 
-// mqlDockerImage for the docker.image resource
-type mqlDockerImage struct {
+// mqlSelinuxBoolean for the selinux.boolean resource
+type mqlSelinuxBoolean struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerImageInternal it will be used here
-	Id          plugin.TValue[string]
-	Size        plugin.TValue[int64]
-	RepoDigests plugin.TValue[[]any]
-	Tags        plugin.TValue[[]any]
-	Labels      plugin.TValue[map[string]any]
+	// optional: if you define mqlSelinuxBooleanInternal it will be used here
+	Name    plugin.TValue[string]
+	Value   plugin.TValue[bool]
+	Pending plugin.TValue[bool]
 }
 
-// createDockerImage creates a new instance of this resource
-func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerImage{
+// createSelinuxBoolean creates a new instance of this resource
+func createSelinuxBoolean(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxBoolean{
 		MqlRuntime: runtime,
 	}
 
@@ -15268,15 +17270,10 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.boolean", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15286,54 +17283,40 @@ func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlDockerImage) MqlName() string {
-	return "docker.image"
+func (c *mqlSelinuxBoolean) MqlName() string {
+	return "selinux.boolean"
 }
 
-func (c *mqlDockerImage) MqlID() string {
+func (c *mqlSelinuxBoolean) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
-	return &c.Size
-}
-
-func (c *mqlDockerImage) GetRepoDigests() *plugin.TValue[[]any] {
-	return &c.RepoDigests
+func (c *mqlSelinuxBoolean) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlDockerImage) GetTags() *plugin.TValue[[]any] {
-	return &c.Tags
+func (c *mqlSelinuxBoolean) GetValue() *plugin.TValue[bool] {
+	return &c.Value
 }
 
-func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]any] {
-	return &c.Labels
+func (c *mqlSelinuxBoolean) GetPending() *plugin.TValue[bool] {
+	return &c.Pending
 }
 
-// mqlDockerContainer for the docker.container resource
-type mqlDockerContainer struct {
+// mqlSelinuxFileContext for the selinux.fileContext resource
+type mqlSelinuxFileContext struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlDockerContainerInternal it will be used here
-	Os         plugin.TValue[*mqlOsLinux]
-	Id         plugin.TValue[string]
-	Command    plugin.TValue[string]
-	Image      plugin.TValue[string]
-	Imageid    plugin.TValue[string]
-	Names      plugin.TValue[[]any]
-	State      plugin.TValue[string]
-	Status     plugin.TValue[string]
-	Labels     plugin.TValue[map[string]any]
-	HostConfig plugin.TValue[any]
+	// optional: if you define mqlSelinuxFileContextInternal it will be used here
+	Path     plugin.TValue[string]
+	FileType plugin.TValue[string]
+	Context  plugin.TValue[string]
+	Local    plugin.TValue[bool]
 }
 
-// createDockerContainer creates a new instance of this resource
-func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDockerContainer{
+// createSelinuxFileContext creates a new instance of this resource
+func createSelinuxFileContext(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxFileContext{
 		MqlRuntime: runtime,
 	}
 
@@ -15342,15 +17325,10 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.fileContext", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15360,79 +17338,43 @@ func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlDockerContainer) MqlName() string {
-	return "docker.container"
+func (c *mqlSelinuxFileContext) MqlName() string {
+	return "selinux.fileContext"
 }
 
-func (c *mqlDockerContainer) MqlID() string {
+func (c *mqlSelinuxFileContext) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
-	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlOsLinux), nil
-			}
-		}
-
-		return c.os()
-	})
-}
-
-func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
-	return &c.Command
-}
-
-func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
-}
-
-func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
-	return &c.Imageid
-}
-
-func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]any] {
-	return &c.Names
-}
-
-func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
-	return &c.State
+func (c *mqlSelinuxFileContext) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
-	return &c.Status
+func (c *mqlSelinuxFileContext) GetFileType() *plugin.TValue[string] {
+	return &c.FileType
 }
 
-func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]any] {
-	return &c.Labels
+func (c *mqlSelinuxFileContext) GetContext() *plugin.TValue[string] {
+	return &c.Context
 }
 
-func (c *mqlDockerContainer) GetHostConfig() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.HostConfig, func() (any, error) {
-		return c.hostConfig()
-	})
+func (c *mqlSelinuxFileContext) GetLocal() *plugin.TValue[bool] {
+	return &c.Local
 }
 
-// mqlContainerd for the containerd resource
-type mqlContainerd struct {
+// mqlSelinuxModule for the selinux.module resource
+type mqlSelinuxModule struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlContainerdInternal it will be used here
-	Containers plugin.TValue[[]any]
+	// optional: if you define mqlSelinuxModuleInternal it will be used here
+	Name     plugin.TValue[string]
+	Priority plugin.TValue[int64]
+	Enabled  plugin.TValue[bool]
 }
 
-// createContainerd creates a new instance of this resource
-func createContainerd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerd{
+// createSelinuxModule creates a new instance of this resource
+func createSelinuxModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSelinuxModule{
 		MqlRuntime: runtime,
 	}
 
@@ -15444,7 +17386,7 @@ func createContainerd(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("containerd", res.__id)
+		args, err = runtime.ResourceFromRecording("selinux.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15454,48 +17396,39 @@ func createContainerd(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlContainerd) MqlName() string {
-	return "containerd"
+func (c *mqlSelinuxModule) MqlName() string {
+	return "selinux.module"
 }
 
-func (c *mqlContainerd) MqlID() string {
+func (c *mqlSelinuxModule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerd) GetContainers() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Containers, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("containerd", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlSelinuxModule) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
 
-		return c.containers()
-	})
+func (c *mqlSelinuxModule) GetPriority() *plugin.TValue[int64] {
+	return &c.Priority
 }
 
-// mqlContainerdContainer for the containerd.container resource
-type mqlContainerdContainer struct {
+func (c *mqlSelinuxModule) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
+
+// mqlApparmor for the apparmor resource
+type mqlApparmor struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlContainerdContainerInternal it will be used here
-	Id          plugin.TValue[string]
-	Image       plugin.TValue[string]
-	Status      plugin.TValue[string]
-	Labels      plugin.TValue[map[string]any]
-	Pid         plugin.TValue[int64]
-	Namespace   plugin.TValue[string]
-	Runtime     plugin.TValue[string]
-	Snapshotter plugin.TValue[string]
+	// optional: if you define mqlApparmorInternal it will be used here
+	Runtime  plugin.TValue[bool]
+	Enabled  plugin.TValue[bool]
+	Profiles plugin.TValue[[]any]
 }
 
-// createContainerdContainer creates a new instance of this resource
-func createContainerdContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerdContainer{
+// createApparmor creates a new instance of this resource
+func createApparmor(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlApparmor{
 		MqlRuntime: runtime,
 	}
 
@@ -15512,7 +17445,7 @@ func createContainerdContainer(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("containerd.container", res.__id)
+		args, err = runtime.ResourceFromRecording("apparmor", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15522,58 +17455,123 @@ func createContainerdContainer(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlContainerdContainer) MqlName() string {
-	return "containerd.container"
+func (c *mqlApparmor) MqlName() string {
+	return "apparmor"
 }
 
-func (c *mqlContainerdContainer) MqlID() string {
+func (c *mqlApparmor) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerdContainer) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlApparmor) GetRuntime() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Runtime, func() (bool, error) {
+		return c.runtime()
+	})
 }
 
-func (c *mqlContainerdContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
+func (c *mqlApparmor) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return false, vargRuntime.Error
+		}
+
+		return c.enabled(vargRuntime.Data)
+	})
 }
 
-func (c *mqlContainerdContainer) GetStatus() *plugin.TValue[string] {
-	return &c.Status
+func (c *mqlApparmor) GetProfiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Profiles, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("apparmor", c.__id, "profiles")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return nil, vargRuntime.Error
+		}
+
+		vargEnabled := c.GetEnabled()
+		if vargEnabled.Error != nil {
+			return nil, vargEnabled.Error
+		}
+
+		return c.profiles(vargRuntime.Data, vargEnabled.Data)
+	})
 }
 
-func (c *mqlContainerdContainer) GetLabels() *plugin.TValue[map[string]any] {
-	return &c.Labels
+// mqlApparmorProfile for the apparmor.profile resource
+type mqlApparmorProfile struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlApparmorProfileInternal it will be used here
+	Name plugin.TValue[string]
+	Mode plugin.TValue[string]
+	File plugin.TValue[string]
 }
 
-func (c *mqlContainerdContainer) GetPid() *plugin.TValue[int64] {
-	return &c.Pid
+// createApparmorProfile creates a new instance of this resource
+func createApparmorProfile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlApparmorProfile{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("apparmor.profile", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlContainerdContainer) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
+func (c *mqlApparmorProfile) MqlName() string {
+	return "apparmor.profile"
 }
 
-func (c *mqlContainerdContainer) GetRuntime() *plugin.TValue[string] {
-	return &c.Runtime
+func (c *mqlApparmorProfile) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlContainerdContainer) GetSnapshotter() *plugin.TValue[string] {
-	return &c.Snapshotter
+func (c *mqlApparmorProfile) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-// mqlIptables for the iptables resource
-type mqlIptables struct {
+func (c *mqlApparmorProfile) GetMode() *plugin.TValue[string] {
+	return &c.Mode
+}
+
+func (c *mqlApparmorProfile) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
+
+// mqlDocker for the docker resource
+type mqlDocker struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlIptablesInternal it will be used here
-	Input  plugin.TValue[[]any]
-	Output plugin.TValue[[]any]
+	// optional: if you define mqlDockerInternal it will be used here
+	Images     plugin.TValue[[]any]
+	Containers plugin.TValue[[]any]
 }
 
-// createIptables creates a new instance of this resource
-func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptables{
+// createDocker creates a new instance of this resource
+func createDocker(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDocker{
 		MqlRuntime: runtime,
 	}
 
@@ -15585,7 +17583,7 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables", res.__id)
+		args, err = runtime.ResourceFromRecording("docker", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15595,18 +17593,18 @@ func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlIptables) MqlName() string {
-	return "iptables"
+func (c *mqlDocker) MqlName() string {
+	return "docker"
 }
 
-func (c *mqlIptables) MqlID() string {
+func (c *mqlDocker) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptables) GetInput() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Input, func() ([]any, error) {
+func (c *mqlDocker) GetImages() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Images, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "images")
 			if err != nil {
 				return nil, err
 			}
@@ -15615,14 +17613,14 @@ func (c *mqlIptables) GetInput() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.input()
+		return c.images()
 	})
 }
 
-func (c *mqlIptables) GetOutput() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Output, func() ([]any, error) {
+func (c *mqlDocker) GetContainers() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Containers, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker", c.__id, "containers")
 			if err != nil {
 				return nil, err
 			}
@@ -15631,22 +17629,23 @@ func (c *mqlIptables) GetOutput() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.output()
+		return c.containers()
 	})
 }
 
-// mqlIp6tables for the ip6tables resource
-type mqlIp6tables struct {
+// mqlDockerFile for the docker.file resource
+type mqlDockerFile struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlIp6tablesInternal it will be used here
-	Input  plugin.TValue[[]any]
-	Output plugin.TValue[[]any]
+	mqlDockerFileInternal
+	File         plugin.TValue[*mqlFile]
+	Instructions plugin.TValue[any]
+	Stages       plugin.TValue[[]any]
 }
 
-// createIp6tables creates a new instance of this resource
-func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIp6tables{
+// createDockerFile creates a new instance of this resource
+func createDockerFile(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFile{
 		MqlRuntime: runtime,
 	}
 
@@ -15655,10 +17654,15 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15668,34 +17672,45 @@ func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlIp6tables) MqlName() string {
-	return "ip6tables"
+func (c *mqlDockerFile) MqlName() string {
+	return "docker.file"
 }
 
-func (c *mqlIp6tables) MqlID() string {
+func (c *mqlDockerFile) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIp6tables) GetInput() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Input, func() ([]any, error) {
+func (c *mqlDockerFile) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "file")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.([]any), nil
+				return d.Value.(*mqlFile), nil
 			}
 		}
 
-		return c.input()
+		return c.file()
 	})
 }
 
-func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Output, func() ([]any, error) {
+func (c *mqlDockerFile) GetInstructions() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Instructions, func() (any, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.instructions(vargFile.Data)
+	})
+}
+
+func (c *mqlDockerFile) GetStages() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Stages, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.file", c.__id, "stages")
 			if err != nil {
 				return nil, err
 			}
@@ -15704,32 +17719,41 @@ func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.output()
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return nil, vargFile.Error
+		}
+
+		return c.stages(vargFile.Data)
 	})
 }
 
-// mqlIptablesEntry for the iptables.entry resource
-type mqlIptablesEntry struct {
+// mqlDockerFileStage for the docker.file.stage resource
+type mqlDockerFileStage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlIptablesEntryInternal it will be used here
-	LineNumber  plugin.TValue[int64]
-	Packets     plugin.TValue[int64]
-	Bytes       plugin.TValue[int64]
-	Target      plugin.TValue[string]
-	Protocol    plugin.TValue[string]
-	Opt         plugin.TValue[string]
-	In          plugin.TValue[string]
-	Out         plugin.TValue[string]
-	Source      plugin.TValue[string]
-	Destination plugin.TValue[string]
-	Options     plugin.TValue[string]
-	Chain       plugin.TValue[string]
+	// optional: if you define mqlDockerFileStageInternal it will be used here
+	From        plugin.TValue[*mqlDockerFileFrom]
+	File        plugin.TValue[*mqlDockerFile]
+	Env         plugin.TValue[[]any]
+	Arg         plugin.TValue[[]any]
+	Labels      plugin.TValue[map[string]any]
+	Run         plugin.TValue[[]any]
+	Cmd         plugin.TValue[*mqlDockerFileRun]
+	User        plugin.TValue[*mqlDockerFileUser]
+	Entrypoint  plugin.TValue[*mqlDockerFileRun]
+	Add         plugin.TValue[[]any]
+	Copy        plugin.TValue[[]any]
+	Expose      plugin.TValue[[]any]
+	Healthcheck plugin.TValue[*mqlDockerFileHealthcheck]
+	Volumes     plugin.TValue[[]any]
+	Shell       plugin.TValue[*mqlDockerFileShell]
+	Workdir     plugin.TValue[[]any]
 }
 
-// createIptablesEntry creates a new instance of this resource
-func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlIptablesEntry{
+// createDockerFileStage creates a new instance of this resource
+func createDockerFileStage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileStage{
 		MqlRuntime: runtime,
 	}
 
@@ -15738,15 +17762,10 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.stage", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15756,73 +17775,90 @@ func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlIptablesEntry) MqlName() string {
-	return "iptables.entry"
+func (c *mqlDockerFileStage) MqlName() string {
+	return "docker.file.stage"
 }
 
-func (c *mqlIptablesEntry) MqlID() string {
+func (c *mqlDockerFileStage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlDockerFileStage) GetFrom() *plugin.TValue[*mqlDockerFileFrom] {
+	return &c.From
 }
 
-func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
-	return &c.Packets
+func (c *mqlDockerFileStage) GetFile() *plugin.TValue[*mqlDockerFile] {
+	return &c.File
 }
 
-func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
-	return &c.Bytes
+func (c *mqlDockerFileStage) GetEnv() *plugin.TValue[[]any] {
+	return &c.Env
 }
 
-func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
-	return &c.Target
+func (c *mqlDockerFileStage) GetArg() *plugin.TValue[[]any] {
+	return &c.Arg
 }
 
-func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlDockerFileStage) GetLabels() *plugin.TValue[map[string]any] {
+	return &c.Labels
 }
 
-func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
-	return &c.Opt
+func (c *mqlDockerFileStage) GetRun() *plugin.TValue[[]any] {
+	return &c.Run
 }
 
-func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
-	return &c.In
+func (c *mqlDockerFileStage) GetCmd() *plugin.TValue[*mqlDockerFileRun] {
+	return &c.Cmd
 }
 
-func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
-	return &c.Out
+func (c *mqlDockerFileStage) GetUser() *plugin.TValue[*mqlDockerFileUser] {
+	return &c.User
 }
 
-func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
-	return &c.Source
+func (c *mqlDockerFileStage) GetEntrypoint() *plugin.TValue[*mqlDockerFileRun] {
+	return &c.Entrypoint
 }
 
-func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
-	return &c.Destination
+func (c *mqlDockerFileStage) GetAdd() *plugin.TValue[[]any] {
+	return &c.Add
 }
 
-func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
-	return &c.Options
+func (c *mqlDockerFileStage) GetCopy() *plugin.TValue[[]any] {
+	return &c.Copy
 }
 
-func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
-	return &c.Chain
+func (c *mqlDockerFileStage) GetExpose() *plugin.TValue[[]any] {
+	return &c.Expose
 }
 
-// mqlNftables for the nftables resource
-type mqlNftables struct {
+func (c *mqlDockerFileStage) GetHealthcheck() *plugin.TValue[*mqlDockerFileHealthcheck] {
+	return &c.Healthcheck
+}
+
+func (c *mqlDockerFileStage) GetVolumes() *plugin.TValue[[]any] {
+	return &c.Volumes
+}
+
+func (c *mqlDockerFileStage) GetShell() *plugin.TValue[*mqlDockerFileShell] {
+	return &c.Shell
+}
+
+func (c *mqlDockerFileStage) GetWorkdir() *plugin.TValue[[]any] {
+	return &c.Workdir
+}
+
+// mqlDockerFileArg for the docker.file.arg resource
+type mqlDockerFileArg struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNftablesInternal it will be used here
-	Tables plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileArgInternal it will be used here
+	Name    plugin.TValue[string]
+	Default plugin.TValue[string]
 }
 
-// createNftables creates a new instance of this resource
-func createNftables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNftables{
+// createDockerFileArg creates a new instance of this resource
+func createDockerFileArg(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileArg{
 		MqlRuntime: runtime,
 	}
 
@@ -15831,15 +17867,10 @@ func createNftables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("nftables", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.arg", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15849,46 +17880,34 @@ func createNftables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlNftables) MqlName() string {
-	return "nftables"
+func (c *mqlDockerFileArg) MqlName() string {
+	return "docker.file.arg"
 }
 
-func (c *mqlNftables) MqlID() string {
+func (c *mqlDockerFileArg) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNftables) GetTables() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Tables, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("nftables", c.__id, "tables")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlDockerFileArg) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
 
-		return c.tables()
-	})
+func (c *mqlDockerFileArg) GetDefault() *plugin.TValue[string] {
+	return &c.Default
 }
 
-// mqlNftablesTable for the nftables.table resource
-type mqlNftablesTable struct {
+// mqlDockerFileEnv for the docker.file.env resource
+type mqlDockerFileEnv struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNftablesTableInternal it will be used here
-	Family plugin.TValue[string]
-	Name   plugin.TValue[string]
-	Handle plugin.TValue[int64]
-	Flags  plugin.TValue[[]any]
-	Chains plugin.TValue[[]any]
-	Rules  plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileEnvInternal it will be used here
+	Name  plugin.TValue[string]
+	Value plugin.TValue[string]
 }
 
-// createNftablesTable creates a new instance of this resource
-func createNftablesTable(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNftablesTable{
+// createDockerFileEnv creates a new instance of this resource
+func createDockerFileEnv(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileEnv{
 		MqlRuntime: runtime,
 	}
 
@@ -15897,15 +17916,10 @@ func createNftablesTable(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("nftables.table", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.env", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15915,58 +17929,34 @@ func createNftablesTable(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlNftablesTable) MqlName() string {
-	return "nftables.table"
+func (c *mqlDockerFileEnv) MqlName() string {
+	return "docker.file.env"
 }
 
-func (c *mqlNftablesTable) MqlID() string {
+func (c *mqlDockerFileEnv) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNftablesTable) GetFamily() *plugin.TValue[string] {
-	return &c.Family
-}
-
-func (c *mqlNftablesTable) GetName() *plugin.TValue[string] {
+func (c *mqlDockerFileEnv) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlNftablesTable) GetHandle() *plugin.TValue[int64] {
-	return &c.Handle
-}
-
-func (c *mqlNftablesTable) GetFlags() *plugin.TValue[[]any] {
-	return &c.Flags
-}
-
-func (c *mqlNftablesTable) GetChains() *plugin.TValue[[]any] {
-	return &c.Chains
-}
-
-func (c *mqlNftablesTable) GetRules() *plugin.TValue[[]any] {
-	return &c.Rules
+func (c *mqlDockerFileEnv) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// mqlNftablesChain for the nftables.chain resource
-type mqlNftablesChain struct {
+// mqlDockerFileUser for the docker.file.user resource
+type mqlDockerFileUser struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNftablesChainInternal it will be used here
-	Family      plugin.TValue[string]
-	Table       plugin.TValue[string]
-	Name        plugin.TValue[string]
-	Handle      plugin.TValue[int64]
-	Type        plugin.TValue[string]
-	Hook        plugin.TValue[string]
-	Prio        plugin.TValue[int64]
-	Policy      plugin.TValue[string]
-	IsBaseChain plugin.TValue[bool]
-	Rules       plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileUserInternal it will be used here
+	User  plugin.TValue[string]
+	Group plugin.TValue[string]
 }
 
-// createNftablesChain creates a new instance of this resource
-func createNftablesChain(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNftablesChain{
+// createDockerFileUser creates a new instance of this resource
+func createDockerFileUser(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileUser{
 		MqlRuntime: runtime,
 	}
 
@@ -15975,15 +17965,10 @@ func createNftablesChain(runtime *plugin.Runtime, args map[string]*llx.RawData)
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("nftables.chain", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.user", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -15993,70 +17978,34 @@ func createNftablesChain(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlNftablesChain) MqlName() string {
-	return "nftables.chain"
+func (c *mqlDockerFileUser) MqlName() string {
+	return "docker.file.user"
 }
 
-func (c *mqlNftablesChain) MqlID() string {
+func (c *mqlDockerFileUser) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNftablesChain) GetFamily() *plugin.TValue[string] {
-	return &c.Family
-}
-
-func (c *mqlNftablesChain) GetTable() *plugin.TValue[string] {
-	return &c.Table
-}
-
-func (c *mqlNftablesChain) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlNftablesChain) GetHandle() *plugin.TValue[int64] {
-	return &c.Handle
-}
-
-func (c *mqlNftablesChain) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlNftablesChain) GetHook() *plugin.TValue[string] {
-	return &c.Hook
-}
-
-func (c *mqlNftablesChain) GetPrio() *plugin.TValue[int64] {
-	return &c.Prio
-}
-
-func (c *mqlNftablesChain) GetPolicy() *plugin.TValue[string] {
-	return &c.Policy
-}
-
-func (c *mqlNftablesChain) GetIsBaseChain() *plugin.TValue[bool] {
-	return &c.IsBaseChain
+func (c *mqlDockerFileUser) GetUser() *plugin.TValue[string] {
+	return &c.User
 }
 
-func (c *mqlNftablesChain) GetRules() *plugin.TValue[[]any] {
-	return &c.Rules
+func (c *mqlDockerFileUser) GetGroup() *plugin.TValue[string] {
+	return &c.Group
 }
 
-// mqlNftablesRule for the nftables.rule resource
-type mqlNftablesRule struct {
+// mqlDockerFileExpose for the docker.file.expose resource
+type mqlDockerFileExpose struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNftablesRuleInternal it will be used here
-	Family  plugin.TValue[string]
-	Table   plugin.TValue[string]
-	Chain   plugin.TValue[string]
-	Handle  plugin.TValue[int64]
-	Expr    plugin.TValue[[]any]
-	Comment plugin.TValue[string]
+	// optional: if you define mqlDockerFileExposeInternal it will be used here
+	Port     plugin.TValue[int64]
+	Protocol plugin.TValue[string]
 }
 
-// createNftablesRule creates a new instance of this resource
-func createNftablesRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNftablesRule{
+// createDockerFileExpose creates a new instance of this resource
+func createDockerFileExpose(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileExpose{
 		MqlRuntime: runtime,
 	}
 
@@ -16065,15 +18014,10 @@ func createNftablesRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("nftables.rule", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.expose", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16083,50 +18027,37 @@ func createNftablesRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlNftablesRule) MqlName() string {
-	return "nftables.rule"
+func (c *mqlDockerFileExpose) MqlName() string {
+	return "docker.file.expose"
 }
 
-func (c *mqlNftablesRule) MqlID() string {
+func (c *mqlDockerFileExpose) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNftablesRule) GetFamily() *plugin.TValue[string] {
-	return &c.Family
+func (c *mqlDockerFileExpose) GetPort() *plugin.TValue[int64] {
+	return &c.Port
 }
 
-func (c *mqlNftablesRule) GetTable() *plugin.TValue[string] {
-	return &c.Table
-}
-
-func (c *mqlNftablesRule) GetChain() *plugin.TValue[string] {
-	return &c.Chain
-}
-
-func (c *mqlNftablesRule) GetHandle() *plugin.TValue[int64] {
-	return &c.Handle
-}
-
-func (c *mqlNftablesRule) GetExpr() *plugin.TValue[[]any] {
-	return &c.Expr
-}
-
-func (c *mqlNftablesRule) GetComment() *plugin.TValue[string] {
-	return &c.Comment
+func (c *mqlDockerFileExpose) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
 }
 
-// mqlFstab for the fstab resource
-type mqlFstab struct {
+// mqlDockerFileFrom for the docker.file.from resource
+type mqlDockerFileFrom struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlFstabInternal it will be used here
-	Path    plugin.TValue[string]
-	Entries plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileFromInternal it will be used here
+	Platform plugin.TValue[string]
+	Image    plugin.TValue[string]
+	Tag      plugin.TValue[string]
+	Digest   plugin.TValue[string]
+	Name     plugin.TValue[string]
 }
 
-// createFstab creates a new instance of this resource
-func createFstab(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFstab{
+// createDockerFileFrom creates a new instance of this resource
+func createDockerFileFrom(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileFrom{
 		MqlRuntime: runtime,
 	}
 
@@ -16138,7 +18069,7 @@ func createFstab(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("fstab", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.from", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16148,50 +18079,45 @@ func createFstab(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlFstab) MqlName() string {
-	return "fstab"
+func (c *mqlDockerFileFrom) MqlName() string {
+	return "docker.file.from"
 }
 
-func (c *mqlFstab) MqlID() string {
+func (c *mqlDockerFileFrom) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFstab) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlDockerFileFrom) GetPlatform() *plugin.TValue[string] {
+	return &c.Platform
 }
 
-func (c *mqlFstab) GetEntries() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("fstab", c.__id, "entries")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlDockerFileFrom) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
 
-		return c.entries()
-	})
+func (c *mqlDockerFileFrom) GetTag() *plugin.TValue[string] {
+	return &c.Tag
 }
 
-// mqlFstabEntry for the fstab.entry resource
-type mqlFstabEntry struct {
+func (c *mqlDockerFileFrom) GetDigest() *plugin.TValue[string] {
+	return &c.Digest
+}
+
+func (c *mqlDockerFileFrom) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+// mqlDockerFileRun for the docker.file.run resource
+type mqlDockerFileRun struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlFstabEntryInternal it will be used here
-	Device     plugin.TValue[string]
-	Mountpoint plugin.TValue[string]
-	Fstype     plugin.TValue[string]
-	Options    plugin.TValue[string]
-	Dump       plugin.TValue[int64]
-	Fsck       plugin.TValue[int64]
+	// optional: if you define mqlDockerFileRunInternal it will be used here
+	Script plugin.TValue[string]
 }
 
-// createFstabEntry creates a new instance of this resource
-func createFstabEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlFstabEntry{
+// createDockerFileRun creates a new instance of this resource
+func createDockerFileRun(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileRun{
 		MqlRuntime: runtime,
 	}
 
@@ -16200,15 +18126,10 @@ func createFstabEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("fstab.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.run", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16218,53 +18139,32 @@ func createFstabEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlFstabEntry) MqlName() string {
-	return "fstab.entry"
+func (c *mqlDockerFileRun) MqlName() string {
+	return "docker.file.run"
 }
 
-func (c *mqlFstabEntry) MqlID() string {
+func (c *mqlDockerFileRun) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlFstabEntry) GetDevice() *plugin.TValue[string] {
-	return &c.Device
-}
-
-func (c *mqlFstabEntry) GetMountpoint() *plugin.TValue[string] {
-	return &c.Mountpoint
-}
-
-func (c *mqlFstabEntry) GetFstype() *plugin.TValue[string] {
-	return &c.Fstype
-}
-
-func (c *mqlFstabEntry) GetOptions() *plugin.TValue[string] {
-	return &c.Options
-}
-
-func (c *mqlFstabEntry) GetDump() *plugin.TValue[int64] {
-	return &c.Dump
-}
-
-func (c *mqlFstabEntry) GetFsck() *plugin.TValue[int64] {
-	return &c.Fsck
+func (c *mqlDockerFileRun) GetScript() *plugin.TValue[string] {
+	return &c.Script
 }
 
-// mqlProcess for the process resource
-type mqlProcess struct {
+// mqlDockerFileAdd for the docker.file.add resource
+type mqlDockerFileAdd struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlProcessInternal
-	Pid        plugin.TValue[int64]
-	State      plugin.TValue[string]
-	Executable plugin.TValue[string]
-	Command    plugin.TValue[string]
-	Flags      plugin.TValue[map[string]any]
+	// optional: if you define mqlDockerFileAddInternal it will be used here
+	Src   plugin.TValue[[]any]
+	Dst   plugin.TValue[string]
+	Chown plugin.TValue[string]
+	Chmod plugin.TValue[string]
 }
 
-// createProcess creates a new instance of this resource
-func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcess{
+// createDockerFileAdd creates a new instance of this resource
+func createDockerFileAdd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileAdd{
 		MqlRuntime: runtime,
 	}
 
@@ -16273,15 +18173,10 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("process", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.add", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16291,53 +18186,44 @@ func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlProcess) MqlName() string {
-	return "process"
+func (c *mqlDockerFileAdd) MqlName() string {
+	return "docker.file.add"
 }
 
-func (c *mqlProcess) MqlID() string {
+func (c *mqlDockerFileAdd) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
-	return &c.Pid
-}
-
-func (c *mqlProcess) GetState() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
-		return c.state()
-	})
+func (c *mqlDockerFileAdd) GetSrc() *plugin.TValue[[]any] {
+	return &c.Src
 }
 
-func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
-		return c.executable()
-	})
+func (c *mqlDockerFileAdd) GetDst() *plugin.TValue[string] {
+	return &c.Dst
 }
 
-func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
-		return c.command()
-	})
+func (c *mqlDockerFileAdd) GetChown() *plugin.TValue[string] {
+	return &c.Chown
 }
 
-func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Flags, func() (map[string]any, error) {
-		return c.flags()
-	})
+func (c *mqlDockerFileAdd) GetChmod() *plugin.TValue[string] {
+	return &c.Chmod
 }
 
-// mqlProcesses for the processes resource
-type mqlProcesses struct {
+// mqlDockerFileCopy for the docker.file.copy resource
+type mqlDockerFileCopy struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlProcessesInternal
-	List plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileCopyInternal it will be used here
+	Src   plugin.TValue[[]any]
+	Dst   plugin.TValue[string]
+	Chown plugin.TValue[string]
+	Chmod plugin.TValue[string]
 }
 
-// createProcesses creates a new instance of this resource
-func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlProcesses{
+// createDockerFileCopy creates a new instance of this resource
+func createDockerFileCopy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileCopy{
 		MqlRuntime: runtime,
 	}
 
@@ -16349,7 +18235,7 @@ func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("processes", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.copy", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16359,49 +18245,47 @@ func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlProcesses) MqlName() string {
-	return "processes"
+func (c *mqlDockerFileCopy) MqlName() string {
+	return "docker.file.copy"
 }
 
-func (c *mqlProcesses) MqlID() string {
+func (c *mqlDockerFileCopy) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlProcesses) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlDockerFileCopy) GetSrc() *plugin.TValue[[]any] {
+	return &c.Src
+}
 
-		return c.list()
-	})
+func (c *mqlDockerFileCopy) GetDst() *plugin.TValue[string] {
+	return &c.Dst
 }
 
-// mqlPort for the port resource
-type mqlPort struct {
+func (c *mqlDockerFileCopy) GetChown() *plugin.TValue[string] {
+	return &c.Chown
+}
+
+func (c *mqlDockerFileCopy) GetChmod() *plugin.TValue[string] {
+	return &c.Chmod
+}
+
+// mqlDockerFileHealthcheck for the docker.file.healthcheck resource
+type mqlDockerFileHealthcheck struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlPortInternal
-	Protocol      plugin.TValue[string]
-	Port          plugin.TValue[int64]
-	Address       plugin.TValue[string]
-	User          plugin.TValue[*mqlUser]
-	Process       plugin.TValue[*mqlProcess]
-	State         plugin.TValue[string]
-	RemoteAddress plugin.TValue[string]
-	RemotePort    plugin.TValue[int64]
-	Tls           plugin.TValue[plugin.Resource]
+	// optional: if you define mqlDockerFileHealthcheckInternal it will be used here
+	Test          plugin.TValue[[]any]
+	Interval      plugin.TValue[int64]
+	Timeout       plugin.TValue[int64]
+	StartPeriod   plugin.TValue[int64]
+	StartInterval plugin.TValue[int64]
+	Retries       plugin.TValue[int64]
+	None          plugin.TValue[bool]
 }
 
-// createPort creates a new instance of this resource
-func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPort{
+// createDockerFileHealthcheck creates a new instance of this resource
+func createDockerFileHealthcheck(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileHealthcheck{
 		MqlRuntime: runtime,
 	}
 
@@ -16410,15 +18294,10 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("port", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.healthcheck", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16428,101 +18307,97 @@ func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlPort) MqlName() string {
-	return "port"
+func (c *mqlDockerFileHealthcheck) MqlName() string {
+	return "docker.file.healthcheck"
 }
 
-func (c *mqlPort) MqlID() string {
+func (c *mqlDockerFileHealthcheck) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlDockerFileHealthcheck) GetTest() *plugin.TValue[[]any] {
+	return &c.Test
 }
 
-func (c *mqlPort) GetPort() *plugin.TValue[int64] {
-	return &c.Port
+func (c *mqlDockerFileHealthcheck) GetInterval() *plugin.TValue[int64] {
+	return &c.Interval
 }
 
-func (c *mqlPort) GetAddress() *plugin.TValue[string] {
-	return &c.Address
+func (c *mqlDockerFileHealthcheck) GetTimeout() *plugin.TValue[int64] {
+	return &c.Timeout
 }
 
-func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
-	return &c.User
+func (c *mqlDockerFileHealthcheck) GetStartPeriod() *plugin.TValue[int64] {
+	return &c.StartPeriod
 }
 
-func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
-	return plugin.GetOrCompute[*mqlProcess](&c.Process, func() (*mqlProcess, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "process")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlProcess), nil
-			}
-		}
-
-		return c.process()
-	})
+func (c *mqlDockerFileHealthcheck) GetStartInterval() *plugin.TValue[int64] {
+	return &c.StartInterval
 }
 
-func (c *mqlPort) GetState() *plugin.TValue[string] {
-	return &c.State
+func (c *mqlDockerFileHealthcheck) GetRetries() *plugin.TValue[int64] {
+	return &c.Retries
 }
 
-func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
-	return &c.RemoteAddress
+func (c *mqlDockerFileHealthcheck) GetNone() *plugin.TValue[bool] {
+	return &c.None
 }
 
-func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
-	return &c.RemotePort
+// mqlDockerFileVolume for the docker.file.volume resource
+type mqlDockerFileVolume struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlDockerFileVolumeInternal it will be used here
+	Path plugin.TValue[string]
 }
 
-func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(plugin.Resource), nil
-			}
-		}
+// createDockerFileVolume creates a new instance of this resource
+func createDockerFileVolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileVolume{
+		MqlRuntime: runtime,
+	}
 
-		vargAddress := c.GetAddress()
-		if vargAddress.Error != nil {
-			return nil, vargAddress.Error
-		}
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-		vargPort := c.GetPort()
-		if vargPort.Error != nil {
-			return nil, vargPort.Error
-		}
+	// to override __id implement: id() (string, error)
 
-		vargProtocol := c.GetProtocol()
-		if vargProtocol.Error != nil {
-			return nil, vargProtocol.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("docker.file.volume", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
-	})
+	return res, nil
 }
 
-// mqlPorts for the ports resource
-type mqlPorts struct {
+func (c *mqlDockerFileVolume) MqlName() string {
+	return "docker.file.volume"
+}
+
+func (c *mqlDockerFileVolume) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlDockerFileVolume) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+// mqlDockerFileShell for the docker.file.shell resource
+type mqlDockerFileShell struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlPortsInternal
-	Listening plugin.TValue[[]any]
-	List      plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileShellInternal it will be used here
+	Command plugin.TValue[[]any]
 }
 
-// createPorts creates a new instance of this resource
-func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPorts{
+// createDockerFileShell creates a new instance of this resource
+func createDockerFileShell(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileShell{
 		MqlRuntime: runtime,
 	}
 
@@ -16534,7 +18409,7 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ports", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.shell", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16544,57 +18419,29 @@ func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlPorts) MqlName() string {
-	return "ports"
+func (c *mqlDockerFileShell) MqlName() string {
+	return "docker.file.shell"
 }
 
-func (c *mqlPorts) MqlID() string {
+func (c *mqlDockerFileShell) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPorts) GetListening() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Listening, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.listening()
-	})
-}
-
-func (c *mqlPorts) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.list()
-	})
+func (c *mqlDockerFileShell) GetCommand() *plugin.TValue[[]any] {
+	return &c.Command
 }
 
-// mqlAuditpol for the auditpol resource
-type mqlAuditpol struct {
+// mqlDockerFileWorkdir for the docker.file.workdir resource
+type mqlDockerFileWorkdir struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlAuditpolInternal it will be used here
-	List plugin.TValue[[]any]
+	// optional: if you define mqlDockerFileWorkdirInternal it will be used here
+	Path plugin.TValue[string]
 }
 
-// createAuditpol creates a new instance of this resource
-func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpol{
+// createDockerFileWorkdir creates a new instance of this resource
+func createDockerFileWorkdir(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerFileWorkdir{
 		MqlRuntime: runtime,
 	}
 
@@ -16606,7 +18453,7 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.file.workdir", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16616,46 +18463,33 @@ func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlAuditpol) MqlName() string {
-	return "auditpol"
+func (c *mqlDockerFileWorkdir) MqlName() string {
+	return "docker.file.workdir"
 }
 
-func (c *mqlAuditpol) MqlID() string {
+func (c *mqlDockerFileWorkdir) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpol) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.list()
-	})
+func (c *mqlDockerFileWorkdir) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-// mqlAuditpolEntry for the auditpol.entry resource
-type mqlAuditpolEntry struct {
+// mqlDockerImage for the docker.image resource
+type mqlDockerImage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlAuditpolEntryInternal it will be used here
-	Machinename      plugin.TValue[string]
-	Policytarget     plugin.TValue[string]
-	Subcategory      plugin.TValue[string]
-	Subcategoryguid  plugin.TValue[string]
-	Inclusionsetting plugin.TValue[string]
-	Exclusionsetting plugin.TValue[string]
+	// optional: if you define mqlDockerImageInternal it will be used here
+	Id          plugin.TValue[string]
+	Size        plugin.TValue[int64]
+	RepoDigests plugin.TValue[[]any]
+	Tags        plugin.TValue[[]any]
+	Labels      plugin.TValue[map[string]any]
 }
 
-// createAuditpolEntry creates a new instance of this resource
-func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlAuditpolEntry{
+// createDockerImage creates a new instance of this resource
+func createDockerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerImage{
 		MqlRuntime: runtime,
 	}
 
@@ -16672,7 +18506,7 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.image", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16682,52 +18516,54 @@ func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlAuditpolEntry) MqlName() string {
-	return "auditpol.entry"
+func (c *mqlDockerImage) MqlName() string {
+	return "docker.image"
 }
 
-func (c *mqlAuditpolEntry) MqlID() string {
+func (c *mqlDockerImage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
-	return &c.Machinename
-}
-
-func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
-	return &c.Policytarget
+func (c *mqlDockerImage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
-	return &c.Subcategory
+func (c *mqlDockerImage) GetSize() *plugin.TValue[int64] {
+	return &c.Size
 }
 
-func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
-	return &c.Subcategoryguid
+func (c *mqlDockerImage) GetRepoDigests() *plugin.TValue[[]any] {
+	return &c.RepoDigests
 }
 
-func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
-	return &c.Inclusionsetting
+func (c *mqlDockerImage) GetTags() *plugin.TValue[[]any] {
+	return &c.Tags
 }
 
-func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
-	return &c.Exclusionsetting
+func (c *mqlDockerImage) GetLabels() *plugin.TValue[map[string]any] {
+	return &c.Labels
 }
 
-// mqlSecpol for the secpol resource
-type mqlSecpol struct {
+// mqlDockerContainer for the docker.container resource
+type mqlDockerContainer struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlSecpolInternal
-	Systemaccess    plugin.TValue[map[string]any]
-	Eventaudit      plugin.TValue[map[string]any]
-	Registryvalues  plugin.TValue[map[string]any]
-	Privilegerights plugin.TValue[map[string]any]
+	// optional: if you define mqlDockerContainerInternal it will be used here
+	Os         plugin.TValue[*mqlOsLinux]
+	Id         plugin.TValue[string]
+	Command    plugin.TValue[string]
+	Image      plugin.TValue[string]
+	Imageid    plugin.TValue[string]
+	Names      plugin.TValue[[]any]
+	State      plugin.TValue[string]
+	Status     plugin.TValue[string]
+	Labels     plugin.TValue[map[string]any]
+	HostConfig plugin.TValue[any]
 }
 
-// createSecpol creates a new instance of this resource
-func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSecpol{
+// createDockerContainer creates a new instance of this resource
+func createDockerContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDockerContainer{
 		MqlRuntime: runtime,
 	}
 
@@ -16736,10 +18572,15 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("secpol", res.__id)
+		args, err = runtime.ResourceFromRecording("docker.container", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16749,54 +18590,79 @@ func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlSecpol) MqlName() string {
-	return "secpol"
+func (c *mqlDockerContainer) MqlName() string {
+	return "docker.container"
 }
 
-func (c *mqlSecpol) MqlID() string {
+func (c *mqlDockerContainer) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Systemaccess, func() (map[string]any, error) {
-		return c.systemaccess()
+func (c *mqlDockerContainer) GetOs() *plugin.TValue[*mqlOsLinux] {
+	return plugin.GetOrCompute[*mqlOsLinux](&c.Os, func() (*mqlOsLinux, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("docker.container", c.__id, "os")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlOsLinux), nil
+			}
+		}
+
+		return c.os()
 	})
 }
 
-func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Eventaudit, func() (map[string]any, error) {
-		return c.eventaudit()
-	})
+func (c *mqlDockerContainer) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Registryvalues, func() (map[string]any, error) {
-		return c.registryvalues()
-	})
+func (c *mqlDockerContainer) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Privilegerights, func() (map[string]any, error) {
-		return c.privilegerights()
+func (c *mqlDockerContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
+
+func (c *mqlDockerContainer) GetImageid() *plugin.TValue[string] {
+	return &c.Imageid
+}
+
+func (c *mqlDockerContainer) GetNames() *plugin.TValue[[]any] {
+	return &c.Names
+}
+
+func (c *mqlDockerContainer) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlDockerContainer) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
+
+func (c *mqlDockerContainer) GetLabels() *plugin.TValue[map[string]any] {
+	return &c.Labels
+}
+
+func (c *mqlDockerContainer) GetHostConfig() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.HostConfig, func() (any, error) {
+		return c.hostConfig()
 	})
 }
 
-// mqlNtpConf for the ntp.conf resource
-type mqlNtpConf struct {
+// mqlContainerd for the containerd resource
+type mqlContainerd struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNtpConfInternal it will be used here
-	File     plugin.TValue[*mqlFile]
-	Content  plugin.TValue[string]
-	Settings plugin.TValue[[]any]
-	Servers  plugin.TValue[[]any]
-	Restrict plugin.TValue[[]any]
-	Fudge    plugin.TValue[[]any]
+	// optional: if you define mqlContainerdInternal it will be used here
+	Containers plugin.TValue[[]any]
 }
 
-// createNtpConf creates a new instance of this resource
-func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNtpConf{
+// createContainerd creates a new instance of this resource
+func createContainerd(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerd{
 		MqlRuntime: runtime,
 	}
 
@@ -16805,15 +18671,10 @@ func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("ntp.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("containerd", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16823,99 +18684,48 @@ func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlNtpConf) MqlName() string {
-	return "ntp.conf"
+func (c *mqlContainerd) MqlName() string {
+	return "containerd"
 }
 
-func (c *mqlNtpConf) MqlID() string {
+func (c *mqlContainerd) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNtpConf) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlContainerd) GetContainers() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Containers, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("ntp.conf", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("containerd", c.__id, "containers")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.([]any), nil
 			}
 		}
 
-		return c.file()
-	})
-}
-
-func (c *mqlNtpConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
-		}
-
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetSettings() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Settings, func() ([]any, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
-
-		return c.settings(vargContent.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetServers() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Servers, func() ([]any, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
-		}
-
-		return c.servers(vargSettings.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetRestrict() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Restrict, func() ([]any, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
-		}
-
-		return c.restrict(vargSettings.Data)
-	})
-}
-
-func (c *mqlNtpConf) GetFudge() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Fudge, func() ([]any, error) {
-		vargSettings := c.GetSettings()
-		if vargSettings.Error != nil {
-			return nil, vargSettings.Error
-		}
-
-		return c.fudge(vargSettings.Data)
+		return c.containers()
 	})
 }
 
-// mqlRsyslogConf for the rsyslog.conf resource
-type mqlRsyslogConf struct {
+// mqlContainerdContainer for the containerd.container resource
+type mqlContainerdContainer struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlRsyslogConfInternal it will be used here
-	Path     plugin.TValue[string]
-	Files    plugin.TValue[[]any]
-	Content  plugin.TValue[string]
-	Settings plugin.TValue[[]any]
+	// optional: if you define mqlContainerdContainerInternal it will be used here
+	Id          plugin.TValue[string]
+	Image       plugin.TValue[string]
+	Status      plugin.TValue[string]
+	Labels      plugin.TValue[map[string]any]
+	Pid         plugin.TValue[int64]
+	Namespace   plugin.TValue[string]
+	Runtime     plugin.TValue[string]
+	Snapshotter plugin.TValue[string]
 }
 
-// createRsyslogConf creates a new instance of this resource
-func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRsyslogConf{
+// createContainerdContainer creates a new instance of this resource
+func createContainerdContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerdContainer{
 		MqlRuntime: runtime,
 	}
 
@@ -16932,7 +18742,7 @@ func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("rsyslog.conf", res.__id)
+		args, err = runtime.ResourceFromRecording("containerd.container", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -16942,24 +18752,2772 @@ func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlRsyslogConf) MqlName() string {
-	return "rsyslog.conf"
-}
+func (c *mqlContainerdContainer) MqlName() string {
+	return "containerd.container"
+}
+
+func (c *mqlContainerdContainer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlContainerdContainer) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlContainerdContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
+
+func (c *mqlContainerdContainer) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
+
+func (c *mqlContainerdContainer) GetLabels() *plugin.TValue[map[string]any] {
+	return &c.Labels
+}
+
+func (c *mqlContainerdContainer) GetPid() *plugin.TValue[int64] {
+	return &c.Pid
+}
+
+func (c *mqlContainerdContainer) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlContainerdContainer) GetRuntime() *plugin.TValue[string] {
+	return &c.Runtime
+}
+
+func (c *mqlContainerdContainer) GetSnapshotter() *plugin.TValue[string] {
+	return &c.Snapshotter
+}
+
+// mqlIptables for the iptables resource
+type mqlIptables struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlIptablesInternal it will be used here
+	Input  plugin.TValue[[]any]
+	Output plugin.TValue[[]any]
+}
+
+// createIptables creates a new instance of this resource
+func createIptables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptables{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("iptables", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlIptables) MqlName() string {
+	return "iptables"
+}
+
+func (c *mqlIptables) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlIptables) GetInput() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Input, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.input()
+	})
+}
+
+func (c *mqlIptables) GetOutput() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Output, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("iptables", c.__id, "output")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.output()
+	})
+}
+
+// mqlIp6tables for the ip6tables resource
+type mqlIp6tables struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlIp6tablesInternal it will be used here
+	Input  plugin.TValue[[]any]
+	Output plugin.TValue[[]any]
+}
+
+// createIp6tables creates a new instance of this resource
+func createIp6tables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIp6tables{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ip6tables", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlIp6tables) MqlName() string {
+	return "ip6tables"
+}
+
+func (c *mqlIp6tables) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlIp6tables) GetInput() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Input, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "input")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.input()
+	})
+}
+
+func (c *mqlIp6tables) GetOutput() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Output, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ip6tables", c.__id, "output")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.output()
+	})
+}
+
+// mqlIptablesEntry for the iptables.entry resource
+type mqlIptablesEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlIptablesEntryInternal it will be used here
+	LineNumber  plugin.TValue[int64]
+	Packets     plugin.TValue[int64]
+	Bytes       plugin.TValue[int64]
+	Target      plugin.TValue[string]
+	Protocol    plugin.TValue[string]
+	Opt         plugin.TValue[string]
+	In          plugin.TValue[string]
+	Out         plugin.TValue[string]
+	Source      plugin.TValue[string]
+	Destination plugin.TValue[string]
+	Options     plugin.TValue[string]
+	Chain       plugin.TValue[string]
+}
+
+// createIptablesEntry creates a new instance of this resource
+func createIptablesEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlIptablesEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("iptables.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlIptablesEntry) MqlName() string {
+	return "iptables.entry"
+}
+
+func (c *mqlIptablesEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlIptablesEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
+
+func (c *mqlIptablesEntry) GetPackets() *plugin.TValue[int64] {
+	return &c.Packets
+}
+
+func (c *mqlIptablesEntry) GetBytes() *plugin.TValue[int64] {
+	return &c.Bytes
+}
+
+func (c *mqlIptablesEntry) GetTarget() *plugin.TValue[string] {
+	return &c.Target
+}
+
+func (c *mqlIptablesEntry) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlIptablesEntry) GetOpt() *plugin.TValue[string] {
+	return &c.Opt
+}
+
+func (c *mqlIptablesEntry) GetIn() *plugin.TValue[string] {
+	return &c.In
+}
+
+func (c *mqlIptablesEntry) GetOut() *plugin.TValue[string] {
+	return &c.Out
+}
+
+func (c *mqlIptablesEntry) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlIptablesEntry) GetDestination() *plugin.TValue[string] {
+	return &c.Destination
+}
+
+func (c *mqlIptablesEntry) GetOptions() *plugin.TValue[string] {
+	return &c.Options
+}
+
+func (c *mqlIptablesEntry) GetChain() *plugin.TValue[string] {
+	return &c.Chain
+}
+
+// mqlNftables for the nftables resource
+type mqlNftables struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlNftablesInternal it will be used here
+	Tables plugin.TValue[[]any]
+}
+
+// createNftables creates a new instance of this resource
+func createNftables(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNftables{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("nftables", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNftables) MqlName() string {
+	return "nftables"
+}
+
+func (c *mqlNftables) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNftables) GetTables() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Tables, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("nftables", c.__id, "tables")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.tables()
+	})
+}
+
+// mqlNftablesTable for the nftables.table resource
+type mqlNftablesTable struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlNftablesTableInternal it will be used here
+	Family plugin.TValue[string]
+	Name   plugin.TValue[string]
+	Handle plugin.TValue[int64]
+	Flags  plugin.TValue[[]any]
+	Chains plugin.TValue[[]any]
+	Rules  plugin.TValue[[]any]
+}
+
+// createNftablesTable creates a new instance of this resource
+func createNftablesTable(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNftablesTable{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("nftables.table", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNftablesTable) MqlName() string {
+	return "nftables.table"
+}
+
+func (c *mqlNftablesTable) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNftablesTable) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+func (c *mqlNftablesTable) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlNftablesTable) GetHandle() *plugin.TValue[int64] {
+	return &c.Handle
+}
+
+func (c *mqlNftablesTable) GetFlags() *plugin.TValue[[]any] {
+	return &c.Flags
+}
+
+func (c *mqlNftablesTable) GetChains() *plugin.TValue[[]any] {
+	return &c.Chains
+}
+
+func (c *mqlNftablesTable) GetRules() *plugin.TValue[[]any] {
+	return &c.Rules
+}
+
+// mqlNftablesChain for the nftables.chain resource
+type mqlNftablesChain struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlNftablesChainInternal it will be used here
+	Family      plugin.TValue[string]
+	Table       plugin.TValue[string]
+	Name        plugin.TValue[string]
+	Handle      plugin.TValue[int64]
+	Type        plugin.TValue[string]
+	Hook        plugin.TValue[string]
+	Prio        plugin.TValue[int64]
+	Policy      plugin.TValue[string]
+	IsBaseChain plugin.TValue[bool]
+	Rules       plugin.TValue[[]any]
+}
+
+// createNftablesChain creates a new instance of this resource
+func createNftablesChain(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNftablesChain{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("nftables.chain", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNftablesChain) MqlName() string {
+	return "nftables.chain"
+}
+
+func (c *mqlNftablesChain) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNftablesChain) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+func (c *mqlNftablesChain) GetTable() *plugin.TValue[string] {
+	return &c.Table
+}
+
+func (c *mqlNftablesChain) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlNftablesChain) GetHandle() *plugin.TValue[int64] {
+	return &c.Handle
+}
+
+func (c *mqlNftablesChain) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlNftablesChain) GetHook() *plugin.TValue[string] {
+	return &c.Hook
+}
+
+func (c *mqlNftablesChain) GetPrio() *plugin.TValue[int64] {
+	return &c.Prio
+}
+
+func (c *mqlNftablesChain) GetPolicy() *plugin.TValue[string] {
+	return &c.Policy
+}
+
+func (c *mqlNftablesChain) GetIsBaseChain() *plugin.TValue[bool] {
+	return &c.IsBaseChain
+}
+
+func (c *mqlNftablesChain) GetRules() *plugin.TValue[[]any] {
+	return &c.Rules
+}
+
+// mqlNftablesRule for the nftables.rule resource
+type mqlNftablesRule struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlNftablesRuleInternal it will be used here
+	Family  plugin.TValue[string]
+	Table   plugin.TValue[string]
+	Chain   plugin.TValue[string]
+	Handle  plugin.TValue[int64]
+	Expr    plugin.TValue[[]any]
+	Comment plugin.TValue[string]
+}
+
+// createNftablesRule creates a new instance of this resource
+func createNftablesRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNftablesRule{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("nftables.rule", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNftablesRule) MqlName() string {
+	return "nftables.rule"
+}
+
+func (c *mqlNftablesRule) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNftablesRule) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+func (c *mqlNftablesRule) GetTable() *plugin.TValue[string] {
+	return &c.Table
+}
+
+func (c *mqlNftablesRule) GetChain() *plugin.TValue[string] {
+	return &c.Chain
+}
+
+func (c *mqlNftablesRule) GetHandle() *plugin.TValue[int64] {
+	return &c.Handle
+}
+
+func (c *mqlNftablesRule) GetExpr() *plugin.TValue[[]any] {
+	return &c.Expr
+}
+
+func (c *mqlNftablesRule) GetComment() *plugin.TValue[string] {
+	return &c.Comment
+}
+
+// mqlFirewalld for the firewalld resource
+type mqlFirewalld struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFirewalldInternal it will be used here
+	Runtime        plugin.TValue[bool]
+	Running        plugin.TValue[bool]
+	DefaultZone    plugin.TValue[string]
+	Zones          plugin.TValue[[]any]
+	PermanentZones plugin.TValue[[]any]
+}
+
+// createFirewalld creates a new instance of this resource
+func createFirewalld(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFirewalld{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("firewalld", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFirewalld) MqlName() string {
+	return "firewalld"
+}
+
+func (c *mqlFirewalld) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFirewalld) GetRuntime() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Runtime, func() (bool, error) {
+		return c.runtime()
+	})
+}
+
+func (c *mqlFirewalld) GetRunning() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Running, func() (bool, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return false, vargRuntime.Error
+		}
+
+		return c.running(vargRuntime.Data)
+	})
+}
+
+func (c *mqlFirewalld) GetDefaultZone() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultZone, func() (string, error) {
+		vargRunning := c.GetRunning()
+		if vargRunning.Error != nil {
+			return "", vargRunning.Error
+		}
+
+		return c.defaultZone(vargRunning.Data)
+	})
+}
+
+func (c *mqlFirewalld) GetZones() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Zones, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("firewalld", c.__id, "zones")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargRunning := c.GetRunning()
+		if vargRunning.Error != nil {
+			return nil, vargRunning.Error
+		}
+
+		return c.zones(vargRunning.Data)
+	})
+}
+
+func (c *mqlFirewalld) GetPermanentZones() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.PermanentZones, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("firewalld", c.__id, "permanentZones")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.permanentZones()
+	})
+}
+
+// mqlFirewalldZone for the firewalld.zone resource
+type mqlFirewalldZone struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFirewalldZoneInternal it will be used here
+	Name               plugin.TValue[string]
+	Short              plugin.TValue[string]
+	Description        plugin.TValue[string]
+	Target             plugin.TValue[string]
+	Active             plugin.TValue[bool]
+	Interfaces         plugin.TValue[[]any]
+	Sources            plugin.TValue[[]any]
+	Services           plugin.TValue[[]any]
+	Ports              plugin.TValue[[]any]
+	SourcePorts        plugin.TValue[[]any]
+	Protocols          plugin.TValue[[]any]
+	Masquerade         plugin.TValue[bool]
+	Forward            plugin.TValue[bool]
+	ForwardPorts       plugin.TValue[[]any]
+	IcmpBlocks         plugin.TValue[[]any]
+	IcmpBlockInversion plugin.TValue[bool]
+	RichRules          plugin.TValue[[]any]
+}
+
+// createFirewalldZone creates a new instance of this resource
+func createFirewalldZone(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFirewalldZone{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("firewalld.zone", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFirewalldZone) MqlName() string {
+	return "firewalld.zone"
+}
+
+func (c *mqlFirewalldZone) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFirewalldZone) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlFirewalldZone) GetShort() *plugin.TValue[string] {
+	return &c.Short
+}
+
+func (c *mqlFirewalldZone) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlFirewalldZone) GetTarget() *plugin.TValue[string] {
+	return &c.Target
+}
+
+func (c *mqlFirewalldZone) GetActive() *plugin.TValue[bool] {
+	return &c.Active
+}
+
+func (c *mqlFirewalldZone) GetInterfaces() *plugin.TValue[[]any] {
+	return &c.Interfaces
+}
+
+func (c *mqlFirewalldZone) GetSources() *plugin.TValue[[]any] {
+	return &c.Sources
+}
+
+func (c *mqlFirewalldZone) GetServices() *plugin.TValue[[]any] {
+	return &c.Services
+}
+
+func (c *mqlFirewalldZone) GetPorts() *plugin.TValue[[]any] {
+	return &c.Ports
+}
+
+func (c *mqlFirewalldZone) GetSourcePorts() *plugin.TValue[[]any] {
+	return &c.SourcePorts
+}
+
+func (c *mqlFirewalldZone) GetProtocols() *plugin.TValue[[]any] {
+	return &c.Protocols
+}
+
+func (c *mqlFirewalldZone) GetMasquerade() *plugin.TValue[bool] {
+	return &c.Masquerade
+}
+
+func (c *mqlFirewalldZone) GetForward() *plugin.TValue[bool] {
+	return &c.Forward
+}
+
+func (c *mqlFirewalldZone) GetForwardPorts() *plugin.TValue[[]any] {
+	return &c.ForwardPorts
+}
+
+func (c *mqlFirewalldZone) GetIcmpBlocks() *plugin.TValue[[]any] {
+	return &c.IcmpBlocks
+}
+
+func (c *mqlFirewalldZone) GetIcmpBlockInversion() *plugin.TValue[bool] {
+	return &c.IcmpBlockInversion
+}
+
+func (c *mqlFirewalldZone) GetRichRules() *plugin.TValue[[]any] {
+	return &c.RichRules
+}
+
+// mqlFirewalldPort for the firewalld.port resource
+type mqlFirewalldPort struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFirewalldPortInternal it will be used here
+	Port     plugin.TValue[string]
+	Protocol plugin.TValue[string]
+}
+
+// createFirewalldPort creates a new instance of this resource
+func createFirewalldPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFirewalldPort{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("firewalld.port", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFirewalldPort) MqlName() string {
+	return "firewalld.port"
+}
+
+func (c *mqlFirewalldPort) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFirewalldPort) GetPort() *plugin.TValue[string] {
+	return &c.Port
+}
+
+func (c *mqlFirewalldPort) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+// mqlFirewalldRule for the firewalld.rule resource
+type mqlFirewalldRule struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFirewalldRuleInternal it will be used here
+	Rule        plugin.TValue[string]
+	Family      plugin.TValue[string]
+	Priority    plugin.TValue[int64]
+	Source      plugin.TValue[string]
+	Destination plugin.TValue[string]
+	Service     plugin.TValue[string]
+	Port        plugin.TValue[string]
+	Protocol    plugin.TValue[string]
+	Log         plugin.TValue[bool]
+	Action      plugin.TValue[string]
+}
+
+// createFirewalldRule creates a new instance of this resource
+func createFirewalldRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFirewalldRule{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("firewalld.rule", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFirewalldRule) MqlName() string {
+	return "firewalld.rule"
+}
+
+func (c *mqlFirewalldRule) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFirewalldRule) GetRule() *plugin.TValue[string] {
+	return &c.Rule
+}
+
+func (c *mqlFirewalldRule) GetFamily() *plugin.TValue[string] {
+	return &c.Family
+}
+
+func (c *mqlFirewalldRule) GetPriority() *plugin.TValue[int64] {
+	return &c.Priority
+}
+
+func (c *mqlFirewalldRule) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlFirewalldRule) GetDestination() *plugin.TValue[string] {
+	return &c.Destination
+}
+
+func (c *mqlFirewalldRule) GetService() *plugin.TValue[string] {
+	return &c.Service
+}
+
+func (c *mqlFirewalldRule) GetPort() *plugin.TValue[string] {
+	return &c.Port
+}
+
+func (c *mqlFirewalldRule) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlFirewalldRule) GetLog() *plugin.TValue[bool] {
+	return &c.Log
+}
+
+func (c *mqlFirewalldRule) GetAction() *plugin.TValue[string] {
+	return &c.Action
+}
+
+// mqlUfw for the ufw resource
+type mqlUfw struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlUfwInternal
+	Runtime              plugin.TValue[bool]
+	Enabled              plugin.TValue[bool]
+	Status               plugin.TValue[string]
+	LogLevel             plugin.TValue[string]
+	Ipv6                 plugin.TValue[bool]
+	DefaultInputPolicy   plugin.TValue[string]
+	DefaultOutputPolicy  plugin.TValue[string]
+	DefaultForwardPolicy plugin.TValue[string]
+	Rules                plugin.TValue[[]any]
+}
+
+// createUfw creates a new instance of this resource
+func createUfw(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUfw{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ufw", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlUfw) MqlName() string {
+	return "ufw"
+}
+
+func (c *mqlUfw) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlUfw) GetRuntime() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Runtime, func() (bool, error) {
+		return c.runtime()
+	})
+}
+
+func (c *mqlUfw) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		return c.enabled()
+	})
+}
+
+func (c *mqlUfw) GetStatus() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
+		vargRuntime := c.GetRuntime()
+		if vargRuntime.Error != nil {
+			return "", vargRuntime.Error
+		}
+
+		vargEnabled := c.GetEnabled()
+		if vargEnabled.Error != nil {
+			return "", vargEnabled.Error
+		}
+
+		return c.status(vargRuntime.Data, vargEnabled.Data)
+	})
+}
+
+func (c *mqlUfw) GetLogLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.LogLevel, func() (string, error) {
+		return c.logLevel()
+	})
+}
+
+func (c *mqlUfw) GetIpv6() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Ipv6, func() (bool, error) {
+		return c.ipv6()
+	})
+}
+
+func (c *mqlUfw) GetDefaultInputPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultInputPolicy, func() (string, error) {
+		return c.defaultInputPolicy()
+	})
+}
+
+func (c *mqlUfw) GetDefaultOutputPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultOutputPolicy, func() (string, error) {
+		return c.defaultOutputPolicy()
+	})
+}
+
+func (c *mqlUfw) GetDefaultForwardPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultForwardPolicy, func() (string, error) {
+		return c.defaultForwardPolicy()
+	})
+}
+
+func (c *mqlUfw) GetRules() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Rules, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ufw", c.__id, "rules")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.rules()
+	})
+}
+
+// mqlUfwRule for the ufw.rule resource
+type mqlUfwRule struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlUfwRuleInternal it will be used here
+	Number    plugin.TValue[int64]
+	Action    plugin.TValue[string]
+	Log       plugin.TValue[string]
+	Route     plugin.TValue[bool]
+	Direction plugin.TValue[string]
+	Interface plugin.TValue[string]
+	Protocol  plugin.TValue[string]
+	To        plugin.TValue[string]
+	ToPort    plugin.TValue[string]
+	ToApp     plugin.TValue[string]
+	From      plugin.TValue[string]
+	FromPort  plugin.TValue[string]
+	FromApp   plugin.TValue[string]
+	Ipv6      plugin.TValue[bool]
+	Comment   plugin.TValue[string]
+}
+
+// createUfwRule creates a new instance of this resource
+func createUfwRule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUfwRule{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ufw.rule", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlUfwRule) MqlName() string {
+	return "ufw.rule"
+}
+
+func (c *mqlUfwRule) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlUfwRule) GetNumber() *plugin.TValue[int64] {
+	return &c.Number
+}
+
+func (c *mqlUfwRule) GetAction() *plugin.TValue[string] {
+	return &c.Action
+}
+
+func (c *mqlUfwRule) GetLog() *plugin.TValue[string] {
+	return &c.Log
+}
+
+func (c *mqlUfwRule) GetRoute() *plugin.TValue[bool] {
+	return &c.Route
+}
+
+func (c *mqlUfwRule) GetDirection() *plugin.TValue[string] {
+	return &c.Direction
+}
+
+func (c *mqlUfwRule) GetInterface() *plugin.TValue[string] {
+	return &c.Interface
+}
+
+func (c *mqlUfwRule) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlUfwRule) GetTo() *plugin.TValue[string] {
+	return &c.To
+}
+
+func (c *mqlUfwRule) GetToPort() *plugin.TValue[string] {
+	return &c.ToPort
+}
+
+func (c *mqlUfwRule) GetToApp() *plugin.TValue[string] {
+	return &c.ToApp
+}
+
+func (c *mqlUfwRule) GetFrom() *plugin.TValue[string] {
+	return &c.From
+}
+
+func (c *mqlUfwRule) GetFromPort() *plugin.TValue[string] {
+	return &c.FromPort
+}
+
+func (c *mqlUfwRule) GetFromApp() *plugin.TValue[string] {
+	return &c.FromApp
+}
+
+func (c *mqlUfwRule) GetIpv6() *plugin.TValue[bool] {
+	return &c.Ipv6
+}
+
+func (c *mqlUfwRule) GetComment() *plugin.TValue[string] {
+	return &c.Comment
+}
+
+// mqlFstab for the fstab resource
+type mqlFstab struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFstabInternal it will be used here
+	Path    plugin.TValue[string]
+	Entries plugin.TValue[[]any]
+}
+
+// createFstab creates a new instance of this resource
+func createFstab(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFstab{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("fstab", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFstab) MqlName() string {
+	return "fstab"
+}
+
+func (c *mqlFstab) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFstab) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlFstab) GetEntries() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("fstab", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.entries()
+	})
+}
+
+// mqlFstabEntry for the fstab.entry resource
+type mqlFstabEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlFstabEntryInternal it will be used here
+	Device     plugin.TValue[string]
+	Mountpoint plugin.TValue[string]
+	Fstype     plugin.TValue[string]
+	Options    plugin.TValue[string]
+	Dump       plugin.TValue[int64]
+	Fsck       plugin.TValue[int64]
+}
+
+// createFstabEntry creates a new instance of this resource
+func createFstabEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlFstabEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("fstab.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlFstabEntry) MqlName() string {
+	return "fstab.entry"
+}
+
+func (c *mqlFstabEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlFstabEntry) GetDevice() *plugin.TValue[string] {
+	return &c.Device
+}
+
+func (c *mqlFstabEntry) GetMountpoint() *plugin.TValue[string] {
+	return &c.Mountpoint
+}
+
+func (c *mqlFstabEntry) GetFstype() *plugin.TValue[string] {
+	return &c.Fstype
+}
+
+func (c *mqlFstabEntry) GetOptions() *plugin.TValue[string] {
+	return &c.Options
+}
+
+func (c *mqlFstabEntry) GetDump() *plugin.TValue[int64] {
+	return &c.Dump
+}
+
+func (c *mqlFstabEntry) GetFsck() *plugin.TValue[int64] {
+	return &c.Fsck
+}
+
+// mqlProcess for the process resource
+type mqlProcess struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlProcessInternal
+	Pid        plugin.TValue[int64]
+	State      plugin.TValue[string]
+	Executable plugin.TValue[string]
+	Command    plugin.TValue[string]
+	Flags      plugin.TValue[map[string]any]
+}
+
+// createProcess creates a new instance of this resource
+func createProcess(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcess{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("process", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlProcess) MqlName() string {
+	return "process"
+}
+
+func (c *mqlProcess) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlProcess) GetPid() *plugin.TValue[int64] {
+	return &c.Pid
+}
+
+func (c *mqlProcess) GetState() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.State, func() (string, error) {
+		return c.state()
+	})
+}
+
+func (c *mqlProcess) GetExecutable() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Executable, func() (string, error) {
+		return c.executable()
+	})
+}
+
+func (c *mqlProcess) GetCommand() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Command, func() (string, error) {
+		return c.command()
+	})
+}
+
+func (c *mqlProcess) GetFlags() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Flags, func() (map[string]any, error) {
+		return c.flags()
+	})
+}
+
+// mqlProcesses for the processes resource
+type mqlProcesses struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlProcessesInternal
+	List plugin.TValue[[]any]
+}
+
+// createProcesses creates a new instance of this resource
+func createProcesses(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlProcesses{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("processes", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlProcesses) MqlName() string {
+	return "processes"
+}
+
+func (c *mqlProcesses) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlProcesses) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("processes", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlPort for the port resource
+type mqlPort struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlPortInternal
+	Protocol      plugin.TValue[string]
+	Port          plugin.TValue[int64]
+	Address       plugin.TValue[string]
+	User          plugin.TValue[*mqlUser]
+	Process       plugin.TValue[*mqlProcess]
+	State         plugin.TValue[string]
+	RemoteAddress plugin.TValue[string]
+	RemotePort    plugin.TValue[int64]
+	Tls           plugin.TValue[plugin.Resource]
+}
+
+// createPort creates a new instance of this resource
+func createPort(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPort{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("port", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPort) MqlName() string {
+	return "port"
+}
+
+func (c *mqlPort) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPort) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlPort) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlPort) GetAddress() *plugin.TValue[string] {
+	return &c.Address
+}
+
+func (c *mqlPort) GetUser() *plugin.TValue[*mqlUser] {
+	return &c.User
+}
+
+func (c *mqlPort) GetProcess() *plugin.TValue[*mqlProcess] {
+	return plugin.GetOrCompute[*mqlProcess](&c.Process, func() (*mqlProcess, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "process")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlProcess), nil
+			}
+		}
+
+		return c.process()
+	})
+}
+
+func (c *mqlPort) GetState() *plugin.TValue[string] {
+	return &c.State
+}
+
+func (c *mqlPort) GetRemoteAddress() *plugin.TValue[string] {
+	return &c.RemoteAddress
+}
+
+func (c *mqlPort) GetRemotePort() *plugin.TValue[int64] {
+	return &c.RemotePort
+}
+
+func (c *mqlPort) GetTls() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.Tls, func() (plugin.Resource, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("port", c.__id, "tls")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(plugin.Resource), nil
+			}
+		}
+
+		vargAddress := c.GetAddress()
+		if vargAddress.Error != nil {
+			return nil, vargAddress.Error
+		}
+
+		vargPort := c.GetPort()
+		if vargPort.Error != nil {
+			return nil, vargPort.Error
+		}
+
+		vargProtocol := c.GetProtocol()
+		if vargProtocol.Error != nil {
+			return nil, vargProtocol.Error
+		}
+
+		return c.tls(vargAddress.Data, vargPort.Data, vargProtocol.Data)
+	})
+}
+
+// mqlPorts for the ports resource
+type mqlPorts struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlPortsInternal
+	Listening plugin.TValue[[]any]
+	List      plugin.TValue[[]any]
+}
+
+// createPorts creates a new instance of this resource
+func createPorts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPorts{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ports", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlPorts) MqlName() string {
+	return "ports"
+}
+
+func (c *mqlPorts) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlPorts) GetListening() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Listening, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "listening")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.listening()
+	})
+}
+
+func (c *mqlPorts) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ports", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlAuditpol for the auditpol resource
+type mqlAuditpol struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlAuditpolInternal it will be used here
+	List plugin.TValue[[]any]
+}
+
+// createAuditpol creates a new instance of this resource
+func createAuditpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("auditpol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditpol) MqlName() string {
+	return "auditpol"
+}
+
+func (c *mqlAuditpol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditpol) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("auditpol", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlAuditpolEntry for the auditpol.entry resource
+type mqlAuditpolEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlAuditpolEntryInternal it will be used here
+	Machinename      plugin.TValue[string]
+	Policytarget     plugin.TValue[string]
+	Subcategory      plugin.TValue[string]
+	Subcategoryguid  plugin.TValue[string]
+	Inclusionsetting plugin.TValue[string]
+	Exclusionsetting plugin.TValue[string]
+}
+
+// createAuditpolEntry creates a new instance of this resource
+func createAuditpolEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlAuditpolEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("auditpol.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlAuditpolEntry) MqlName() string {
+	return "auditpol.entry"
+}
+
+func (c *mqlAuditpolEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlAuditpolEntry) GetMachinename() *plugin.TValue[string] {
+	return &c.Machinename
+}
+
+func (c *mqlAuditpolEntry) GetPolicytarget() *plugin.TValue[string] {
+	return &c.Policytarget
+}
+
+func (c *mqlAuditpolEntry) GetSubcategory() *plugin.TValue[string] {
+	return &c.Subcategory
+}
+
+func (c *mqlAuditpolEntry) GetSubcategoryguid() *plugin.TValue[string] {
+	return &c.Subcategoryguid
+}
+
+func (c *mqlAuditpolEntry) GetInclusionsetting() *plugin.TValue[string] {
+	return &c.Inclusionsetting
+}
+
+func (c *mqlAuditpolEntry) GetExclusionsetting() *plugin.TValue[string] {
+	return &c.Exclusionsetting
+}
+
+// mqlSecpol for the secpol resource
+type mqlSecpol struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	mqlSecpolInternal
+	Systemaccess    plugin.TValue[map[string]any]
+	Eventaudit      plugin.TValue[map[string]any]
+	Registryvalues  plugin.TValue[map[string]any]
+	Privilegerights plugin.TValue[map[string]any]
+}
+
+// createSecpol creates a new instance of this resource
+func createSecpol(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSecpol{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("secpol", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSecpol) MqlName() string {
+	return "secpol"
+}
+
+func (c *mqlSecpol) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSecpol) GetSystemaccess() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Systemaccess, func() (map[string]any, error) {
+		return c.systemaccess()
+	})
+}
+
+func (c *mqlSecpol) GetEventaudit() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Eventaudit, func() (map[string]any, error) {
+		return c.eventaudit()
+	})
+}
+
+func (c *mqlSecpol) GetRegistryvalues() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Registryvalues, func() (map[string]any, error) {
+		return c.registryvalues()
+	})
+}
+
+func (c *mqlSecpol) GetPrivilegerights() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Privilegerights, func() (map[string]any, error) {
+		return c.privilegerights()
+	})
+}
+
+// mqlNtpConf for the ntp.conf resource
+type mqlNtpConf struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlNtpConfInternal it will be used here
+	File     plugin.TValue[*mqlFile]
+	Content  plugin.TValue[string]
+	Settings plugin.TValue[[]any]
+	Servers  plugin.TValue[[]any]
+	Restrict plugin.TValue[[]any]
+	Fudge    plugin.TValue[[]any]
+}
+
+// createNtpConf creates a new instance of this resource
+func createNtpConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNtpConf{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ntp.conf", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlNtpConf) MqlName() string {
+	return "ntp.conf"
+}
+
+func (c *mqlNtpConf) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlNtpConf) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ntp.conf", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
+}
+
+func (c *mqlNtpConf) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlNtpConf) GetSettings() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Settings, func() ([]any, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.settings(vargContent.Data)
+	})
+}
+
+func (c *mqlNtpConf) GetServers() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Servers, func() ([]any, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
+		}
+
+		return c.servers(vargSettings.Data)
+	})
+}
+
+func (c *mqlNtpConf) GetRestrict() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Restrict, func() ([]any, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
+		}
+
+		return c.restrict(vargSettings.Data)
+	})
+}
+
+func (c *mqlNtpConf) GetFudge() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Fudge, func() ([]any, error) {
+		vargSettings := c.GetSettings()
+		if vargSettings.Error != nil {
+			return nil, vargSettings.Error
+		}
+
+		return c.fudge(vargSettings.Data)
+	})
+}
+
+// mqlRsyslogConf for the rsyslog.conf resource
+type mqlRsyslogConf struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlRsyslogConfInternal it will be used here
+	Path     plugin.TValue[string]
+	Files    plugin.TValue[[]any]
+	Content  plugin.TValue[string]
+	Settings plugin.TValue[[]any]
+}
+
+// createRsyslogConf creates a new instance of this resource
+func createRsyslogConf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRsyslogConf{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("rsyslog.conf", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlRsyslogConf) MqlName() string {
+	return "rsyslog.conf"
+}
+
+func (c *mqlRsyslogConf) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlRsyslogConf) GetPath() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Path, func() (string, error) {
+		return c.path()
+	})
+}
+
+func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rsyslog.conf", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargPath := c.GetPath()
+		if vargPath.Error != nil {
+			return nil, vargPath.Error
+		}
+
+		return c.files(vargPath.Data)
+	})
+}
+
+func (c *mqlRsyslogConf) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
+		}
+
+		return c.content(vargFiles.Data)
+	})
+}
+
+func (c *mqlRsyslogConf) GetSettings() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Settings, func() ([]any, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.settings(vargContent.Data)
+	})
+}
+
+// mqlLogindefs for the logindefs resource
+type mqlLogindefs struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlLogindefsInternal it will be used here
+	File    plugin.TValue[*mqlFile]
+	Content plugin.TValue[string]
+	Params  plugin.TValue[map[string]any]
+}
+
+// createLogindefs creates a new instance of this resource
+func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLogindefs{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("logindefs", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlLogindefs) MqlName() string {
+	return "logindefs"
+}
+
+func (c *mqlLogindefs) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlLogindefs) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("logindefs", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
+}
+
+func (c *mqlLogindefs) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFile := c.GetFile()
+		if vargFile.Error != nil {
+			return "", vargFile.Error
+		}
+
+		return c.content(vargFile.Data)
+	})
+}
+
+func (c *mqlLogindefs) GetParams() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.params(vargContent.Data)
+	})
+}
+
+// mqlLimits for the limits resource
+type mqlLimits struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlLimitsInternal it will be used here
+	Files   plugin.TValue[[]any]
+	Entries plugin.TValue[[]any]
+}
+
+// createLimits creates a new instance of this resource
+func createLimits(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLimits{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("limits", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlLimits) MqlName() string {
+	return "limits"
+}
+
+func (c *mqlLimits) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlLimits) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("limits", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.files()
+	})
+}
+
+func (c *mqlLimits) GetEntries() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("limits", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.entries(vargFiles.Data)
+	})
+}
+
+// mqlLimitsEntry for the limits.entry resource
+type mqlLimitsEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlLimitsEntryInternal it will be used here
+	File       plugin.TValue[*mqlFile]
+	LineNumber plugin.TValue[int64]
+	Domain     plugin.TValue[string]
+	Type       plugin.TValue[string]
+	Item       plugin.TValue[string]
+	Value      plugin.TValue[string]
+}
+
+// createLimitsEntry creates a new instance of this resource
+func createLimitsEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLimitsEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("limits.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlLimitsEntry) MqlName() string {
+	return "limits.entry"
+}
+
+func (c *mqlLimitsEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlLimitsEntry) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
+}
+
+func (c *mqlLimitsEntry) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
+
+func (c *mqlLimitsEntry) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlLimitsEntry) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlLimitsEntry) GetItem() *plugin.TValue[string] {
+	return &c.Item
+}
+
+func (c *mqlLimitsEntry) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+// mqlSudoers for the sudoers resource
+type mqlSudoers struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlSudoersInternal it will be used here
+	Files     plugin.TValue[[]any]
+	Content   plugin.TValue[string]
+	UserSpecs plugin.TValue[[]any]
+	Defaults  plugin.TValue[[]any]
+	Aliases   plugin.TValue[[]any]
+}
+
+// createSudoers creates a new instance of this resource
+func createSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoers{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("sudoers", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSudoers) MqlName() string {
+	return "sudoers"
+}
+
+func (c *mqlSudoers) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSudoers) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.files()
+	})
+}
+
+func (c *mqlSudoers) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return "", vargFiles.Error
+		}
+
+		return c.content(vargFiles.Data)
+	})
+}
+
+func (c *mqlSudoers) GetUserSpecs() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.UserSpecs, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "userSpecs")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.userSpecs(vargFiles.Data)
+	})
+}
+
+func (c *mqlSudoers) GetDefaults() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Defaults, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "defaults")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.defaults(vargFiles.Data)
+	})
+}
+
+func (c *mqlSudoers) GetAliases() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Aliases, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "aliases")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.aliases(vargFiles.Data)
+	})
+}
+
+// mqlSudoersUserSpec for the sudoers.userSpec resource
+type mqlSudoersUserSpec struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlSudoersUserSpecInternal it will be used here
+	File        plugin.TValue[string]
+	LineNumber  plugin.TValue[int64]
+	Users       plugin.TValue[[]any]
+	Hosts       plugin.TValue[[]any]
+	RunasUsers  plugin.TValue[[]any]
+	RunasGroups plugin.TValue[[]any]
+	Commands    plugin.TValue[[]any]
+	Tags        plugin.TValue[[]any]
+}
+
+// createSudoersUserSpec creates a new instance of this resource
+func createSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersUserSpec{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
 
-func (c *mqlRsyslogConf) MqlID() string {
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("sudoers.userSpec", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSudoersUserSpec) MqlName() string {
+	return "sudoers.userSpec"
+}
+
+func (c *mqlSudoersUserSpec) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSudoersUserSpec) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
+
+func (c *mqlSudoersUserSpec) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
+
+func (c *mqlSudoersUserSpec) GetUsers() *plugin.TValue[[]any] {
+	return &c.Users
+}
+
+func (c *mqlSudoersUserSpec) GetHosts() *plugin.TValue[[]any] {
+	return &c.Hosts
+}
+
+func (c *mqlSudoersUserSpec) GetRunasUsers() *plugin.TValue[[]any] {
+	return &c.RunasUsers
+}
+
+func (c *mqlSudoersUserSpec) GetRunasGroups() *plugin.TValue[[]any] {
+	return &c.RunasGroups
+}
+
+func (c *mqlSudoersUserSpec) GetCommands() *plugin.TValue[[]any] {
+	return &c.Commands
+}
+
+func (c *mqlSudoersUserSpec) GetTags() *plugin.TValue[[]any] {
+	return &c.Tags
+}
+
+// mqlSudoersDefault for the sudoers.default resource
+type mqlSudoersDefault struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlSudoersDefaultInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Raw        plugin.TValue[string]
+	Scope      plugin.TValue[string]
+	Target     plugin.TValue[string]
+	Parameter  plugin.TValue[string]
+	Value      plugin.TValue[string]
+	Operation  plugin.TValue[string]
+	Negated    plugin.TValue[bool]
+}
+
+// createSudoersDefault creates a new instance of this resource
+func createSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersDefault{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("sudoers.default", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSudoersDefault) MqlName() string {
+	return "sudoers.default"
+}
+
+func (c *mqlSudoersDefault) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSudoersDefault) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
+
+func (c *mqlSudoersDefault) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
+
+func (c *mqlSudoersDefault) GetRaw() *plugin.TValue[string] {
+	return &c.Raw
+}
+
+func (c *mqlSudoersDefault) GetScope() *plugin.TValue[string] {
+	return &c.Scope
+}
+
+func (c *mqlSudoersDefault) GetTarget() *plugin.TValue[string] {
+	return &c.Target
+}
+
+func (c *mqlSudoersDefault) GetParameter() *plugin.TValue[string] {
+	return &c.Parameter
+}
+
+func (c *mqlSudoersDefault) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+func (c *mqlSudoersDefault) GetOperation() *plugin.TValue[string] {
+	return &c.Operation
+}
+
+func (c *mqlSudoersDefault) GetNegated() *plugin.TValue[bool] {
+	return &c.Negated
+}
+
+// mqlSudoersAlias for the sudoers.alias resource
+type mqlSudoersAlias struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlSudoersAliasInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Type       plugin.TValue[string]
+	Name       plugin.TValue[string]
+	Members    plugin.TValue[[]any]
+}
+
+// createSudoersAlias creates a new instance of this resource
+func createSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSudoersAlias{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("sudoers.alias", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSudoersAlias) MqlName() string {
+	return "sudoers.alias"
+}
+
+func (c *mqlSudoersAlias) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRsyslogConf) GetPath() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Path, func() (string, error) {
-		return c.path()
-	})
+func (c *mqlSudoersAlias) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
+
+func (c *mqlSudoersAlias) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
+
+func (c *mqlSudoersAlias) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlSudoersAlias) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlSudoersAlias) GetMembers() *plugin.TValue[[]any] {
+	return &c.Members
+}
+
+// mqlLsblk for the lsblk resource
+type mqlLsblk struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlLsblkInternal it will be used here
+	List plugin.TValue[[]any]
+}
+
+// createLsblk creates a new instance of this resource
+func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLsblk{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("lsblk", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlLsblk) MqlName() string {
+	return "lsblk"
+}
+
+func (c *mqlLsblk) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+func (c *mqlLsblk) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("rsyslog.conf", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("lsblk", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -16968,50 +21526,96 @@ func (c *mqlRsyslogConf) GetFiles() *plugin.TValue[[]any] {
 			}
 		}
 
-		vargPath := c.GetPath()
-		if vargPath.Error != nil {
-			return nil, vargPath.Error
-		}
-
-		return c.files(vargPath.Data)
+		return c.list()
 	})
 }
 
-func (c *mqlRsyslogConf) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
+// mqlLsblkEntry for the lsblk.entry resource
+type mqlLsblkEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlLsblkEntryInternal it will be used here
+	Name        plugin.TValue[string]
+	Fstype      plugin.TValue[string]
+	Label       plugin.TValue[string]
+	Uuid        plugin.TValue[string]
+	Mountpoints plugin.TValue[[]any]
+}
+
+// createLsblkEntry creates a new instance of this resource
+func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLsblkEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.content(vargFiles.Data)
-	})
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("lsblk.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlRsyslogConf) GetSettings() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Settings, func() ([]any, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlLsblkEntry) MqlName() string {
+	return "lsblk.entry"
+}
 
-		return c.settings(vargContent.Data)
-	})
+func (c *mqlLsblkEntry) MqlID() string {
+	return c.__id
 }
 
-// mqlLogindefs for the logindefs resource
-type mqlLogindefs struct {
+func (c *mqlLsblkEntry) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlLsblkEntry) GetFstype() *plugin.TValue[string] {
+	return &c.Fstype
+}
+
+func (c *mqlLsblkEntry) GetLabel() *plugin.TValue[string] {
+	return &c.Label
+}
+
+func (c *mqlLsblkEntry) GetUuid() *plugin.TValue[string] {
+	return &c.Uuid
+}
+
+func (c *mqlLsblkEntry) GetMountpoints() *plugin.TValue[[]any] {
+	return &c.Mountpoints
+}
+
+// mqlModprobe for the modprobe resource
+type mqlModprobe struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlLogindefsInternal it will be used here
-	File    plugin.TValue[*mqlFile]
-	Content plugin.TValue[string]
-	Params  plugin.TValue[map[string]any]
+	// optional: if you define mqlModprobeInternal it will be used here
+	Files      plugin.TValue[[]any]
+	Installs   plugin.TValue[[]any]
+	Removes    plugin.TValue[[]any]
+	Blacklists plugin.TValue[[]any]
+	Options    plugin.TValue[[]any]
+	Aliases    plugin.TValue[[]any]
+	Softdeps   plugin.TValue[[]any]
 }
 
-// createLogindefs creates a new instance of this resource
-func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLogindefs{
+// createModprobe creates a new instance of this resource
+func createModprobe(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobe{
 		MqlRuntime: runtime,
 	}
 
@@ -17028,7 +21632,7 @@ func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("logindefs", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17038,64 +21642,170 @@ func createLogindefs(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlLogindefs) MqlName() string {
-	return "logindefs"
+func (c *mqlModprobe) MqlName() string {
+	return "modprobe"
 }
 
-func (c *mqlLogindefs) MqlID() string {
+func (c *mqlModprobe) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLogindefs) GetFile() *plugin.TValue[*mqlFile] {
-	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+func (c *mqlModprobe) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("logindefs", c.__id, "file")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlFile), nil
+				return d.Value.([]any), nil
 			}
 		}
 
-		return c.file()
+		return c.files()
 	})
 }
 
-func (c *mqlLogindefs) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFile := c.GetFile()
-		if vargFile.Error != nil {
-			return "", vargFile.Error
+func (c *mqlModprobe) GetInstalls() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Installs, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "installs")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.installs(vargFiles.Data)
+	})
+}
+
+func (c *mqlModprobe) GetRemoves() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Removes, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "removes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.removes(vargFiles.Data)
+	})
+}
+
+func (c *mqlModprobe) GetBlacklists() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Blacklists, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "blacklists")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.blacklists(vargFiles.Data)
+	})
+}
+
+func (c *mqlModprobe) GetOptions() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Options, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "options")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.options(vargFiles.Data)
+	})
+}
+
+func (c *mqlModprobe) GetAliases() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Aliases, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "aliases")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
+		}
+
+		return c.aliases(vargFiles.Data)
+	})
+}
+
+func (c *mqlModprobe) GetSoftdeps() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Softdeps, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "softdeps")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
 		}
 
-		return c.content(vargFile.Data)
-	})
-}
-
-func (c *mqlLogindefs) GetParams() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
+		vargFiles := c.GetFiles()
+		if vargFiles.Error != nil {
+			return nil, vargFiles.Error
 		}
 
-		return c.params(vargContent.Data)
+		return c.softdeps(vargFiles.Data)
 	})
 }
 
-// mqlLimits for the limits resource
-type mqlLimits struct {
+// mqlModprobeInstall for the modprobe.install resource
+type mqlModprobeInstall struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlLimitsInternal it will be used here
-	Files   plugin.TValue[[]any]
-	Entries plugin.TValue[[]any]
+	// optional: if you define mqlModprobeInstallInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Module     plugin.TValue[string]
+	Command    plugin.TValue[string]
 }
 
-// createLimits creates a new instance of this resource
-func createLimits(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLimits{
+// createModprobeInstall creates a new instance of this resource
+func createModprobeInstall(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeInstall{
 		MqlRuntime: runtime,
 	}
 
@@ -17112,7 +21822,7 @@ func createLimits(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("limits", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe.install", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17122,67 +21832,44 @@ func createLimits(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlLimits) MqlName() string {
-	return "limits"
+func (c *mqlModprobeInstall) MqlName() string {
+	return "modprobe.install"
 }
 
-func (c *mqlLimits) MqlID() string {
+func (c *mqlModprobeInstall) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLimits) GetFiles() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("limits", c.__id, "files")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.files()
-	})
+func (c *mqlModprobeInstall) GetFile() *plugin.TValue[string] {
+	return &c.File
 }
 
-func (c *mqlLimits) GetEntries() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Entries, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("limits", c.__id, "entries")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlModprobeInstall) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
+func (c *mqlModprobeInstall) GetModule() *plugin.TValue[string] {
+	return &c.Module
+}
 
-		return c.entries(vargFiles.Data)
-	})
+func (c *mqlModprobeInstall) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-// mqlLimitsEntry for the limits.entry resource
-type mqlLimitsEntry struct {
+// mqlModprobeRemove for the modprobe.remove resource
+type mqlModprobeRemove struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlLimitsEntryInternal it will be used here
-	File       plugin.TValue[*mqlFile]
+	// optional: if you define mqlModprobeRemoveInternal it will be used here
+	File       plugin.TValue[string]
 	LineNumber plugin.TValue[int64]
-	Domain     plugin.TValue[string]
-	Type       plugin.TValue[string]
-	Item       plugin.TValue[string]
-	Value      plugin.TValue[string]
+	Module     plugin.TValue[string]
+	Command    plugin.TValue[string]
 }
 
-// createLimitsEntry creates a new instance of this resource
-func createLimitsEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLimitsEntry{
+// createModprobeRemove creates a new instance of this resource
+func createModprobeRemove(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeRemove{
 		MqlRuntime: runtime,
 	}
 
@@ -17199,7 +21886,7 @@ func createLimitsEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("limits.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe.remove", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17209,53 +21896,43 @@ func createLimitsEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlLimitsEntry) MqlName() string {
-	return "limits.entry"
+func (c *mqlModprobeRemove) MqlName() string {
+	return "modprobe.remove"
 }
 
-func (c *mqlLimitsEntry) MqlID() string {
+func (c *mqlModprobeRemove) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLimitsEntry) GetFile() *plugin.TValue[*mqlFile] {
+func (c *mqlModprobeRemove) GetFile() *plugin.TValue[string] {
 	return &c.File
 }
 
-func (c *mqlLimitsEntry) GetLineNumber() *plugin.TValue[int64] {
+func (c *mqlModprobeRemove) GetLineNumber() *plugin.TValue[int64] {
 	return &c.LineNumber
 }
 
-func (c *mqlLimitsEntry) GetDomain() *plugin.TValue[string] {
-	return &c.Domain
-}
-
-func (c *mqlLimitsEntry) GetType() *plugin.TValue[string] {
-	return &c.Type
-}
-
-func (c *mqlLimitsEntry) GetItem() *plugin.TValue[string] {
-	return &c.Item
+func (c *mqlModprobeRemove) GetModule() *plugin.TValue[string] {
+	return &c.Module
 }
 
-func (c *mqlLimitsEntry) GetValue() *plugin.TValue[string] {
-	return &c.Value
+func (c *mqlModprobeRemove) GetCommand() *plugin.TValue[string] {
+	return &c.Command
 }
 
-// mqlSudoers for the sudoers resource
-type mqlSudoers struct {
+// mqlModprobeBlacklist for the modprobe.blacklist resource
+type mqlModprobeBlacklist struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlSudoersInternal it will be used here
-	Files     plugin.TValue[[]any]
-	Content   plugin.TValue[string]
-	UserSpecs plugin.TValue[[]any]
-	Defaults  plugin.TValue[[]any]
-	Aliases   plugin.TValue[[]any]
+	// optional: if you define mqlModprobeBlacklistInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Module     plugin.TValue[string]
 }
 
-// createSudoers creates a new instance of this resource
-func createSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSudoers{
+// createModprobeBlacklist creates a new instance of this resource
+func createModprobeBlacklist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeBlacklist{
 		MqlRuntime: runtime,
 	}
 
@@ -17272,7 +21949,7 @@ func createSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sudoers", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe.blacklist", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17282,122 +21959,111 @@ func createSudoers(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlSudoers) MqlName() string {
-	return "sudoers"
+func (c *mqlModprobeBlacklist) MqlName() string {
+	return "modprobe.blacklist"
 }
 
-func (c *mqlSudoers) MqlID() string {
+func (c *mqlModprobeBlacklist) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSudoers) GetFiles() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "files")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlModprobeBlacklist) GetFile() *plugin.TValue[string] {
+	return &c.File
+}
 
-		return c.files()
-	})
+func (c *mqlModprobeBlacklist) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
 }
 
-func (c *mqlSudoers) GetContent() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return "", vargFiles.Error
-		}
+func (c *mqlModprobeBlacklist) GetModule() *plugin.TValue[string] {
+	return &c.Module
+}
 
-		return c.content(vargFiles.Data)
-	})
+// mqlModprobeOption for the modprobe.option resource
+type mqlModprobeOption struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlModprobeOptionInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Module     plugin.TValue[string]
+	Parameters plugin.TValue[string]
+	Params     plugin.TValue[map[string]any]
 }
 
-func (c *mqlSudoers) GetUserSpecs() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.UserSpecs, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "userSpecs")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
+// createModprobeOption creates a new instance of this resource
+func createModprobeOption(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeOption{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("modprobe.option", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.userSpecs(vargFiles.Data)
-	})
+	return res, nil
 }
 
-func (c *mqlSudoers) GetDefaults() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Defaults, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "defaults")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlModprobeOption) MqlName() string {
+	return "modprobe.option"
+}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
+func (c *mqlModprobeOption) MqlID() string {
+	return c.__id
+}
 
-		return c.defaults(vargFiles.Data)
-	})
+func (c *mqlModprobeOption) GetFile() *plugin.TValue[string] {
+	return &c.File
 }
 
-func (c *mqlSudoers) GetAliases() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Aliases, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("sudoers", c.__id, "aliases")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlModprobeOption) GetLineNumber() *plugin.TValue[int64] {
+	return &c.LineNumber
+}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
+func (c *mqlModprobeOption) GetModule() *plugin.TValue[string] {
+	return &c.Module
+}
 
-		return c.aliases(vargFiles.Data)
+func (c *mqlModprobeOption) GetParameters() *plugin.TValue[string] {
+	return &c.Parameters
+}
+
+func (c *mqlModprobeOption) GetParams() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
+		return c.params()
 	})
 }
 
-// mqlSudoersUserSpec for the sudoers.userSpec resource
-type mqlSudoersUserSpec struct {
+// mqlModprobeAlias for the modprobe.alias resource
+type mqlModprobeAlias struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlSudoersUserSpecInternal it will be used here
-	File        plugin.TValue[string]
-	LineNumber  plugin.TValue[int64]
-	Users       plugin.TValue[[]any]
-	Hosts       plugin.TValue[[]any]
-	RunasUsers  plugin.TValue[[]any]
-	RunasGroups plugin.TValue[[]any]
-	Commands    plugin.TValue[[]any]
-	Tags        plugin.TValue[[]any]
+	// optional: if you define mqlModprobeAliasInternal it will be used here
+	File       plugin.TValue[string]
+	LineNumber plugin.TValue[int64]
+	Alias      plugin.TValue[string]
+	Module     plugin.TValue[string]
 }
 
-// createSudoersUserSpec creates a new instance of this resource
-func createSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSudoersUserSpec{
+// createModprobeAlias creates a new instance of this resource
+func createModprobeAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeAlias{
 		MqlRuntime: runtime,
 	}
 
@@ -17414,7 +22080,7 @@ func createSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sudoers.userSpec", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe.alias", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17424,65 +22090,45 @@ func createSudoersUserSpec(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlSudoersUserSpec) MqlName() string {
-	return "sudoers.userSpec"
+func (c *mqlModprobeAlias) MqlName() string {
+	return "modprobe.alias"
 }
 
-func (c *mqlSudoersUserSpec) MqlID() string {
+func (c *mqlModprobeAlias) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSudoersUserSpec) GetFile() *plugin.TValue[string] {
+func (c *mqlModprobeAlias) GetFile() *plugin.TValue[string] {
 	return &c.File
 }
 
-func (c *mqlSudoersUserSpec) GetLineNumber() *plugin.TValue[int64] {
+func (c *mqlModprobeAlias) GetLineNumber() *plugin.TValue[int64] {
 	return &c.LineNumber
 }
 
-func (c *mqlSudoersUserSpec) GetUsers() *plugin.TValue[[]any] {
-	return &c.Users
-}
-
-func (c *mqlSudoersUserSpec) GetHosts() *plugin.TValue[[]any] {
-	return &c.Hosts
-}
-
-func (c *mqlSudoersUserSpec) GetRunasUsers() *plugin.TValue[[]any] {
-	return &c.RunasUsers
-}
-
-func (c *mqlSudoersUserSpec) GetRunasGroups() *plugin.TValue[[]any] {
-	return &c.RunasGroups
-}
-
-func (c *mqlSudoersUserSpec) GetCommands() *plugin.TValue[[]any] {
-	return &c.Commands
+func (c *mqlModprobeAlias) GetAlias() *plugin.TValue[string] {
+	return &c.Alias
 }
 
-func (c *mqlSudoersUserSpec) GetTags() *plugin.TValue[[]any] {
-	return &c.Tags
+func (c *mqlModprobeAlias) GetModule() *plugin.TValue[string] {
+	return &c.Module
 }
 
-// mqlSudoersDefault for the sudoers.default resource
-type mqlSudoersDefault struct {
+// mqlModprobeSoftdep for the modprobe.softdep resource
+type mqlModprobeSoftdep struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlSudoersDefaultInternal it will be used here
+	// optional: if you define mqlModprobeSoftdepInternal it will be used here
 	File       plugin.TValue[string]
 	LineNumber plugin.TValue[int64]
-	Raw        plugin.TValue[string]
-	Scope      plugin.TValue[string]
-	Target     plugin.TValue[string]
-	Parameter  plugin.TValue[string]
-	Value      plugin.TValue[string]
-	Operation  plugin.TValue[string]
-	Negated    plugin.TValue[bool]
+	Module     plugin.TValue[string]
+	Pre        plugin.TValue[[]any]
+	Post       plugin.TValue[[]any]
 }
 
-// createSudoersDefault creates a new instance of this resource
-func createSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSudoersDefault{
+// createModprobeSoftdep creates a new instance of this resource
+func createModprobeSoftdep(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlModprobeSoftdep{
 		MqlRuntime: runtime,
 	}
 
@@ -17499,7 +22145,7 @@ func createSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sudoers.default", res.__id)
+		args, err = runtime.ResourceFromRecording("modprobe.softdep", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17509,65 +22155,110 @@ func createSudoersDefault(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlSudoersDefault) MqlName() string {
-	return "sudoers.default"
+func (c *mqlModprobeSoftdep) MqlName() string {
+	return "modprobe.softdep"
 }
 
-func (c *mqlSudoersDefault) MqlID() string {
+func (c *mqlModprobeSoftdep) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSudoersDefault) GetFile() *plugin.TValue[string] {
+func (c *mqlModprobeSoftdep) GetFile() *plugin.TValue[string] {
 	return &c.File
 }
 
-func (c *mqlSudoersDefault) GetLineNumber() *plugin.TValue[int64] {
+func (c *mqlModprobeSoftdep) GetLineNumber() *plugin.TValue[int64] {
 	return &c.LineNumber
 }
 
-func (c *mqlSudoersDefault) GetRaw() *plugin.TValue[string] {
-	return &c.Raw
+func (c *mqlModprobeSoftdep) GetModule() *plugin.TValue[string] {
+	return &c.Module
 }
 
-func (c *mqlSudoersDefault) GetScope() *plugin.TValue[string] {
-	return &c.Scope
+func (c *mqlModprobeSoftdep) GetPre() *plugin.TValue[[]any] {
+	return &c.Pre
 }
 
-func (c *mqlSudoersDefault) GetTarget() *plugin.TValue[string] {
-	return &c.Target
+func (c *mqlModprobeSoftdep) GetPost() *plugin.TValue[[]any] {
+	return &c.Post
 }
 
-func (c *mqlSudoersDefault) GetParameter() *plugin.TValue[string] {
-	return &c.Parameter
+// mqlMount for the mount resource
+type mqlMount struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlMountInternal it will be used here
+	List plugin.TValue[[]any]
 }
 
-func (c *mqlSudoersDefault) GetValue() *plugin.TValue[string] {
-	return &c.Value
+// createMount creates a new instance of this resource
+func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMount{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("mount", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlSudoersDefault) GetOperation() *plugin.TValue[string] {
-	return &c.Operation
+func (c *mqlMount) MqlName() string {
+	return "mount"
 }
 
-func (c *mqlSudoersDefault) GetNegated() *plugin.TValue[bool] {
-	return &c.Negated
+func (c *mqlMount) MqlID() string {
+	return c.__id
 }
 
-// mqlSudoersAlias for the sudoers.alias resource
-type mqlSudoersAlias struct {
+func (c *mqlMount) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("mount", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlMountPoint for the mount.point resource
+type mqlMountPoint struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlSudoersAliasInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Type       plugin.TValue[string]
-	Name       plugin.TValue[string]
-	Members    plugin.TValue[[]any]
+	// optional: if you define mqlMountPointInternal it will be used here
+	Device  plugin.TValue[string]
+	Path    plugin.TValue[string]
+	Fstype  plugin.TValue[string]
+	Options plugin.TValue[map[string]any]
+	Mounted plugin.TValue[bool]
 }
 
-// createSudoersAlias creates a new instance of this resource
-func createSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSudoersAlias{
+// createMountPoint creates a new instance of this resource
+func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlMountPoint{
 		MqlRuntime: runtime,
 	}
 
@@ -17584,7 +22275,7 @@ func createSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("sudoers.alias", res.__id)
+		args, err = runtime.ResourceFromRecording("mount.point", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17594,45 +22285,45 @@ func createSudoersAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlSudoersAlias) MqlName() string {
-	return "sudoers.alias"
+func (c *mqlMountPoint) MqlName() string {
+	return "mount.point"
 }
 
-func (c *mqlSudoersAlias) MqlID() string {
+func (c *mqlMountPoint) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSudoersAlias) GetFile() *plugin.TValue[string] {
-	return &c.File
+func (c *mqlMountPoint) GetDevice() *plugin.TValue[string] {
+	return &c.Device
 }
 
-func (c *mqlSudoersAlias) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlMountPoint) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlSudoersAlias) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlMountPoint) GetFstype() *plugin.TValue[string] {
+	return &c.Fstype
 }
 
-func (c *mqlSudoersAlias) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlMountPoint) GetOptions() *plugin.TValue[map[string]any] {
+	return &c.Options
 }
 
-func (c *mqlSudoersAlias) GetMembers() *plugin.TValue[[]any] {
-	return &c.Members
+func (c *mqlMountPoint) GetMounted() *plugin.TValue[bool] {
+	return &c.Mounted
 }
 
-// mqlLsblk for the lsblk resource
-type mqlLsblk struct {
+// mqlShadow for the shadow resource
+type mqlShadow struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlLsblkInternal it will be used here
+	// optional: if you define mqlShadowInternal it will be used here
 	List plugin.TValue[[]any]
 }
 
-// createLsblk creates a new instance of this resource
-func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLsblk{
+// createShadow creates a new instance of this resource
+func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlShadow{
 		MqlRuntime: runtime,
 	}
 
@@ -17649,7 +22340,7 @@ func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("lsblk", res.__id)
+		args, err = runtime.ResourceFromRecording("shadow", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17659,18 +22350,18 @@ func createLsblk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlLsblk) MqlName() string {
-	return "lsblk"
+func (c *mqlShadow) MqlName() string {
+	return "shadow"
 }
 
-func (c *mqlLsblk) MqlID() string {
+func (c *mqlShadow) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLsblk) GetList() *plugin.TValue[[]any] {
+func (c *mqlShadow) GetList() *plugin.TValue[[]any] {
 	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("lsblk", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("shadow", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -17683,21 +22374,25 @@ func (c *mqlLsblk) GetList() *plugin.TValue[[]any] {
 	})
 }
 
-// mqlLsblkEntry for the lsblk.entry resource
-type mqlLsblkEntry struct {
+// mqlShadowEntry for the shadow.entry resource
+type mqlShadowEntry struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlLsblkEntryInternal it will be used here
-	Name        plugin.TValue[string]
-	Fstype      plugin.TValue[string]
-	Label       plugin.TValue[string]
-	Uuid        plugin.TValue[string]
-	Mountpoints plugin.TValue[[]any]
+	// optional: if you define mqlShadowEntryInternal it will be used here
+	User         plugin.TValue[string]
+	Password     plugin.TValue[string]
+	Lastchanged  plugin.TValue[*time.Time]
+	Mindays      plugin.TValue[int64]
+	Maxdays      plugin.TValue[int64]
+	Warndays     plugin.TValue[int64]
+	Inactivedays plugin.TValue[int64]
+	Expirydates  plugin.TValue[string]
+	Reserved     plugin.TValue[string]
 }
 
-// createLsblkEntry creates a new instance of this resource
-func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlLsblkEntry{
+// createShadowEntry creates a new instance of this resource
+func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlShadowEntry{
 		MqlRuntime: runtime,
 	}
 
@@ -17714,7 +22409,7 @@ func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("lsblk.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("shadow.entry", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17724,51 +22419,62 @@ func createLsblkEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlLsblkEntry) MqlName() string {
-	return "lsblk.entry"
+func (c *mqlShadowEntry) MqlName() string {
+	return "shadow.entry"
 }
 
-func (c *mqlLsblkEntry) MqlID() string {
+func (c *mqlShadowEntry) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlLsblkEntry) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlShadowEntry) GetUser() *plugin.TValue[string] {
+	return &c.User
 }
 
-func (c *mqlLsblkEntry) GetFstype() *plugin.TValue[string] {
-	return &c.Fstype
+func (c *mqlShadowEntry) GetPassword() *plugin.TValue[string] {
+	return &c.Password
 }
 
-func (c *mqlLsblkEntry) GetLabel() *plugin.TValue[string] {
-	return &c.Label
+func (c *mqlShadowEntry) GetLastchanged() *plugin.TValue[*time.Time] {
+	return &c.Lastchanged
 }
 
-func (c *mqlLsblkEntry) GetUuid() *plugin.TValue[string] {
-	return &c.Uuid
+func (c *mqlShadowEntry) GetMindays() *plugin.TValue[int64] {
+	return &c.Mindays
 }
 
-func (c *mqlLsblkEntry) GetMountpoints() *plugin.TValue[[]any] {
-	return &c.Mountpoints
+func (c *mqlShadowEntry) GetMaxdays() *plugin.TValue[int64] {
+	return &c.Maxdays
 }
 
-// mqlModprobe for the modprobe resource
-type mqlModprobe struct {
+func (c *mqlShadowEntry) GetWarndays() *plugin.TValue[int64] {
+	return &c.Warndays
+}
+
+func (c *mqlShadowEntry) GetInactivedays() *plugin.TValue[int64] {
+	return &c.Inactivedays
+}
+
+func (c *mqlShadowEntry) GetExpirydates() *plugin.TValue[string] {
+	return &c.Expirydates
+}
+
+func (c *mqlShadowEntry) GetReserved() *plugin.TValue[string] {
+	return &c.Reserved
+}
+
+// mqlYum for the yum resource
+type mqlYum struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeInternal it will be used here
-	Files      plugin.TValue[[]any]
-	Installs   plugin.TValue[[]any]
-	Removes    plugin.TValue[[]any]
-	Blacklists plugin.TValue[[]any]
-	Options    plugin.TValue[[]any]
-	Aliases    plugin.TValue[[]any]
-	Softdeps   plugin.TValue[[]any]
+	// optional: if you define mqlYumInternal it will be used here
+	Vars  plugin.TValue[map[string]any]
+	Repos plugin.TValue[[]any]
 }
 
-// createModprobe creates a new instance of this resource
-func createModprobe(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobe{
+// createYum creates a new instance of this resource
+func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlYum{
 		MqlRuntime: runtime,
 	}
 
@@ -17785,7 +22491,7 @@ func createModprobe(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe", res.__id)
+		args, err = runtime.ResourceFromRecording("yum", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17795,139 +22501,24 @@ func createModprobe(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlModprobe) MqlName() string {
-	return "modprobe"
+func (c *mqlYum) MqlName() string {
+	return "yum"
 }
 
-func (c *mqlModprobe) MqlID() string {
+func (c *mqlYum) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobe) GetFiles() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "files")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.files()
-	})
-}
-
-func (c *mqlModprobe) GetInstalls() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Installs, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "installs")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.installs(vargFiles.Data)
-	})
-}
-
-func (c *mqlModprobe) GetRemoves() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Removes, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "removes")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.removes(vargFiles.Data)
-	})
-}
-
-func (c *mqlModprobe) GetBlacklists() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Blacklists, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "blacklists")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.blacklists(vargFiles.Data)
-	})
-}
-
-func (c *mqlModprobe) GetOptions() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Options, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "options")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.options(vargFiles.Data)
-	})
-}
-
-func (c *mqlModprobe) GetAliases() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Aliases, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "aliases")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.aliases(vargFiles.Data)
+func (c *mqlYum) GetVars() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Vars, func() (map[string]any, error) {
+		return c.vars()
 	})
 }
 
-func (c *mqlModprobe) GetSoftdeps() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Softdeps, func() ([]any, error) {
+func (c *mqlYum) GetRepos() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Repos, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("modprobe", c.__id, "softdeps")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("yum", c.__id, "repos")
 			if err != nil {
 				return nil, err
 			}
@@ -17936,29 +22527,31 @@ func (c *mqlModprobe) GetSoftdeps() *plugin.TValue[[]any] {
 			}
 		}
 
-		vargFiles := c.GetFiles()
-		if vargFiles.Error != nil {
-			return nil, vargFiles.Error
-		}
-
-		return c.softdeps(vargFiles.Data)
+		return c.repos()
 	})
 }
-
-// mqlModprobeInstall for the modprobe.install resource
-type mqlModprobeInstall struct {
+
+// mqlYumRepo for the yum.repo resource
+type mqlYumRepo struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeInstallInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Module     plugin.TValue[string]
-	Command    plugin.TValue[string]
+	// optional: if you define mqlYumRepoInternal it will be used here
+	Id       plugin.TValue[string]
+	Name     plugin.TValue[string]
+	Status   plugin.TValue[string]
+	Baseurl  plugin.TValue[[]any]
+	Expire   plugin.TValue[string]
+	File     plugin.TValue[*mqlFile]
+	Revision plugin.TValue[string]
+	Pkgs     plugin.TValue[string]
+	Size     plugin.TValue[string]
+	Mirrors  plugin.TValue[string]
+	Enabled  plugin.TValue[bool]
 }
 
-// createModprobeInstall creates a new instance of this resource
-func createModprobeInstall(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeInstall{
+// createYumRepo creates a new instance of this resource
+func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlYumRepo{
 		MqlRuntime: runtime,
 	}
 
@@ -17975,7 +22568,7 @@ func createModprobeInstall(runtime *plugin.Runtime, args map[string]*llx.RawData
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.install", res.__id)
+		args, err = runtime.ResourceFromRecording("yum.repo", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -17985,44 +22578,75 @@ func createModprobeInstall(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlModprobeInstall) MqlName() string {
-	return "modprobe.install"
+func (c *mqlYumRepo) MqlName() string {
+	return "yum.repo"
 }
 
-func (c *mqlModprobeInstall) MqlID() string {
+func (c *mqlYumRepo) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeInstall) GetFile() *plugin.TValue[string] {
+func (c *mqlYumRepo) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlYumRepo) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlYumRepo) GetStatus() *plugin.TValue[string] {
+	return &c.Status
+}
+
+func (c *mqlYumRepo) GetBaseurl() *plugin.TValue[[]any] {
+	return &c.Baseurl
+}
+
+func (c *mqlYumRepo) GetExpire() *plugin.TValue[string] {
+	return &c.Expire
+}
+
+func (c *mqlYumRepo) GetFile() *plugin.TValue[*mqlFile] {
 	return &c.File
 }
 
-func (c *mqlModprobeInstall) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlYumRepo) GetRevision() *plugin.TValue[string] {
+	return &c.Revision
 }
 
-func (c *mqlModprobeInstall) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlYumRepo) GetPkgs() *plugin.TValue[string] {
+	return &c.Pkgs
 }
 
-func (c *mqlModprobeInstall) GetCommand() *plugin.TValue[string] {
-	return &c.Command
+func (c *mqlYumRepo) GetSize() *plugin.TValue[string] {
+	return &c.Size
 }
 
-// mqlModprobeRemove for the modprobe.remove resource
-type mqlModprobeRemove struct {
+func (c *mqlYumRepo) GetMirrors() *plugin.TValue[string] {
+	return &c.Mirrors
+}
+
+func (c *mqlYumRepo) GetEnabled() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
+		return c.enabled()
+	})
+}
+
+// mqlRegistrykey for the registrykey resource
+type mqlRegistrykey struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeRemoveInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Module     plugin.TValue[string]
-	Command    plugin.TValue[string]
+	// optional: if you define mqlRegistrykeyInternal it will be used here
+	Path       plugin.TValue[string]
+	Exists     plugin.TValue[bool]
+	Properties plugin.TValue[map[string]any]
+	Items      plugin.TValue[[]any]
+	Children   plugin.TValue[[]any]
 }
 
-// createModprobeRemove creates a new instance of this resource
-func createModprobeRemove(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeRemove{
+// createRegistrykey creates a new instance of this resource
+func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykey{
 		MqlRuntime: runtime,
 	}
 
@@ -18039,7 +22663,7 @@ func createModprobeRemove(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.remove", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18049,43 +22673,68 @@ func createModprobeRemove(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlModprobeRemove) MqlName() string {
-	return "modprobe.remove"
+func (c *mqlRegistrykey) MqlName() string {
+	return "registrykey"
 }
 
-func (c *mqlModprobeRemove) MqlID() string {
+func (c *mqlRegistrykey) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeRemove) GetFile() *plugin.TValue[string] {
-	return &c.File
+func (c *mqlRegistrykey) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlModprobeRemove) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlRegistrykey) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
+	})
 }
 
-func (c *mqlModprobeRemove) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlRegistrykey) GetProperties() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Properties, func() (map[string]any, error) {
+		return c.properties()
+	})
 }
 
-func (c *mqlModprobeRemove) GetCommand() *plugin.TValue[string] {
-	return &c.Command
+func (c *mqlRegistrykey) GetItems() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Items, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("registrykey", c.__id, "items")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.items()
+	})
 }
 
-// mqlModprobeBlacklist for the modprobe.blacklist resource
-type mqlModprobeBlacklist struct {
+func (c *mqlRegistrykey) GetChildren() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Children, func() ([]any, error) {
+		return c.children()
+	})
+}
+
+// mqlRegistrykeyProperty for the registrykey.property resource
+type mqlRegistrykeyProperty struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeBlacklistInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Module     plugin.TValue[string]
+	// optional: if you define mqlRegistrykeyPropertyInternal it will be used here
+	Path   plugin.TValue[string]
+	Name   plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Value  plugin.TValue[string]
+	Type   plugin.TValue[string]
+	Data   plugin.TValue[any]
 }
 
-// createModprobeBlacklist creates a new instance of this resource
-func createModprobeBlacklist(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeBlacklist{
+// createRegistrykeyProperty creates a new instance of this resource
+func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRegistrykeyProperty{
 		MqlRuntime: runtime,
 	}
 
@@ -18102,7 +22751,7 @@ func createModprobeBlacklist(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.blacklist", res.__id)
+		args, err = runtime.ResourceFromRecording("registrykey.property", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18112,41 +22761,61 @@ func createModprobeBlacklist(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	return res, nil
 }
 
-func (c *mqlModprobeBlacklist) MqlName() string {
-	return "modprobe.blacklist"
+func (c *mqlRegistrykeyProperty) MqlName() string {
+	return "registrykey.property"
 }
 
-func (c *mqlModprobeBlacklist) MqlID() string {
+func (c *mqlRegistrykeyProperty) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeBlacklist) GetFile() *plugin.TValue[string] {
-	return &c.File
+func (c *mqlRegistrykeyProperty) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlModprobeBlacklist) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlRegistrykeyProperty) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlModprobeBlacklist) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlRegistrykeyProperty) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
+	})
 }
 
-// mqlModprobeOption for the modprobe.option resource
-type mqlModprobeOption struct {
+func (c *mqlRegistrykeyProperty) GetValue() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
+		return c.value()
+	})
+}
+
+func (c *mqlRegistrykeyProperty) GetType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
+		return c.compute_type()
+	})
+}
+
+func (c *mqlRegistrykeyProperty) GetData() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Data, func() (any, error) {
+		return c.data()
+	})
+}
+
+// mqlContainerImage for the container.image resource
+type mqlContainerImage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeOptionInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Module     plugin.TValue[string]
-	Parameters plugin.TValue[string]
-	Params     plugin.TValue[map[string]any]
+	// optional: if you define mqlContainerImageInternal it will be used here
+	Reference      plugin.TValue[string]
+	Name           plugin.TValue[string]
+	Identifier     plugin.TValue[string]
+	IdentifierType plugin.TValue[string]
+	Repository     plugin.TValue[*mqlContainerRepository]
 }
 
-// createModprobeOption creates a new instance of this resource
-func createModprobeOption(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeOption{
+// createContainerImage creates a new instance of this resource
+func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerImage{
 		MqlRuntime: runtime,
 	}
 
@@ -18163,7 +22832,7 @@ func createModprobeOption(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.option", res.__id)
+		args, err = runtime.ResourceFromRecording("container.image", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18173,50 +22842,60 @@ func createModprobeOption(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlModprobeOption) MqlName() string {
-	return "modprobe.option"
+func (c *mqlContainerImage) MqlName() string {
+	return "container.image"
 }
 
-func (c *mqlModprobeOption) MqlID() string {
+func (c *mqlContainerImage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeOption) GetFile() *plugin.TValue[string] {
-	return &c.File
+func (c *mqlContainerImage) GetReference() *plugin.TValue[string] {
+	return &c.Reference
 }
 
-func (c *mqlModprobeOption) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlContainerImage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlModprobeOption) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlContainerImage) GetIdentifier() *plugin.TValue[string] {
+	return &c.Identifier
 }
 
-func (c *mqlModprobeOption) GetParameters() *plugin.TValue[string] {
-	return &c.Parameters
+func (c *mqlContainerImage) GetIdentifierType() *plugin.TValue[string] {
+	return &c.IdentifierType
 }
 
-func (c *mqlModprobeOption) GetParams() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Params, func() (map[string]any, error) {
-		return c.params()
+func (c *mqlContainerImage) GetRepository() *plugin.TValue[*mqlContainerRepository] {
+	return plugin.GetOrCompute[*mqlContainerRepository](&c.Repository, func() (*mqlContainerRepository, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("container.image", c.__id, "repository")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlContainerRepository), nil
+			}
+		}
+
+		return c.repository()
 	})
 }
 
-// mqlModprobeAlias for the modprobe.alias resource
-type mqlModprobeAlias struct {
+// mqlContainerRepository for the container.repository resource
+type mqlContainerRepository struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeAliasInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Alias      plugin.TValue[string]
-	Module     plugin.TValue[string]
+	// optional: if you define mqlContainerRepositoryInternal it will be used here
+	Name     plugin.TValue[string]
+	Scheme   plugin.TValue[string]
+	FullName plugin.TValue[string]
+	Registry plugin.TValue[string]
 }
 
-// createModprobeAlias creates a new instance of this resource
-func createModprobeAlias(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeAlias{
+// createContainerRepository creates a new instance of this resource
+func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlContainerRepository{
 		MqlRuntime: runtime,
 	}
 
@@ -18233,7 +22912,7 @@ func createModprobeAlias(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.alias", res.__id)
+		args, err = runtime.ResourceFromRecording("container.repository", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18243,45 +22922,43 @@ func createModprobeAlias(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlModprobeAlias) MqlName() string {
-	return "modprobe.alias"
+func (c *mqlContainerRepository) MqlName() string {
+	return "container.repository"
 }
 
-func (c *mqlModprobeAlias) MqlID() string {
+func (c *mqlContainerRepository) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeAlias) GetFile() *plugin.TValue[string] {
-	return &c.File
+func (c *mqlContainerRepository) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlModprobeAlias) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
+func (c *mqlContainerRepository) GetScheme() *plugin.TValue[string] {
+	return &c.Scheme
 }
 
-func (c *mqlModprobeAlias) GetAlias() *plugin.TValue[string] {
-	return &c.Alias
+func (c *mqlContainerRepository) GetFullName() *plugin.TValue[string] {
+	return &c.FullName
 }
 
-func (c *mqlModprobeAlias) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlContainerRepository) GetRegistry() *plugin.TValue[string] {
+	return &c.Registry
 }
 
-// mqlModprobeSoftdep for the modprobe.softdep resource
-type mqlModprobeSoftdep struct {
+// mqlKubelet for the kubelet resource
+type mqlKubelet struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlModprobeSoftdepInternal it will be used here
-	File       plugin.TValue[string]
-	LineNumber plugin.TValue[int64]
-	Module     plugin.TValue[string]
-	Pre        plugin.TValue[[]any]
-	Post       plugin.TValue[[]any]
+	// optional: if you define mqlKubeletInternal it will be used here
+	ConfigFile    plugin.TValue[*mqlFile]
+	Process       plugin.TValue[*mqlProcess]
+	Configuration plugin.TValue[any]
 }
 
-// createModprobeSoftdep creates a new instance of this resource
-func createModprobeSoftdep(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlModprobeSoftdep{
+// createKubelet creates a new instance of this resource
+func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlKubelet{
 		MqlRuntime: runtime,
 	}
 
@@ -18290,15 +22967,10 @@ func createModprobeSoftdep(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	if res.__id == "" {
-		res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("modprobe.softdep", res.__id)
+		args, err = runtime.ResourceFromRecording("kubelet", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18308,45 +22980,41 @@ func createModprobeSoftdep(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlModprobeSoftdep) MqlName() string {
-	return "modprobe.softdep"
+func (c *mqlKubelet) MqlName() string {
+	return "kubelet"
 }
 
-func (c *mqlModprobeSoftdep) MqlID() string {
+func (c *mqlKubelet) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlModprobeSoftdep) GetFile() *plugin.TValue[string] {
-	return &c.File
-}
-
-func (c *mqlModprobeSoftdep) GetLineNumber() *plugin.TValue[int64] {
-	return &c.LineNumber
-}
-
-func (c *mqlModprobeSoftdep) GetModule() *plugin.TValue[string] {
-	return &c.Module
+func (c *mqlKubelet) GetConfigFile() *plugin.TValue[*mqlFile] {
+	return &c.ConfigFile
 }
 
-func (c *mqlModprobeSoftdep) GetPre() *plugin.TValue[[]any] {
-	return &c.Pre
+func (c *mqlKubelet) GetProcess() *plugin.TValue[*mqlProcess] {
+	return &c.Process
 }
 
-func (c *mqlModprobeSoftdep) GetPost() *plugin.TValue[[]any] {
-	return &c.Post
+func (c *mqlKubelet) GetConfiguration() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.Configuration, func() (any, error) {
+		return c.configuration()
+	})
 }
 
-// mqlMount for the mount resource
-type mqlMount struct {
+// mqlPython for the python resource
+type mqlPython struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlMountInternal it will be used here
-	List plugin.TValue[[]any]
+	// optional: if you define mqlPythonInternal it will be used here
+	Path     plugin.TValue[string]
+	Packages plugin.TValue[[]any]
+	Toplevel plugin.TValue[[]any]
 }
 
-// createMount creates a new instance of this resource
-func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMount{
+// createPython creates a new instance of this resource
+func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPython{
 		MqlRuntime: runtime,
 	}
 
@@ -18363,7 +23031,7 @@ func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mount", res.__id)
+		args, err = runtime.ResourceFromRecording("python", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18373,18 +23041,22 @@ func createMount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.
 	return res, nil
 }
 
-func (c *mqlMount) MqlName() string {
-	return "mount"
+func (c *mqlPython) MqlName() string {
+	return "python"
 }
 
-func (c *mqlMount) MqlID() string {
+func (c *mqlPython) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMount) GetList() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
+func (c *mqlPython) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlPython) GetPackages() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Packages, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("mount", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "packages")
 			if err != nil {
 				return nil, err
 			}
@@ -18393,25 +23065,49 @@ func (c *mqlMount) GetList() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.list()
+		return c.packages()
 	})
 }
 
-// mqlMountPoint for the mount.point resource
-type mqlMountPoint struct {
+func (c *mqlPython) GetToplevel() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Toplevel, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "toplevel")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.toplevel()
+	})
+}
+
+// mqlPythonPackage for the python.package resource
+type mqlPythonPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlMountPointInternal it will be used here
-	Device  plugin.TValue[string]
-	Path    plugin.TValue[string]
-	Fstype  plugin.TValue[string]
-	Options plugin.TValue[map[string]any]
-	Mounted plugin.TValue[bool]
+	mqlPythonPackageInternal
+	Id             plugin.TValue[string]
+	Name           plugin.TValue[string]
+	File           plugin.TValue[*mqlFile]
+	Version        plugin.TValue[string]
+	License        plugin.TValue[string]
+	Author         plugin.TValue[string]
+	AuthorEmail    plugin.TValue[string]
+	Summary        plugin.TValue[string]
+	RequiresPython plugin.TValue[string]
+	ProjectUrls    plugin.TValue[map[string]any]
+	Purl           plugin.TValue[string]
+	Cpes           plugin.TValue[[]any]
+	Dependencies   plugin.TValue[[]any]
 }
 
-// createMountPoint creates a new instance of this resource
-func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlMountPoint{
+// createPythonPackage creates a new instance of this resource
+func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPythonPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -18428,7 +23124,7 @@ func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("mount.point", res.__id)
+		args, err = runtime.ResourceFromRecording("python.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18438,45 +23134,95 @@ func createMountPoint(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlMountPoint) MqlName() string {
-	return "mount.point"
+func (c *mqlPythonPackage) MqlName() string {
+	return "python.package"
 }
 
-func (c *mqlMountPoint) MqlID() string {
+func (c *mqlPythonPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlMountPoint) GetDevice() *plugin.TValue[string] {
-	return &c.Device
+func (c *mqlPythonPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlMountPoint) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlPythonPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlMountPoint) GetFstype() *plugin.TValue[string] {
-	return &c.Fstype
+func (c *mqlPythonPackage) GetFile() *plugin.TValue[*mqlFile] {
+	return &c.File
 }
 
-func (c *mqlMountPoint) GetOptions() *plugin.TValue[map[string]any] {
-	return &c.Options
+func (c *mqlPythonPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlPythonPackage) GetLicense() *plugin.TValue[string] {
+	return &c.License
+}
+
+func (c *mqlPythonPackage) GetAuthor() *plugin.TValue[string] {
+	return &c.Author
+}
+
+func (c *mqlPythonPackage) GetAuthorEmail() *plugin.TValue[string] {
+	return &c.AuthorEmail
+}
+
+func (c *mqlPythonPackage) GetSummary() *plugin.TValue[string] {
+	return &c.Summary
+}
+
+func (c *mqlPythonPackage) GetRequiresPython() *plugin.TValue[string] {
+	return &c.RequiresPython
+}
+
+func (c *mqlPythonPackage) GetProjectUrls() *plugin.TValue[map[string]any] {
+	return &c.ProjectUrls
+}
+
+func (c *mqlPythonPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
+}
+
+func (c *mqlPythonPackage) GetCpes() *plugin.TValue[[]any] {
+	return &c.Cpes
 }
 
-func (c *mqlMountPoint) GetMounted() *plugin.TValue[bool] {
-	return &c.Mounted
+func (c *mqlPythonPackage) GetDependencies() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Dependencies, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "dependencies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.dependencies()
+	})
 }
 
-// mqlShadow for the shadow resource
-type mqlShadow struct {
+// mqlNpmPackages for the npm.packages resource
+type mqlNpmPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlShadowInternal it will be used here
-	List plugin.TValue[[]any]
+	mqlNpmPackagesInternal
+	Path               plugin.TValue[string]
+	Paths              plugin.TValue[[]any]
+	Root               plugin.TValue[*mqlNpmPackage]
+	DirectDependencies plugin.TValue[[]any]
+	Files              plugin.TValue[[]any]
+	Scripts            plugin.TValue[map[string]any]
+	List               plugin.TValue[[]any]
 }
 
-// createShadow creates a new instance of this resource
-func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlShadow{
+// createNpmPackages creates a new instance of this resource
+func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -18493,7 +23239,7 @@ func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("shadow", res.__id)
+		args, err = runtime.ResourceFromRecording("npm.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18503,18 +23249,82 @@ func createShadow(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlShadow) MqlName() string {
-	return "shadow"
+func (c *mqlNpmPackages) MqlName() string {
+	return "npm.packages"
 }
 
-func (c *mqlShadow) MqlID() string {
+func (c *mqlNpmPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlShadow) GetList() *plugin.TValue[[]any] {
+func (c *mqlNpmPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlNpmPackages) GetPaths() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Paths, func() ([]any, error) {
+		return c.paths()
+	})
+}
+
+func (c *mqlNpmPackages) GetRoot() *plugin.TValue[*mqlNpmPackage] {
+	return plugin.GetOrCompute[*mqlNpmPackage](&c.Root, func() (*mqlNpmPackage, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "root")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlNpmPackage), nil
+			}
+		}
+
+		return c.root()
+	})
+}
+
+func (c *mqlNpmPackages) GetDirectDependencies() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.DirectDependencies, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "directDependencies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.directDependencies()
+	})
+}
+
+func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.files()
+	})
+}
+
+func (c *mqlNpmPackages) GetScripts() *plugin.TValue[map[string]any] {
+	return plugin.GetOrCompute[map[string]any](&c.Scripts, func() (map[string]any, error) {
+		return c.scripts()
+	})
+}
+
+func (c *mqlNpmPackages) GetList() *plugin.TValue[[]any] {
 	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("shadow", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -18527,25 +23337,22 @@ func (c *mqlShadow) GetList() *plugin.TValue[[]any] {
 	})
 }
 
-// mqlShadowEntry for the shadow.entry resource
-type mqlShadowEntry struct {
+// mqlNpmPackage for the npm.package resource
+type mqlNpmPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlShadowEntryInternal it will be used here
-	User         plugin.TValue[string]
-	Password     plugin.TValue[string]
-	Lastchanged  plugin.TValue[*time.Time]
-	Mindays      plugin.TValue[int64]
-	Maxdays      plugin.TValue[int64]
-	Warndays     plugin.TValue[int64]
-	Inactivedays plugin.TValue[int64]
-	Expirydates  plugin.TValue[string]
-	Reserved     plugin.TValue[string]
+	// optional: if you define mqlNpmPackageInternal it will be used here
+	Id      plugin.TValue[string]
+	Name    plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl    plugin.TValue[string]
+	Cpes    plugin.TValue[[]any]
+	Files   plugin.TValue[[]any]
 }
 
-// createShadowEntry creates a new instance of this resource
-func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlShadowEntry{
+// createNpmPackage creates a new instance of this resource
+func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlNpmPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -18562,7 +23369,7 @@ func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("shadow.entry", res.__id)
+		args, err = runtime.ResourceFromRecording("npm.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18572,62 +23379,81 @@ func createShadowEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlShadowEntry) MqlName() string {
-	return "shadow.entry"
+func (c *mqlNpmPackage) MqlName() string {
+	return "npm.package"
 }
 
-func (c *mqlShadowEntry) MqlID() string {
+func (c *mqlNpmPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlShadowEntry) GetUser() *plugin.TValue[string] {
-	return &c.User
-}
-
-func (c *mqlShadowEntry) GetPassword() *plugin.TValue[string] {
-	return &c.Password
+func (c *mqlNpmPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlShadowEntry) GetLastchanged() *plugin.TValue[*time.Time] {
-	return &c.Lastchanged
+func (c *mqlNpmPackage) GetName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
+		return c.name()
+	})
 }
 
-func (c *mqlShadowEntry) GetMindays() *plugin.TValue[int64] {
-	return &c.Mindays
+func (c *mqlNpmPackage) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
 }
 
-func (c *mqlShadowEntry) GetMaxdays() *plugin.TValue[int64] {
-	return &c.Maxdays
+func (c *mqlNpmPackage) GetPurl() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
+		return c.purl()
+	})
 }
 
-func (c *mqlShadowEntry) GetWarndays() *plugin.TValue[int64] {
-	return &c.Warndays
-}
+func (c *mqlNpmPackage) GetCpes() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Cpes, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "cpes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlShadowEntry) GetInactivedays() *plugin.TValue[int64] {
-	return &c.Inactivedays
+		return c.cpes()
+	})
 }
 
-func (c *mqlShadowEntry) GetExpirydates() *plugin.TValue[string] {
-	return &c.Expirydates
-}
+func (c *mqlNpmPackage) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlShadowEntry) GetReserved() *plugin.TValue[string] {
-	return &c.Reserved
+		return c.files()
+	})
 }
 
-// mqlYum for the yum resource
-type mqlYum struct {
+// mqlJavaPackages for the java.packages resource
+type mqlJavaPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlYumInternal it will be used here
-	Vars  plugin.TValue[map[string]any]
-	Repos plugin.TValue[[]any]
+	mqlJavaPackagesInternal
+	Path  plugin.TValue[string]
+	Files plugin.TValue[[]any]
+	List  plugin.TValue[[]any]
 }
 
-// createYum creates a new instance of this resource
-func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlYum{
+// createJavaPackages creates a new instance of this resource
+func createJavaPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -18644,7 +23470,7 @@ func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("yum", res.__id)
+		args, err = runtime.ResourceFromRecording("java.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18654,24 +23480,38 @@ func createYum(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlYum) MqlName() string {
-	return "yum"
+func (c *mqlJavaPackages) MqlName() string {
+	return "java.packages"
 }
 
-func (c *mqlYum) MqlID() string {
+func (c *mqlJavaPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlYum) GetVars() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Vars, func() (map[string]any, error) {
-		return c.vars()
+func (c *mqlJavaPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlJavaPackages) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("java.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.files()
 	})
 }
 
-func (c *mqlYum) GetRepos() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Repos, func() ([]any, error) {
+func (c *mqlJavaPackages) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("yum", c.__id, "repos")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("java.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -18680,31 +23520,27 @@ func (c *mqlYum) GetRepos() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.repos()
+		return c.list()
 	})
 }
 
-// mqlYumRepo for the yum.repo resource
-type mqlYumRepo struct {
+// mqlJavaPackage for the java.package resource
+type mqlJavaPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlYumRepoInternal it will be used here
-	Id       plugin.TValue[string]
-	Name     plugin.TValue[string]
-	Status   plugin.TValue[string]
-	Baseurl  plugin.TValue[[]any]
-	Expire   plugin.TValue[string]
-	File     plugin.TValue[*mqlFile]
-	Revision plugin.TValue[string]
-	Pkgs     plugin.TValue[string]
-	Size     plugin.TValue[string]
-	Mirrors  plugin.TValue[string]
-	Enabled  plugin.TValue[bool]
+	// optional: if you define mqlJavaPackageInternal it will be used here
+	Id      plugin.TValue[string]
+	GroupId plugin.TValue[string]
+	Name    plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl    plugin.TValue[string]
+	Cpes    plugin.TValue[[]any]
+	Files   plugin.TValue[[]any]
 }
 
-// createYumRepo creates a new instance of this resource
-func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlYumRepo{
+// createJavaPackage creates a new instance of this resource
+func createJavaPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJavaPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -18721,7 +23557,7 @@ func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("yum.repo", res.__id)
+		args, err = runtime.ResourceFromRecording("java.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18731,75 +23567,55 @@ func createYumRepo(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlYumRepo) MqlName() string {
-	return "yum.repo"
+func (c *mqlJavaPackage) MqlName() string {
+	return "java.package"
 }
 
-func (c *mqlYumRepo) MqlID() string {
+func (c *mqlJavaPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlYumRepo) GetId() *plugin.TValue[string] {
+func (c *mqlJavaPackage) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlYumRepo) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlYumRepo) GetStatus() *plugin.TValue[string] {
-	return &c.Status
-}
-
-func (c *mqlYumRepo) GetBaseurl() *plugin.TValue[[]any] {
-	return &c.Baseurl
-}
-
-func (c *mqlYumRepo) GetExpire() *plugin.TValue[string] {
-	return &c.Expire
-}
-
-func (c *mqlYumRepo) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlJavaPackage) GetGroupId() *plugin.TValue[string] {
+	return &c.GroupId
 }
 
-func (c *mqlYumRepo) GetRevision() *plugin.TValue[string] {
-	return &c.Revision
+func (c *mqlJavaPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlYumRepo) GetPkgs() *plugin.TValue[string] {
-	return &c.Pkgs
+func (c *mqlJavaPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlYumRepo) GetSize() *plugin.TValue[string] {
-	return &c.Size
+func (c *mqlJavaPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlYumRepo) GetMirrors() *plugin.TValue[string] {
-	return &c.Mirrors
+func (c *mqlJavaPackage) GetCpes() *plugin.TValue[[]any] {
+	return &c.Cpes
 }
 
-func (c *mqlYumRepo) GetEnabled() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Enabled, func() (bool, error) {
-		return c.enabled()
-	})
+func (c *mqlJavaPackage) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
 }
 
-// mqlRegistrykey for the registrykey resource
-type mqlRegistrykey struct {
+// mqlGoModules for the go.modules resource
+type mqlGoModules struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlRegistrykeyInternal it will be used here
-	Path       plugin.TValue[string]
-	Exists     plugin.TValue[bool]
-	Properties plugin.TValue[map[string]any]
-	Items      plugin.TValue[[]any]
-	Children   plugin.TValue[[]any]
+	mqlGoModulesInternal
+	Path  plugin.TValue[string]
+	Files plugin.TValue[[]any]
+	List  plugin.TValue[[]any]
 }
 
-// createRegistrykey creates a new instance of this resource
-func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRegistrykey{
+// createGoModules creates a new instance of this resource
+func createGoModules(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGoModules{
 		MqlRuntime: runtime,
 	}
 
@@ -18816,7 +23632,7 @@ func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("registrykey", res.__id)
+		args, err = runtime.ResourceFromRecording("go.modules", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18826,34 +23642,38 @@ func createRegistrykey(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlRegistrykey) MqlName() string {
-	return "registrykey"
+func (c *mqlGoModules) MqlName() string {
+	return "go.modules"
 }
 
-func (c *mqlRegistrykey) MqlID() string {
+func (c *mqlGoModules) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRegistrykey) GetPath() *plugin.TValue[string] {
+func (c *mqlGoModules) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlRegistrykey) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		return c.exists()
-	})
-}
+func (c *mqlGoModules) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("go.modules", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlRegistrykey) GetProperties() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Properties, func() (map[string]any, error) {
-		return c.properties()
+		return c.files()
 	})
 }
 
-func (c *mqlRegistrykey) GetItems() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Items, func() ([]any, error) {
+func (c *mqlGoModules) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("registrykey", c.__id, "items")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("go.modules", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -18862,32 +23682,26 @@ func (c *mqlRegistrykey) GetItems() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.items()
-	})
-}
-
-func (c *mqlRegistrykey) GetChildren() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Children, func() ([]any, error) {
-		return c.children()
+		return c.list()
 	})
 }
 
-// mqlRegistrykeyProperty for the registrykey.property resource
-type mqlRegistrykeyProperty struct {
+// mqlGoModule for the go.module resource
+type mqlGoModule struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlRegistrykeyPropertyInternal it will be used here
-	Path   plugin.TValue[string]
-	Name   plugin.TValue[string]
-	Exists plugin.TValue[bool]
-	Value  plugin.TValue[string]
-	Type   plugin.TValue[string]
-	Data   plugin.TValue[any]
+	// optional: if you define mqlGoModuleInternal it will be used here
+	Id      plugin.TValue[string]
+	Name    plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl    plugin.TValue[string]
+	Cpes    plugin.TValue[[]any]
+	Files   plugin.TValue[[]any]
 }
 
-// createRegistrykeyProperty creates a new instance of this resource
-func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlRegistrykeyProperty{
+// createGoModule creates a new instance of this resource
+func createGoModule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlGoModule{
 		MqlRuntime: runtime,
 	}
 
@@ -18904,7 +23718,7 @@ func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("registrykey.property", res.__id)
+		args, err = runtime.ResourceFromRecording("go.module", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18914,61 +23728,51 @@ func createRegistrykeyProperty(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlRegistrykeyProperty) MqlName() string {
-	return "registrykey.property"
+func (c *mqlGoModule) MqlName() string {
+	return "go.module"
 }
 
-func (c *mqlRegistrykeyProperty) MqlID() string {
+func (c *mqlGoModule) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlRegistrykeyProperty) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlGoModule) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlRegistrykeyProperty) GetName() *plugin.TValue[string] {
+func (c *mqlGoModule) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlRegistrykeyProperty) GetExists() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
-		return c.exists()
-	})
+func (c *mqlGoModule) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlRegistrykeyProperty) GetValue() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Value, func() (string, error) {
-		return c.value()
-	})
+func (c *mqlGoModule) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlRegistrykeyProperty) GetType() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
-		return c.compute_type()
-	})
+func (c *mqlGoModule) GetCpes() *plugin.TValue[[]any] {
+	return &c.Cpes
 }
 
-func (c *mqlRegistrykeyProperty) GetData() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.Data, func() (any, error) {
-		return c.data()
-	})
+func (c *mqlGoModule) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
 }
 
-// mqlContainerImage for the container.image resource
-type mqlContainerImage struct {
+// mqlRustPackages for the rust.packages resource
+type mqlRustPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlContainerImageInternal it will be used here
-	Reference      plugin.TValue[string]
-	Name           plugin.TValue[string]
-	Identifier     plugin.TValue[string]
-	IdentifierType plugin.TValue[string]
-	Repository     plugin.TValue[*mqlContainerRepository]
+	mqlRustPackagesInternal
+	Path  plugin.TValue[string]
+	Files plugin.TValue[[]any]
+	List  plugin.TValue[[]any]
 }
 
-// createContainerImage creates a new instance of this resource
-func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerImage{
+// createRustPackages creates a new instance of this resource
+func createRustPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRustPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -18985,7 +23789,7 @@ func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("container.image", res.__id)
+		args, err = runtime.ResourceFromRecording("rust.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -18995,60 +23799,66 @@ func createContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlContainerImage) MqlName() string {
-	return "container.image"
+func (c *mqlRustPackages) MqlName() string {
+	return "rust.packages"
 }
 
-func (c *mqlContainerImage) MqlID() string {
+func (c *mqlRustPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerImage) GetReference() *plugin.TValue[string] {
-	return &c.Reference
-}
-
-func (c *mqlContainerImage) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlRustPackages) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlContainerImage) GetIdentifier() *plugin.TValue[string] {
-	return &c.Identifier
-}
+func (c *mqlRustPackages) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rust.packages", c.__id, "files")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
 
-func (c *mqlContainerImage) GetIdentifierType() *plugin.TValue[string] {
-	return &c.IdentifierType
+		return c.files()
+	})
 }
 
-func (c *mqlContainerImage) GetRepository() *plugin.TValue[*mqlContainerRepository] {
-	return plugin.GetOrCompute[*mqlContainerRepository](&c.Repository, func() (*mqlContainerRepository, error) {
+func (c *mqlRustPackages) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("container.image", c.__id, "repository")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("rust.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlContainerRepository), nil
+				return d.Value.([]any), nil
 			}
 		}
 
-		return c.repository()
+		return c.list()
 	})
 }
 
-// mqlContainerRepository for the container.repository resource
-type mqlContainerRepository struct {
+// mqlRustPackage for the rust.package resource
+type mqlRustPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlContainerRepositoryInternal it will be used here
-	Name     plugin.TValue[string]
-	Scheme   plugin.TValue[string]
-	FullName plugin.TValue[string]
-	Registry plugin.TValue[string]
+	// optional: if you define mqlRustPackageInternal it will be used here
+	Id      plugin.TValue[string]
+	Name    plugin.TValue[string]
+	Version plugin.TValue[string]
+	Purl    plugin.TValue[string]
+	Cpes    plugin.TValue[[]any]
+	Files   plugin.TValue[[]any]
 }
 
-// createContainerRepository creates a new instance of this resource
-func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlContainerRepository{
+// createRustPackage creates a new instance of this resource
+func createRustPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRustPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -19065,7 +23875,7 @@ func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("container.repository", res.__id)
+		args, err = runtime.ResourceFromRecording("rust.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -19075,99 +23885,51 @@ func createContainerRepository(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlContainerRepository) MqlName() string {
-	return "container.repository"
+func (c *mqlRustPackage) MqlName() string {
+	return "rust.package"
 }
 
-func (c *mqlContainerRepository) MqlID() string {
+func (c *mqlRustPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlContainerRepository) GetName() *plugin.TValue[string] {
-	return &c.Name
-}
-
-func (c *mqlContainerRepository) GetScheme() *plugin.TValue[string] {
-	return &c.Scheme
-}
-
-func (c *mqlContainerRepository) GetFullName() *plugin.TValue[string] {
-	return &c.FullName
-}
-
-func (c *mqlContainerRepository) GetRegistry() *plugin.TValue[string] {
-	return &c.Registry
-}
-
-// mqlKubelet for the kubelet resource
-type mqlKubelet struct {
-	MqlRuntime *plugin.Runtime
-	__id       string
-	// optional: if you define mqlKubeletInternal it will be used here
-	ConfigFile    plugin.TValue[*mqlFile]
-	Process       plugin.TValue[*mqlProcess]
-	Configuration plugin.TValue[any]
-}
-
-// createKubelet creates a new instance of this resource
-func createKubelet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlKubelet{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	// to override __id implement: id() (string, error)
-
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("kubelet", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
-
-	return res, nil
+func (c *mqlRustPackage) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlKubelet) MqlName() string {
-	return "kubelet"
+func (c *mqlRustPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlKubelet) MqlID() string {
-	return c.__id
+func (c *mqlRustPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlKubelet) GetConfigFile() *plugin.TValue[*mqlFile] {
-	return &c.ConfigFile
+func (c *mqlRustPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlKubelet) GetProcess() *plugin.TValue[*mqlProcess] {
-	return &c.Process
+func (c *mqlRustPackage) GetCpes() *plugin.TValue[[]any] {
+	return &c.Cpes
 }
 
-func (c *mqlKubelet) GetConfiguration() *plugin.TValue[any] {
-	return plugin.GetOrCompute[any](&c.Configuration, func() (any, error) {
-		return c.configuration()
-	})
+func (c *mqlRustPackage) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
 }
 
-// mqlPython for the python resource
-type mqlPython struct {
+// mqlRubyPackages for the ruby.packages resource
+type mqlRubyPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlPythonInternal it will be used here
-	Path     plugin.TValue[string]
-	Packages plugin.TValue[[]any]
-	Toplevel plugin.TValue[[]any]
+	mqlRubyPackagesInternal
+	Path  plugin.TValue[string]
+	Files plugin.TValue[[]any]
+	List  plugin.TValue[[]any]
 }
 
-// createPython creates a new instance of this resource
-func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPython{
+// createRubyPackages creates a new instance of this resource
+func createRubyPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -19184,7 +23946,7 @@ func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("python", res.__id)
+		args, err = runtime.ResourceFromRecording("ruby.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -19194,22 +23956,22 @@ func createPython(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlPython) MqlName() string {
-	return "python"
+func (c *mqlRubyPackages) MqlName() string {
+	return "ruby.packages"
 }
 
-func (c *mqlPython) MqlID() string {
+func (c *mqlRubyPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPython) GetPath() *plugin.TValue[string] {
+func (c *mqlRubyPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlPython) GetPackages() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Packages, func() ([]any, error) {
+func (c *mqlRubyPackages) GetFiles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "packages")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ruby.packages", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -19218,14 +23980,14 @@ func (c *mqlPython) GetPackages() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.packages()
+		return c.files()
 	})
 }
 
-func (c *mqlPython) GetToplevel() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Toplevel, func() ([]any, error) {
+func (c *mqlRubyPackages) GetList() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python", c.__id, "toplevel")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ruby.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -19234,33 +23996,27 @@ func (c *mqlPython) GetToplevel() *plugin.TValue[[]any] {
 			}
 		}
 
-		return c.toplevel()
+		return c.list()
 	})
 }
 
-// mqlPythonPackage for the python.package resource
-type mqlPythonPackage struct {
+// mqlRubyPackage for the ruby.package resource
+type mqlRubyPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlPythonPackageInternal
-	Id             plugin.TValue[string]
-	Name           plugin.TValue[string]
-	File           plugin.TValue[*mqlFile]
-	Version        plugin.TValue[string]
-	License        plugin.TValue[string]
-	Author         plugin.TValue[string]
-	AuthorEmail    plugin.TValue[string]
-	Summary        plugin.TValue[string]
-	RequiresPython plugin.TValue[string]
-	ProjectUrls    plugin.TValue[map[string]any]
-	Purl           plugin.TValue[string]
-	Cpes           plugin.TValue[[]any]
-	Dependencies   plugin.TValue[[]any]
+	// optional: if you define mqlRubyPackageInternal it will be used here
+	Id       plugin.TValue[string]
+	Name     plugin.TValue[string]
+	Platform plugin.TValue[string]
+	Version  plugin.TValue[string]
+	Purl     plugin.TValue[string]
+	Cpes     plugin.TValue[[]any]
+	Files    plugin.TValue[[]any]
 }
 
-// createPythonPackage creates a new instance of this resource
-func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPythonPackage{
+// createRubyPackage creates a new instance of this resource
+func createRubyPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRubyPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -19277,7 +24033,7 @@ func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("python.package", res.__id)
+		args, err = runtime.ResourceFromRecording("ruby.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -19287,95 +24043,55 @@ func createPythonPackage(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlPythonPackage) MqlName() string {
-	return "python.package"
+func (c *mqlRubyPackage) MqlName() string {
+	return "ruby.package"
 }
 
-func (c *mqlPythonPackage) MqlID() string {
+func (c *mqlRubyPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPythonPackage) GetId() *plugin.TValue[string] {
+func (c *mqlRubyPackage) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlPythonPackage) GetName() *plugin.TValue[string] {
+func (c *mqlRubyPackage) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlPythonPackage) GetFile() *plugin.TValue[*mqlFile] {
-	return &c.File
+func (c *mqlRubyPackage) GetPlatform() *plugin.TValue[string] {
+	return &c.Platform
 }
 
-func (c *mqlPythonPackage) GetVersion() *plugin.TValue[string] {
+func (c *mqlRubyPackage) GetVersion() *plugin.TValue[string] {
 	return &c.Version
 }
 
-func (c *mqlPythonPackage) GetLicense() *plugin.TValue[string] {
-	return &c.License
-}
-
-func (c *mqlPythonPackage) GetAuthor() *plugin.TValue[string] {
-	return &c.Author
-}
-
-func (c *mqlPythonPackage) GetAuthorEmail() *plugin.TValue[string] {
-	return &c.AuthorEmail
-}
-
-func (c *mqlPythonPackage) GetSummary() *plugin.TValue[string] {
-	return &c.Summary
-}
-
-func (c *mqlPythonPackage) GetRequiresPython() *plugin.TValue[string] {
-	return &c.RequiresPython
-}
-
-func (c *mqlPythonPackage) GetProjectUrls() *plugin.TValue[map[string]any] {
-	return &c.ProjectUrls
-}
-
-func (c *mqlPythonPackage) GetPurl() *plugin.TValue[string] {
+func (c *mqlRubyPackage) GetPurl() *plugin.TValue[string] {
 	return &c.Purl
 }
 
-func (c *mqlPythonPackage) GetCpes() *plugin.TValue[[]any] {
+func (c *mqlRubyPackage) GetCpes() *plugin.TValue[[]any] {
 	return &c.Cpes
 }
 
-func (c *mqlPythonPackage) GetDependencies() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Dependencies, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("python.package", c.__id, "dependencies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.dependencies()
-	})
+func (c *mqlRubyPackage) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
 }
 
-// mqlNpmPackages for the npm.packages resource
-type mqlNpmPackages struct {
+// mqlPhpPackages for the php.packages resource
+type mqlPhpPackages struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	mqlNpmPackagesInternal
-	Path               plugin.TValue[string]
-	Paths              plugin.TValue[[]any]
-	Root               plugin.TValue[*mqlNpmPackage]
-	DirectDependencies plugin.TValue[[]any]
-	Files              plugin.TValue[[]any]
-	Scripts            plugin.TValue[map[string]any]
-	List               plugin.TValue[[]any]
+	mqlPhpPackagesInternal
+	Path  plugin.TValue[string]
+	Files plugin.TValue[[]any]
+	List  plugin.TValue[[]any]
 }
 
-// createNpmPackages creates a new instance of this resource
-func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNpmPackages{
+// createPhpPackages creates a new instance of this resource
+func createPhpPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPhpPackages{
 		MqlRuntime: runtime,
 	}
 
@@ -19392,7 +24108,7 @@ func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("npm.packages", res.__id)
+		args, err = runtime.ResourceFromRecording("php.packages", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -19402,60 +24118,22 @@ func createNpmPackages(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlNpmPackages) MqlName() string {
-	return "npm.packages"
+func (c *mqlPhpPackages) MqlName() string {
+	return "php.packages"
 }
 
-func (c *mqlNpmPackages) MqlID() string {
+func (c *mqlPhpPackages) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNpmPackages) GetPath() *plugin.TValue[string] {
+func (c *mqlPhpPackages) GetPath() *plugin.TValue[string] {
 	return &c.Path
 }
 
-func (c *mqlNpmPackages) GetPaths() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Paths, func() ([]any, error) {
-		return c.paths()
-	})
-}
-
-func (c *mqlNpmPackages) GetRoot() *plugin.TValue[*mqlNpmPackage] {
-	return plugin.GetOrCompute[*mqlNpmPackage](&c.Root, func() (*mqlNpmPackage, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "root")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlNpmPackage), nil
-			}
-		}
-
-		return c.root()
-	})
-}
-
-func (c *mqlNpmPackages) GetDirectDependencies() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.DirectDependencies, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "directDependencies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.directDependencies()
-	})
-}
-
-func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]any] {
+func (c *mqlPhpPackages) GetFiles() *plugin.TValue[[]any] {
 	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "files")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("php.packages", c.__id, "files")
 			if err != nil {
 				return nil, err
 			}
@@ -19468,16 +24146,10 @@ func (c *mqlNpmPackages) GetFiles() *plugin.TValue[[]any] {
 	})
 }
 
-func (c *mqlNpmPackages) GetScripts() *plugin.TValue[map[string]any] {
-	return plugin.GetOrCompute[map[string]any](&c.Scripts, func() (map[string]any, error) {
-		return c.scripts()
-	})
-}
-
-func (c *mqlNpmPackages) GetList() *plugin.TValue[[]any] {
+func (c *mqlPhpPackages) GetList() *plugin.TValue[[]any] {
 	return plugin.GetOrCompute[[]any](&c.List, func() ([]any, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.packages", c.__id, "list")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("php.packages", c.__id, "list")
 			if err != nil {
 				return nil, err
 			}
@@ -19490,22 +24162,23 @@ func (c *mqlNpmPackages) GetList() *plugin.TValue[[]any] {
 	})
 }
 
-// mqlNpmPackage for the npm.package resource
-type mqlNpmPackage struct {
+// mqlPhpPackage for the php.package resource
+type mqlPhpPackage struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
-	// optional: if you define mqlNpmPackageInternal it will be used here
-	Id      plugin.TValue[string]
-	Name    plugin.TValue[string]
-	Version plugin.TValue[string]
-	Purl    plugin.TValue[string]
-	Cpes    plugin.TValue[[]any]
-	Files   plugin.TValue[[]any]
+	// optional: if you define mqlPhpPackageInternal it will be used here
+	Id          plugin.TValue[string]
+	Name        plugin.TValue[string]
+	Description plugin.TValue[string]
+	Version     plugin.TValue[string]
+	Purl        plugin.TValue[string]
+	Cpes        plugin.TValue[[]any]
+	Files       plugin.TValue[[]any]
 }
 
-// createNpmPackage creates a new instance of this resource
-func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlNpmPackage{
+// createPhpPackage creates a new instance of this resource
+func createPhpPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPhpPackage{
 		MqlRuntime: runtime,
 	}
 
@@ -19522,7 +24195,7 @@ func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("npm.package", res.__id)
+		args, err = runtime.ResourceFromRecording("php.package", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -19532,66 +24205,40 @@ func createNpmPackage(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlNpmPackage) MqlName() string {
-	return "npm.package"
+func (c *mqlPhpPackage) MqlName() string {
+	return "php.package"
 }
 
-func (c *mqlNpmPackage) MqlID() string {
+func (c *mqlPhpPackage) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlNpmPackage) GetId() *plugin.TValue[string] {
+func (c *mqlPhpPackage) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlNpmPackage) GetName() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Name, func() (string, error) {
-		return c.name()
-	})
+func (c *mqlPhpPackage) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlNpmPackage) GetVersion() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
-		return c.version()
-	})
+func (c *mqlPhpPackage) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlNpmPackage) GetPurl() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Purl, func() (string, error) {
-		return c.purl()
-	})
+func (c *mqlPhpPackage) GetVersion() *plugin.TValue[string] {
+	return &c.Version
 }
 
-func (c *mqlNpmPackage) GetCpes() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Cpes, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "cpes")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
-
-		return c.cpes()
-	})
+func (c *mqlPhpPackage) GetPurl() *plugin.TValue[string] {
+	return &c.Purl
 }
 
-func (c *mqlNpmPackage) GetFiles() *plugin.TValue[[]any] {
-	return plugin.GetOrCompute[[]any](&c.Files, func() ([]any, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("npm.package", c.__id, "files")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]any), nil
-			}
-		}
+func (c *mqlPhpPackage) GetCpes() *plugin.TValue[[]any] {
+	return &c.Cpes
+}
 
-		return c.files()
-	})
+func (c *mqlPhpPackage) GetFiles() *plugin.TValue[[]any] {
+	return &c.Files
 }
 
 // mqlMacos for the macos resource
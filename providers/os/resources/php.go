@@ -0,0 +1,66 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/php/composerlock"
+)
+
+var phpPackageScanner = &languagePackageScanner{
+	resource:     "php.package",
+	defaultPaths: defaultProjectSearchPaths,
+	extractor: func(p string, fi os.FileInfo) languages.Extractor {
+		if fi.Name() == "composer.lock" || strings.HasSuffix(p, "/vendor/composer/installed.json") {
+			return &composerlock.Extractor{}
+		}
+		return nil
+	},
+}
+
+func initPhpPackages(_ *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initLanguagePackages("php.packages", args)
+}
+
+type mqlPhpPackagesInternal struct {
+	lock sync.Mutex
+}
+
+func (r *mqlPhpPackages) id() (string, error) {
+	return languagePackagesId("php.packages", r.Path.Data), nil
+}
+
+func (r *mqlPhpPackages) gatherData() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.List.IsSet() {
+		return nil
+	}
+
+	pkgs, files, err := phpPackageScanner.scan(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]any]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlPhpPackages) list() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlPhpPackages) files() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlPhpPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/ruby/gemfilelock"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/ruby/gemspec"
+)
+
+var rubyPackageScanner = &languagePackageScanner{
+	resource: "ruby.package",
+	defaultPaths: append([]languageSearchPath{
+		// specifications of installed gems
+		{pattern: "/usr/lib/ruby/gems/*/specifications", maxDepth: 1},
+		{pattern: "/usr/lib64/ruby/gems/*/specifications", maxDepth: 1},
+		{pattern: "/usr/local/lib/ruby/gems/*/specifications", maxDepth: 1},
+		{pattern: "/var/lib/gems/*/specifications", maxDepth: 1},
+		{pattern: "/usr/share/gems/specifications", maxDepth: 1},
+		{pattern: "/usr/local/bundle/specifications", maxDepth: 1},
+	}, defaultProjectSearchPaths...),
+	extractor: func(p string, fi os.FileInfo) languages.Extractor {
+		switch {
+		case fi.Name() == "Gemfile.lock":
+			return &gemfilelock.Extractor{}
+		case strings.HasSuffix(fi.Name(), ".gemspec") && strings.Contains(p, "/specifications/"):
+			return &gemspec.Extractor{}
+		}
+		return nil
+	},
+}
+
+func initRubyPackages(_ *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initLanguagePackages("ruby.packages", args)
+}
+
+type mqlRubyPackagesInternal struct {
+	lock sync.Mutex
+}
+
+func (r *mqlRubyPackages) id() (string, error) {
+	return languagePackagesId("ruby.packages", r.Path.Data), nil
+}
+
+func (r *mqlRubyPackages) gatherData() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.List.IsSet() {
+		return nil
+	}
+
+	pkgs, files, err := rubyPackageScanner.scan(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]any]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlRubyPackages) list() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRubyPackages) files() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRubyPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
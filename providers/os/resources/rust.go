@@ -0,0 +1,65 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"sync"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages"
+	"go.mondoo.com/mql/v13/providers/os/resources/languages/rust/cargolock"
+)
+
+var rustPackageScanner = &languagePackageScanner{
+	resource:     "rust.package",
+	defaultPaths: defaultProjectSearchPaths,
+	extractor: func(p string, fi os.FileInfo) languages.Extractor {
+		if fi.Name() == "Cargo.lock" {
+			return &cargolock.Extractor{}
+		}
+		return nil
+	},
+}
+
+func initRustPackages(_ *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initLanguagePackages("rust.packages", args)
+}
+
+type mqlRustPackagesInternal struct {
+	lock sync.Mutex
+}
+
+func (r *mqlRustPackages) id() (string, error) {
+	return languagePackagesId("rust.packages", r.Path.Data), nil
+}
+
+func (r *mqlRustPackages) gatherData() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.List.IsSet() {
+		return nil
+	}
+
+	pkgs, files, err := rustPackageScanner.scan(r.MqlRuntime, r.Path.Data)
+	if err != nil {
+		return err
+	}
+	r.List = plugin.TValue[[]any]{Data: pkgs, State: plugin.StateIsSet}
+	r.Files = plugin.TValue[[]any]{Data: files, State: plugin.StateIsSet}
+	return nil
+}
+
+func (r *mqlRustPackages) list() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRustPackages) files() ([]any, error) {
+	return nil, r.gatherData()
+}
+
+func (r *mqlRustPackage) id() (string, error) {
+	return r.Id.Data, nil
+}
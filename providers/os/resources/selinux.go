@@ -0,0 +1,327 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/parsers"
+	"go.mondoo.com/mql/v13/providers/os/resources/selinux"
+)
+
+type mqlSelinuxInternal struct {
+	lock         sync.Mutex
+	configLoaded bool
+	config       map[string]string
+}
+
+func (s *mqlSelinux) id() (string, error) {
+	return "selinux", nil
+}
+
+// loadConfig reads /etc/selinux/config once. A missing config file results
+// in an empty config.
+func (s *mqlSelinux) loadConfig() (map[string]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.configLoaded {
+		return s.config, nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	s.config = map[string]string{}
+	data, err := afs.ReadFile(selinux.ConfigPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		ini := parsers.ParseIni(string(data), "=")
+		if fields, ok := ini.Fields[""].(map[string]any); ok {
+			for k, v := range fields {
+				if str, ok := v.(string); ok {
+					s.config[k] = strings.Trim(str, "\"")
+				}
+			}
+		}
+	}
+
+	s.configLoaded = true
+	return s.config, nil
+}
+
+func (s *mqlSelinux) runtime() (bool, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	return isLiveConnection(conn), nil
+}
+
+// selinuxfsMounted checks if selinuxfs is available, which is the case
+// when SELinux is enabled in the running kernel
+func (s *mqlSelinux) selinuxfsMounted() bool {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+	ok, _ := afs.Exists(selinux.SysfsPath + "/enforce")
+	return ok
+}
+
+func (s *mqlSelinux) enabled(runtime bool) (bool, error) {
+	if runtime {
+		return s.selinuxfsMounted(), nil
+	}
+
+	mode, err := s.configuredMode()
+	if err != nil {
+		return false, err
+	}
+	return mode != "" && mode != "disabled", nil
+}
+
+func (s *mqlSelinux) mode(runtime bool, enabled bool) (string, error) {
+	if !runtime {
+		mode, err := s.configuredMode()
+		if err != nil {
+			return "", err
+		}
+		if mode == "" {
+			return "disabled", nil
+		}
+		return mode, nil
+	}
+
+	if !enabled {
+		return "disabled", nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+	data, err := afs.ReadFile(selinux.SysfsPath + "/enforce")
+	if err != nil {
+		return "", err
+	}
+	return selinux.ParseEnforce(string(data)), nil
+}
+
+func (s *mqlSelinux) configuredMode() (string, error) {
+	cfg, err := s.loadConfig()
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(cfg["SELINUX"]), nil
+}
+
+func (s *mqlSelinux) configMode() (string, error) {
+	return s.configuredMode()
+}
+
+func (s *mqlSelinux) policyType() (string, error) {
+	cfg, err := s.loadConfig()
+	if err != nil {
+		return "", err
+	}
+	return cfg["SELINUXTYPE"], nil
+}
+
+func (s *mqlSelinux) policyVersion(runtime bool, enabled bool, policyType string) (int64, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	if runtime && enabled {
+		data, err := afs.ReadFile(selinux.SysfsPath + "/policyvers")
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	}
+
+	if policyType == "" {
+		s.PolicyVersion = plugin.TValue[int64]{State: plugin.StateIsSet | plugin.StateIsNull}
+		return 0, nil
+	}
+
+	// without a loaded policy, we use the newest binary policy on disk
+	entries, err := afs.ReadDir(selinux.PolicyDir(policyType) + "/policy")
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	version := -1
+	for _, entry := range entries {
+		if v, ok := selinux.PolicyVersion(entry.Name()); ok && v > version {
+			version = v
+		}
+	}
+	if version < 0 {
+		s.PolicyVersion = plugin.TValue[int64]{State: plugin.StateIsSet | plugin.StateIsNull}
+		return 0, nil
+	}
+	return int64(version), nil
+}
+
+func (s *mqlSelinux) booleans(runtime bool, enabled bool, policyType string) ([]any, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	type boolean struct {
+		name           string
+		value, pending bool
+	}
+	var booleans []boolean
+
+	if runtime {
+		if !enabled {
+			return []any{}, nil
+		}
+
+		dir := selinux.SysfsPath + "/booleans"
+		entries, err := afs.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			data, err := afs.ReadFile(dir + "/" + entry.Name())
+			if err != nil {
+				return nil, err
+			}
+			value, pending, err := selinux.ParseSysfsBoolean(string(data))
+			if err != nil {
+				return nil, err
+			}
+			booleans = append(booleans, boolean{name: entry.Name(), value: value, pending: pending})
+		}
+	} else if policyType != "" {
+		data, err := afs.ReadFile(selinux.BooleansLocalPath(policyType))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		for name, value := range selinux.ParseBooleansLocal(string(data)) {
+			booleans = append(booleans, boolean{name: name, value: value, pending: value})
+		}
+	}
+
+	sort.Slice(booleans, func(i, j int) bool {
+		return booleans[i].name < booleans[j].name
+	})
+
+	res := make([]any, len(booleans))
+	for i, b := range booleans {
+		obj, err := CreateResource(s.MqlRuntime, "selinux.boolean", map[string]*llx.RawData{
+			"__id":    llx.StringData("selinux.boolean/" + b.name),
+			"name":    llx.StringData(b.name),
+			"value":   llx.BoolData(b.value),
+			"pending": llx.BoolData(b.pending),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
+
+func (s *mqlSelinux) fileContexts(policyType string) ([]any, error) {
+	if policyType == "" {
+		return []any{}, nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	res := []any{}
+	for _, local := range []bool{false, true} {
+		path := selinux.FileContextsPath(policyType)
+		if local {
+			path += ".local"
+		}
+
+		data, err := afs.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for i, fc := range selinux.ParseFileContexts(string(data)) {
+			obj, err := CreateResource(s.MqlRuntime, "selinux.fileContext", map[string]*llx.RawData{
+				"__id":     llx.StringData(path + "/" + strconv.Itoa(i)),
+				"path":     llx.StringData(fc.Path),
+				"fileType": llx.StringData(fc.FileType),
+				"context":  llx.StringData(fc.Context),
+				"local":    llx.BoolData(local),
+			})
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, obj)
+		}
+	}
+	return res, nil
+}
+
+func (s *mqlSelinux) modules(policyType string) ([]any, error) {
+	if policyType == "" {
+		return []any{}, nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	// The module store has one directory per priority, which contains one
+	// directory per module. Disabled modules are marked with a file in the
+	// `disabled` directory.
+	root := selinux.ModulesPath(policyType)
+	priorities, err := afs.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []any{}, nil
+		}
+		return nil, err
+	}
+
+	disabled := map[string]bool{}
+	if entries, err := afs.ReadDir(path.Join(root, "disabled")); err == nil {
+		for _, entry := range entries {
+			disabled[entry.Name()] = true
+		}
+	}
+
+	res := []any{}
+	for _, p := range priorities {
+		priority, err := strconv.Atoi(p.Name())
+		if err != nil || !p.IsDir() {
+			continue
+		}
+
+		modules, err := afs.ReadDir(path.Join(root, p.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range modules {
+			if !m.IsDir() {
+				continue
+			}
+			obj, err := CreateResource(s.MqlRuntime, "selinux.module", map[string]*llx.RawData{
+				"__id":     llx.StringData("selinux.module/" + p.Name() + "/" + m.Name()),
+				"name":     llx.StringData(m.Name()),
+				"priority": llx.IntData(priority),
+				"enabled":  llx.BoolData(!disabled[m.Name()]),
+			})
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, obj)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selinux
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SysfsPath is the mount point of selinuxfs on a running system
+	SysfsPath = "/sys/fs/selinux"
+	// ConfigPath is the main SELinux configuration file
+	ConfigPath = "/etc/selinux/config"
+	// ModuleStorePath is the root of the policy module store
+	ModuleStorePath = "/var/lib/selinux"
+)
+
+// FileContext is one entry of a file_contexts file.
+type FileContext struct {
+	Path     string // path regular expression
+	FileType string // e.g. "--" or "-d", empty for all files
+	Context  string // e.g. "system_u:object_r:etc_t:s0"
+}
+
+// PolicyDir returns the directory of the given policy type, e.g.
+// /etc/selinux/targeted
+func PolicyDir(policyType string) string {
+	return "/etc/selinux/" + policyType
+}
+
+// FileContextsPath returns the path of the file_contexts file for the given
+// policy type
+func FileContextsPath(policyType string) string {
+	return PolicyDir(policyType) + "/contexts/files/file_contexts"
+}
+
+// BooleansLocalPath returns the path of the locally customized booleans for
+// the given policy type
+func BooleansLocalPath(policyType string) string {
+	return ModuleStorePath + "/" + policyType + "/active/booleans.local"
+}
+
+// ModulesPath returns the path of the active module store for the given
+// policy type
+func ModulesPath(policyType string) string {
+	return ModuleStorePath + "/" + policyType + "/active/modules"
+}
+
+// ParseFileContexts parses the content of a file_contexts file
+func ParseFileContexts(content string) []FileContext {
+	var res []FileContext
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 2:
+			res = append(res, FileContext{Path: fields[0], Context: fields[1]})
+		case 3:
+			res = append(res, FileContext{Path: fields[0], FileType: fields[1], Context: fields[2]})
+		}
+	}
+	return res
+}
+
+// ParseBooleansLocal parses locally customized booleans, which are stored
+// as `name=value` lines
+func ParseBooleansLocal(content string) map[string]bool {
+	res := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		b, err := parseBool(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		res[strings.TrimSpace(name)] = b
+	}
+	return res
+}
+
+// ParseSysfsBoolean parses a boolean from /sys/fs/selinux/booleans, which
+// contains the current and the pending value, e.g. "1 0"
+func ParseSysfsBoolean(content string) (bool, bool, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 2 {
+		return false, false, errors.New("invalid selinux boolean: '" + strings.TrimSpace(content) + "'")
+	}
+	value, err := parseBool(fields[0])
+	if err != nil {
+		return false, false, err
+	}
+	pending, err := parseBool(fields[1])
+	if err != nil {
+		return false, false, err
+	}
+	return value, pending, nil
+}
+
+// ParseEnforce parses /sys/fs/selinux/enforce into a mode
+func ParseEnforce(content string) string {
+	if strings.TrimSpace(content) == "1" {
+		return "enforcing"
+	}
+	return "permissive"
+}
+
+// PolicyVersion extracts the version from a binary policy filename, e.g.
+// "policy.33" returns 33
+func PolicyVersion(name string) (int, bool) {
+	version, ok := strings.CutPrefix(name, "policy.")
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "1", "true", "on":
+		return true, nil
+	case "0", "false", "off":
+		return false, nil
+	}
+	return false, errors.New("invalid selinux boolean value: '" + s + "'")
+}
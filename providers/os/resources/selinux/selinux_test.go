@@ -0,0 +1,53 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selinux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileContexts(t *testing.T) {
+	content := `
+# comment
+/etc(/.*)?          system_u:object_r:etc_t:s0
+/var/run        -l  system_u:object_r:var_run_t:s0
+invalid
+`
+	assert.Equal(t, []FileContext{
+		{Path: "/etc(/.*)?", Context: "system_u:object_r:etc_t:s0"},
+		{Path: "/var/run", FileType: "-l", Context: "system_u:object_r:var_run_t:s0"},
+	}, ParseFileContexts(content))
+}
+
+func TestParseBooleansLocal(t *testing.T) {
+	res := ParseBooleansLocal("httpd_can_network_connect=1\nssh_sysadm_login = off\nbroken=maybe\n")
+	assert.Equal(t, map[string]bool{
+		"httpd_can_network_connect": true,
+		"ssh_sysadm_login":          false,
+	}, res)
+}
+
+func TestParseSysfsBoolean(t *testing.T) {
+	value, pending, err := ParseSysfsBoolean("1 0")
+	require.NoError(t, err)
+	assert.True(t, value)
+	assert.False(t, pending)
+
+	_, _, err = ParseSysfsBoolean("1")
+	assert.Error(t, err)
+}
+
+func TestPolicyVersion(t *testing.T) {
+	v, ok := PolicyVersion("policy.33")
+	assert.True(t, ok)
+	assert.Equal(t, 33, v)
+
+	_, ok = PolicyVersion("policy.kern")
+	assert.False(t, ok)
+	_, ok = PolicyVersion("file_contexts")
+	assert.False(t, ok)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+)
+
+func TestSelinux(t *testing.T) {
+	tests := []struct {
+		name          string
+		offline       bool
+		mode          string
+		policyVersion int64
+		// booleans maps the name to the value and whether a change is pending
+		booleans map[string][2]bool
+	}{
+		{
+			// the kernel runs in permissive mode, although the config enforces
+			name:          "selinuxfs",
+			mode:          "permissive",
+			policyVersion: 33,
+			booleans: map[string][2]bool{
+				"httpd_can_network_connect": {true, true},
+				"ssh_sysadm_login":          {false, true},
+			},
+		},
+		{
+			// only booleans that are changed locally are known, and their
+			// value also applies at the next boot
+			name:          "policy store",
+			offline:       true,
+			mode:          "enforcing",
+			policyVersion: 33,
+			booleans: map[string][2]bool{
+				"httpd_can_network_connect": {true, true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conn shared.Connection = newMockConnection(t, nil, "./testdata/selinux.toml")
+			if tt.offline {
+				conn = &offlineConnection{Connection: conn}
+			}
+			s := newTestResource[*mqlSelinux](t, conn, "selinux", nil)
+
+			assert.Equal(t, !tt.offline, s.GetRuntime().Data)
+			assert.True(t, s.GetEnabled().Data)
+			assert.Equal(t, tt.mode, s.GetMode().Data)
+			assert.Equal(t, "enforcing", s.GetConfigMode().Data)
+			assert.Equal(t, "targeted", s.GetPolicyType().Data)
+			assert.Equal(t, tt.policyVersion, s.GetPolicyVersion().Data)
+
+			booleans := s.GetBooleans()
+			require.NoError(t, booleans.Error)
+			res := map[string][2]bool{}
+			for _, b := range booleans.Data {
+				boolean := b.(*mqlSelinuxBoolean)
+				res[boolean.Name.Data] = [2]bool{boolean.Value.Data, boolean.Pending.Data}
+			}
+			assert.Equal(t, tt.booleans, res)
+		})
+	}
+}
+
+func TestSelinuxPolicyStore(t *testing.T) {
+	conn := newMockConnection(t, nil, "./testdata/selinux.toml")
+	s := newTestResource[*mqlSelinux](t, conn, "selinux", nil)
+
+	fileContexts := s.GetFileContexts()
+	require.NoError(t, fileContexts.Error)
+	require.Len(t, fileContexts.Data, 4)
+	fc := fileContexts.Data[1].(*mqlSelinuxFileContext)
+	assert.Equal(t, "/var/run", fc.Path.Data)
+	assert.Equal(t, "-l", fc.FileType.Data)
+	assert.False(t, fc.Local.Data)
+	// file_contexts.local is appended to the shipped contexts
+	fc = fileContexts.Data[3].(*mqlSelinuxFileContext)
+	assert.Equal(t, "system_u:object_r:httpd_sys_content_t:s0", fc.Context.Data)
+	assert.True(t, fc.Local.Data)
+
+	modules := s.GetModules()
+	require.NoError(t, modules.Error)
+	enabled := map[string]bool{}
+	priorities := map[string]int64{}
+	for _, m := range modules.Data {
+		mod := m.(*mqlSelinuxModule)
+		enabled[mod.Name.Data] = mod.Enabled.Data
+		priorities[mod.Name.Data] = mod.Priority.Data
+	}
+	assert.Equal(t, map[string]bool{"apache": true, "telnet": false, "mymodule": true}, enabled)
+	assert.Equal(t, int64(400), priorities["mymodule"])
+}
+
+func TestApparmor(t *testing.T) {
+	tests := []struct {
+		name     string
+		offline  bool
+		profiles map[string]string
+	}{
+		{
+			name: "loaded profiles",
+			profiles: map[string]string{
+				"/usr/sbin/cupsd":              "enforce",
+				"/usr/sbin/cupsd//third_party": "enforce",
+				"docker-default":               "enforce",
+				"man_filter":                   "complain",
+			},
+		},
+		{
+			// profiles linked in /etc/apparmor.d/disable are not loaded at boot
+			name:    "profiles in /etc/apparmor.d",
+			offline: true,
+			profiles: map[string]string{
+				"/usr/sbin/cupsd":         "enforce",
+				"man":                     "complain",
+				"man_groff":               "enforce",
+				"/usr/{bin,sbin}/tcpdump": "disabled",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conn shared.Connection = newMockConnection(t, nil, "./testdata/selinux.toml")
+			if tt.offline {
+				conn = &offlineConnection{Connection: conn}
+			}
+			a := newTestResource[*mqlApparmor](t, conn, "apparmor", nil)
+
+			assert.Equal(t, !tt.offline, a.GetRuntime().Data)
+			assert.True(t, a.GetEnabled().Data)
+
+			profiles := a.GetProfiles()
+			require.NoError(t, profiles.Error)
+			modes := map[string]string{}
+			for _, p := range profiles.Data {
+				profile := p.(*mqlApparmorProfile)
+				modes[profile.Name.Data] = profile.Mode.Data
+			}
+			assert.Equal(t, tt.profiles, modes)
+		})
+	}
+}
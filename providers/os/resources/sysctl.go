@@ -0,0 +1,144 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/kernel"
+)
+
+type mqlSysctlInternal struct {
+	lock            sync.Mutex
+	persistedLoaded bool
+	persisted       map[string]kernel.SysctlSetting
+	persistedFiles  []string
+}
+
+func initSysctl(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	conn := runtime.Connection.(shared.Connection)
+	if !conn.Asset().Platform.IsFamily("linux") {
+		return nil, nil, errors.New("sysctl resource is only supported on linux platforms")
+	}
+	return args, nil, nil
+}
+
+func (s *mqlSysctl) id() (string, error) {
+	return "sysctl", nil
+}
+
+// loadPersisted reads all sysctl configuration files once
+func (s *mqlSysctl) loadPersisted() (map[string]kernel.SysctlSetting, []string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.persistedLoaded {
+		return s.persisted, s.persistedFiles, nil
+	}
+
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	persisted, files, err := kernel.PersistedSysctl(conn.FileSystem())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.persisted = persisted
+	s.persistedFiles = files
+	s.persistedLoaded = true
+	return s.persisted, s.persistedFiles, nil
+}
+
+func (s *mqlSysctl) runtime() (bool, error) {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	return isLiveConnection(conn), nil
+}
+
+func (s *mqlSysctl) files() ([]any, error) {
+	_, files, err := s.loadPersisted()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]any, len(files))
+	for i, path := range files {
+		f, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(path),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = f
+	}
+	return res, nil
+}
+
+func (s *mqlSysctl) entries(runtime bool) ([]any, error) {
+	persisted, _, err := s.loadPersisted()
+	if err != nil {
+		return nil, err
+	}
+
+	// offline filesystems have no running kernel, so we only report the
+	// persisted configuration and leave the runtime values empty
+	var live map[string]string
+	if runtime {
+		conn := s.MqlRuntime.Connection.(shared.Connection)
+		mm, err := kernel.ResolveManager(conn)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not detect suitable kernel module manager for platform")
+		}
+		live, err = mm.Parameters()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	names := make([]string, 0, len(live)+len(persisted))
+	for name := range live {
+		names = append(names, name)
+	}
+	for name := range persisted {
+		if _, ok := live[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	res := make([]any, len(names))
+	for i, name := range names {
+		args := map[string]*llx.RawData{
+			"__id":           llx.StringData("sysctl.entry/" + name),
+			"name":           llx.StringData(name),
+			"value":          llx.NilData,
+			"persistedValue": llx.NilData,
+			"source":         llx.StringData(""),
+			"drift":          llx.BoolFalse,
+		}
+
+		value, isLive := live[name]
+		if isLive {
+			args["value"] = llx.StringData(value)
+		}
+		setting, isPersisted := persisted[name]
+		if isPersisted {
+			args["persistedValue"] = llx.StringData(setting.Value)
+			args["source"] = llx.StringData(setting.File)
+		}
+		if isLive && isPersisted {
+			args["drift"] = llx.BoolData(!kernel.SysctlValuesEqual(value, setting.Value))
+		}
+
+		obj, err := CreateResource(s.MqlRuntime, "sysctl.entry", args)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+)
+
+func TestSysctl(t *testing.T) {
+	// an empty value or persisted value means the field is null
+	tests := []struct {
+		name           string
+		offline        bool
+		entry          string
+		value          string
+		persistedValue string
+		source         string
+		drift          bool
+		absent         bool
+	}{
+		{
+			name:           "later files override earlier ones",
+			entry:          "net.ipv4.ip_forward",
+			value:          "1",
+			persistedValue: "1",
+			source:         "/usr/lib/sysctl.d/90-late.conf",
+		},
+		{
+			name:           "whitespace in values is not drift",
+			entry:          "net.ipv4.tcp_rmem",
+			value:          "4096\t131072\t6291456",
+			persistedValue: "4096 131072 6291456",
+			source:         "/etc/sysctl.d/10-network.conf",
+		},
+		{
+			name:           "sysctl.conf is applied last",
+			entry:          "vm.swappiness",
+			value:          "60",
+			persistedValue: "10",
+			source:         "/etc/sysctl.conf",
+			drift:          true,
+		},
+		{
+			name:           "files in /etc/sysctl.d replace files with the same name",
+			entry:          "kernel.randomize_va_space",
+			value:          "2",
+			persistedValue: "2",
+			source:         "/etc/sysctl.d/50-default.conf",
+		},
+		{
+			name:   "parameters of replaced files are ignored",
+			entry:  "kernel.kptr_restrict",
+			absent: true,
+		},
+		{
+			name:  "parameter that is not persisted",
+			entry: "kernel.hostname",
+			value: "test",
+		},
+		{
+			name:           "offline targets only have persisted values",
+			offline:        true,
+			entry:          "vm.swappiness",
+			persistedValue: "10",
+			source:         "/etc/sysctl.conf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conn shared.Connection = newMockConnection(t, &inventory.Asset{
+				Platform: &inventory.Platform{Name: "debian", Family: []string{"debian", "linux", "unix", "os"}},
+			}, "./testdata/sysctl.toml")
+			if tt.offline {
+				conn = &offlineConnection{Connection: conn}
+			}
+			s := newTestResource[*mqlSysctl](t, conn, "sysctl", nil)
+			assert.Equal(t, !tt.offline, s.GetRuntime().Data)
+
+			entries := s.GetEntries()
+			require.NoError(t, entries.Error)
+			var entry *mqlSysctlEntry
+			for _, e := range entries.Data {
+				if e.(*mqlSysctlEntry).Name.Data == tt.entry {
+					entry = e.(*mqlSysctlEntry)
+				}
+			}
+			if tt.absent {
+				assert.Nil(t, entry)
+				return
+			}
+			require.NotNil(t, entry, tt.entry)
+
+			if tt.value == "" {
+				assert.True(t, entry.Value.IsNull())
+			} else {
+				assert.Equal(t, tt.value, entry.Value.Data)
+			}
+			if tt.persistedValue == "" {
+				assert.True(t, entry.PersistedValue.IsNull())
+			} else {
+				assert.Equal(t, tt.persistedValue, entry.PersistedValue.Data)
+			}
+			assert.Equal(t, tt.source, entry.Source.Data)
+			assert.Equal(t, tt.drift, entry.Drift.Data)
+		})
+	}
+}
+
+func TestSysctlFiles(t *testing.T) {
+	conn := newMockConnection(t, &inventory.Asset{
+		Platform: &inventory.Platform{Name: "debian", Family: []string{"debian", "linux", "unix", "os"}},
+	}, "./testdata/sysctl.toml")
+	files := newTestResource[*mqlSysctl](t, conn, "sysctl", nil).GetFiles()
+	require.NoError(t, files.Error)
+
+	paths := []string{}
+	for _, f := range files.Data {
+		paths = append(paths, f.(*mqlFile).Path.Data)
+	}
+	assert.Equal(t, []string{
+		"/etc/sysctl.d/10-network.conf",
+		"/etc/sysctl.d/50-default.conf",
+		"/usr/lib/sysctl.d/90-late.conf",
+		"/etc/sysctl.conf",
+	}, paths)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/parsers"
+	"go.mondoo.com/mql/v13/providers/os/resources/ufw"
+)
+
+type mqlUfwInternal struct {
+	lock   sync.Mutex
+	loaded bool
+	// settings of ufw.conf and /etc/default/ufw
+	config map[string]string
+}
+
+func (u *mqlUfw) id() (string, error) {
+	return "ufw", nil
+}
+
+// loadConfig reads ufw.conf and /etc/default/ufw once. Both use the same
+// shell variable format, so they are merged into one map.
+func (u *mqlUfw) loadConfig() (map[string]string, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if u.loaded {
+		return u.config, nil
+	}
+
+	conn := u.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	u.config = map[string]string{}
+	for _, path := range []string{ufw.DefaultsPath, ufw.ConfigPath} {
+		data, err := afs.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		ini := parsers.ParseIni(string(data), "=")
+		if fields, ok := ini.Fields[""].(map[string]any); ok {
+			for k, v := range fields {
+				if str, ok := v.(string); ok {
+					u.config[k] = strings.Trim(str, "\"")
+				}
+			}
+		}
+	}
+
+	u.loaded = true
+	return u.config, nil
+}
+
+func (u *mqlUfw) configValue(key string) (string, error) {
+	cfg, err := u.loadConfig()
+	if err != nil {
+		return "", err
+	}
+	return cfg[key], nil
+}
+
+func (u *mqlUfw) runtime() (bool, error) {
+	conn := u.MqlRuntime.Connection.(shared.Connection)
+	return isLiveConnection(conn), nil
+}
+
+func (u *mqlUfw) enabled() (bool, error) {
+	v, err := u.configValue("ENABLED")
+	return strings.ToLower(v) == "yes", err
+}
+
+func (u *mqlUfw) status(runtime bool, enabled bool) (string, error) {
+	if runtime {
+		o, err := CreateResource(u.MqlRuntime, "command", map[string]*llx.RawData{
+			"command": llx.StringData("ufw status"),
+		})
+		if err != nil {
+			return "", err
+		}
+		// ufw status requires root, so we fall back to the boot
+		// configuration if it fails
+		cmd := o.(*mqlCommand)
+		if exit := cmd.GetExitcode(); exit.Data == 0 {
+			if active, ok := ufw.ParseStatus(strings.NewReader(cmd.GetStdout().Data)); ok {
+				return ufwStatus(active), nil
+			}
+		}
+	}
+	return ufwStatus(enabled), nil
+}
+
+func ufwStatus(active bool) string {
+	if active {
+		return "active"
+	}
+	return "inactive"
+}
+
+func (u *mqlUfw) logLevel() (string, error) {
+	return u.configValue("LOGLEVEL")
+}
+
+func (u *mqlUfw) ipv6() (bool, error) {
+	v, err := u.configValue("IPV6")
+	return strings.ToLower(v) == "yes", err
+}
+
+func (u *mqlUfw) defaultInputPolicy() (string, error) {
+	v, err := u.configValue("DEFAULT_INPUT_POLICY")
+	return ufw.Policy(v), err
+}
+
+func (u *mqlUfw) defaultOutputPolicy() (string, error) {
+	v, err := u.configValue("DEFAULT_OUTPUT_POLICY")
+	return ufw.Policy(v), err
+}
+
+func (u *mqlUfw) defaultForwardPolicy() (string, error) {
+	v, err := u.configValue("DEFAULT_FORWARD_POLICY")
+	return ufw.Policy(v), err
+}
+
+func (u *mqlUfw) rules() ([]any, error) {
+	conn := u.MqlRuntime.Connection.(shared.Connection)
+	afs := &afero.Afero{Fs: conn.FileSystem()}
+
+	var rules []ufw.Rule
+	for _, path := range []string{ufw.RulesPath, ufw.Rules6Path} {
+		data, err := afs.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		parsed, err := ufw.ParseRules(bytes.NewReader(data), path == ufw.Rules6Path)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, parsed...)
+	}
+
+	res := make([]any, len(rules))
+	for i, rule := range rules {
+		obj, err := CreateResource(u.MqlRuntime, "ufw.rule", map[string]*llx.RawData{
+			"__id":      llx.StringData("ufw.rule/" + strconv.Itoa(i+1)),
+			"number":    llx.IntData(i + 1),
+			"action":    llx.StringData(rule.Action),
+			"log":       llx.StringData(rule.Log),
+			"route":     llx.BoolData(rule.Route),
+			"direction": llx.StringData(rule.Direction),
+			"interface": llx.StringData(rule.Interface),
+			"protocol":  llx.StringData(rule.Protocol),
+			"to":        llx.StringData(rule.To),
+			"toPort":    llx.StringData(rule.ToPort),
+			"toApp":     llx.StringData(rule.ToApp),
+			"from":      llx.StringData(rule.From),
+			"fromPort":  llx.StringData(rule.FromPort),
+			"fromApp":   llx.StringData(rule.FromApp),
+			"ipv6":      llx.BoolData(rule.IPv6),
+			"comment":   llx.StringData(rule.Comment),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = obj
+	}
+	return res, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ufw
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const (
+	// ConfigPath configures whether ufw is started at boot and its log level
+	ConfigPath = "/etc/ufw/ufw.conf"
+	// DefaultsPath configures the default policies
+	DefaultsPath = "/etc/default/ufw"
+	// RulesPath contains the IPv4 rules that were added with the ufw command
+	RulesPath = "/etc/ufw/user.rules"
+	// Rules6Path contains the IPv6 rules that were added with the ufw command
+	Rules6Path = "/etc/ufw/user6.rules"
+)
+
+// Rule is a rule that was added with the ufw command
+type Rule struct {
+	Action    string // allow, deny, reject, or limit
+	Log       string // empty, log, or log-all
+	Route     bool   // whether this is a route (forward) rule
+	Direction string // in or out
+	Interface string
+	Protocol  string
+	To        string
+	ToPort    string
+	ToApp     string
+	From      string
+	FromPort  string
+	FromApp   string
+	Comment   string
+	IPv6      bool
+}
+
+const tuplePrefix = "### tuple ###"
+
+// ParseRules parses the rules in user.rules or user6.rules. ufw persists
+// every rule as a comment that precedes the generated iptables rules, e.g.
+//
+//	### tuple ### allow tcp 22 0.0.0.0/0 any 0.0.0.0/0 in
+//	### tuple ### deny any 80 0.0.0.0/0 any 10.0.0.0/8 Apache - out_eth0 comment=77656220
+//
+// The fields are: action, protocol, destination port, destination address,
+// source port, source address, optionally the destination and source
+// application, and the direction with an optional interface.
+func ParseRules(r io.Reader, ipv6 bool) ([]Rule, error) {
+	var res []Rule
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), tuplePrefix)
+		if !ok {
+			continue
+		}
+
+		// older versions of ufw did not persist the direction, which
+		// defaults to incoming traffic
+		fields := strings.Fields(line)
+		rule := Rule{IPv6: ipv6, Direction: "in"}
+
+		if n := len(fields); n > 0 && strings.HasPrefix(fields[n-1], "comment=") {
+			rule.Comment = decodeComment(strings.TrimPrefix(fields[n-1], "comment="))
+			fields = fields[:n-1]
+		}
+
+		if len(fields) < 6 || len(fields) > 9 {
+			continue
+		}
+
+		action := fields[0]
+		if a, ok := strings.CutPrefix(action, "route:"); ok {
+			rule.Route = true
+			action = a
+		}
+		rule.Action, rule.Log, _ = strings.Cut(action, "_")
+
+		rule.Protocol = fields[1]
+		rule.ToPort = fields[2]
+		rule.To = fields[3]
+		rule.FromPort = fields[4]
+		rule.From = fields[5]
+
+		if len(fields) >= 8 {
+			rule.ToApp = decodeApp(fields[6])
+			rule.FromApp = decodeApp(fields[7])
+		}
+		if len(fields) == 7 || len(fields) == 9 {
+			direction, iface, _ := strings.Cut(fields[len(fields)-1], "_")
+			rule.Direction = direction
+			rule.Interface = iface
+		}
+
+		res = append(res, rule)
+	}
+
+	return res, scanner.Err()
+}
+
+// decodeApp decodes an application name, which has its spaces escaped,
+// e.g. "Apache%20Full". A dash means no application.
+func decodeApp(app string) string {
+	if app == "-" {
+		return ""
+	}
+	if s, err := url.PathUnescape(app); err == nil {
+		return s
+	}
+	return app
+}
+
+// decodeComment decodes a hex-encoded comment
+func decodeComment(comment string) string {
+	data, err := hex.DecodeString(comment)
+	if err != nil {
+		return comment
+	}
+	return string(data)
+}
+
+// Policy converts an iptables target as used in /etc/default/ufw into the
+// policy names of ufw, e.g. DROP is deny
+func Policy(target string) string {
+	switch strings.ToUpper(strings.Trim(target, "\"")) {
+	case "ACCEPT":
+		return "allow"
+	case "DROP":
+		return "deny"
+	case "REJECT":
+		return "reject"
+	}
+	return strings.ToLower(target)
+}
+
+// ParseStatus returns whether `ufw status` reports the firewall as active
+func ParseStatus(r io.Reader) (bool, bool) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if status, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "Status:"); ok {
+			return strings.TrimSpace(status) == "active", true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,43 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ufw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader(`
+### tuple ### allow tcp 22 0.0.0.0/0 any 0.0.0.0/0
+### tuple ### allow_log-all any 80,443 0.0.0.0/0 any 0.0.0.0/0 Apache%20Full - in comment=776562
+### tuple ### route:reject udp any 0.0.0.0/0 53 10.0.0.0/8 out_eth1
+-A ufw-user-input -p tcp --dport 22 -j ACCEPT
+`), false)
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	assert.Equal(t, Rule{
+		Action: "allow", Direction: "in", Protocol: "tcp",
+		To: "0.0.0.0/0", ToPort: "22", From: "0.0.0.0/0", FromPort: "any",
+	}, rules[0])
+	assert.Equal(t, Rule{
+		Action: "allow", Log: "log-all", Direction: "in", Protocol: "any",
+		To: "0.0.0.0/0", ToPort: "80,443", ToApp: "Apache Full",
+		From: "0.0.0.0/0", FromPort: "any", Comment: "web",
+	}, rules[1])
+	assert.Equal(t, Rule{
+		Action: "reject", Route: true, Direction: "out", Interface: "eth1", Protocol: "udp",
+		To: "0.0.0.0/0", ToPort: "any", From: "10.0.0.0/8", FromPort: "53",
+	}, rules[2])
+}
+
+func TestPolicy(t *testing.T) {
+	assert.Equal(t, "deny", Policy(`"DROP"`))
+	assert.Equal(t, "allow", Policy("ACCEPT"))
+	assert.Equal(t, "reject", Policy("REJECT"))
+}
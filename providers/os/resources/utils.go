@@ -9,8 +9,17 @@ import (
 
 	"go.mondoo.com/mql/v13/llx"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
 )
 
+// isLiveConnection returns true if the connection is to a running system,
+// where kernel state like /proc and /sys is available. Offline connections
+// (filesystems, tar images, devices, snapshots) can't run commands and only
+// expose what is persisted on disk.
+func isLiveConnection(conn shared.Connection) bool {
+	return conn.Capabilities().Has(shared.Capability_RunCommand)
+}
+
 // For a given path, return either the path itself it if it's a file
 // or return the list of files in the path sorted.
 // This is super useful for loading e.g. /etc/some/config.d
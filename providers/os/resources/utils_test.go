@@ -0,0 +1,89 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/os/connection/mock"
+	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/utils/syncx"
+)
+
+// newMockConnection loads a mock connection from a testdata file. The asset
+// is optional, it is only needed by resources that depend on the platform.
+func newMockConnection(t *testing.T, asset *inventory.Asset, path string) *mock.Connection {
+	t.Helper()
+	if asset == nil {
+		asset = &inventory.Asset{}
+	}
+	var opts []mock.Option
+	if path != "" {
+		opts = append(opts, mock.WithPath(path))
+	}
+	conn, err := mock.New(0, asset, opts...)
+	require.NoError(t, err)
+	return conn
+}
+
+// newTestResource creates a resource in a new runtime for the connection
+func newTestResource[T plugin.Resource](t *testing.T, conn shared.Connection, name string, args map[string]*llx.RawData) T {
+	t.Helper()
+	runtime := &plugin.Runtime{
+		Connection: conn,
+		Resources:  &syncx.Map[plugin.Resource]{},
+		Callback:   &providerCallbacks{},
+	}
+	if args == nil {
+		args = map[string]*llx.RawData{}
+	}
+	raw, err := CreateResource(runtime, name, args)
+	require.NoError(t, err)
+	res, ok := raw.(T)
+	require.True(t, ok, "unexpected type %T for resource %s", raw, name)
+	return res
+}
+
+// offlineConnection drops the command capability of the mock connection, which
+// is what filesystem, tar and device connections look like to resources
+type offlineConnection struct {
+	shared.Connection
+}
+
+func (c *offlineConnection) Capabilities() shared.Capabilities {
+	return shared.Capability_File
+}
+
+func TestIsLiveConnection(t *testing.T) {
+	conn, err := mock.New(0, &inventory.Asset{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		conn     shared.Connection
+		expected bool
+	}{
+		{
+			name:     "connection that runs commands",
+			conn:     conn,
+			expected: true,
+		},
+		{
+			name:     "connection with files only",
+			conn:     &offlineConnection{Connection: conn},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isLiveConnection(tt.conn))
+		})
+	}
+}
@@ -0,0 +1,521 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vulndb
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/facebookincubator/nvdtools/wfn"
+	"github.com/package-url/packageurl-go"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/providers/core/resources/versions/deb"
+	"go.mondoo.com/mql/v13/providers/core/resources/versions/generic"
+	"go.mondoo.com/mql/v13/providers/core/resources/versions/semver"
+)
+
+// Platform is the operating system the packages are installed on
+type Platform struct {
+	Name    string
+	Version string
+	// Cpes of the platform, used to select applicable OVAL definitions
+	Cpes []string
+}
+
+// Package is an installed package
+type Package struct {
+	Name    string
+	Version string
+	Epoch   string
+	Arch    string
+	Format  string
+	// Origin is the source package name, if it differs from the name
+	Origin string
+	Purl   string
+	Cpes   []string
+}
+
+// MatchedPackage is an installed package that is affected by at least one advisory
+type MatchedPackage struct {
+	Name    string
+	Version string
+	Arch    string
+	Format  string
+	// Available is the lowest version that fixes all matched advisories
+	Available  string
+	Advisories []string
+}
+
+// Report is the result of matching packages against the database
+type Report struct {
+	// Advisories that are not CVEs themselves
+	Advisories []*Advisory
+	// Cves contains all CVEs, either matched directly or referenced by advisories
+	Cves     []*Advisory
+	Packages []*MatchedPackage
+}
+
+// Match returns all advisories that affect the given packages
+func (db *Database) Match(platform Platform, packages []Package) *Report {
+	db.ensureIndex()
+	report := &Report{}
+	advisories := map[string]*Advisory{}
+	cves := map[string]*Advisory{}
+	platformCpes := parseCpes(platform.Cpes)
+
+	for i := range packages {
+		pkg := &packages[i]
+		m := newPackageMatcher(platform, platformCpes, pkg)
+
+		var matched *MatchedPackage
+		var last *Advisory
+		for _, entry := range db.candidates(m) {
+			// the first affected entry of an advisory that applies decides
+			if entry.advisory == last {
+				continue
+			}
+			if !m.identifies(entry) {
+				continue
+			}
+			fixed, ok := m.affectedVersion(entry.affected)
+			if !ok {
+				continue
+			}
+			a := entry.advisory
+			last = a
+
+			if matched == nil {
+				matched = &MatchedPackage{
+					Name:    pkg.Name,
+					Version: pkg.Version,
+					Arch:    pkg.Arch,
+					Format:  pkg.Format,
+				}
+				report.Packages = append(report.Packages, matched)
+			}
+			if !slices.Contains(matched.Advisories, a.ID) {
+				matched.Advisories = append(matched.Advisories, a.ID)
+			}
+			if fixed != "" && (matched.Available == "" || m.compare(fixed, matched.Available) > 0) {
+				matched.Available = fixed
+			}
+
+			if a.IsCve() {
+				cves[a.ID] = db.cveRecord(a.ID, a)
+				continue
+			}
+			advisories[a.ID] = a
+			for _, id := range a.Cves {
+				if _, ok := cves[id]; !ok {
+					cves[id] = db.cveRecord(id, a)
+				}
+			}
+		}
+	}
+
+	for _, a := range advisories {
+		report.Advisories = append(report.Advisories, a)
+	}
+	for _, c := range cves {
+		report.Cves = append(report.Cves, c)
+	}
+	sort.Slice(report.Advisories, func(i, j int) bool { return report.Advisories[i].ID < report.Advisories[j].ID })
+	sort.Slice(report.Cves, func(i, j int) bool { return report.Cves[i].ID < report.Cves[j].ID })
+	return report
+}
+
+// cveRecord returns the database record for a CVE, or a record derived from
+// the advisory that references it
+func (db *Database) cveRecord(id string, from *Advisory) *Advisory {
+	if c := db.Cve(id); c != nil {
+		return c
+	}
+	return &Advisory{
+		ID:        id,
+		Title:     id,
+		Published: from.Published,
+		Modified:  from.Modified,
+		Cvss:      from.Cvss,
+	}
+}
+
+// indexEntry is an affected entry of an advisory with its CPEs parsed
+type indexEntry struct {
+	// advisoryIdx and affectedIdx keep candidates in database order
+	advisoryIdx int
+	affectedIdx int
+	advisory    *Advisory
+	affected    *Affected
+	cpes        []*wfn.Attributes
+	platforms   []*wfn.Attributes
+}
+
+func newIndexEntry(advisoryIdx int, affectedIdx int, a *Advisory) *indexEntry {
+	affected := &a.Affected[affectedIdx]
+	return &indexEntry{
+		advisoryIdx: advisoryIdx,
+		affectedIdx: affectedIdx,
+		advisory:    a,
+		affected:    affected,
+		cpes:        parseCpes(affected.Cpes),
+		platforms:   parseCpes(affected.Platforms),
+	}
+}
+
+// keys returns the index keys of the entry. They use the same identifier
+// that identifies checks for the entry.
+func (e *indexEntry) keys() []string {
+	a := e.affected
+	switch {
+	case a.Purl != "":
+		parts := strings.SplitN(a.Purl, "/", 3)
+		if len(parts) != 3 {
+			return nil
+		}
+		return []string{purlIndexKey(parts[0], parts[2])}
+	case a.Ecosystem != "":
+		name, _, _ := strings.Cut(a.Ecosystem, ":")
+		return []string{ecosystemIndexKey(name, a.Name)}
+	case a.Format != "":
+		return []string{formatIndexKey(a.Format, a.Name)}
+	}
+
+	res := []string{}
+	for _, c := range e.cpes {
+		key := cpeIndexKey(c)
+		if !slices.Contains(res, key) {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+func purlIndexKey(typ string, name string) string {
+	return "purl:" + strings.ToLower(typ) + "/" + strings.ToLower(name)
+}
+
+func ecosystemIndexKey(ecosystem string, name string) string {
+	return "ecosystem:" + ecosystem + "/" + strings.ToLower(name)
+}
+
+func formatIndexKey(format string, name string) string {
+	return "format:" + format + "/" + strings.ToLower(name)
+}
+
+func cpeIndexKey(cpe *wfn.Attributes) string {
+	return "cpe:" + cpe.Vendor + ":" + cpe.Product
+}
+
+// candidates returns the affected entries that may refer to a package, in
+// database order
+func (db *Database) candidates(m *packageMatcher) []*indexEntry {
+	keys := []string{}
+	for _, name := range m.names {
+		if m.purl != nil {
+			keys = append(keys, purlIndexKey(m.purl.Type, name))
+		}
+		if m.ecosystem != "" {
+			keys = append(keys, ecosystemIndexKey(m.ecosystem, name))
+		}
+		if m.pkg.Format != "" {
+			keys = append(keys, formatIndexKey(m.pkg.Format, name))
+		}
+	}
+	// distributions backport fixes without changing the upstream version,
+	// so their packages are only matched by distribution advisories
+	if !m.isDistroPackage() {
+		for _, c := range m.cpes {
+			keys = append(keys, cpeIndexKey(c))
+		}
+	}
+
+	res := []*indexEntry{}
+	seen := map[*indexEntry]struct{}{}
+	for _, key := range keys {
+		for _, entry := range db.affected[key] {
+			if _, ok := seen[entry]; ok {
+				continue
+			}
+			seen[entry] = struct{}{}
+			res = append(res, entry)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].advisoryIdx != res[j].advisoryIdx {
+			return res[i].advisoryIdx < res[j].advisoryIdx
+		}
+		return res[i].affectedIdx < res[j].affectedIdx
+	})
+	return res
+}
+
+func parseCpes(cpes []string) []*wfn.Attributes {
+	var res []*wfn.Attributes
+	for _, c := range cpes {
+		if attr, err := wfn.Parse(c); err == nil {
+			res = append(res, attr)
+		}
+	}
+	return res
+}
+
+type packageMatcher struct {
+	platform     Platform
+	platformCpes []*wfn.Attributes
+	pkg          *Package
+	names        []string
+	version      string
+	ecosystem    string
+	purl         *packageurl.PackageURL
+	cpes         []*wfn.Attributes
+}
+
+func newPackageMatcher(platform Platform, platformCpes []*wfn.Attributes, pkg *Package) *packageMatcher {
+	m := &packageMatcher{
+		platform:     platform,
+		platformCpes: platformCpes,
+		pkg:          pkg,
+		names:        []string{pkg.Name},
+		version:      pkg.Version,
+		cpes:         parseCpes(pkg.Cpes),
+	}
+
+	// advisories for distribution packages usually refer to the source package
+	if origin := originName(pkg.Origin); origin != "" && origin != pkg.Name {
+		m.names = append(m.names, origin)
+	}
+	if pkg.Epoch != "" && pkg.Epoch != "0" && !strings.Contains(pkg.Version, ":") {
+		m.version = pkg.Epoch + ":" + pkg.Version
+	}
+	if pkg.Purl != "" {
+		if p, err := packageurl.FromString(pkg.Purl); err == nil {
+			m.purl = &p
+		}
+	}
+	m.ecosystem = m.packageEcosystem()
+	return m
+}
+
+// originName removes the version from an origin like "openssl (3.0.11-1)"
+func originName(origin string) string {
+	fields := strings.Fields(origin)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// identifies checks if an affected entry refers to this package
+func (m *packageMatcher) identifies(entry *indexEntry) bool {
+	a := entry.affected
+	switch {
+	case a.Purl != "":
+		if m.purl == nil {
+			return false
+		}
+		parts := strings.SplitN(a.Purl, "/", 3)
+		if len(parts) != 3 || parts[0] != strings.ToLower(m.purl.Type) {
+			return false
+		}
+		if parts[1] != "" && parts[1] != strings.ToLower(m.purl.Namespace) {
+			return false
+		}
+		// the purl does not carry the distribution release
+		if a.Ecosystem != "" && m.ecosystem != "" && !m.matchesEcosystem(a.Ecosystem) {
+			return false
+		}
+		return m.hasName(parts[2])
+
+	case a.Ecosystem != "":
+		return m.matchesEcosystem(a.Ecosystem) && m.hasName(a.Name)
+
+	case a.Format != "":
+		return a.Format == m.pkg.Format && m.hasName(a.Name) && m.onPlatform(entry.platforms)
+
+	case len(entry.cpes) > 0:
+		// distributions backport fixes without changing the upstream version,
+		// so their packages are only matched by distribution advisories
+		if m.isDistroPackage() {
+			return false
+		}
+		for _, attr := range entry.cpes {
+			for _, own := range m.cpes {
+				if attr.Vendor == own.Vendor && attr.Product == own.Product {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (m *packageMatcher) hasName(name string) bool {
+	for _, n := range m.names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// onPlatform checks the platform CPEs of an OVAL definition. Definitions
+// without CPEs, or platforms without known CPEs, are not restricted.
+func (m *packageMatcher) onPlatform(cpes []*wfn.Attributes) bool {
+	if len(cpes) == 0 || len(m.platformCpes) == 0 {
+		return true
+	}
+	for _, attr := range cpes {
+		for _, own := range m.platformCpes {
+			if attr.Vendor != own.Vendor || !sameProduct(attr.Vendor, attr.Product, own.Product) {
+				continue
+			}
+			if attr.Version == wfn.Any || attr.Version == "" || attr.Version == own.Version ||
+				strings.HasPrefix(own.Version, attr.Version+"\\.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameProduct compares CPE products, which are sometimes prefixed with the
+// vendor (e.g. redhat:redhat_enterprise_linux and redhat:enterprise_linux)
+func sameProduct(vendor, a, b string) bool {
+	return a == b || vendor+"_"+a == b || a == vendor+"_"+b
+}
+
+// affectedVersion evaluates the version ranges of an affected entry
+func (m *packageMatcher) affectedVersion(a *Affected) (string, bool) {
+	if slices.Contains(a.Versions, m.pkg.Version) || slices.Contains(a.Versions, m.version) {
+		return "", true
+	}
+
+	for _, r := range a.Ranges {
+		affected := false
+		fixed := ""
+		for _, e := range r.Events {
+			switch {
+			case e.Introduced != "":
+				if e.Introduced == "0" || m.compare(m.version, e.Introduced) >= 0 {
+					affected = true
+				}
+			case e.Fixed != "":
+				if m.compare(m.version, e.Fixed) >= 0 {
+					affected = false
+				} else if affected && fixed == "" {
+					fixed = e.Fixed
+				}
+			case e.LastAffected != "":
+				if m.compare(m.version, e.LastAffected) > 0 {
+					affected = false
+				}
+			}
+		}
+		if affected {
+			return fixed, true
+		}
+	}
+	return "", false
+}
+
+// compare compares two versions with the scheme of the package format
+func (m *packageMatcher) compare(a, b string) int {
+	format := m.versionFormat()
+	var cmp int
+	var err error
+	if format == "semver" {
+		cmp, err = semver.Parser{}.Compare(a, b)
+	} else {
+		cmp, err = generic.Compare(format, a, b)
+	}
+	if err != nil {
+		// fall back to the debian algorithm, which handles arbitrary strings
+		log.Trace().Err(err).Str("a", a).Str("b", b).Str("format", format).Msg("could not compare versions")
+		cmp, _ = deb.Parser{}.Compare(a, b)
+	}
+	return cmp
+}
+
+func (m *packageMatcher) isDistroPackage() bool {
+	switch m.pkg.Format {
+	case "deb", "rpm", "apk", "pacman":
+		return true
+	}
+	return false
+}
+
+func (m *packageMatcher) versionFormat() string {
+	if m.isDistroPackage() {
+		return m.pkg.Format
+	}
+	return "semver"
+}
+
+// osvEcosystems maps purl types of language packages to OSV ecosystems
+var osvEcosystems = map[string]string{
+	packageurl.TypeNPM:      "npm",
+	packageurl.TypePyPi:     "PyPI",
+	packageurl.TypeGolang:   "Go",
+	packageurl.TypeCargo:    "crates.io",
+	packageurl.TypeMaven:    "Maven",
+	packageurl.TypeGem:      "RubyGems",
+	packageurl.TypeComposer: "Packagist",
+	packageurl.TypeNuget:    "NuGet",
+	packageurl.TypeHex:      "Hex",
+	packageurl.TypePub:      "Pub",
+}
+
+// osvDistributions maps platform names to OSV ecosystems
+var osvDistributions = map[string]string{
+	"debian":     "Debian",
+	"ubuntu":     "Ubuntu",
+	"alpine":     "Alpine",
+	"wolfi":      "Wolfi",
+	"rockylinux": "Rocky Linux",
+	"almalinux":  "AlmaLinux",
+	"redhat":     "Red Hat",
+	"opensuse":   "openSUSE",
+	"sles":       "SUSE",
+	"photon":     "Photon OS",
+	"mageia":     "Mageia",
+}
+
+// packageEcosystem determines the OSV ecosystem of the package
+func (m *packageMatcher) packageEcosystem() string {
+	if m.purl != nil {
+		if eco, ok := osvEcosystems[m.purl.Type]; ok {
+			return eco
+		}
+	}
+	switch m.pkg.Format {
+	case "deb", "rpm", "apk":
+		return osvDistributions[m.platform.Name]
+	}
+	if eco, ok := osvEcosystems[m.pkg.Format]; ok {
+		return eco
+	}
+	return ""
+}
+
+// matchesEcosystem compares an OSV ecosystem like "Debian:12" or
+// "Ubuntu:22.04:LTS" with the package ecosystem and platform release
+func (m *packageMatcher) matchesEcosystem(ecosystem string) bool {
+	name, release, _ := strings.Cut(ecosystem, ":")
+	if m.ecosystem == "" || name != m.ecosystem {
+		return false
+	}
+	release, _, _ = strings.Cut(release, ":")
+	if release == "" {
+		return true
+	}
+
+	// only distribution packages are tied to a release
+	if _, ok := osvDistributions[m.platform.Name]; !ok {
+		return false
+	}
+	release = strings.TrimPrefix(release, "v")
+	version := m.platform.Version
+	return version == release || strings.HasPrefix(version, release+".")
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vulndb
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// osvRecord is a record in the Open Source Vulnerability format, see
+// https://ossf.github.io/osv-schema/
+type osvRecord struct {
+	ID        string   `json:"id"`
+	Summary   string   `json:"summary"`
+	Details   string   `json:"details"`
+	Aliases   []string `json:"aliases"`
+	Upstream  []string `json:"upstream"`
+	Published string   `json:"published"`
+	Modified  string   `json:"modified"`
+	Withdrawn string   `json:"withdrawn"`
+	Severity  []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+			Purl      string `json:"purl"`
+		} `json:"package"`
+		Ranges []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced   string `json:"introduced"`
+				Fixed        string `json:"fixed"`
+				LastAffected string `json:"last_affected"`
+			} `json:"events"`
+		} `json:"ranges"`
+		Versions         []string       `json:"versions"`
+		DatabaseSpecific map[string]any `json:"database_specific"`
+	} `json:"affected"`
+	DatabaseSpecific map[string]any `json:"database_specific"`
+}
+
+// parseOsv parses a single OSV record or a list of records
+func parseOsv(db *Database, data []byte) error {
+	var records []osvRecord
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return err
+		}
+	} else {
+		var record osvRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return err
+		}
+		records = []osvRecord{record}
+	}
+
+	for i := range records {
+		r := &records[i]
+		if r.ID == "" || r.Withdrawn != "" {
+			continue
+		}
+		db.add(r.advisory())
+	}
+	return nil
+}
+
+func (r *osvRecord) advisory() *Advisory {
+	a := &Advisory{
+		ID:          r.ID,
+		Title:       r.Summary,
+		Description: r.Details,
+		Published:   parseTime(r.Published),
+		Modified:    parseTime(r.Modified),
+	}
+	if a.Title == "" {
+		a.Title = r.ID
+	}
+
+	for _, alias := range slices.Concat(r.Aliases, r.Upstream) {
+		if strings.HasPrefix(alias, "CVE-") && alias != r.ID && !slices.Contains(a.Cves, alias) {
+			a.Cves = append(a.Cves, alias)
+		}
+	}
+
+	for _, s := range r.Severity {
+		if strings.HasPrefix(s.Type, "CVSS_") && s.Score != "" {
+			a.Cvss = append(a.Cvss, s.Score)
+		}
+	}
+
+	for _, ra := range r.Affected {
+		affected := Affected{
+			Ecosystem: ra.Package.Ecosystem,
+			Name:      ra.Package.Name,
+			Versions:  ra.Versions,
+			Cpes:      cpesFromDatabaseSpecific(ra.DatabaseSpecific),
+		}
+		if ra.Package.Purl != "" {
+			if p, err := packageurl.FromString(ra.Package.Purl); err == nil {
+				affected.Purl = purlKey(p)
+			}
+		}
+		for _, rr := range ra.Ranges {
+			// commit ranges cannot be evaluated against installed packages
+			if rr.Type == "GIT" {
+				continue
+			}
+			rng := Range{}
+			for _, e := range rr.Events {
+				rng.Events = append(rng.Events, Event{
+					Introduced:   e.Introduced,
+					Fixed:        e.Fixed,
+					LastAffected: e.LastAffected,
+				})
+			}
+			affected.Ranges = append(affected.Ranges, rng)
+		}
+		if len(affected.Ranges) == 0 && len(affected.Versions) == 0 {
+			continue
+		}
+		a.Affected = append(a.Affected, affected)
+	}
+
+	// CVE records converted from NVD carry their CPEs on the record
+	if cpes := cpesFromDatabaseSpecific(r.DatabaseSpecific); len(cpes) > 0 {
+		for i := range a.Affected {
+			if a.Affected[i].Ecosystem == "" && a.Affected[i].Purl == "" && len(a.Affected[i].Cpes) == 0 {
+				a.Affected[i].Cpes = cpes
+			}
+		}
+	}
+
+	return a
+}
+
+// cpesFromDatabaseSpecific extracts CPEs from a database_specific object,
+// which uses different spellings depending on the source of the record
+func cpesFromDatabaseSpecific(m map[string]any) []string {
+	res := []string{}
+	for k, v := range m {
+		if !strings.EqualFold(k, "cpes") && !strings.EqualFold(k, "cpe") {
+			continue
+		}
+		switch x := v.(type) {
+		case string:
+			res = append(res, x)
+		case []any:
+			for _, e := range x {
+				if s, ok := e.(string); ok {
+					res = append(res, s)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// purlKey renders the parts of a package url that identify a package
+func purlKey(p packageurl.PackageURL) string {
+	return strings.ToLower(p.Type + "/" + p.Namespace + "/" + p.Name)
+}
+
+func parseTime(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, "2006-01-02T15:04:05", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
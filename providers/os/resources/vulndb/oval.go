@@ -0,0 +1,241 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vulndb
+
+import (
+	"bytes"
+	"encoding/xml"
+	"slices"
+	"strings"
+)
+
+// ovalDefinitions is the subset of an OVAL definitions document that is
+// needed to find vulnerable package versions. Distribution feeds (Red Hat,
+// Debian, Ubuntu, SUSE) describe vulnerable packages as rpminfo or dpkginfo
+// tests that compare the installed version against the fixed one.
+type ovalDefinitions struct {
+	Generator struct {
+		Timestamp string `xml:"timestamp"`
+	} `xml:"generator"`
+	Definitions []ovalDefinition `xml:"definitions>definition"`
+	Tests       struct {
+		Items []ovalTest `xml:",any"`
+	} `xml:"tests"`
+	Objects struct {
+		Items []ovalObject `xml:",any"`
+	} `xml:"objects"`
+	States struct {
+		Items []ovalState `xml:",any"`
+	} `xml:"states"`
+	Variables struct {
+		Items []ovalVariable `xml:",any"`
+	} `xml:"variables"`
+}
+
+type ovalDefinition struct {
+	ID          string `xml:"id,attr"`
+	Class       string `xml:"class,attr"`
+	Title       string `xml:"metadata>title"`
+	Description string `xml:"metadata>description"`
+	References  []struct {
+		RefID  string `xml:"ref_id,attr"`
+		Source string `xml:"source,attr"`
+	} `xml:"metadata>reference"`
+	Advisory struct {
+		Issued struct {
+			Date string `xml:"date,attr"`
+		} `xml:"issued"`
+		Updated struct {
+			Date string `xml:"date,attr"`
+		} `xml:"updated"`
+		Cves []struct {
+			ID         string `xml:",chardata"`
+			Cvss2      string `xml:"cvss2,attr"`
+			Cvss3      string `xml:"cvss3,attr"`
+			CvssVector string `xml:"cvss_vector,attr"`
+		} `xml:"cve"`
+		AffectedCpes []string `xml:"affected_cpe_list>cpe"`
+	} `xml:"metadata>advisory"`
+	Criteria ovalCriteria `xml:"criteria"`
+}
+
+type ovalCriteria struct {
+	Criteria   []ovalCriteria `xml:"criteria"`
+	Criterions []struct {
+		TestRef string `xml:"test_ref,attr"`
+	} `xml:"criterion"`
+}
+
+func (c *ovalCriteria) testRefs() []string {
+	res := []string{}
+	for i := range c.Criterions {
+		res = append(res, c.Criterions[i].TestRef)
+	}
+	for i := range c.Criteria {
+		res = append(res, c.Criteria[i].testRefs()...)
+	}
+	return res
+}
+
+type ovalTest struct {
+	XMLName xml.Name
+	ID      string `xml:"id,attr"`
+	Object  struct {
+		Ref string `xml:"object_ref,attr"`
+	} `xml:"object"`
+	State struct {
+		Ref string `xml:"state_ref,attr"`
+	} `xml:"state"`
+}
+
+type ovalObject struct {
+	ID   string `xml:"id,attr"`
+	Name struct {
+		Value  string `xml:",chardata"`
+		VarRef string `xml:"var_ref,attr"`
+	} `xml:"name"`
+}
+
+type ovalState struct {
+	ID  string `xml:"id,attr"`
+	Evr struct {
+		Value     string `xml:",chardata"`
+		Operation string `xml:"operation,attr"`
+	} `xml:"evr"`
+}
+
+type ovalVariable struct {
+	ID     string   `xml:"id,attr"`
+	Values []string `xml:"value"`
+}
+
+// parseOval adds one advisory per OVAL definition. Only tests that compare
+// a package against a fixed version are considered; the boolean structure of
+// the criteria is not evaluated, since the platform checks it contains are
+// covered by the affected CPEs and by using the feed for the right release.
+func parseOval(db *Database, data []byte) error {
+	var doc ovalDefinitions
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return err
+	}
+	db.touch(parseTime(doc.Generator.Timestamp))
+
+	tests := make(map[string]*ovalTest, len(doc.Tests.Items))
+	for i := range doc.Tests.Items {
+		tests[doc.Tests.Items[i].ID] = &doc.Tests.Items[i]
+	}
+	objects := make(map[string]*ovalObject, len(doc.Objects.Items))
+	for i := range doc.Objects.Items {
+		objects[doc.Objects.Items[i].ID] = &doc.Objects.Items[i]
+	}
+	states := make(map[string]*ovalState, len(doc.States.Items))
+	for i := range doc.States.Items {
+		states[doc.States.Items[i].ID] = &doc.States.Items[i]
+	}
+	variables := make(map[string][]string, len(doc.Variables.Items))
+	for i := range doc.Variables.Items {
+		variables[doc.Variables.Items[i].ID] = doc.Variables.Items[i].Values
+	}
+
+	for i := range doc.Definitions {
+		def := &doc.Definitions[i]
+		if def.Class == "inventory" {
+			continue
+		}
+
+		a := def.advisory()
+		for _, ref := range def.Criteria.testRefs() {
+			test, ok := tests[ref]
+			if !ok {
+				continue
+			}
+			format := ""
+			switch test.XMLName.Local {
+			case "rpminfo_test":
+				format = "rpm"
+			case "dpkginfo_test":
+				format = "deb"
+			default:
+				continue
+			}
+
+			state, ok := states[test.State.Ref]
+			if !ok || state.Evr.Operation != "less than" || state.Evr.Value == "" {
+				continue
+			}
+			object, ok := objects[test.Object.Ref]
+			if !ok {
+				continue
+			}
+			names := []string{strings.TrimSpace(object.Name.Value)}
+			if object.Name.VarRef != "" {
+				names = variables[object.Name.VarRef]
+			}
+
+			for _, name := range names {
+				if name == "" {
+					continue
+				}
+				a.Affected = append(a.Affected, Affected{
+					Name:      name,
+					Format:    format,
+					Platforms: def.Advisory.AffectedCpes,
+					Ranges:    []Range{{Events: []Event{{Introduced: "0"}, {Fixed: strings.TrimSpace(state.Evr.Value)}}}},
+				})
+			}
+		}
+
+		if len(a.Affected) > 0 {
+			db.add(a)
+		}
+	}
+	return nil
+}
+
+func (def *ovalDefinition) advisory() *Advisory {
+	a := &Advisory{
+		Title:       strings.TrimSpace(def.Title),
+		Description: strings.TrimSpace(def.Description),
+		Published:   parseTime(def.Advisory.Issued.Date),
+		Modified:    parseTime(def.Advisory.Updated.Date),
+	}
+	if a.Modified.IsZero() {
+		a.Modified = a.Published
+	}
+
+	for _, ref := range def.References {
+		if ref.Source == "CVE" {
+			if !slices.Contains(a.Cves, ref.RefID) {
+				a.Cves = append(a.Cves, ref.RefID)
+			}
+		} else if a.ID == "" {
+			a.ID = ref.RefID
+		}
+	}
+	for _, c := range def.Advisory.Cves {
+		id := strings.TrimSpace(c.ID)
+		if id != "" && !slices.Contains(a.Cves, id) {
+			a.Cves = append(a.Cves, id)
+		}
+		for _, vector := range []string{c.Cvss3, c.CvssVector, c.Cvss2} {
+			if vector != "" {
+				a.Cvss = append(a.Cvss, vector)
+				break
+			}
+		}
+	}
+
+	// definitions that describe a single CVE are reported as that CVE
+	if a.ID == "" && len(a.Cves) == 1 {
+		a.ID = a.Cves[0]
+		a.Cves = nil
+	}
+	if a.ID == "" {
+		a.ID = def.ID
+	}
+	if a.Title == "" {
+		a.Title = a.ID
+	}
+	return a
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vulndb
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// secDb is the security database format used by Alpine and Wolfi, see
+// https://secdb.alpinelinux.org/
+type secDb struct {
+	DistroVersion string `json:"distroversion"`
+	RepoName      string `json:"reponame"`
+	Packages      []struct {
+		Pkg struct {
+			Name string `json:"name"`
+			// Secfixes maps the fixed version to the CVEs it fixes
+			Secfixes map[string][]string `json:"secfixes"`
+		} `json:"pkg"`
+	} `json:"packages"`
+}
+
+// parseSecDb adds one advisory per CVE, which lists every package that
+// fixed it in the given distribution release
+func parseSecDb(db *Database, data []byte) error {
+	var sdb secDb
+	if err := json.Unmarshal(data, &sdb); err != nil {
+		return err
+	}
+
+	ecosystem := "Alpine"
+	if sdb.DistroVersion != "" {
+		ecosystem += ":" + sdb.DistroVersion
+	}
+
+	byCve := map[string]*Advisory{}
+	for _, p := range sdb.Packages {
+		for fixed, ids := range p.Pkg.Secfixes {
+			// version 0 marks CVEs that never affected the distribution package
+			if fixed == "0" {
+				continue
+			}
+			for _, id := range ids {
+				// entries may carry additional ids, e.g. "CVE-2023-0464 GHSA-..."
+				fields := strings.Fields(id)
+				if len(fields) == 0 {
+					continue
+				}
+				id = fields[0]
+				a, ok := byCve[id]
+				if !ok {
+					a = &Advisory{ID: id, Title: id}
+					byCve[id] = a
+				}
+				a.Affected = append(a.Affected, Affected{
+					Ecosystem: ecosystem,
+					Name:      p.Pkg.Name,
+					Format:    "apk",
+					Ranges:    []Range{{Events: []Event{{Introduced: "0"}, {Fixed: fixed}}}},
+				})
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(byCve))
+	for id := range byCve {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		db.add(byCve[id])
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package vulndb matches installed packages against a local vulnerability
+// database so that vulnerability reports can be generated without access to
+// Mondoo Platform. The database is a directory, tarball or zip archive with
+// any mix of OSV JSON records, OVAL definitions and Alpine secdb feeds.
+package vulndb
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Advisory is a single vulnerability record from any of the supported feeds.
+// Records whose ID is a CVE are reported as CVEs, all others as advisories
+// that reference CVEs.
+type Advisory struct {
+	ID          string
+	Title       string
+	Description string
+	Published   time.Time
+	Modified    time.Time
+	// Cves referenced by this advisory
+	Cves []string
+	// Cvss vectors, optionally prefixed with their score (e.g. 7.5/CVSS:3.1/...)
+	Cvss     []string
+	Affected []Affected
+}
+
+// IsCve returns true if the advisory describes a CVE itself
+func (a *Advisory) IsCve() bool {
+	return strings.HasPrefix(a.ID, "CVE-")
+}
+
+// Affected describes a package that is affected by an advisory. A package is
+// identified by its ecosystem and name, its package URL, CPEs or, for OVAL
+// definitions, by its package format and the platforms the definition applies to.
+type Affected struct {
+	// Ecosystem in OSV notation, e.g. Debian:12, Alpine:v3.18 or npm
+	Ecosystem string
+	Name      string
+	// Purl without version and qualifiers
+	Purl string
+	// Cpes in URI or formatted string binding
+	Cpes []string
+	// Format is the version scheme (deb, rpm, apk), it overrides the ecosystem
+	Format string
+	// Platforms are the platform CPEs the entry is restricted to
+	Platforms []string
+	Ranges    []Range
+	// Versions that are explicitly listed as affected
+	Versions []string
+}
+
+// Range is a list of OSV-style events. A version is affected once it reaches
+// Introduced until it reaches Fixed or exceeds LastAffected.
+type Range struct {
+	Events []Event
+}
+
+type Event struct {
+	Introduced   string
+	Fixed        string
+	LastAffected string
+}
+
+// Database is an in-memory vulnerability database
+type Database struct {
+	Advisories []*Advisory
+	// Updated is the time of the most recent change in the database
+	Updated time.Time
+
+	indexOnce sync.Once
+	cves      map[string]*Advisory
+	affected  map[string][]*indexEntry
+}
+
+func (db *Database) add(advisories ...*Advisory) {
+	for _, a := range advisories {
+		if a.Modified.After(db.Updated) {
+			db.Updated = a.Modified
+		}
+		db.Advisories = append(db.Advisories, a)
+	}
+}
+
+func (db *Database) touch(t time.Time) {
+	if t.After(db.Updated) {
+		db.Updated = t
+	}
+}
+
+// Cve returns the record for a CVE, if the database has one
+func (db *Database) Cve(id string) *Advisory {
+	db.ensureIndex()
+	return db.cves[id]
+}
+
+// ensureIndex builds the lookup tables for CVEs and affected packages. It is
+// called by Load before a database is shared, so that concurrent readers
+// never modify it.
+func (db *Database) ensureIndex() {
+	db.indexOnce.Do(func() {
+		db.cves = map[string]*Advisory{}
+		db.affected = map[string][]*indexEntry{}
+		for i, a := range db.Advisories {
+			if a.IsCve() {
+				// several feeds may describe the same CVE, keep the one with most detail
+				if cur, ok := db.cves[a.ID]; !ok || len(cur.Cvss) < len(a.Cvss) || cur.Description == "" {
+					db.cves[a.ID] = a
+				}
+			}
+			for j := range a.Affected {
+				entry := newIndexEntry(i, j, a)
+				for _, key := range entry.keys() {
+					db.affected[key] = append(db.affected[key], entry)
+				}
+			}
+		}
+	})
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[string]cachedDatabase{}
+)
+
+type cachedDatabase struct {
+	fingerprint string
+	db          *Database
+}
+
+// Load reads a vulnerability database from a directory, a tarball (.tar,
+// .tar.gz, .tgz), a zip archive or a single feed file. Databases are cached
+// per path until any of their files is modified.
+func Load(path string) (*Database, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := os.Stat(abs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open vulnerability database")
+	}
+	fp, err := fingerprint(abs, stat)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open vulnerability database")
+	}
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if c, ok := cache[abs]; ok && c.fingerprint == fp {
+		return c.db, nil
+	}
+
+	db := &Database{}
+	switch {
+	case stat.IsDir():
+		err = loadDir(db, abs)
+	case isTarball(abs):
+		err = loadTarball(db, abs)
+	case strings.HasSuffix(abs, ".zip"):
+		err = loadZip(db, abs)
+	default:
+		var data []byte
+		data, err = os.ReadFile(abs)
+		if err == nil {
+			err = parseFile(db, abs, data)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	db.ensureIndex()
+
+	log.Debug().Str("path", abs).Int("advisories", len(db.Advisories)).Msg("loaded local vulnerability database")
+	cache[abs] = cachedDatabase{fingerprint: fp, db: db}
+	return db, nil
+}
+
+// fingerprint identifies the state of a database by the size and modification
+// time of its files. The modification time of a directory only changes when
+// entries are added or removed, not when a file in it is updated.
+func fingerprint(path string, stat fs.FileInfo) (string, error) {
+	hash := sha256.New()
+	add := func(name string, info fs.FileInfo) {
+		fmt.Fprintf(hash, "%s\x00%d\x00%d\n", name, info.Size(), info.ModTime().UnixNano())
+	}
+
+	if !stat.IsDir() {
+		add(path, stat)
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	err := filepath.WalkDir(path, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isFeedFile(name) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		add(name, info)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar") || strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func loadDir(db *Database, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isFeedFile(path) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return parseFile(db, path, data)
+	})
+}
+
+func loadTarball(db *Database, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if !strings.HasSuffix(path, ".tar") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return errors.Wrap(err, "could not decompress vulnerability database")
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not read vulnerability database")
+		}
+		if hdr.Typeflag != tar.TypeReg || !isFeedFile(hdr.Name) {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := parseFile(db, hdr.Name, data); err != nil {
+			return err
+		}
+	}
+}
+
+func loadZip(db *Database, path string) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return errors.Wrap(err, "could not read vulnerability database")
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !isFeedFile(f.Name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+		if err := parseFile(db, f.Name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isFeedFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".json" || ext == ".xml"
+}
+
+// parseFile detects the feed type of a file and adds its records
+func parseFile(db *Database, path string, data []byte) error {
+	var err error
+	switch {
+	case filepath.Ext(path) == ".xml":
+		err = parseOval(db, data)
+	case isSecDb(data):
+		err = parseSecDb(db, data)
+	default:
+		err = parseOsv(db, data)
+	}
+	return errors.Wrap(err, "could not parse "+path)
+}
+
+func isSecDb(data []byte) bool {
+	return bytes.Contains(data, []byte(`"secfixes"`)) && bytes.Contains(data, []byte(`"distroversion"`))
+}
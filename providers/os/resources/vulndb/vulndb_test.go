@@ -0,0 +1,202 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vulndb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ids(advisories []*Advisory) []string {
+	res := make([]string, len(advisories))
+	for i := range advisories {
+		res[i] = advisories[i].ID
+	}
+	return res
+}
+
+func TestLoad(t *testing.T) {
+	for _, path := range []string{"testdata/db", "testdata/db.tar.gz"} {
+		t.Run(path, func(t *testing.T) {
+			db, err := Load(path)
+			require.NoError(t, err)
+
+			// the withdrawn record and the CVE that never affected alpine are skipped
+			assert.ElementsMatch(t, []string{
+				"CVE-2022-48174",
+				"CVE-2023-2650",
+				"CVE-2023-31102",
+				"DSA-5417-1",
+				"GHSA-p6mc-m468-83gw",
+				"RHSA-2023:3722",
+			}, ids(db.Advisories))
+			assert.Equal(t, time.Date(2023, 11, 14, 17, 0, 0, 0, time.UTC), db.Updated)
+		})
+	}
+}
+
+func TestLoad_Oval(t *testing.T) {
+	db, err := Load("testdata/db/oval/rhel-8.oval.xml")
+	require.NoError(t, err)
+	require.Len(t, db.Advisories, 1)
+
+	a := db.Advisories[0]
+	assert.Equal(t, "RHSA-2023:3722", a.ID)
+	assert.Equal(t, "RHSA-2023:3722: openssl security and bug fix update (Moderate)", a.Title)
+	assert.Equal(t, []string{"CVE-2023-0464"}, a.Cves)
+	assert.Equal(t, []string{"5.9/CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:N/A:H"}, a.Cvss)
+	assert.Equal(t, time.Date(2023, 6, 19, 0, 0, 0, 0, time.UTC), a.Published)
+
+	// the release and signature checks are not package versions
+	require.Len(t, a.Affected, 1)
+	assert.Equal(t, "openssl", a.Affected[0].Name)
+	assert.Equal(t, "rpm", a.Affected[0].Format)
+	assert.Equal(t, []string{"cpe:/o:redhat:enterprise_linux:8"}, a.Affected[0].Platforms)
+	assert.Equal(t, "1:1.1.1k-9.el8_7", a.Affected[0].Ranges[0].Events[1].Fixed)
+}
+
+func TestMatch_Debian(t *testing.T) {
+	db, err := Load("testdata/db")
+	require.NoError(t, err)
+
+	platform := Platform{Name: "debian", Version: "12.0"}
+	report := db.Match(platform, []Package{
+		{Name: "libssl3", Version: "3.0.8-1", Format: "deb", Origin: "openssl", Purl: "pkg:deb/debian/libssl3@3.0.8-1?arch=amd64&distro=debian-12.0", Cpes: []string{"cpe:2.3:a:openssl:openssl:3.0.8-1:*:*:*:*:*:*:*"}},
+		{Name: "openssl", Version: "3.0.9-1", Format: "deb", Purl: "pkg:deb/debian/openssl@3.0.9-1?arch=amd64&distro=debian-12.0"},
+		{Name: "bash", Version: "5.2.15-2+b2", Format: "deb"},
+	})
+
+	assert.Equal(t, []string{"DSA-5417-1"}, ids(report.Advisories))
+	assert.Equal(t, []string{"CVE-2023-0464", "CVE-2023-2650"}, ids(report.Cves))
+	require.Len(t, report.Packages, 1)
+	assert.Equal(t, "libssl3", report.Packages[0].Name)
+	assert.Equal(t, "3.0.9-1", report.Packages[0].Available)
+
+	// CVE details come from the CVE record, if the database has one
+	assert.Equal(t, "Possible DoS translating ASN.1 object identifiers", report.Cves[1].Title)
+	assert.Equal(t, "CVE-2023-0464", report.Cves[0].Title)
+
+	// advisories for other releases do not apply
+	report = db.Match(Platform{Name: "debian", Version: "11.7"}, []Package{
+		{Name: "openssl", Version: "1.1.1n-0+deb11u4", Format: "deb", Purl: "pkg:deb/debian/openssl@1.1.1n-0%2Bdeb11u4?arch=amd64&distro=debian-11.7"},
+	})
+	assert.Empty(t, report.Packages)
+}
+
+func TestMatch_Oval(t *testing.T) {
+	db, err := Load("testdata/db")
+	require.NoError(t, err)
+
+	pkgs := []Package{
+		{Name: "openssl", Version: "1.1.1k-7.el8_6", Epoch: "1", Format: "rpm"},
+		{Name: "redhat-release", Version: "8.6-0.1.el8", Format: "rpm"},
+	}
+	report := db.Match(Platform{Name: "redhat", Version: "8.6", Cpes: []string{"cpe:2.3:o:redhat:redhat_enterprise_linux:8.6:*:*:*:*:*:*:*"}}, pkgs)
+	assert.Equal(t, []string{"RHSA-2023:3722"}, ids(report.Advisories))
+	require.Len(t, report.Packages, 1)
+	assert.Equal(t, "openssl", report.Packages[0].Name)
+	assert.Equal(t, "1:1.1.1k-9.el8_7", report.Packages[0].Available)
+
+	// definitions are restricted to the listed platforms
+	report = db.Match(Platform{Name: "redhat", Version: "9.2", Cpes: []string{"cpe:2.3:o:redhat:redhat_enterprise_linux:9.2:*:*:*:*:*:*:*"}}, pkgs)
+	assert.Empty(t, report.Advisories)
+
+	// fixed packages are not affected
+	report = db.Match(Platform{Name: "redhat", Version: "8.8"}, []Package{
+		{Name: "openssl", Version: "1.1.1k-9.el8_7", Epoch: "1", Format: "rpm"},
+	})
+	assert.Empty(t, report.Packages)
+}
+
+func TestMatch_SecDb(t *testing.T) {
+	db, err := Load("testdata/db")
+	require.NoError(t, err)
+
+	report := db.Match(Platform{Name: "alpine", Version: "3.18.4"}, []Package{
+		{Name: "busybox", Version: "1.36.1-r0", Format: "apk"},
+	})
+	assert.Empty(t, report.Advisories)
+	assert.Equal(t, []string{"CVE-2022-48174"}, ids(report.Cves))
+	require.Len(t, report.Packages, 1)
+	assert.Equal(t, "1.36.1-r2", report.Packages[0].Available)
+
+	report = db.Match(Platform{Name: "alpine", Version: "3.19.0"}, []Package{
+		{Name: "busybox", Version: "1.36.1-r0", Format: "apk"},
+	})
+	assert.Empty(t, report.Packages)
+}
+
+func TestMatch_Languages(t *testing.T) {
+	db, err := Load("testdata/db")
+	require.NoError(t, err)
+
+	report := db.Match(Platform{Name: "windows", Version: "10.0.20348"}, []Package{
+		{Name: "lodash", Version: "4.17.15", Format: "npm", Purl: "pkg:npm/lodash@4.17.15"},
+		{Name: "lodash", Version: "4.17.21", Format: "npm", Purl: "pkg:npm/lodash@4.17.21"},
+		{Name: "7-Zip 22.01 (x64)", Version: "22.01", Format: "windows", Cpes: []string{"cpe:2.3:a:7-zip:7-zip:22.01:*:*:*:*:*:*:*"}},
+	})
+	assert.Equal(t, []string{"GHSA-p6mc-m468-83gw"}, ids(report.Advisories))
+	assert.Equal(t, []string{"CVE-2020-8203", "CVE-2023-31102"}, ids(report.Cves))
+	require.Len(t, report.Packages, 2)
+	assert.Equal(t, "4.17.15", report.Packages[0].Version)
+	assert.Equal(t, "4.17.19", report.Packages[0].Available)
+	assert.Equal(t, "23.00", report.Packages[1].Available)
+
+	// the CVE referenced by the advisory inherits its score
+	assert.Equal(t, []string{"CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:H/A:H"}, report.Cves[0].Cvss)
+}
+
+func TestLoad_Cache(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "osv"), 0o755))
+	data, err := os.ReadFile("testdata/db/osv/CVE-2023-2650.json")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "osv", "cve.json")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	db, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CVE-2023-2650"}, ids(db.Advisories))
+
+	cached, err := Load(dir)
+	require.NoError(t, err)
+	assert.Same(t, db, cached)
+
+	// updating a file in a subdirectory does not change the mtime of the
+	// database directory
+	data, err = os.ReadFile("testdata/db/osv/CVE-2023-31102.json")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	db, err = Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CVE-2023-31102"}, ids(db.Advisories))
+}
+
+func TestMatch_Concurrent(t *testing.T) {
+	db, err := Load("testdata/db")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report := db.Match(Platform{Name: "alpine", Version: "3.18.4"}, []Package{
+				{Name: "busybox", Version: "1.36.1-r0", Format: "apk"},
+			})
+			assert.Equal(t, []string{"CVE-2022-48174"}, ids(report.Cves))
+			assert.NotNil(t, db.Cve("CVE-2023-2650"))
+		}()
+	}
+	wg.Wait()
+}
@@ -6,6 +6,7 @@ package resources
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -14,7 +15,12 @@ import (
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/resources"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/upstream/gql"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/upstream/mvd/cvss"
 	"go.mondoo.com/mql/v13/providers/os/connection/shared"
+	"go.mondoo.com/mql/v13/providers/os/resources/cpe"
+	"go.mondoo.com/mql/v13/providers/os/resources/vulndb"
+	"go.mondoo.com/mql/v13/sbom/vex"
+	"go.mondoo.com/mql/v13/types"
 )
 
 type mqlVulnmgmtInternal struct {
@@ -22,6 +28,17 @@ type mqlVulnmgmtInternal struct {
 }
 
 func (v *mqlVulnmgmt) lastAssessment() (*time.Time, error) {
+	if path := localVulnDbPath(v.MqlRuntime); path != "" {
+		db, err := vulndb.Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if db.Updated.IsZero() {
+			return &llx.NeverFutureTime, nil
+		}
+		return &db.Updated, nil
+	}
+
 	mcc := v.MqlRuntime.Upstream
 	if mcc == nil || mcc.ApiEndpoint == "" {
 		return nil, resources.MissingUpstreamError{}
@@ -74,6 +91,13 @@ func (v *mqlVulnmgmt) cves() ([]any, error) {
 	return nil, v.populateData()
 }
 
+func (v *mqlVulnmgmt) suppressedCves() ([]any, error) {
+	// see command resource for reference
+	// we ignore the return value because everything is set in populateData
+	// `plugin.StateIsSet` is used to indicate that the data is available
+	return nil, v.populateData()
+}
+
 func (v *mqlVulnmgmt) advisories() ([]any, error) {
 	// see command resource for reference
 	// we ignore the return value because everything is set in populateData
@@ -96,11 +120,29 @@ func (v *mqlVulnmgmt) stats() (*mqlAuditCvss, error) {
 }
 
 func (v *mqlVulnmgmt) populateData() error {
-	vulnReport, err := v.getReport()
+	vulnReport, cvePackages, err := v.getReport()
+	if err != nil {
+		return err
+	}
+	vexDoc, products, err := v.vexDocument()
 	if err != nil {
 		return err
 	}
 
+	statements := map[string]*vex.Statement{}
+	suppressed := map[string]struct{}{}
+	for _, c := range vulnReport.Cves {
+		statement := vexDoc.Lookup(c.Id, products.affectedBy(cvePackages[c.Id])...)
+		if statement == nil {
+			continue
+		}
+		statements[c.Id] = statement
+		if statement.Status.Suppresses() {
+			suppressed[c.Id] = struct{}{}
+		}
+	}
+	vulnReport = withoutSuppressed(vulnReport, cvePackages, suppressed)
+
 	mqlVulAdvisories := make([]any, len(vulnReport.Advisories))
 	for i, a := range vulnReport.Advisories {
 		var parsedPublished *time.Time
@@ -141,8 +183,9 @@ func (v *mqlVulnmgmt) populateData() error {
 		mqlVulAdvisories[i] = mqlVulnAdvisory
 	}
 
-	mqlVulnCves := make([]any, len(vulnReport.Cves))
-	for i, c := range vulnReport.Cves {
+	mqlVulnCves := []any{}
+	mqlSuppressedCves := []any{}
+	for _, c := range vulnReport.Cves {
 		var parsedPublished *time.Time
 		var parsedModified *time.Time
 		var err error
@@ -167,6 +210,24 @@ func (v *mqlVulnmgmt) populateData() error {
 		if err != nil {
 			return err
 		}
+		statement := statements[c.Id]
+		vexData := llx.NilData
+		if statement != nil {
+			mqlVex, err := v.MqlRuntime.CreateSharedResource("vulnerability.exchange", map[string]*llx.RawData{
+				"id":              llx.StringData(c.Id),
+				"source":          llx.StringData(statement.Source),
+				"status":          llx.StringData(string(statement.Status)),
+				"justification":   llx.StringData(statement.Justification),
+				"impactStatement": llx.StringData(statement.ImpactStatement),
+				"actionStatement": llx.StringData(statement.ActionStatement),
+				"products":        llx.ArrayData(llx.TArr2Raw(statement.Products), types.String),
+				"timestamp":       llx.TimeData(statement.Timestamp),
+			})
+			if err != nil {
+				return err
+			}
+			vexData = llx.ResourceData(mqlVex, "vulnerability.exchange")
+		}
 		mqlVulnCve, err := CreateResource(v.MqlRuntime, "vuln.cve", map[string]*llx.RawData{
 			"id":         llx.StringData(c.Id),
 			"worstScore": llx.ResourceData(cvssScore, "audit.cvss"),
@@ -174,11 +235,16 @@ func (v *mqlVulnmgmt) populateData() error {
 			"summary":    llx.StringData(c.Summary),
 			"published":  llx.TimeDataPtr(parsedPublished),
 			"modified":   llx.TimeDataPtr(parsedModified),
+			"vex":        vexData,
 		})
 		if err != nil {
 			return err
 		}
-		mqlVulnCves[i] = mqlVulnCve
+		if statement != nil && statement.Status.Suppresses() {
+			mqlSuppressedCves = append(mqlSuppressedCves, mqlVulnCve)
+			continue
+		}
+		mqlVulnCves = append(mqlVulnCves, mqlVulnCve)
 	}
 
 	mqlVulnPackages := make([]any, len(vulnReport.Packages))
@@ -208,13 +274,28 @@ func (v *mqlVulnmgmt) populateData() error {
 
 	v.Advisories = plugin.TValue[[]any]{Data: mqlVulAdvisories, State: plugin.StateIsSet}
 	v.Cves = plugin.TValue[[]any]{Data: mqlVulnCves, State: plugin.StateIsSet}
+	v.SuppressedCves = plugin.TValue[[]any]{Data: mqlSuppressedCves, State: plugin.StateIsSet}
 	v.Packages = plugin.TValue[[]any]{Data: mqlVulnPackages, State: plugin.StateIsSet}
 	v.Stats = plugin.TValue[*mqlAuditCvss]{Data: statsCvssScore, State: plugin.StateIsSet}
 
 	return nil
 }
 
-func (v *mqlVulnmgmt) getReport() (*gql.VulnReport, error) {
+// getReport returns the vulnerability report of the asset, along with the
+// names of the packages affected by each CVE
+func (v *mqlVulnmgmt) getReport() (*gql.VulnReport, map[string][]string, error) {
+	if path := localVulnDbPath(v.MqlRuntime); path != "" {
+		return v.getLocalReport(path)
+	}
+
+	report, err := v.getUpstreamReport()
+	if err != nil {
+		return nil, nil, err
+	}
+	return report, cvePackagesFromAdvisories(report), nil
+}
+
+func (v *mqlVulnmgmt) getUpstreamReport() (*gql.VulnReport, error) {
 	mcc := v.MqlRuntime.Upstream
 	if mcc == nil || mcc.ApiEndpoint == "" {
 		return nil, resources.MissingUpstreamError{}
@@ -304,6 +385,378 @@ func (v *mqlVulnmgmt) getIncognitoReport(mondooClient *gql.MondooClient) (*gql.V
 	return gqlVulnReport, nil
 }
 
+// localVulnDbPath returns the local vulnerability database configured for
+// the asset, if there is one
+func localVulnDbPath(runtime *plugin.Runtime) string {
+	conn, ok := runtime.Connection.(shared.Connection)
+	if !ok || conn.Asset() == nil {
+		return ""
+	}
+	for _, c := range conn.Asset().Connections {
+		if path := c.Options[shared.VulnDbOption]; path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+// vexProducts holds the identifiers of the installed products that VEX
+// statements can refer to
+type vexProducts struct {
+	platform []string
+	// packages maps package names to their package URLs and CPEs
+	packages map[string][]string
+}
+
+// affectedBy returns the identifiers of the given packages. CVEs that the
+// report does not attribute to any package are attributed to the platform.
+func (p *vexProducts) affectedBy(packages []string) []string {
+	if p == nil {
+		return nil
+	}
+	if len(packages) == 0 {
+		return p.platform
+	}
+	res := []string{}
+	for _, name := range packages {
+		res = append(res, p.packages[name]...)
+	}
+	return res
+}
+
+// vexDocument loads the VEX documents configured for the asset, along with
+// the identifiers of the installed products that statements can refer to
+func (v *mqlVulnmgmt) vexDocument() (*vex.Document, *vexProducts, error) {
+	conn, ok := v.MqlRuntime.Connection.(shared.Connection)
+	if !ok || conn.Asset() == nil {
+		return nil, nil, nil
+	}
+	paths := []string{}
+	for _, c := range conn.Asset().Connections {
+		paths = append(paths, vex.SplitPaths(c.Options[shared.VexOption])...)
+	}
+	if len(paths) == 0 {
+		return nil, nil, nil
+	}
+	doc, err := vex.Load(paths...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products := &vexProducts{packages: map[string][]string{}}
+	if platform := conn.Asset().Platform; platform != nil {
+		workstation := platform.Labels["windows.mondoo.com/product-type"] == "1"
+		if platformCpe, ok := cpe.PlatformCPE(platform.Name, platform.Version, workstation); ok {
+			products.platform = append(products.platform, platformCpe)
+		}
+	}
+	pkgsRes, err := CreateResource(v.MqlRuntime, "packages", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkgsList := pkgsRes.(*mqlPackages).GetList()
+	if pkgsList.Error != nil {
+		return nil, nil, pkgsList.Error
+	}
+	for _, p := range pkgsList.Data {
+		mqlPkg := p.(*mqlPackage)
+		name := mqlPkg.Name.Data
+		if mqlPkg.Purl.Data != "" {
+			products.packages[name] = append(products.packages[name], mqlPkg.Purl.Data)
+		}
+		for _, c := range mqlPkg.GetCpes().Data {
+			products.packages[name] = append(products.packages[name], c.(plugin.Resource).MqlID())
+		}
+	}
+
+	return doc, products, nil
+}
+
+// cvePackagesFromAdvisories returns the names of the packages affected by
+// each CVE, as far as the advisories of the report link them
+func cvePackagesFromAdvisories(report *gql.VulnReport) map[string][]string {
+	res := map[string][]string{}
+	for _, a := range report.Advisories {
+		for _, c := range a.Cves {
+			for _, p := range a.AffectedPackages {
+				if !slices.Contains(res[c.Id], p.Name) {
+					res[c.Id] = append(res[c.Id], p.Name)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// withoutSuppressed removes the advisories and packages whose CVEs are all
+// suppressed by VEX statements and recomputes the statistics of the report.
+// The suppressed CVEs stay in the report, so they can still be listed.
+func withoutSuppressed(report *gql.VulnReport, cvePackages map[string][]string, suppressed map[string]struct{}) *gql.VulnReport {
+	if len(suppressed) == 0 {
+		return report
+	}
+	isSuppressed := func(id string) bool {
+		_, ok := suppressed[id]
+		return ok
+	}
+
+	res := &gql.VulnReport{
+		AssetMrn: report.AssetMrn,
+		Cves:     report.Cves,
+	}
+	for _, a := range report.Advisories {
+		if len(a.Cves) > 0 && !slices.ContainsFunc(a.Cves, func(c struct{ gql.Cve }) bool { return !isSuppressed(c.Id) }) {
+			continue
+		}
+		res.Advisories = append(res.Advisories, a)
+	}
+
+	packageCves := map[string][]string{}
+	for id, names := range cvePackages {
+		for _, name := range names {
+			packageCves[name] = append(packageCves[name], id)
+		}
+	}
+	for _, p := range report.Packages {
+		cves := packageCves[p.Name]
+		if len(cves) > 0 && !slices.ContainsFunc(cves, func(id string) bool { return !isSuppressed(id) }) {
+			continue
+		}
+		res.Packages = append(res.Packages, p)
+	}
+
+	res.Stats = computeStats(res, suppressed)
+	// exploits cannot be attributed to CVEs from the report
+	if report.Stats != nil {
+		res.Stats.Exploits = report.Stats.Exploits
+	}
+	return res
+}
+
+// computeStats computes the score and severity counts of a report. The
+// suppressed CVEs are not counted.
+func computeStats(report *gql.VulnReport, suppressed map[string]struct{}) *gql.ReportStats {
+	stats := &gql.ReportStats{}
+	none, _ := cvss.New(cvss.NoneVector)
+	stats.Score.Vector = none.Vector
+	worst := func(value int, vector string) {
+		if value > stats.Score.Value {
+			stats.Score.Value = value
+			stats.Score.Vector = vector
+		}
+	}
+
+	cveScores := []int{}
+	for _, c := range report.Cves {
+		if _, ok := suppressed[c.Id]; ok {
+			continue
+		}
+		cveScores = append(cveScores, c.CvssScore.Value)
+		worst(c.CvssScore.Value, c.CvssScore.Vector)
+	}
+	stats.Cves.Total = len(cveScores)
+	stats.Cves.Critical, stats.Cves.High, stats.Cves.Medium, stats.Cves.Low, stats.Cves.None = severityCounts(cveScores)
+
+	advisoryScores := []int{}
+	for _, a := range report.Advisories {
+		advisoryScores = append(advisoryScores, a.CvssScore.Value)
+		worst(a.CvssScore.Value, a.CvssScore.Vector)
+	}
+	stats.Advisories.Total = len(advisoryScores)
+	stats.Advisories.Critical, stats.Advisories.High, stats.Advisories.Medium, stats.Advisories.Low, stats.Advisories.None = severityCounts(advisoryScores)
+
+	packageScores := []int{}
+	for _, p := range report.Packages {
+		packageScores = append(packageScores, p.Score.Value)
+	}
+	stats.Packages.Total = len(packageScores)
+	stats.Packages.Affected = len(packageScores)
+	stats.Packages.Critical, stats.Packages.High, stats.Packages.Medium, stats.Packages.Low, stats.Packages.None = severityCounts(packageScores)
+	return stats
+}
+
+// severityCounts counts the scores per rating. Reports store scores
+// multiplied by ten.
+func severityCounts(scores []int) (critical, high, medium, low, none int) {
+	for _, score := range scores {
+		switch cvss.Rating(float32(score) / 10) {
+		case cvss.Critical:
+			critical++
+		case cvss.High:
+			high++
+		case cvss.Medium:
+			medium++
+		case cvss.Low:
+			low++
+		default:
+			none++
+		}
+	}
+	return critical, high, medium, low, none
+}
+
+// getLocalReport matches the installed packages against a local vulnerability
+// database and converts the result into the report format of Mondoo Platform
+func (v *mqlVulnmgmt) getLocalReport(path string) (*gql.VulnReport, map[string][]string, error) {
+	db, err := vulndb.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn := v.MqlRuntime.Connection.(shared.Connection)
+	platform := conn.Asset().Platform
+	if platform == nil {
+		return nil, nil, errors.New("cannot match vulnerabilities, no platform information available")
+	}
+	workstation := platform.Labels["windows.mondoo.com/product-type"] == "1"
+	dbPlatform := vulndb.Platform{Name: platform.Name, Version: platform.Version}
+	if platformCpe, ok := cpe.PlatformCPE(platform.Name, platform.Version, workstation); ok {
+		dbPlatform.Cpes = []string{platformCpe}
+	}
+
+	pkgsRes, err := CreateResource(v.MqlRuntime, "packages", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkgsList := pkgsRes.(*mqlPackages).GetList()
+	if pkgsList.Error != nil {
+		return nil, nil, pkgsList.Error
+	}
+
+	dbPackages := make([]vulndb.Package, len(pkgsList.Data))
+	for i, p := range pkgsList.Data {
+		mqlPkg := p.(*mqlPackage)
+		cpes := []string{}
+		for _, c := range mqlPkg.GetCpes().Data {
+			// the id of a cpe resource is its uri
+			cpes = append(cpes, c.(plugin.Resource).MqlID())
+		}
+		dbPackages[i] = vulndb.Package{
+			Name:    mqlPkg.Name.Data,
+			Version: mqlPkg.Version.Data,
+			Epoch:   mqlPkg.Epoch.Data,
+			Arch:    mqlPkg.Arch.Data,
+			Format:  mqlPkg.Format.Data,
+			Origin:  mqlPkg.GetOrigin().Data,
+			Purl:    mqlPkg.Purl.Data,
+			Cpes:    cpes,
+		}
+	}
+
+	report := db.Match(dbPlatform, dbPackages)
+	log.Debug().Str("path", path).Int("advisories", len(report.Advisories)).Int("cves", len(report.Cves)).
+		Int("packages", len(report.Packages)).Msg("matched packages against local vulnerability database")
+
+	res := &gql.VulnReport{
+		Advisories: make([]*gql.Advisory, len(report.Advisories)),
+		Cves:       make([]*gql.Cve, len(report.Cves)),
+		Packages:   make([]*gql.Package, len(report.Packages)),
+	}
+	// packages are rated by the worst advisory or CVE that they matched
+	scores := map[string]*cvss.Cvss{}
+	for i, a := range report.Advisories {
+		score := worstCvss(a.Cvss)
+		scores[a.ID] = score
+		gqlAdvisory := &gql.Advisory{
+			Id:          a.ID,
+			Title:       a.Title,
+			Description: a.Description,
+			PublishedAt: formatReportTime(a.Published),
+			ModifiedAt:  formatReportTime(a.Modified),
+		}
+		gqlAdvisory.CvssScore.Value = int(score.Score * 10)
+		gqlAdvisory.CvssScore.Vector = score.Vector
+		for _, id := range a.Cves {
+			gqlAdvisory.Cves = append(gqlAdvisory.Cves, struct{ gql.Cve }{gql.Cve{Id: id}})
+		}
+		res.Advisories[i] = gqlAdvisory
+	}
+	for i, c := range report.Cves {
+		score := worstCvss(c.Cvss)
+		scores[c.ID] = score
+		gqlCve := &gql.Cve{
+			Id:          c.ID,
+			Title:       c.Title,
+			Description: c.Description,
+			Summary:     c.Title,
+			PublishedAt: formatReportTime(c.Published),
+			ModifiedAt:  formatReportTime(c.Modified),
+		}
+		gqlCve.CvssScore.Value = int(score.Score * 10)
+		gqlCve.CvssScore.Vector = score.Vector
+		res.Cves[i] = gqlCve
+	}
+	for i, p := range report.Packages {
+		gqlPackage := &gql.Package{
+			Name:      p.Name,
+			Version:   p.Version,
+			Arch:      p.Arch,
+			Format:    p.Format,
+			Available: p.Available,
+		}
+		pkgScores := []*cvss.Cvss{}
+		for _, id := range p.Advisories {
+			if score, ok := scores[id]; ok {
+				pkgScores = append(pkgScores, score)
+			}
+		}
+		if worst, err := cvss.MaxScore(pkgScores); err == nil {
+			gqlPackage.Score.Value = int(worst.Score * 10)
+			gqlPackage.Score.Vector = worst.Vector
+		}
+		res.Packages[i] = gqlPackage
+	}
+	res.Stats = computeStats(res, nil)
+
+	// packages list the advisories and CVEs that they matched directly
+	advisoryCves := map[string][]string{}
+	for _, a := range report.Advisories {
+		advisoryCves[a.ID] = a.Cves
+	}
+	cvePackages := map[string][]string{}
+	for _, p := range report.Packages {
+		for _, id := range p.Advisories {
+			cves, ok := advisoryCves[id]
+			if !ok {
+				cves = []string{id}
+			}
+			for _, c := range cves {
+				if !slices.Contains(cvePackages[c], p.Name) {
+					cvePackages[c] = append(cvePackages[c], p.Name)
+				}
+			}
+		}
+	}
+
+	return res, cvePackages, nil
+}
+
+// worstCvss returns the highest of the given scores, vectors that cannot be
+// parsed are ignored
+func worstCvss(vectors []string) *cvss.Cvss {
+	scores := []*cvss.Cvss{}
+	for _, vector := range vectors {
+		score, err := cvss.New(vector)
+		if err != nil {
+			log.Debug().Err(err).Str("vector", vector).Msg("could not parse cvss vector")
+			continue
+		}
+		scores = append(scores, score)
+	}
+	worst, err := cvss.MaxScore(scores)
+	if err != nil {
+		worst, _ = cvss.New(cvss.NoneVector)
+	}
+	return worst
+}
+
+func formatReportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func (a *mqlVulnAdvisory) id() (string, error) {
 	return a.Id.Data, a.Id.Error
 }
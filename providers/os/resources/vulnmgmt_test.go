@@ -0,0 +1,94 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/upstream/gql"
+)
+
+func testCve(id string, score int, vector string) *gql.Cve {
+	c := &gql.Cve{Id: id}
+	c.CvssScore.Value = score
+	c.CvssScore.Vector = vector
+	return c
+}
+
+func TestWithoutSuppressed(t *testing.T) {
+	const highVector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+	const criticalVector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+	advisory := &gql.Advisory{Id: "DSA-1"}
+	advisory.Cves = append(advisory.Cves, struct{ gql.Cve }{*testCve("CVE-1", 98, criticalVector)})
+	report := &gql.VulnReport{
+		Advisories: []*gql.Advisory{advisory},
+		Cves: []*gql.Cve{
+			testCve("CVE-1", 98, criticalVector),
+			testCve("CVE-2", 75, highVector),
+		},
+		Packages: []*gql.Package{{Name: "openssl"}, {Name: "curl"}},
+		Stats:    &gql.ReportStats{},
+	}
+	cvePackages := map[string][]string{
+		"CVE-1": {"openssl"},
+		"CVE-2": {"curl"},
+	}
+
+	// nothing suppressed leaves the report as it is
+	assert.Same(t, report, withoutSuppressed(report, cvePackages, nil))
+
+	res := withoutSuppressed(report, cvePackages, map[string]struct{}{"CVE-1": {}})
+	// suppressed CVEs are kept, so they can be listed as suppressed
+	assert.Len(t, res.Cves, 2)
+	assert.Empty(t, res.Advisories)
+	require.Len(t, res.Packages, 1)
+	assert.Equal(t, "curl", res.Packages[0].Name)
+
+	assert.Equal(t, 75, res.Stats.Score.Value)
+	assert.Equal(t, highVector, res.Stats.Score.Vector)
+	assert.Equal(t, 1, res.Stats.Cves.Total)
+	assert.Equal(t, 1, res.Stats.Cves.High)
+	assert.Equal(t, 0, res.Stats.Cves.Critical)
+	assert.Equal(t, 0, res.Stats.Advisories.Total)
+	assert.Equal(t, 1, res.Stats.Packages.Total)
+}
+
+func TestComputeStats(t *testing.T) {
+	const highVector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+	const criticalVector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+	advisory := &gql.Advisory{Id: "DSA-1"}
+	advisory.CvssScore.Value = 98
+	advisory.CvssScore.Vector = criticalVector
+	openssl := &gql.Package{Name: "openssl"}
+	openssl.Score.Value = 98
+	report := &gql.VulnReport{
+		Advisories: []*gql.Advisory{advisory},
+		Cves: []*gql.Cve{
+			testCve("CVE-1", 98, criticalVector),
+			testCve("CVE-2", 75, highVector),
+		},
+		Packages: []*gql.Package{openssl, {Name: "curl"}},
+	}
+
+	stats := computeStats(report, nil)
+	assert.Equal(t, 98, stats.Score.Value)
+	assert.Equal(t, criticalVector, stats.Score.Vector)
+	assert.Equal(t, 2, stats.Cves.Total)
+	assert.Equal(t, 1, stats.Cves.Critical)
+	assert.Equal(t, 1, stats.Cves.High)
+	assert.Equal(t, 1, stats.Advisories.Critical)
+	assert.Equal(t, 2, stats.Packages.Total)
+	assert.Equal(t, 1, stats.Packages.Critical)
+	assert.Equal(t, 1, stats.Packages.None)
+
+	// suppressed CVEs are not counted
+	stats = computeStats(&gql.VulnReport{Cves: report.Cves}, map[string]struct{}{"CVE-1": {}})
+	assert.Equal(t, 75, stats.Score.Value)
+	assert.Equal(t, 1, stats.Cves.Total)
+	assert.Equal(t, 0, stats.Cves.Critical)
+}
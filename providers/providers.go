@@ -414,6 +414,9 @@ func EnsureProvider(search ProviderLookup, autoUpdate bool, existing Providers)
 
 func Install(name string, version string) (*Provider, error) {
 	ctx := context.Background()
+	if version == "" {
+		version = Lock.Version(name)
+	}
 	if version == "" {
 		// if no version is specified, we default to installing the latest one
 		latestVersion, err := registry.GetLatestVersion(ctx, name)
@@ -429,9 +432,60 @@ func Install(name string, version string) (*Provider, error) {
 	return installVersion(ctx, name, version)
 }
 
+// Uninstall removes all installed copies of a provider and returns them.
+// Builtin providers cannot be removed.
+func Uninstall(name string) ([]*Provider, error) {
+	all, err := ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*Provider{}
+	isBuiltin := false
+	for _, provider := range all {
+		if provider.Name != name {
+			continue
+		}
+		if provider.Path == "" {
+			isBuiltin = true
+			continue
+		}
+
+		log.Debug().Str("path", provider.Path).Msg("removing provider")
+		if err := osRetry(func() error {
+			return os.RemoveAll(provider.Path)
+		}, maxInstallBinaryRetries); err != nil {
+			return res, errors.Wrap(err, "failed to remove provider from "+provider.Path)
+		}
+		res = append(res, provider)
+	}
+
+	// we need to clear out the cache now, it still has the removed providers
+	CachedProviders = nil
+
+	if len(res) == 0 {
+		if isBuiltin {
+			return nil, errors.New("cannot uninstall builtin provider '" + name + "'")
+		}
+		return nil, errors.New("provider '" + name + "' is not installed")
+	}
+	return res, nil
+}
+
 func installVersion(ctx context.Context, name string, version string) (*Provider, error) {
 	logCtx := log.With().Str("provider", name).Str("version", version).Logger()
 
+	// mirrors often don't publish the index, so a failed lookup is only
+	// fatal in strict mode and otherwise installs without a checksum
+	file, err := lookupProviderFile(ctx, name, version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		if Verification.Strict {
+			return nil, errors.Wrap(err, "failed to install "+name+"-"+version+", cannot look up its checksum")
+		}
+		// VerifyPackage warns about the missing checksum
+		logCtx.Debug().Err(err).Msg("cannot look up the checksum of the provider package")
+	}
+
 	res, err := registry.DownloadProvider(ctx, name, version, runtime.GOOS, runtime.GOARCH)
 	if err != nil {
 		return nil, err
@@ -445,6 +499,11 @@ func installVersion(ctx context.Context, name string, version string) (*Provider
 		return nil, errors.Wrap(err, "failed to install "+name+"-"+version+", failed to read body")
 	}
 
+	if err := Verification.VerifyPackage(tar, file); err != nil {
+		logCtx.Debug().Msg("failed to verify provider package")
+		return nil, errors.Wrap(err, "refusing to install "+name+"-"+version)
+	}
+
 	reader := io.NopCloser(
 		bytes.NewReader(tar),
 	)
@@ -682,6 +741,11 @@ func InstallIO(reader io.ReadCloser, conf InstallConf) ([]*Provider, error) {
 			return nil, err
 		}
 
+		// record what we installed, so that it can be verified later on
+		if err = writeChecksums(dstPath, []string{name, providerName + ".json", providerName + ".resources.json"}); err != nil {
+			return nil, errors.Wrap(err, "failed to record checksums of provider "+providerName)
+		}
+
 		providerDirs = append(providerDirs, dstPath)
 	}
 
@@ -843,6 +907,23 @@ func TryProviderUpdate(provider *Provider, update UpdateProvidersConfig) (*Provi
 		return nil, err
 	}
 
+	// pinned providers are kept at their version, no matter how recently
+	// they were refreshed
+	if pinned := Lock.Version(provider.Name); pinned != "" {
+		if pinned == provider.Version {
+			return provider, nil
+		}
+		log.Info().
+			Str("installed", provider.Version).
+			Str("pinned", pinned).
+			Msg("installing pinned version of '" + provider.Name + "' provider")
+		provider, err = installVersion(ctx, provider.Name, pinned)
+		if err != nil {
+			return nil, err
+		}
+		return finishProviderUpdate(provider, statPath)
+	}
+
 	if update.RefreshInterval > 0 {
 		mtime := stat.ModTime()
 		secs := time.Since(mtime).Seconds()
@@ -888,6 +969,12 @@ func TryProviderUpdate(provider *Provider, update UpdateProvidersConfig) (*Provi
 	if err != nil {
 		return nil, err
 	}
+	return finishProviderUpdate(provider, statPath)
+}
+
+// finishProviderUpdate refreshes the update time of a provider that was
+// just installed and installs its dependencies
+func finishProviderUpdate(provider *Provider, statPath string) (*Provider, error) {
 	PrintInstallResults([]*Provider{provider})
 	now := time.Now()
 	if err := os.Chtimes(statPath, now, now); err != nil {
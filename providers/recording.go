@@ -29,7 +29,7 @@ var (
 			Connectors: []plugin.Connector{
 				{
 					Name:    "recording",
-					Use:     "recording [flags]",
+					Use:     "recording [PATH] [flags]",
 					MinArgs: 0,
 					MaxArgs: 1,
 					Short:   "read recording file from disk",
@@ -90,6 +90,9 @@ func (s *recordingProvider) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIR
 	if pathFlag != nil && pathFlag.RawData().Value.(string) != "" {
 		filePath = pathFlag.RawData().Value.(string)
 	}
+	if filePath == "" && len(req.Args) > 0 {
+		filePath = req.Args[0]
+	}
 
 	asset := &inventory.Asset{
 		Connections: []*inventory.Config{
@@ -10,6 +10,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -36,9 +38,27 @@ type ProviderRegistry interface {
 	DownloadProvider(ctx context.Context, name, version, os, arch string) (io.ReadCloser, error)
 }
 
+// ProviderIndex is implemented by registries that publish digests and
+// signatures of their provider packages. Packages downloaded from registries
+// without an index cannot be verified.
+type ProviderIndex interface {
+	// GetProviderFile returns the index entry for a provider package or nil
+	// if the index doesn't list it
+	GetProviderFile(ctx context.Context, name, version, os, arch string) (*ProviderFile, error)
+}
+
+// latestVersionsTTL is how long latest.json is cached. Installing a provider
+// looks up its latest version and the checksum of its package, which should
+// only fetch latest.json once.
+const latestVersionsTTL = time.Minute
+
 // MondooProviderRegistry implements ProviderRegistry for Mondoo's provider registry
 type MondooProviderRegistry struct {
 	BaseURL string
+
+	lock          sync.Mutex
+	latest        *ProviderVersions
+	latestFetched time.Time
 }
 
 // MondooProviderRegistryOption defines a function type for configuring MondooProviderRegistry
@@ -71,53 +91,130 @@ func LatestVersion(ctx context.Context, name string) (string, error) {
 
 // GetLatestVersion fetches the latest version for the given provider name
 func (r *MondooProviderRegistry) GetLatestVersion(ctx context.Context, name string) (string, error) {
-	client, err := httpClientWithRetry()
+	upstreamVersions, err := r.latestVersions(ctx)
 	if err != nil {
 		return "", err
 	}
 
+	var latestVersion string
+	for i := range upstreamVersions.Providers {
+		if upstreamVersions.Providers[i].Name == name {
+			latestVersion = upstreamVersions.Providers[i].Version
+			break
+		}
+	}
+
+	if latestVersion == "" {
+		return "", errors.New("cannot determine latest version of provider '" + name + "'")
+	}
+	return latestVersion, nil
+}
+
+func (r *MondooProviderRegistry) latestVersions(ctx context.Context) (*ProviderVersions, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.latest != nil && time.Since(r.latestFetched) < latestVersionsTTL {
+		return r.latest, nil
+	}
+
+	client, err := httpClientWithRetry()
+	if err != nil {
+		return nil, err
+	}
+
 	latestURL, err := url.JoinPath(r.BaseURL, "latest.json")
 	if err != nil {
-		return "", errors.Wrap(err, "failed to construct latest version URL")
+		return nil, errors.Wrap(err, "failed to construct latest version URL")
 	}
 
-	res, err := client.Get(latestURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, latestURL, nil)
 	if err != nil {
-		return "", err
+		return nil, errors.Wrap(err, "failed to create latest version request")
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
 	}
 	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch upstream provider versions, received status code: " + res.Status)
+	}
 
 	data, err := io.ReadAll(res.Body)
 	if err != nil {
 		log.Debug().Err(err).Msg("reading latest.json failed")
-		return "", errors.New("failed to read response from upstream provider versions")
+		return nil, errors.New("failed to read response from upstream provider versions")
 	}
 
 	var upstreamVersions ProviderVersions
 	err = json.Unmarshal(data, &upstreamVersions)
 	if err != nil {
 		log.Debug().Err(err).Msg("parsing latest.json failed")
-		return "", errors.New("failed to parse response from upstream provider versions")
+		return nil, errors.New("failed to parse response from upstream provider versions")
 	}
+	r.latest = &upstreamVersions
+	r.latestFetched = time.Now()
+	return r.latest, nil
+}
 
-	var latestVersion string
-	for i := range upstreamVersions.Providers {
-		if upstreamVersions.Providers[i].Name == name {
-			latestVersion = upstreamVersions.Providers[i].Version
-			break
+// GetProviderFile looks up the digest and signature of a provider package.
+// The latest version of every provider is listed in latest.json, all other
+// versions have an index.json next to their packages. It returns nil if the
+// registry has no index for this version.
+func (r *MondooProviderRegistry) GetProviderFile(ctx context.Context, name, version, os, arch string) (*ProviderFile, error) {
+	latest, err := r.latestVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range latest.Providers {
+		entry := latest.Providers[i]
+		if entry.Name == name && entry.Version == version && len(entry.Files) != 0 {
+			return entry.findFile(os, arch), nil
 		}
 	}
 
-	if latestVersion == "" {
-		return "", errors.New("cannot determine latest version of provider '" + name + "'")
+	indexURL, err := url.JoinPath(r.BaseURL, name, version, "index.json")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct index URL")
 	}
-	return latestVersion, nil
+
+	client, err := httpClientWithRetry()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create index request")
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch index of "+name+"-"+version)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		log.Debug().Str("url", indexURL).Msg("no index for provider version")
+		return nil, nil
+	} else if res.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch index of " + name + "-" + version + ", received status code: " + res.Status)
+	}
+
+	var entry ProviderVersion
+	if err := json.NewDecoder(res.Body).Decode(&entry); err != nil {
+		log.Debug().Err(err).Str("url", indexURL).Msg("parsing index.json failed")
+		return nil, errors.New("failed to parse index of " + name + "-" + version)
+	}
+	if entry.Name != name || entry.Version != version {
+		return nil, errors.New("index of " + name + "-" + version + " describes " + entry.Name + "-" + entry.Version)
+	}
+	return entry.findFile(os, arch), nil
 }
 
 // DownloadProvider downloads a provider package from the registry
 func (r *MondooProviderRegistry) DownloadProvider(ctx context.Context, name, version, os, arch string) (io.ReadCloser, error) {
 	// Build the filename using the same pattern as the original
-	filename := fmt.Sprintf("%s_%s_%s_%s.tar.xz", name, version, os, arch)
+	filename := providerFilename(name, version, os, arch)
 
 	// Construct the download URL using url.JoinPath for robust path handling
 	downloadURL, err := url.JoinPath(r.BaseURL, name, version, filename)
@@ -147,3 +244,19 @@ func (r *MondooProviderRegistry) DownloadProvider(ctx context.Context, name, ver
 
 	return res.Body, nil
 }
+
+// providerFilename is the name of the package for a provider version and platform
+func providerFilename(name, version, os, arch string) string {
+	return fmt.Sprintf("%s_%s_%s_%s.tar.xz", name, version, os, arch)
+}
+
+// findFile returns the package of this version for the platform or nil
+func (v ProviderVersion) findFile(os, arch string) *ProviderFile {
+	filename := providerFilename(v.Name, v.Version, os, arch)
+	for i := range v.Files {
+		if v.Files[i].Platform == os+"_"+arch || v.Files[i].Filename == filename {
+			return &v.Files[i]
+		}
+	}
+	return nil
+}
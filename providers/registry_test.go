@@ -5,10 +5,13 @@ package providers
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -166,3 +169,183 @@ func TestMondooProviderRegistry_DownloadProvider(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot find provider")
 	})
 }
+
+func TestMondooProviderRegistry_GetProviderFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest.json":
+			versions := ProviderVersions{
+				Providers: []ProviderVersion{
+					{Name: "aws", Version: "1.2.3", Files: []ProviderFile{
+						{Filename: "aws_1.2.3_linux_amd64.tar.xz", Platform: "linux_amd64", Hash: "abc123", Signature: "c2ln"},
+						{Filename: "aws_1.2.3_darwin_arm64.tar.xz", Platform: "darwin_arm64", Hash: "def456"},
+					}},
+					{Name: "gcp", Version: "3.1.4"},
+				},
+			}
+			json.NewEncoder(w).Encode(versions) // nolint:errcheck
+		case "/aws/1.2.2/index.json":
+			json.NewEncoder(w).Encode(ProviderVersion{ // nolint:errcheck
+				Name: "aws", Version: "1.2.2", Files: []ProviderFile{
+					{Filename: "aws_1.2.2_linux_amd64.tar.xz", Platform: "linux_amd64", Hash: "older"},
+				},
+			})
+		case "/aws/1.0.0/index.json":
+			json.NewEncoder(w).Encode(ProviderVersion{Name: "azure", Version: "1.0.0"}) // nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	registry := NewMondooProviderRegistry(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	t.Run("latest version", func(t *testing.T) {
+		file, err := registry.GetProviderFile(ctx, "aws", "1.2.3", "linux", "amd64")
+		require.NoError(t, err)
+		require.NotNil(t, file)
+		assert.Equal(t, "abc123", file.Hash)
+		assert.Equal(t, "c2ln", file.Signature)
+	})
+
+	t.Run("older version", func(t *testing.T) {
+		file, err := registry.GetProviderFile(ctx, "aws", "1.2.2", "linux", "amd64")
+		require.NoError(t, err)
+		require.NotNil(t, file)
+		assert.Equal(t, "older", file.Hash)
+	})
+
+	t.Run("unknown platform", func(t *testing.T) {
+		file, err := registry.GetProviderFile(ctx, "aws", "1.2.3", "windows", "amd64")
+		require.NoError(t, err)
+		assert.Nil(t, file)
+	})
+
+	t.Run("version without index", func(t *testing.T) {
+		file, err := registry.GetProviderFile(ctx, "gcp", "3.1.4", "linux", "amd64")
+		require.NoError(t, err)
+		assert.Nil(t, file)
+	})
+
+	t.Run("index of another provider", func(t *testing.T) {
+		_, err := registry.GetProviderFile(ctx, "aws", "1.0.0", "linux", "amd64")
+		assert.Error(t, err)
+	})
+}
+
+func TestInstallVersion_RefusesChecksumMismatch(t *testing.T) {
+	pkg := testProviderPackage(t, "dummy")
+	filename := providerFilename("dummy", "1.0.0", runtime.GOOS, runtime.GOARCH)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest.json":
+			json.NewEncoder(w).Encode(ProviderVersions{ // nolint:errcheck
+				Providers: []ProviderVersion{{Name: "dummy", Version: "1.0.0", Files: []ProviderFile{
+					{Filename: filename, Platform: runtime.GOOS + "_" + runtime.GOARCH, Hash: hex.EncodeToString(make([]byte, 32))},
+				}}},
+			})
+		case "/dummy/1.0.0/" + filename:
+			w.Write(pkg) // nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	prevRegistry, prevPath := registry, DefaultPath
+	defer func() {
+		registry, DefaultPath = prevRegistry, prevPath
+	}()
+	SetProviderRegistry(NewMondooProviderRegistry(WithBaseURL(server.URL)))
+	DefaultPath = t.TempDir()
+
+	_, err := installVersion(context.Background(), "dummy", "1.0.0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+	assert.NoDirExists(t, filepath.Join(DefaultPath, "dummy"))
+}
+
+func TestInstallVersion_IndexUnavailable(t *testing.T) {
+	pkg := testProviderPackage(t, "dummy")
+	filename := providerFilename("dummy", "1.0.0", runtime.GOOS, runtime.GOARCH)
+
+	// mirrors may only serve the packages
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/dummy/1.0.0/" + filename:
+			w.Write(pkg) // nolint:errcheck
+		default:
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	prevRegistry, prevPath, prevVerification := registry, DefaultPath, Verification
+	defer func() {
+		registry, DefaultPath, Verification = prevRegistry, prevPath, prevVerification
+	}()
+	SetProviderRegistry(NewMondooProviderRegistry(WithBaseURL(server.URL)))
+
+	tests := []struct {
+		name    string
+		strict  bool
+		wantErr bool
+	}{
+		{
+			name: "installs without a checksum",
+		},
+		{
+			name:    "strict mode refuses the package",
+			strict:  true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			DefaultPath = t.TempDir()
+			Verification = VerificationConfig{Strict: tt.strict}
+
+			provider, err := installVersion(context.Background(), "dummy", "1.0.0")
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "cannot look up its checksum")
+				assert.NoDirExists(t, filepath.Join(DefaultPath, "dummy"))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1.0.0", provider.Version)
+		})
+	}
+}
+
+func TestMondooProviderRegistry_LatestVersionsCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/latest.json" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		json.NewEncoder(w).Encode(ProviderVersions{ // nolint:errcheck
+			Providers: []ProviderVersion{{Name: "aws", Version: "1.2.3", Files: []ProviderFile{
+				{Filename: "aws_1.2.3_linux_amd64.tar.xz", Platform: "linux_amd64", Hash: "abc123"},
+			}}},
+		})
+	}))
+	defer server.Close()
+
+	registry := NewMondooProviderRegistry(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	// installing the latest version looks up the version and its checksum
+	version, err := registry.GetLatestVersion(ctx, "aws")
+	require.NoError(t, err)
+	file, err := registry.GetProviderFile(ctx, "aws", version, "linux", "amd64")
+	require.NoError(t, err)
+	require.NotNil(t, file)
+	assert.Equal(t, "abc123", file.Hash)
+	assert.Equal(t, 1, requests)
+}
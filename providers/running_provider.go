@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/metrics"
 	pp "go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/resources"
 	"google.golang.org/grpc/status"
@@ -393,6 +394,7 @@ func (p *RunningProvider) Reconnect() error {
 			log.Error().Err(err).Str("plugin", p.Name).Msg("error in plugin reconnect")
 			return err
 		}
+		metrics.ProviderRestarts.WithLabelValues(p.Name).Inc()
 		p.isClosed = false
 		p.isShutdown = false
 		hbCtx, hbCancelFunc := context.WithCancel(context.Background())
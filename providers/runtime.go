@@ -10,6 +10,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/metrics"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/recording"
@@ -34,6 +35,7 @@ type Runtime struct {
 	AutoUpdate     UpdateProvidersConfig
 
 	recording llx.Recording
+	profiler  *llx.Profiler
 	features  []byte
 	// coordinator is used to grab providers
 	coordinator ProvidersCoordinator
@@ -110,6 +112,17 @@ func (r *Runtime) Recording() llx.Recording {
 	return r.recording
 }
 
+// SetProfiler collects timings, cache hits and transferred bytes of all
+// provider calls of this runtime. Set it to nil to turn profiling off.
+func (r *Runtime) SetProfiler(profiler *llx.Profiler) {
+	r.profiler = profiler
+}
+
+// Profiler returns the profiler of this runtime, which may be nil
+func (r *Runtime) Profiler() *llx.Profiler {
+	return r.profiler
+}
+
 func (r *Runtime) AssetMRN() string {
 	if r.Provider != nil && r.Provider.Connection != nil && r.Provider.Connection.Asset != nil {
 		return r.Provider.Connection.Asset.Mrn
@@ -337,8 +350,11 @@ func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (l
 		Resource:   name,
 		Args:       args,
 	}
+	start := time.Now()
 	res, err := provider.Instance.Plugin.GetData(req)
+	r.profiler.ProviderCall(name, "", time.Since(start), res.SizeVT(), err)
 	if err != nil {
+		metrics.ResourceFetchErrors.WithLabelValues(provider.Instance.Name, name).Inc()
 		return nil, err
 	}
 
@@ -445,6 +461,7 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 	}
 
 	if cached, ok := r.Recording().GetData(llx.AssetRecordingLookup{ConnectionId: provider.Connection.Id}, resource, resourceID, field); ok {
+		r.profiler.CacheHit(resource, field)
 		return cached, nil
 	}
 
@@ -454,7 +471,15 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 		ResourceId: resourceID,
 		Field:      field,
 	}
+	start := time.Now()
 	data, err := provider.Instance.Plugin.GetData(req)
+	r.profiler.ProviderCall(resource, field, time.Since(start), data.SizeVT(), err)
+	if err == nil && data.Error != "" {
+		r.profiler.ProviderError(resource, field)
+	}
+	if err != nil || data.Error != "" {
+		metrics.ResourceFetchErrors.WithLabelValues(provider.Instance.Name, resource).Inc()
+	}
 	if err != nil {
 		// Recoverable errors can continue with the execution,
 		// they only store errors in the place of actual data.
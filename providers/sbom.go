@@ -10,6 +10,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
+	"github.com/package-url/packageurl-go"
 	"go.mondoo.com/mql/v13"
 	"go.mondoo.com/mql/v13/llx"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
@@ -279,6 +280,7 @@ func newRecording(asset *inventory.Asset, s *sbom.Sbom) (*recording.Asset, error
 	osPackageReferences := []any{}
 	npmPackageReferences := []any{}
 	pythonPackageReferences := []any{}
+	languagePackageReferences := map[string][]any{}
 
 	for i := range s.Packages {
 		pkg := s.Packages[i]
@@ -310,6 +312,18 @@ func newRecording(asset *inventory.Asset, s *sbom.Sbom) (*recording.Asset, error
 				ID:   pkgResource.ID,
 			})
 		}
+
+		for _, res := range languagePackageResources {
+			if pkg.Type != res.pkgType {
+				continue
+			}
+			pkgResource := newLanguagePackage(res.pkg, pkg)
+			r.Resources = append(r.Resources, pkgResource)
+			languagePackageReferences[res.list] = append(languagePackageReferences[res.list], &llx.MockResource{
+				Name: res.pkg,
+				ID:   pkgResource.ID,
+			})
+		}
 	}
 
 	r.Resources = append(r.Resources, recording.Resource{
@@ -347,6 +361,32 @@ func newRecording(asset *inventory.Asset, s *sbom.Sbom) (*recording.Asset, error
 		},
 	})
 
+	// packages of other language ecosystems, e.g. java.packages
+	for _, res := range languagePackageResources {
+		references := languagePackageReferences[res.list]
+		if references == nil {
+			references = []any{}
+		}
+		r.Resources = append(r.Resources, recording.Resource{
+			Resource: res.list,
+			ID:       res.list,
+			Fields: map[string]*llx.RawData{
+				"list": {
+					Type:  types.Array(types.Resource(res.pkg)),
+					Value: references,
+				},
+				"path": {
+					Type:  types.String,
+					Value: "",
+				},
+				"files": {
+					Type:  types.Array(types.Resource("pkgFileInfo")),
+					Value: []any{},
+				},
+			},
+		})
+	}
+
 	r.Resources = append(r.Resources, recording.Resource{
 		Resource: "kernel",
 		ID:       "",
@@ -508,6 +548,77 @@ func newPythonPackage(pkg *sbom.Package) recording.Resource {
 	}
 }
 
+// languagePackageResource are the resources for the SBOM packages of a
+// language ecosystem, e.g. java.packages and java.package for maven
+type languagePackageResource struct {
+	pkgType string
+	list    string
+	pkg     string
+}
+
+var languagePackageResources = []languagePackageResource{
+	{pkgType: "maven", list: "java.packages", pkg: "java.package"},
+	{pkgType: "golang", list: "go.modules", pkg: "go.module"},
+	{pkgType: "cargo", list: "rust.packages", pkg: "rust.package"},
+	{pkgType: "gem", list: "ruby.packages", pkg: "ruby.package"},
+	{pkgType: "composer", list: "php.packages", pkg: "php.package"},
+}
+
+// newLanguagePackage records a package of a language ecosystem. Fields that
+// only some ecosystems have, e.g. the group ID of Java packages, are taken
+// from the package URL.
+func newLanguagePackage(resource string, pkg *sbom.Package) recording.Resource {
+	fields := map[string]*llx.RawData{
+		"id": {
+			Type:  types.String,
+			Value: pkg.Purl,
+		},
+		"name": {
+			Type:  types.String,
+			Value: pkg.Name,
+		},
+		"version": {
+			Type:  types.String,
+			Value: pkg.Version,
+		},
+		"purl": {
+			Type:  types.String,
+			Value: pkg.Purl,
+		},
+		"cpes": {
+			Type: types.Array(types.Resource("cpe")),
+			// TODO: add support for CPEs
+			Value: []any{},
+		},
+		"files": {
+			Type:  types.Array(types.Resource("pkgFileInfo")),
+			Value: []any{},
+		},
+	}
+
+	var purl packageurl.PackageURL
+	if pkg.Purl != "" {
+		if p, err := packageurl.FromString(pkg.Purl); err == nil {
+			purl = p
+		}
+	}
+
+	switch resource {
+	case "java.package":
+		fields["groupId"] = &llx.RawData{Type: types.String, Value: purl.Namespace}
+	case "ruby.package":
+		fields["platform"] = &llx.RawData{Type: types.String, Value: purl.Qualifiers.Map()["platform"]}
+	case "php.package":
+		fields["description"] = &llx.RawData{Type: types.String, Value: pkg.Description}
+	}
+
+	return recording.Resource{
+		Resource: resource,
+		ID:       pkg.Purl,
+		Fields:   fields,
+	}
+}
+
 func newCpeResource(cpe string) recording.Resource {
 	return recording.Resource{
 		Resource: "cpe",
@@ -0,0 +1,50 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
+	"go.mondoo.com/mql/v13/sbom"
+)
+
+func TestNewRecording_LanguagePackages(t *testing.T) {
+	bom := &sbom.Sbom{
+		Asset: &sbom.Asset{Platform: &sbom.Platform{}},
+		Packages: []*sbom.Package{
+			{Name: "commons-io", Version: "2.11.0", Type: "maven", Purl: "pkg:maven/commons-io/commons-io@2.11.0"},
+			{Name: "nokogiri", Version: "1.15.4", Type: "gem", Purl: "pkg:gem/nokogiri@1.15.4?platform=x86_64-linux"},
+			{Name: "autocfg", Version: "1.1.0", Type: "cargo", Purl: "pkg:cargo/autocfg@1.1.0"},
+		},
+	}
+	rec, err := newRecording(&inventory.Asset{}, bom)
+	require.NoError(t, err)
+
+	resources := map[string]map[string]*llx.RawData{}
+	for _, r := range rec.Resources {
+		resources[r.Resource+"\x00"+r.ID] = r.Fields
+	}
+
+	java := resources["java.packages\x00java.packages"]
+	require.NotNil(t, java)
+	assert.Equal(t, []any{&llx.MockResource{Name: "java.package", ID: "pkg:maven/commons-io/commons-io@2.11.0"}}, java["list"].Value)
+	pkg := resources["java.package\x00pkg:maven/commons-io/commons-io@2.11.0"]
+	require.NotNil(t, pkg)
+	assert.Equal(t, "commons-io", pkg["groupId"].Value)
+	assert.Equal(t, "2.11.0", pkg["version"].Value)
+
+	gem := resources["ruby.package\x00pkg:gem/nokogiri@1.15.4?platform=x86_64-linux"]
+	require.NotNil(t, gem)
+	assert.Equal(t, "x86_64-linux", gem["platform"].Value)
+
+	require.NotNil(t, resources["rust.package\x00pkg:cargo/autocfg@1.1.0"])
+	// ecosystems without packages are recorded as empty lists
+	php := resources["php.packages\x00php.packages"]
+	require.NotNil(t, php)
+	assert.Equal(t, []any{}, php["list"].Value)
+}
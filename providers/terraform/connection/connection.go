@@ -4,6 +4,8 @@
 package connection
 
 import (
+	"sync"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/inventory"
@@ -26,6 +28,10 @@ type Connection struct {
 	parsed          *hclparse.Parser
 	tfVars          map[string]*hcl.Attribute
 	modulesManifest *ModuleManifest
+	scopes          *HclScopes
+	scopesOnce      sync.Once
+	graph           *HclGraph
+	graphOnce       sync.Once
 	state           *State
 	plan            *Plan
 	closer          func()
@@ -61,6 +67,29 @@ func (c *Connection) TfVars() map[string]*hcl.Attribute {
 	return c.tfVars
 }
 
+// EvalContext returns the context to evaluate expressions of a file with,
+// which resolves variables, locals and module inputs
+func (c *Connection) EvalContext(filename string) *hcl.EvalContext {
+	c.scopesOnce.Do(func() {
+		if c.parsed != nil {
+			c.scopes = NewHclScopes(c.parsed.Files(), c.tfVars)
+		}
+	})
+	return c.scopes.EvalContext(filename)
+}
+
+// Graph returns the reference graph of the parsed configuration
+func (c *Connection) Graph() *HclGraph {
+	c.graphOnce.Do(func() {
+		var files map[string]*hcl.File
+		if c.parsed != nil {
+			files = c.parsed.Files()
+		}
+		c.graph = NewHclGraph(files)
+	})
+	return c.graph
+}
+
 func (c *Connection) ModulesManifest() *ModuleManifest {
 	return c.modulesManifest
 }
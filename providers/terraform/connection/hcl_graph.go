@@ -0,0 +1,581 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Kinds of nodes in the reference graph
+const (
+	GraphNodeResource = "resource"
+	GraphNodeData     = "data"
+	GraphNodeVariable = "variable"
+	GraphNodeLocal    = "local"
+	GraphNodeOutput   = "output"
+	GraphNodeModule   = "module"
+	GraphNodeProvider = "provider"
+)
+
+// graphSchema selects the blocks that become nodes of the reference graph
+var graphSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "provider", LabelNames: []string{"name"}},
+		{Type: "variable", LabelNames: []string{"name"}},
+		{Type: "locals"},
+		{Type: "output", LabelNames: []string{"name"}},
+		{Type: "module", LabelNames: []string{"name"}},
+		{Type: "resource", LabelNames: []string{"type", "name"}},
+		{Type: "data", LabelNames: []string{"type", "name"}},
+	},
+}
+
+var providerSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "alias"}},
+}
+
+// HclGraph is the reference graph of a Terraform configuration. Nodes are the
+// resources, data sources, variables, locals, outputs, module calls and
+// providers of all modules. An edge points from the node that holds a
+// reference to the node it references.
+//
+// Local child modules are expanded for every module block that calls them, so
+// their nodes are addressed like in Terraform, e.g. module.network.aws_vpc.main.
+// If the configuration has several root modules, e.g. envs/prod and envs/dev,
+// addresses are prefixed with the directory of their root module, e.g.
+// envs/prod:aws_instance.web.
+type HclGraph struct {
+	Nodes []*GraphNode
+	Edges []*GraphEdge
+
+	nodes map[string]*GraphNode
+	edges map[GraphEdge]struct{}
+}
+
+// GraphNode is a block or local value of a module instance
+type GraphNode struct {
+	// Address of the node, e.g. aws_instance.web, var.region or module.network.output.id
+	Address string
+	// Kind of the node, one of the GraphNode* constants
+	Kind string
+	// Module is the address of the module instance. It is empty for root
+	// modules, or their directory if the configuration has several.
+	Module string
+	// Block declares the node, for locals it is the locals block
+	Block *hcl.Block
+
+	DependsOn  []*GraphEdge
+	Dependents []*GraphEdge
+}
+
+// GraphEdge is a reference from one node to another
+type GraphEdge struct {
+	From *GraphNode
+	To   *GraphNode
+	// Attribute is the argument that holds the reference, arguments of nested
+	// blocks are prefixed with the block type, e.g. ingress.cidr_blocks
+	Attribute string
+}
+
+type graphModule struct {
+	blocks []*hcl.Block
+	calls  map[string]moduleCall
+}
+
+// graphInstance is a module that is instantiated at a module address
+type graphInstance struct {
+	module *graphModule
+	// root prefixes all addresses if the configuration has several root modules
+	root   string
+	prefix string
+	// caller and call are set for child modules
+	caller *graphInstance
+	call   moduleCall
+	// nodes are the nodes of the instance with the expressions that define them
+	nodes []graphDefinition
+}
+
+// addressPrefix is prepended to the addresses of the nodes of the instance
+func (inst *graphInstance) addressPrefix() string {
+	return inst.root + inst.prefix
+}
+
+type graphDefinition struct {
+	node *GraphNode
+	// body is walked for references, attr is used for locals
+	body hcl.Body
+	attr *hcl.Attribute
+}
+
+// NewHclGraph builds the reference graph from the given files. Modules that
+// are not called by another module of the configuration are root modules.
+func NewHclGraph(files map[string]*hcl.File) *HclGraph {
+	g := &HclGraph{
+		nodes: map[string]*GraphNode{},
+		edges: map[GraphEdge]struct{}{},
+	}
+
+	modules := map[string]*graphModule{}
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		if strings.HasSuffix(filename, ".tf") || strings.HasSuffix(filename, ".tf.json") {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		file := files[filename]
+		if file == nil || file.Body == nil {
+			continue
+		}
+		dir := filepath.Dir(filename)
+		m, ok := modules[dir]
+		if !ok {
+			m = &graphModule{calls: map[string]moduleCall{}}
+			modules[dir] = m
+		}
+		// do not handle diag information here, it also reports unrelated blocks
+		content, _, _ := file.Body.PartialContent(graphSchema)
+		for _, block := range content.Blocks {
+			m.blocks = append(m.blocks, block)
+			if block.Type != "module" {
+				continue
+			}
+			if call, ok := parseModuleCall(dir, block); ok {
+				m.calls[call.name] = call
+			}
+		}
+	}
+
+	called := map[string]bool{}
+	for _, m := range modules {
+		for _, call := range m.calls {
+			called[call.target] = true
+		}
+	}
+	dirs := make([]string, 0, len(modules))
+	for dir := range modules {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	roots := []string{}
+	for _, dir := range dirs {
+		if !called[dir] {
+			roots = append(roots, dir)
+		}
+	}
+	rootPrefixes := rootAddressPrefixes(roots)
+
+	instances := []*graphInstance{}
+	for _, dir := range roots {
+		inst := &graphInstance{module: modules[dir], root: rootPrefixes[dir]}
+		instances = g.instantiate(modules, dir, inst, map[string]bool{}, instances)
+	}
+
+	// references may point into other instances, so all nodes must exist first
+	for _, inst := range instances {
+		for _, def := range inst.nodes {
+			g.addReferences(inst, def)
+		}
+	}
+
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Address < g.Nodes[j].Address
+	})
+	sortEdges(g.Edges)
+	for _, n := range g.Nodes {
+		sortEdges(n.DependsOn)
+		sortEdges(n.Dependents)
+	}
+	return g
+}
+
+// rootAddressPrefixes returns the address prefix of every root module. A
+// single root module has no prefix, so its addresses match the ones of
+// Terraform. Several root modules are separate configurations and are
+// prefixed with their directory relative to their common parent.
+func rootAddressPrefixes(roots []string) map[string]string {
+	res := map[string]string{}
+	if len(roots) < 2 {
+		return res
+	}
+
+	parent := roots[0]
+	for _, dir := range roots[1:] {
+		for parent != dir && !strings.HasPrefix(dir, parent+string(filepath.Separator)) {
+			next := filepath.Dir(parent)
+			if next == parent {
+				break
+			}
+			parent = next
+		}
+	}
+
+	for _, dir := range roots {
+		rel, err := filepath.Rel(parent, dir)
+		if err != nil || rel == "." {
+			rel = filepath.Base(dir)
+		}
+		res[dir] = filepath.ToSlash(rel) + ":"
+	}
+	return res
+}
+
+func sortEdges(edges []*GraphEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.From.Address != b.From.Address {
+			return a.From.Address < b.From.Address
+		}
+		if a.To.Address != b.To.Address {
+			return a.To.Address < b.To.Address
+		}
+		return a.Attribute < b.Attribute
+	})
+}
+
+// Node returns the node with the given address
+func (g *HclGraph) Node(address string) (*GraphNode, bool) {
+	if g == nil {
+		return nil, false
+	}
+	n, ok := g.nodes[address]
+	return n, ok
+}
+
+// instantiate adds the nodes of a module instance and all the local modules it
+// calls. Modules that call themselves through other modules are instantiated once.
+func (g *HclGraph) instantiate(modules map[string]*graphModule, dir string, inst *graphInstance, stack map[string]bool, res []*graphInstance) []*graphInstance {
+	stack[dir] = true
+	defer delete(stack, dir)
+	res = append(res, inst)
+
+	base := inst.addressPrefix()
+	module := strings.TrimSuffix(strings.TrimSuffix(base, "."), ":")
+	for _, block := range inst.module.blocks {
+		switch block.Type {
+		case "locals":
+			attrs, _ := block.Body.JustAttributes()
+			for name, attr := range attrs {
+				node := g.addNode(base+"local."+name, GraphNodeLocal, module, block)
+				inst.nodes = append(inst.nodes, graphDefinition{node: node, attr: attr})
+			}
+			continue
+		case "variable":
+			node := g.addNode(base+"var."+block.Labels[0], GraphNodeVariable, module, block)
+			// defaults cannot have references, the value of child module variables
+			// comes from the module call
+			inst.nodes = append(inst.nodes, graphDefinition{node: node})
+			continue
+		}
+
+		var address, kind string
+		switch block.Type {
+		case "resource":
+			address, kind = block.Labels[0]+"."+block.Labels[1], GraphNodeResource
+		case "data":
+			address, kind = "data."+block.Labels[0]+"."+block.Labels[1], GraphNodeData
+		case "output":
+			address, kind = "output."+block.Labels[0], GraphNodeOutput
+		case "module":
+			address, kind = "module."+block.Labels[0], GraphNodeModule
+		case "provider":
+			address, kind = "provider."+block.Labels[0], GraphNodeProvider
+			content, _, _ := block.Body.PartialContent(providerSchema)
+			if alias, ok := content.Attributes["alias"]; ok {
+				if val, diags := alias.Expr.Value(nil); !diags.HasErrors() && val.Type() == cty.String && !val.IsNull() {
+					address += "." + val.AsString()
+				}
+			}
+		default:
+			continue
+		}
+		node := g.addNode(base+address, kind, module, block)
+		inst.nodes = append(inst.nodes, graphDefinition{node: node, body: block.Body})
+	}
+
+	callNames := make([]string, 0, len(inst.module.calls))
+	for name := range inst.module.calls {
+		callNames = append(callNames, name)
+	}
+	sort.Strings(callNames)
+	for _, name := range callNames {
+		call := inst.module.calls[name]
+		callee, ok := modules[call.target]
+		if !ok || stack[call.target] {
+			continue
+		}
+		res = g.instantiate(modules, call.target, &graphInstance{
+			module: callee,
+			root:   inst.root,
+			prefix: inst.prefix + "module." + name + ".",
+			caller: inst,
+			call:   call,
+		}, stack, res)
+	}
+	return res
+}
+
+func (g *HclGraph) addNode(address string, kind string, module string, block *hcl.Block) *GraphNode {
+	if node, ok := g.nodes[address]; ok {
+		return node
+	}
+	node := &GraphNode{
+		Address: address,
+		Kind:    kind,
+		Module:  module,
+		Block:   block,
+	}
+	g.nodes[address] = node
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+func (g *HclGraph) addEdge(from *GraphNode, to *GraphNode, attribute string) {
+	if to == nil {
+		return
+	}
+	key := GraphEdge{From: from, To: to, Attribute: attribute}
+	if _, ok := g.edges[key]; ok {
+		return
+	}
+	g.edges[key] = struct{}{}
+	edge := &key
+	g.Edges = append(g.Edges, edge)
+	from.DependsOn = append(from.DependsOn, edge)
+	to.Dependents = append(to.Dependents, edge)
+}
+
+func (g *HclGraph) addReferences(inst *graphInstance, def graphDefinition) {
+	node := def.node
+	switch {
+	case def.attr != nil:
+		for _, traversal := range def.attr.Expr.Variables() {
+			g.addEdge(node, g.resolve(inst.addressPrefix(), traversal), def.attr.Name)
+		}
+		return
+	case node.Kind == GraphNodeVariable:
+		// variables of child modules get their value from the module call
+		if inst.caller == nil {
+			return
+		}
+		name := node.Block.Labels[0]
+		if arg, ok := inst.call.args[name]; ok {
+			for _, traversal := range arg.Expr.Variables() {
+				g.addEdge(node, g.resolve(inst.caller.addressPrefix(), traversal), name)
+			}
+		}
+		return
+	}
+
+	explicitProvider := false
+	walkGraphBody(def.body, "", func(attribute string, expr hcl.Expression) {
+		switch {
+		case node.Kind == GraphNodeModule && (attribute == "source" || attribute == "version"):
+			return
+		case attribute == "provider" && (node.Kind == GraphNodeResource || node.Kind == GraphNodeData):
+			// provider references are not expressions, e.g. provider = aws.west
+			if traversal, diags := hcl.AbsTraversalForExpr(expr); !diags.HasErrors() {
+				name := traversalNames(traversal)
+				explicitProvider = true
+				g.addEdge(node, g.provider(inst.root, inst.prefix, strings.Join(name, ".")), attribute)
+			}
+			return
+		}
+		for _, traversal := range expr.Variables() {
+			g.addEdge(node, g.resolve(inst.addressPrefix(), traversal), attribute)
+		}
+	})
+
+	if !explicitProvider && (node.Kind == GraphNodeResource || node.Kind == GraphNodeData) {
+		// resources use the default provider of the first segment of their type
+		name, _, _ := strings.Cut(node.Block.Labels[0], "_")
+		g.addEdge(node, g.provider(inst.root, inst.prefix, name), "provider")
+	}
+}
+
+// resolve returns the node a traversal refers to in a module instance, or nil
+// if it refers to something that is not part of the graph
+func (g *HclGraph) resolve(prefix string, traversal hcl.Traversal) *GraphNode {
+	names := traversalNames(traversal)
+	if len(names) < 2 {
+		return nil
+	}
+
+	var address string
+	switch names[0] {
+	case "var", "local":
+		address = names[0] + "." + names[1]
+	case "data":
+		if len(names) < 3 {
+			return nil
+		}
+		address = "data." + names[1] + "." + names[2]
+	case "module":
+		address = "module." + names[1]
+		if len(names) > 2 {
+			// outputs of local child modules, otherwise the module call itself
+			if output, ok := g.nodes[prefix+address+".output."+names[2]]; ok {
+				return output
+			}
+		}
+	case "path", "terraform", "count", "each", "self":
+		return nil
+	default:
+		address = names[0] + "." + names[1]
+	}
+	return g.nodes[prefix+address]
+}
+
+// provider returns the provider configuration with the given name, which
+// child modules inherit from their callers up to their root module
+func (g *HclGraph) provider(root string, prefix string, name string) *GraphNode {
+	for {
+		if node, ok := g.nodes[root+prefix+"provider."+name]; ok {
+			return node
+		}
+		if prefix == "" {
+			return nil
+		}
+		// strip the last module.<name>. segment
+		parts := strings.Split(strings.TrimSuffix(prefix, "."), ".")
+		prefix = strings.Join(parts[:len(parts)-2], ".")
+		if prefix != "" {
+			prefix += "."
+		}
+	}
+}
+
+// traversalNames returns the names of a traversal, indexes are skipped, e.g.
+// aws_instance.web[0].id is aws_instance, web, id
+func traversalNames(traversal hcl.Traversal) []string {
+	res := []string{}
+	for _, step := range traversal {
+		switch v := step.(type) {
+		case hcl.TraverseRoot:
+			res = append(res, v.Name)
+		case hcl.TraverseAttr:
+			res = append(res, v.Name)
+		}
+	}
+	return res
+}
+
+func walkGraphBody(body hcl.Body, path string, fn func(attribute string, expr hcl.Expression)) {
+	if body == nil {
+		return
+	}
+	switch b := body.(type) {
+	case *hclsyntax.Body:
+		for name, attr := range b.Attributes {
+			fn(path+name, attr.Expr)
+		}
+		for _, block := range b.Blocks {
+			walkGraphBody(block.Body, path+block.Type+".", fn)
+		}
+	default:
+		attrs, _ := body.JustAttributes()
+		for name, attr := range attrs {
+			fn(path+name, attr.Expr)
+		}
+	}
+}
+
+// Cycles returns the reference cycles of the graph. Every cycle lists the
+// addresses of the nodes that form it in alphabetical order.
+func (g *HclGraph) Cycles() [][]string {
+	if g == nil {
+		return nil
+	}
+
+	// Tarjan's algorithm for strongly connected components
+	index := map[*GraphNode]int{}
+	lowlink := map[*GraphNode]int{}
+	onStack := map[*GraphNode]bool{}
+	stack := []*GraphNode{}
+	res := [][]string{}
+
+	var connect func(n *GraphNode)
+	connect = func(n *GraphNode) {
+		index[n] = len(index)
+		lowlink[n] = index[n]
+		stack = append(stack, n)
+		onStack[n] = true
+
+		selfReference := false
+		for _, edge := range n.DependsOn {
+			to := edge.To
+			if to == n {
+				selfReference = true
+			}
+			if _, ok := index[to]; !ok {
+				connect(to)
+				lowlink[n] = min(lowlink[n], lowlink[to])
+			} else if onStack[to] {
+				lowlink[n] = min(lowlink[n], index[to])
+			}
+		}
+
+		if lowlink[n] != index[n] {
+			return
+		}
+		component := []string{}
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last.Address)
+			if last == n {
+				break
+			}
+		}
+		if len(component) > 1 || selfReference {
+			sort.Strings(component)
+			res = append(res, component)
+		}
+	}
+
+	for _, n := range g.Nodes {
+		if _, ok := index[n]; !ok {
+			connect(n)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i][0] < res[j][0]
+	})
+	return res
+}
+
+// Impacted returns all nodes that reference this node directly or through
+// other nodes, sorted by address
+func (n *GraphNode) Impacted() []*GraphNode {
+	seen := map[*GraphNode]bool{n: true}
+	queue := []*GraphNode{n}
+	res := []*GraphNode{}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, edge := range cur.Dependents {
+			if seen[edge.From] {
+				continue
+			}
+			seen[edge.From] = true
+			res = append(res, edge.From)
+			queue = append(queue, edge.From)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Address < res[j].Address
+	})
+	return res
+}
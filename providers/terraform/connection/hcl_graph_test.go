@@ -0,0 +1,229 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphRootModule = `
+provider "aws" {
+  region = var.region
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+variable "region" {}
+variable "cidr" {}
+
+locals {
+  name = "web-${var.region}"
+  a    = local.b
+  b    = local.a
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}
+
+module "network" {
+  source = "./modules/network"
+  cidr   = var.cidr
+}
+
+resource "aws_instance" "web" {
+  ami       = data.aws_ami.ubuntu.id
+  subnet_id = module.network.subnet_id
+  tags = {
+    Name = local.name
+  }
+}
+
+resource "aws_security_group" "web" {
+  provider = aws.west
+
+  ingress {
+    cidr_blocks = [var.cidr]
+  }
+}
+
+output "ip" {
+  value = aws_instance.web.private_ip
+}
+`
+
+const graphChildModule = `
+variable "cidr" {}
+
+resource "aws_subnet" "main" {
+  cidr_block = var.cidr
+}
+
+output "subnet_id" {
+  value = aws_subnet.main.id
+}
+`
+
+func parseGraph(t *testing.T) *HclGraph {
+	parser := hclparse.NewParser()
+	_, diags := parser.ParseHCL([]byte(graphRootModule), "main.tf")
+	require.False(t, diags.HasErrors(), diags.Error())
+	_, diags = parser.ParseHCL([]byte(graphChildModule), "modules/network/main.tf")
+	require.False(t, diags.HasErrors(), diags.Error())
+	return NewHclGraph(parser.Files())
+}
+
+func dependsOn(t *testing.T, g *HclGraph, address string) []string {
+	n, ok := g.Node(address)
+	require.True(t, ok, address)
+	res := []string{}
+	for _, edge := range n.DependsOn {
+		res = append(res, edge.Attribute+" -> "+edge.To.Address)
+	}
+	return res
+}
+
+func TestHclGraphNodes(t *testing.T) {
+	g := parseGraph(t)
+
+	addresses := []string{}
+	for _, n := range g.Nodes {
+		addresses = append(addresses, n.Address)
+	}
+	assert.Equal(t, []string{
+		"aws_instance.web",
+		"aws_security_group.web",
+		"data.aws_ami.ubuntu",
+		"local.a",
+		"local.b",
+		"local.name",
+		"module.network",
+		"module.network.aws_subnet.main",
+		"module.network.output.subnet_id",
+		"module.network.var.cidr",
+		"output.ip",
+		"provider.aws",
+		"provider.aws.west",
+		"var.cidr",
+		"var.region",
+	}, addresses)
+
+	n, ok := g.Node("module.network.aws_subnet.main")
+	require.True(t, ok)
+	assert.Equal(t, GraphNodeResource, n.Kind)
+	assert.Equal(t, "module.network", n.Module)
+	assert.Equal(t, "resource", n.Block.Type)
+
+	n, ok = g.Node("local.name")
+	require.True(t, ok)
+	assert.Equal(t, GraphNodeLocal, n.Kind)
+	assert.Equal(t, "", n.Module)
+	assert.Equal(t, "locals", n.Block.Type)
+}
+
+func TestHclGraphEdges(t *testing.T) {
+	g := parseGraph(t)
+
+	assert.Equal(t, []string{
+		"ami -> data.aws_ami.ubuntu",
+		"tags -> local.name",
+		"subnet_id -> module.network.output.subnet_id",
+		"provider -> provider.aws",
+	}, dependsOn(t, g, "aws_instance.web"))
+	assert.Equal(t, []string{
+		"provider -> provider.aws.west",
+		"ingress.cidr_blocks -> var.cidr",
+	}, dependsOn(t, g, "aws_security_group.web"))
+	assert.Equal(t, []string{"cidr -> var.cidr"}, dependsOn(t, g, "module.network"))
+	assert.Equal(t, []string{"region -> var.region"}, dependsOn(t, g, "provider.aws"))
+
+	// module inputs and outputs connect the caller with the child module
+	assert.Equal(t, []string{"cidr -> var.cidr"}, dependsOn(t, g, "module.network.var.cidr"))
+	assert.Equal(t, []string{
+		"cidr_block -> module.network.var.cidr",
+		"provider -> provider.aws",
+	}, dependsOn(t, g, "module.network.aws_subnet.main"))
+	assert.Equal(t, []string{"value -> module.network.aws_subnet.main"}, dependsOn(t, g, "module.network.output.subnet_id"))
+}
+
+func TestHclGraphImpacted(t *testing.T) {
+	g := parseGraph(t)
+
+	n, ok := g.Node("var.cidr")
+	require.True(t, ok)
+	impacted := []string{}
+	for _, i := range n.Impacted() {
+		impacted = append(impacted, i.Address)
+	}
+	assert.Equal(t, []string{
+		"aws_instance.web",
+		"aws_security_group.web",
+		"module.network",
+		"module.network.aws_subnet.main",
+		"module.network.output.subnet_id",
+		"module.network.var.cidr",
+		"output.ip",
+	}, impacted)
+}
+
+func TestHclGraphCycles(t *testing.T) {
+	g := parseGraph(t)
+	assert.Equal(t, [][]string{{"local.a", "local.b"}}, g.Cycles())
+
+	assert.Empty(t, NewHclGraph(nil).Cycles())
+}
+
+func TestHclGraphRoots(t *testing.T) {
+	stack := `
+provider "aws" {}
+
+module "network" {
+  source = "../../modules/network"
+  cidr   = "10.0.0.0/16"
+}
+
+resource "aws_instance" "web" {
+  subnet_id = module.network.subnet_id
+}
+`
+	parser := hclparse.NewParser()
+	for _, filename := range []string{"envs/prod/main.tf", "envs/dev/main.tf"} {
+		_, diags := parser.ParseHCL([]byte(stack), filename)
+		require.False(t, diags.HasErrors(), diags.Error())
+	}
+	_, diags := parser.ParseHCL([]byte(graphChildModule), "modules/network/main.tf")
+	require.False(t, diags.HasErrors(), diags.Error())
+	g := NewHclGraph(parser.Files())
+
+	// every root module is a separate configuration
+	assert.Equal(t, []string{
+		"subnet_id -> dev:module.network.output.subnet_id",
+		"provider -> dev:provider.aws",
+	}, dependsOn(t, g, "dev:aws_instance.web"))
+	assert.Equal(t, []string{
+		"subnet_id -> prod:module.network.output.subnet_id",
+		"provider -> prod:provider.aws",
+	}, dependsOn(t, g, "prod:aws_instance.web"))
+	assert.Equal(t, []string{
+		"cidr_block -> prod:module.network.var.cidr",
+		"provider -> prod:provider.aws",
+	}, dependsOn(t, g, "prod:module.network.aws_subnet.main"))
+
+	n, ok := g.Node("prod:module.network.aws_subnet.main")
+	require.True(t, ok)
+	assert.Equal(t, "prod:module.network", n.Module)
+	n, ok = g.Node("prod:aws_instance.web")
+	require.True(t, ok)
+	assert.Equal(t, "prod", n.Module)
+
+	_, ok = g.Node("aws_instance.web")
+	assert.False(t, ok)
+}
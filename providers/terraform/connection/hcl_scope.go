@@ -0,0 +1,390 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// scopeSchema selects the blocks that contribute to the scope of a module
+var scopeSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "variable", LabelNames: []string{"name"}},
+		{Type: "locals"},
+		{Type: "module", LabelNames: []string{"name"}},
+	},
+}
+
+// HclScopes holds the evaluation context of every Terraform module in the
+// scanned configuration. A module is a directory; its variables get their
+// values from defaults, .tfvars files (root modules) or the arguments of the
+// module blocks that call it (local child modules).
+type HclScopes struct {
+	modules map[string]*hclModule
+}
+
+type hclModule struct {
+	dir         string
+	variables   map[string]*hcl.Block
+	locals      []*hcl.Attribute
+	moduleCalls []moduleCall
+
+	// root is the directory of the root module this module is called from
+	root string
+	// inputs are the values passed in by callers, per variable
+	inputs map[string][]cty.Value
+	// calls is the number of module blocks that call this module
+	calls int
+	ctx   *hcl.EvalContext
+}
+
+type moduleCall struct {
+	name   string
+	target string
+	args   map[string]*hcl.Attribute
+}
+
+// TerraformFunctions returns the functions available when expressions are
+// evaluated. They cover the subset of the Terraform functions that cty
+// implements. The table is shared and must not be modified.
+func TerraformFunctions() map[string]function.Function {
+	return terraformFunctions
+}
+
+var terraformFunctions = map[string]function.Function{
+	"abs":             stdlib.AbsoluteFunc,
+	"ceil":            stdlib.CeilFunc,
+	"chomp":           stdlib.ChompFunc,
+	"chunklist":       stdlib.ChunklistFunc,
+	"coalesce":        stdlib.CoalesceFunc,
+	"coalescelist":    stdlib.CoalesceListFunc,
+	"compact":         stdlib.CompactFunc,
+	"concat":          stdlib.ConcatFunc,
+	"contains":        stdlib.ContainsFunc,
+	"distinct":        stdlib.DistinctFunc,
+	"element":         stdlib.ElementFunc,
+	"flatten":         stdlib.FlattenFunc,
+	"floor":           stdlib.FloorFunc,
+	"format":          stdlib.FormatFunc,
+	"formatdate":      stdlib.FormatDateFunc,
+	"formatlist":      stdlib.FormatListFunc,
+	"indent":          stdlib.IndentFunc,
+	"index":           stdlib.IndexFunc,
+	"join":            stdlib.JoinFunc,
+	"jsondecode":      stdlib.JSONDecodeFunc,
+	"jsonencode":      stdlib.JSONEncodeFunc,
+	"keys":            stdlib.KeysFunc,
+	"length":          stdlib.LengthFunc,
+	"log":             stdlib.LogFunc,
+	"lookup":          stdlib.LookupFunc,
+	"lower":           stdlib.LowerFunc,
+	"max":             stdlib.MaxFunc,
+	"merge":           stdlib.MergeFunc,
+	"min":             stdlib.MinFunc,
+	"parseint":        stdlib.ParseIntFunc,
+	"pow":             stdlib.PowFunc,
+	"range":           stdlib.RangeFunc,
+	"regex":           stdlib.RegexFunc,
+	"regexall":        stdlib.RegexAllFunc,
+	"replace":         stdlib.ReplaceFunc,
+	"reverse":         stdlib.ReverseListFunc,
+	"setintersection": stdlib.SetIntersectionFunc,
+	"setproduct":      stdlib.SetProductFunc,
+	"setsubtract":     stdlib.SetSubtractFunc,
+	"setunion":        stdlib.SetUnionFunc,
+	"signum":          stdlib.SignumFunc,
+	"slice":           stdlib.SliceFunc,
+	"sort":            stdlib.SortFunc,
+	"split":           stdlib.SplitFunc,
+	"strrev":          stdlib.ReverseFunc,
+	"substr":          stdlib.SubstrFunc,
+	"timeadd":         stdlib.TimeAddFunc,
+	"title":           stdlib.TitleFunc,
+	"tobool":          stdlib.MakeToFunc(cty.Bool),
+	"tolist":          stdlib.MakeToFunc(cty.List(cty.DynamicPseudoType)),
+	"tomap":           stdlib.MakeToFunc(cty.Map(cty.DynamicPseudoType)),
+	"tonumber":        stdlib.MakeToFunc(cty.Number),
+	"toset":           stdlib.MakeToFunc(cty.Set(cty.DynamicPseudoType)),
+	"tostring":        stdlib.MakeToFunc(cty.String),
+	"trim":            stdlib.TrimFunc,
+	"trimprefix":      stdlib.TrimPrefixFunc,
+	"trimspace":       stdlib.TrimSpaceFunc,
+	"trimsuffix":      stdlib.TrimSuffixFunc,
+	"upper":           stdlib.UpperFunc,
+	"values":          stdlib.ValuesFunc,
+	"zipmap":          stdlib.ZipmapFunc,
+}
+
+// NewHclScopes evaluates variables and locals of all modules in the given
+// files. The .tfvars values apply to root modules, i.e. modules that are not
+// called by another module of the configuration.
+func NewHclScopes(files map[string]*hcl.File, tfVars map[string]*hcl.Attribute) *HclScopes {
+	s := &HclScopes{modules: map[string]*hclModule{}}
+
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	// evaluate in a stable order, so that results do not depend on map iteration
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, ".tf") && !strings.HasSuffix(filename, ".tf.json") {
+			continue
+		}
+		s.module(filepath.Dir(filename)).addFile(files[filename])
+	}
+
+	for _, m := range s.modules {
+		for _, call := range m.moduleCalls {
+			if callee, ok := s.modules[call.target]; ok {
+				callee.calls++
+			}
+		}
+	}
+
+	// callers are evaluated before the modules they call, pending counts the
+	// calls that were not evaluated yet
+	pending := map[*hclModule]int{}
+	queue := []*hclModule{}
+	for _, dir := range s.sortedDirs() {
+		m := s.modules[dir]
+		pending[m] = m.calls
+		if m.calls == 0 {
+			m.root = dir
+			m.inputs = evalTfVars(tfVars)
+			queue = append(queue, m)
+		}
+	}
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		m.evaluate()
+
+		for _, call := range m.moduleCalls {
+			callee, ok := s.modules[call.target]
+			if !ok || callee.ctx != nil {
+				continue
+			}
+			callee.root = m.root
+			for name, arg := range call.args {
+				val, diags := arg.Expr.Value(m.ctx)
+				if diags.HasErrors() {
+					val = cty.DynamicVal
+				}
+				callee.inputs[name] = append(callee.inputs[name], val)
+			}
+			pending[callee]--
+			if pending[callee] == 0 {
+				queue = append(queue, callee)
+			}
+		}
+	}
+
+	// modules in call cycles are evaluated with the inputs they received so far
+	for _, dir := range s.sortedDirs() {
+		if m := s.modules[dir]; m.ctx == nil {
+			if m.root == "" {
+				m.root = dir
+			}
+			m.evaluate()
+		}
+	}
+
+	return s
+}
+
+// EvalContext returns the evaluation context for expressions in a file. Files
+// that are not part of the configuration get a context without variables.
+func (s *HclScopes) EvalContext(filename string) *hcl.EvalContext {
+	if s != nil {
+		if m, ok := s.modules[filepath.Dir(filename)]; ok {
+			return m.ctx
+		}
+	}
+	return &hcl.EvalContext{Functions: TerraformFunctions()}
+}
+
+func (s *HclScopes) module(dir string) *hclModule {
+	m, ok := s.modules[dir]
+	if !ok {
+		m = &hclModule{
+			dir:       dir,
+			variables: map[string]*hcl.Block{},
+			inputs:    map[string][]cty.Value{},
+		}
+		s.modules[dir] = m
+	}
+	return m
+}
+
+func (s *HclScopes) sortedDirs() []string {
+	dirs := make([]string, 0, len(s.modules))
+	for dir := range s.modules {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+func (m *hclModule) addFile(file *hcl.File) {
+	if file == nil || file.Body == nil {
+		return
+	}
+	// do not handle diag information here, it also reports unrelated blocks
+	content, _, _ := file.Body.PartialContent(scopeSchema)
+	for _, block := range content.Blocks {
+		switch block.Type {
+		case "variable":
+			m.variables[block.Labels[0]] = block
+		case "locals":
+			attrs, _ := block.Body.JustAttributes()
+			for _, attr := range attrs {
+				m.locals = append(m.locals, attr)
+			}
+		case "module":
+			m.addCall(block)
+		}
+	}
+}
+
+// addCall records calls of local modules
+func (m *hclModule) addCall(block *hcl.Block) {
+	if call, ok := parseModuleCall(m.dir, block); ok {
+		m.moduleCalls = append(m.moduleCalls, call)
+	}
+}
+
+// parseModuleCall reads a module block that calls a local module, whose source
+// is a relative path. Modules from registries or other remote sources are not
+// part of the scan.
+func parseModuleCall(dir string, block *hcl.Block) (moduleCall, bool) {
+	attrs, _ := block.Body.JustAttributes()
+	source, ok := attrs["source"]
+	if !ok {
+		return moduleCall{}, false
+	}
+	val, diags := source.Expr.Value(nil)
+	if diags.HasErrors() || val.Type() != cty.String || val.IsNull() {
+		return moduleCall{}, false
+	}
+	path := val.AsString()
+	if !strings.HasPrefix(path, "./") && !strings.HasPrefix(path, "../") {
+		return moduleCall{}, false
+	}
+
+	args := map[string]*hcl.Attribute{}
+	for name, attr := range attrs {
+		switch name {
+		case "source", "version", "count", "for_each", "providers", "depends_on":
+			// meta-arguments are not inputs of the module
+		default:
+			args[name] = attr
+		}
+	}
+	return moduleCall{
+		name:   block.Labels[0],
+		target: filepath.Join(dir, path),
+		args:   args,
+	}, true
+}
+
+// evaluate builds the evaluation context of the module from its variables and locals
+func (m *hclModule) evaluate() {
+	vars := map[string]cty.Value{}
+	for name, block := range m.variables {
+		vars[name] = cty.DynamicVal
+		if inputs, ok := m.inputs[name]; ok {
+			// every caller must pass the variable, otherwise some use the default
+			if m.calls == 0 || len(inputs) == m.calls {
+				if val, ok := agreedValue(inputs); ok {
+					vars[name] = val
+				}
+			}
+			continue
+		}
+		attrs, _ := block.Body.JustAttributes()
+		if def, ok := attrs["default"]; ok {
+			if val, diags := def.Expr.Value(&hcl.EvalContext{Functions: TerraformFunctions()}); !diags.HasErrors() {
+				vars[name] = val
+			}
+		}
+	}
+
+	m.ctx = &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"var":   cty.ObjectVal(vars),
+			"local": cty.EmptyObjectVal,
+			"path": cty.ObjectVal(map[string]cty.Value{
+				"module": cty.StringVal(m.dir),
+				"root":   cty.StringVal(m.root),
+				"cwd":    cty.StringVal("."),
+			}),
+			"terraform": cty.ObjectVal(map[string]cty.Value{
+				"workspace": cty.StringVal("default"),
+			}),
+		},
+		Functions: TerraformFunctions(),
+	}
+
+	// locals may refer to each other, so they are evaluated until no more
+	// values can be resolved
+	locals := map[string]cty.Value{}
+	pending := m.locals
+	for len(pending) > 0 {
+		next := []*hcl.Attribute{}
+		for _, attr := range pending {
+			val, diags := attr.Expr.Value(m.ctx)
+			if diags.HasErrors() || !val.IsWhollyKnown() {
+				next = append(next, attr)
+				continue
+			}
+			locals[attr.Name] = val
+		}
+		if len(next) == len(pending) {
+			break
+		}
+		pending = next
+		m.ctx.Variables["local"] = cty.ObjectVal(locals)
+	}
+	for _, attr := range pending {
+		log.Trace().Str("local", attr.Name).Str("module", m.dir).Msg("could not resolve terraform local")
+		locals[attr.Name] = cty.DynamicVal
+	}
+	m.ctx.Variables["local"] = cty.ObjectVal(locals)
+}
+
+// agreedValue returns the value all callers passed for a variable. Callers
+// that pass different values leave the variable unknown.
+func agreedValue(values []cty.Value) (cty.Value, bool) {
+	if len(values) == 0 || !values[0].IsWhollyKnown() {
+		return cty.DynamicVal, false
+	}
+	for _, v := range values[1:] {
+		if !v.IsWhollyKnown() || !v.RawEquals(values[0]) {
+			return cty.DynamicVal, false
+		}
+	}
+	return values[0], true
+}
+
+func evalTfVars(tfVars map[string]*hcl.Attribute) map[string][]cty.Value {
+	res := map[string][]cty.Value{}
+	for name, attr := range tfVars {
+		val, diags := attr.Expr.Value(&hcl.EvalContext{Functions: TerraformFunctions()})
+		if diags.HasErrors() {
+			continue
+		}
+		res[name] = []cty.Value{val}
+	}
+	return res
+}
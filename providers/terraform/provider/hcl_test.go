@@ -15,6 +15,7 @@ import (
 const (
 	terraformHclPath       = "./testdata/terraform"
 	terraformHclModulePath = "./testdata/terraform-module"
+	terraformHclVarsPath   = "./testdata/terraform-vars"
 )
 
 func TestResource_Terraform(t *testing.T) {
@@ -208,6 +209,123 @@ func TestModuleWithoutResources_Terraform(t *testing.T) {
 	})
 }
 
+func TestResolvedArguments_Terraform(t *testing.T) {
+	srv, connRes := newTestService(HclConnectionType, terraformHclVarsPath)
+	require.NotEmpty(t, srv)
+
+	dataResp, err := srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform",
+	})
+	require.NoError(t, err)
+	resourceId := string(dataResp.Data.Value)
+
+	dataResp, err = srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform",
+		ResourceId: resourceId,
+		Field:      "resources",
+	})
+	require.NoError(t, err)
+
+	// collect the raw and resolved arguments of all resources by their type label
+	arguments := map[string]map[string]any{}
+	resolved := map[string]map[string]any{}
+	for _, block := range dataResp.Data.Array {
+		blockId := string(block.Value)
+		labelsResp, err := srv.GetData(&plugin.DataReq{
+			Connection: connRes.Id,
+			Resource:   "terraform.block",
+			ResourceId: blockId,
+			Field:      "labels",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, labelsResp.Data.Array)
+		label := string(labelsResp.Data.Array[0].Value)
+
+		for field, res := range map[string]map[string]any{"arguments": arguments, "resolvedArguments": resolved} {
+			argsResp, err := srv.GetData(&plugin.DataReq{
+				Connection: connRes.Id,
+				Resource:   "terraform.block",
+				ResourceId: blockId,
+				Field:      field,
+			})
+			require.NoError(t, err)
+			args, ok := argsResp.Data.RawData().Value.(map[string]any)
+			require.True(t, ok)
+			res[label] = args
+		}
+	}
+
+	// arguments keep the references as they are written
+	assert.Equal(t, "var.encrypted", arguments["aws_ebs_volume"]["encrypted"])
+	assert.Equal(t, "local.bucket_name", arguments["aws_s3_bucket"]["bucket"])
+
+	// variables are read from terraform.tfvars
+	assert.Equal(t, true, resolved["aws_ebs_volume"]["encrypted"])
+	assert.Equal(t, map[string]any{"environment": "prod", "owner": "platform"}, resolved["aws_ebs_volume"]["tags"])
+	// references to other resources are kept as they are
+	assert.Equal(t, "aws_kms_key.data.arn", resolved["aws_ebs_volume"]["kms_key_id"])
+	// locals may reference other locals
+	assert.Equal(t, "acme-prod-logs", resolved["aws_s3_bucket"]["bucket"])
+	assert.Equal(t, "/acme/prod", resolved["aws_cloudwatch_log_group"]["name"])
+	// module inputs are passed from the module call
+	assert.Equal(t, "10.0.0.0/16", resolved["aws_vpc"]["cidr_block"])
+}
+
+func TestGraph_Terraform(t *testing.T) {
+	srv, connRes := newTestService(HclConnectionType, terraformHclVarsPath)
+	require.NotEmpty(t, srv)
+
+	dataResp, err := srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform.graph",
+	})
+	require.NoError(t, err)
+	resourceId := string(dataResp.Data.Value)
+
+	dataResp, err = srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform.graph",
+		ResourceId: resourceId,
+		Field:      "hasCycle",
+	})
+	require.NoError(t, err)
+	assert.False(t, dataResp.Data.RawData().Value.(bool))
+
+	dataResp, err = srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform.graph",
+		ResourceId: resourceId,
+		Field:      "nodes",
+	})
+	require.NoError(t, err)
+	nodes := []string{}
+	for _, node := range dataResp.Data.Array {
+		nodes = append(nodes, string(node.Value))
+	}
+	assert.Contains(t, nodes, "terraform.graph.node/module.network.aws_vpc.main")
+
+	// the tfvars variable flows into the child module through the module call
+	dataResp, err = srv.GetData(&plugin.DataReq{
+		Connection: connRes.Id,
+		Resource:   "terraform.graph.node",
+		ResourceId: "terraform.graph.node/var.encrypted",
+		Field:      "impacted",
+	})
+	require.NoError(t, err)
+	impacted := []string{}
+	for _, node := range dataResp.Data.Array {
+		impacted = append(impacted, string(node.Value))
+	}
+	assert.Equal(t, []string{
+		"terraform.graph.node/aws_ebs_volume.data",
+		"terraform.graph.node/module.network",
+		"terraform.graph.node/module.network.aws_flow_log.main",
+		"terraform.graph.node/module.network.var.enable_flow_logs",
+	}, impacted)
+}
+
 func TestKeyString(t *testing.T) {
 	require.Equal(t, "keytest", resources.GetKeyString("keytest"))
 	require.Equal(t, "key,thing", resources.GetKeyString([]string{"key", "thing"}))
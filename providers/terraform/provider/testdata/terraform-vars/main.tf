@@ -0,0 +1,49 @@
+variable "encrypted" {
+  type    = bool
+  default = false
+}
+
+variable "environment" {
+  type    = string
+  default = "dev"
+}
+
+variable "retention_days" {
+  type    = number
+  default = 7
+}
+
+locals {
+  bucket_name = "${local.prefix}-logs"
+  prefix      = "acme-${var.environment}"
+  tags = {
+    environment = var.environment
+    owner       = "platform"
+  }
+}
+
+resource "aws_kms_key" "data" {
+  description = "data volume key"
+}
+
+resource "aws_ebs_volume" "data" {
+  availability_zone = "us-east-1a"
+  encrypted         = var.encrypted
+  kms_key_id        = aws_kms_key.data.arn
+  tags              = local.tags
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = local.bucket_name
+}
+
+resource "aws_cloudwatch_log_group" "logs" {
+  name              = "/acme/${var.environment}"
+  retention_in_days = var.retention_days * 2
+}
+
+module "network" {
+  source           = "./modules/network"
+  cidr_block       = "10.0.0.0/16"
+  enable_flow_logs = var.encrypted
+}
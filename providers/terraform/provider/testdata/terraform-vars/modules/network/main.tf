@@ -0,0 +1,18 @@
+variable "cidr_block" {
+  type = string
+}
+
+variable "enable_flow_logs" {
+  type    = bool
+  default = false
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = var.cidr_block
+}
+
+resource "aws_flow_log" "main" {
+  count        = var.enable_flow_logs ? 1 : 0
+  traffic_type = "ALL"
+  vpc_id       = aws_vpc.main.id
+}
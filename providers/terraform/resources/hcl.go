@@ -16,8 +16,6 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/rs/zerolog/log"
 	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/function"
-	"github.com/zclconf/go-cty/cty/function/stdlib"
 	"go.mondoo.com/mql/v13/checksums"
 	"go.mondoo.com/mql/v13/llx"
 	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
@@ -54,7 +52,7 @@ func (t *mqlTerraform) files() ([]any, error) {
 
 func (t *mqlTerraform) tfvars() (any, error) {
 	conn := t.MqlRuntime.Connection.(*connection.Connection)
-	return hclAttributesToDict(conn.TfVars())
+	return hclAttributesToDict(conn.TfVars(), nil)
 }
 
 func (t *mqlTerraform) modules() ([]any, error) {
@@ -219,7 +217,7 @@ func listRelatedBlocks(t *mqlTerraform, rawBody any) ([]*mqlTerraformBlock, erro
 	case *hclsyntax.Body:
 		for _, v := range body.Attributes {
 			refs := getReferences(v.Expr, &hcl.EvalContext{
-				Functions: hclFunctions(),
+				Functions: connection.TerraformFunctions(),
 			})
 			// we need the resource name and its ID at least
 			if len(refs) < 2 {
@@ -412,7 +410,8 @@ func (t *mqlTerraformBlock) attributes() (map[string]any, error) {
 
 	// do not handle diag information here, it also throws errors for blocks nearby
 	attributes, _ := hclBlock.Body.JustAttributes()
-	return hclAttributesToDict(attributes)
+	conn := t.MqlRuntime.Connection.(*connection.Connection)
+	return hclAttributesToDict(attributes, conn.EvalContext(hclBlock.DefRange.Filename))
 }
 
 func (t *mqlTerraformBlock) arguments() (map[string]any, error) {
@@ -428,41 +427,61 @@ func (t *mqlTerraformBlock) arguments() (map[string]any, error) {
 
 	// do not handle diag information here, it also throws errors for blocks nearby
 	attributes, _ := hclBlock.Body.JustAttributes()
-	return hclResolvedAttributesToDict(attributes)
+	return hclResolvedAttributesToDict(attributes, nil)
+}
+
+func (t *mqlTerraformBlock) resolvedArguments() (map[string]any, error) {
+	var hclBlock *hcl.Block
+	if t.block.State == plugin.StateIsSet {
+		hclBlock = t.block.Data
+	} else {
+		if t.block.Error != nil {
+			return nil, t.block.Error
+		}
+		return nil, errors.New("cannot get hcl block")
+	}
+
+	// do not handle diag information here, it also throws errors for blocks nearby
+	attributes, _ := hclBlock.Body.JustAttributes()
+	conn := t.MqlRuntime.Connection.(*connection.Connection)
+	return hclResolvedAttributesToDict(attributes, conn.EvalContext(hclBlock.DefRange.Filename))
 }
 
-func hclResolvedAttributesToDict(attributes map[string]*hcl.Attribute) (map[string]any, error) {
+// hclResolvedAttributesToDict converts attributes to their values. If a context
+// is given, references to variables, locals and module inputs are resolved
+// with it; references that cannot be resolved are kept as they are.
+func hclResolvedAttributesToDict(attributes map[string]*hcl.Attribute, ctx *hcl.EvalContext) (map[string]any, error) {
+	if ctx == nil {
+		ctx = &hcl.EvalContext{Functions: connection.TerraformFunctions()}
+	}
 	dict := map[string]any{}
 	for k := range attributes {
-		dict[k] = getCtyValue(attributes[k].Expr, &hcl.EvalContext{
-			Functions: hclFunctions(),
-		})
+		dict[k] = getCtyValue(attributes[k].Expr, ctx)
 	}
 	return dict, nil
 }
 
-func hclAttributesToDict(attributes map[string]*hcl.Attribute) (map[string]any, error) {
+// hclAttributesToDict converts attributes to their raw values and types. If a
+// context is given, the resolved value is added next to the raw value.
+func hclAttributesToDict(attributes map[string]*hcl.Attribute, ctx *hcl.EvalContext) (map[string]any, error) {
 	dict := map[string]any{}
 	for k := range attributes {
 		val, _ := attributes[k].Expr.Value(nil)
-		dict[k] = map[string]any{
+		entry := map[string]any{
 			"value": getCtyValue(attributes[k].Expr, &hcl.EvalContext{
-				Functions: hclFunctions(),
+				Functions: connection.TerraformFunctions(),
 			}),
 			"type": typeexpr.TypeString(val.Type()),
 		}
+		if ctx != nil {
+			entry["resolved"] = getCtyValue(attributes[k].Expr, ctx)
+		}
+		dict[k] = entry
 	}
 
 	return dict, nil
 }
 
-func hclFunctions() map[string]function.Function {
-	return map[string]function.Function{
-		"jsondecode": stdlib.JSONDecodeFunc,
-		"jsonencode": stdlib.JSONEncodeFunc,
-	}
-}
-
 func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 	switch t := expr.(type) {
 	case *hclsyntax.TupleConsExpr:
@@ -478,6 +497,9 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 		}
 		return results
 	case *hclsyntax.ScopeTraversalExpr:
+		if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
+		}
 		traversal := t.Variables()
 		res := []string{}
 		for i := range traversal {
@@ -506,6 +528,8 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 			} else {
 				results = append(results, subVal.AsString())
 			}
+		} else if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
 		}
 		return results
 	case *hclsyntax.ConditionalExpr:
@@ -513,6 +537,8 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 		subVal, err := t.Value(ctx)
 		if err == nil && subVal.Type() == cty.String {
 			results = append(results, subVal.AsString())
+		} else if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
 		}
 		return results
 	case *hclsyntax.LiteralValueExpr:
@@ -534,6 +560,9 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 		if len(t.Parts) == 1 {
 			return getCtyValue(t.Parts[0], ctx)
 		}
+		if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
+		}
 
 		results := []any{}
 		for _, p := range t.Parts {
@@ -547,6 +576,9 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 		}
 		return results
 	case *hclsyntax.TemplateWrapExpr:
+		if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
+		}
 		results := []any{}
 		res := getCtyValue(t.Wrapped, ctx)
 		switch v := res.(type) {
@@ -572,11 +604,72 @@ func getCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) any {
 		v := getCtyValue(t.Expression, ctx)
 		return v
 	default:
+		// other expressions like operators or index expressions only have a
+		// value if all their references can be resolved
+		if v, ok := resolveCtyValue(t, ctx); ok {
+			return v
+		}
 		log.Warn().Msgf("unknown type %T", t)
 		return nil
 	}
 }
 
+// resolveCtyValue evaluates an expression that references variables, locals
+// or module inputs. It fails if the context has no variables or if any part
+// of the value is unknown, e.g. because it refers to a resource attribute.
+func resolveCtyValue(expr hcl.Expression, ctx *hcl.EvalContext) (any, bool) {
+	if ctx == nil || ctx.Variables == nil || len(expr.Variables()) == 0 {
+		return nil, false
+	}
+	val, diags := expr.Value(ctx)
+	if diags.HasErrors() || !val.IsWhollyKnown() {
+		return nil, false
+	}
+	return ctyToAny(val)
+}
+
+// ctyToAny converts a cty value into the types of an MQL dict
+func ctyToAny(val cty.Value) (any, bool) {
+	if val.IsNull() {
+		return nil, true
+	}
+	val, _ = val.Unmark()
+
+	ty := val.Type()
+	switch {
+	case ty == cty.String:
+		return val.AsString(), true
+	case ty == cty.Bool:
+		return val.True(), true
+	case ty == cty.Number:
+		f, _ := val.AsBigFloat().Float64()
+		return f, true
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		res := []any{}
+		for it := val.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			e, ok := ctyToAny(v)
+			if !ok {
+				return nil, false
+			}
+			res = append(res, e)
+		}
+		return res, true
+	case ty.IsMapType() || ty.IsObjectType():
+		res := map[string]any{}
+		for it := val.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			e, ok := ctyToAny(v)
+			if !ok {
+				return nil, false
+			}
+			res[k.AsString()] = e
+		}
+		return res, true
+	}
+	return nil, false
+}
+
 func getReferences(expr hcl.Expression, ctx *hcl.EvalContext) []string {
 	switch t := expr.(type) {
 	case *hclsyntax.ScopeTraversalExpr:
@@ -797,7 +890,7 @@ func initTerraformSettings(runtime *plugin.Runtime, args map[string]*llx.RawData
 			requireProviderBlock := getBlockByName(hb, "required_providers")
 			if requireProviderBlock != nil {
 				attributes, _ := requireProviderBlock.Body.JustAttributes()
-				dict, err := hclResolvedAttributesToDict(attributes)
+				dict, err := hclResolvedAttributesToDict(attributes, nil)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -832,7 +925,7 @@ func initTerraformSettings(runtime *plugin.Runtime, args map[string]*llx.RawData
 			backendBlock := getBlockByName(hb, "backend")
 			if backendBlock != nil {
 				attributes, _ := backendBlock.Body.JustAttributes()
-				dict, err := hclResolvedAttributesToDict(attributes)
+				dict, err := hclResolvedAttributesToDict(attributes, nil)
 				if err != nil {
 					return nil, nil, err
 				}
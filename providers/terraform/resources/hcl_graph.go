@@ -0,0 +1,162 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+
+	"go.mondoo.com/mql/v13/llx"
+	"go.mondoo.com/mql/v13/providers-sdk/v1/plugin"
+	"go.mondoo.com/mql/v13/providers/terraform/connection"
+)
+
+func (g *mqlTerraformGraph) id() (string, error) {
+	return "terraform.graph", nil
+}
+
+func (g *mqlTerraformGraph) nodes() ([]any, error) {
+	conn := g.MqlRuntime.Connection.(*connection.Connection)
+
+	res := []any{}
+	for _, node := range conn.Graph().Nodes {
+		mqlNode, err := newMqlGraphNode(g.MqlRuntime, node)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mqlNode)
+	}
+	return res, nil
+}
+
+func (g *mqlTerraformGraph) edges() ([]any, error) {
+	conn := g.MqlRuntime.Connection.(*connection.Connection)
+	return newMqlGraphEdges(g.MqlRuntime, conn.Graph().Edges)
+}
+
+func (g *mqlTerraformGraph) cycles() ([]any, error) {
+	conn := g.MqlRuntime.Connection.(*connection.Connection)
+
+	res := []any{}
+	for _, cycle := range conn.Graph().Cycles() {
+		addresses := make([]any, len(cycle))
+		for i := range cycle {
+			addresses[i] = cycle[i]
+		}
+		res = append(res, addresses)
+	}
+	return res, nil
+}
+
+func (g *mqlTerraformGraph) hasCycle() (bool, error) {
+	conn := g.MqlRuntime.Connection.(*connection.Connection)
+	return len(conn.Graph().Cycles()) > 0, nil
+}
+
+func newMqlGraphNode(runtime *plugin.Runtime, node *connection.GraphNode) (*mqlTerraformGraphNode, error) {
+	res, err := CreateResource(runtime, "terraform.graph.node", map[string]*llx.RawData{
+		"address": llx.StringData(node.Address),
+		"kind":    llx.StringData(node.Kind),
+		"module":  llx.StringData(node.Module),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlTerraformGraphNode), nil
+}
+
+func newMqlGraphEdges(runtime *plugin.Runtime, edges []*connection.GraphEdge) ([]any, error) {
+	res := []any{}
+	for _, edge := range edges {
+		from, err := newMqlGraphNode(runtime, edge.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := newMqlGraphNode(runtime, edge.To)
+		if err != nil {
+			return nil, err
+		}
+
+		mqlEdge, err := CreateResource(runtime, "terraform.graph.edge", map[string]*llx.RawData{
+			"from":      llx.ResourceData(from, "terraform.graph.node"),
+			"to":        llx.ResourceData(to, "terraform.graph.node"),
+			"attribute": llx.StringData(edge.Attribute),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mqlEdge)
+	}
+	return res, nil
+}
+
+func (n *mqlTerraformGraphNode) id() (string, error) {
+	return "terraform.graph.node/" + n.Address.Data, nil
+}
+
+// graphNode looks up the node in the reference graph of the connection
+func (n *mqlTerraformGraphNode) graphNode() (*connection.GraphNode, error) {
+	conn := n.MqlRuntime.Connection.(*connection.Connection)
+	node, ok := conn.Graph().Node(n.Address.Data)
+	if !ok {
+		return nil, errors.New("cannot find terraform graph node " + n.Address.Data)
+	}
+	return node, nil
+}
+
+func (n *mqlTerraformGraphNode) block() (*mqlTerraformBlock, error) {
+	node, err := n.graphNode()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := n.MqlRuntime.Connection.(*connection.Connection)
+	file := conn.Parser().Files()[node.Block.DefRange.Filename]
+	res, err := newMqlHclBlock(n.MqlRuntime, node.Block, file)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlTerraformBlock), nil
+}
+
+func (n *mqlTerraformGraphNode) dependsOn() ([]any, error) {
+	node, err := n.graphNode()
+	if err != nil {
+		return nil, err
+	}
+	return newMqlGraphEdges(n.MqlRuntime, node.DependsOn)
+}
+
+func (n *mqlTerraformGraphNode) dependents() ([]any, error) {
+	node, err := n.graphNode()
+	if err != nil {
+		return nil, err
+	}
+	return newMqlGraphEdges(n.MqlRuntime, node.Dependents)
+}
+
+func (n *mqlTerraformGraphNode) impacted() ([]any, error) {
+	node, err := n.graphNode()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []any{}
+	for _, impacted := range node.Impacted() {
+		mqlNode, err := newMqlGraphNode(n.MqlRuntime, impacted)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mqlNode)
+	}
+	return res, nil
+}
+
+func (e *mqlTerraformGraphEdge) id() (string, error) {
+	from := e.From.Data
+	to := e.To.Data
+	if from == nil || to == nil {
+		return "", errors.New("terraform graph edge has no nodes")
+	}
+	return "terraform.graph.edge/" + from.Address.Data + "/" + to.Address.Data + "/" + e.Attribute.Data, nil
+}
@@ -24,6 +24,9 @@ const (
 	ResourceTerraformModule                   string = "terraform.module"
 	ResourceTerraformSettings                 string = "terraform.settings"
 	ResourceTerraformSettingsRequiredProvider string = "terraform.settings.requiredProvider"
+	ResourceTerraformGraph                    string = "terraform.graph"
+	ResourceTerraformGraphNode                string = "terraform.graph.node"
+	ResourceTerraformGraphEdge                string = "terraform.graph.edge"
 	ResourceTerraformState                    string = "terraform.state"
 	ResourceTerraformStateOutput              string = "terraform.state.output"
 	ResourceTerraformStateModule              string = "terraform.state.module"
@@ -71,6 +74,18 @@ func init() {
 			// to override args, implement: initTerraformSettingsRequiredProvider(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createTerraformSettingsRequiredProvider,
 		},
+		"terraform.graph": {
+			// to override args, implement: initTerraformGraph(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createTerraformGraph,
+		},
+		"terraform.graph.node": {
+			// to override args, implement: initTerraformGraphNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createTerraformGraphNode,
+		},
+		"terraform.graph.edge": {
+			// to override args, implement: initTerraformGraphEdge(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createTerraformGraphEdge,
+		},
 		"terraform.state": {
 			Init:   initTerraformState,
 			Create: createTerraformState,
@@ -250,6 +265,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"terraform.block.arguments": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTerraformBlock).GetArguments()).ToDataRes(types.Dict)
 	},
+	"terraform.block.resolvedArguments": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformBlock).GetResolvedArguments()).ToDataRes(types.Dict)
+	},
 	"terraform.block.attributes": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTerraformBlock).GetAttributes()).ToDataRes(types.Dict)
 	},
@@ -295,6 +313,48 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"terraform.settings.requiredProvider.version": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTerraformSettingsRequiredProvider).GetVersion()).ToDataRes(types.String)
 	},
+	"terraform.graph.nodes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraph).GetNodes()).ToDataRes(types.Array(types.Resource("terraform.graph.node")))
+	},
+	"terraform.graph.edges": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraph).GetEdges()).ToDataRes(types.Array(types.Resource("terraform.graph.edge")))
+	},
+	"terraform.graph.cycles": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraph).GetCycles()).ToDataRes(types.Array(types.Array(types.String)))
+	},
+	"terraform.graph.hasCycle": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraph).GetHasCycle()).ToDataRes(types.Bool)
+	},
+	"terraform.graph.node.address": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetAddress()).ToDataRes(types.String)
+	},
+	"terraform.graph.node.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetKind()).ToDataRes(types.String)
+	},
+	"terraform.graph.node.module": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetModule()).ToDataRes(types.String)
+	},
+	"terraform.graph.node.block": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetBlock()).ToDataRes(types.Resource("terraform.block"))
+	},
+	"terraform.graph.node.dependsOn": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetDependsOn()).ToDataRes(types.Array(types.Resource("terraform.graph.edge")))
+	},
+	"terraform.graph.node.dependents": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetDependents()).ToDataRes(types.Array(types.Resource("terraform.graph.edge")))
+	},
+	"terraform.graph.node.impacted": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphNode).GetImpacted()).ToDataRes(types.Array(types.Resource("terraform.graph.node")))
+	},
+	"terraform.graph.edge.from": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphEdge).GetFrom()).ToDataRes(types.Resource("terraform.graph.node"))
+	},
+	"terraform.graph.edge.to": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphEdge).GetTo()).ToDataRes(types.Resource("terraform.graph.node"))
+	},
+	"terraform.graph.edge.attribute": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTerraformGraphEdge).GetAttribute()).ToDataRes(types.String)
+	},
 	"terraform.state.formatVersion": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTerraformState).GetFormatVersion()).ToDataRes(types.String)
 	},
@@ -564,6 +624,10 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlTerraformBlock).Arguments, ok = plugin.RawToTValue[any](v.Value, v.Error)
 		return
 	},
+	"terraform.block.resolvedArguments": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformBlock).ResolvedArguments, ok = plugin.RawToTValue[any](v.Value, v.Error)
+		return
+	},
 	"terraform.block.attributes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlTerraformBlock).Attributes, ok = plugin.RawToTValue[any](v.Value, v.Error)
 		return
@@ -636,6 +700,74 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool{
 		r.(*mqlTerraformSettingsRequiredProvider).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"terraform.graph.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraph).__id, ok = v.Value.(string)
+		return
+	},
+	"terraform.graph.nodes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraph).Nodes, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.edges": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraph).Edges, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.cycles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraph).Cycles, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.hasCycle": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraph).HasCycle, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).__id, ok = v.Value.(string)
+		return
+	},
+	"terraform.graph.node.address": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Address, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.module": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Module, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.block": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Block, ok = plugin.RawToTValue[*mqlTerraformBlock](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.dependsOn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).DependsOn, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.dependents": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Dependents, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.node.impacted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphNode).Impacted, ok = plugin.RawToTValue[[]any](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.edge.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphEdge).__id, ok = v.Value.(string)
+		return
+	},
+	"terraform.graph.edge.from": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphEdge).From, ok = plugin.RawToTValue[*mqlTerraformGraphNode](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.edge.to": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphEdge).To, ok = plugin.RawToTValue[*mqlTerraformGraphNode](v.Value, v.Error)
+		return
+	},
+	"terraform.graph.edge.attribute": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTerraformGraphEdge).Attribute, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"terraform.state.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlTerraformState).__id, ok = v.Value.(string)
 		return
@@ -1256,16 +1388,17 @@ type mqlTerraformBlock struct {
 	MqlRuntime *plugin.Runtime
 	__id       string
 	mqlTerraformBlockInternal
-	Type       plugin.TValue[string]
-	Labels     plugin.TValue[[]any]
-	NameLabel  plugin.TValue[string]
-	Start      plugin.TValue[*mqlTerraformFileposition]
-	End        plugin.TValue[*mqlTerraformFileposition]
-	Arguments  plugin.TValue[any]
-	Attributes plugin.TValue[any]
-	Blocks     plugin.TValue[[]any]
-	Related    plugin.TValue[[]any]
-	Snippet    plugin.TValue[string]
+	Type              plugin.TValue[string]
+	Labels            plugin.TValue[[]any]
+	NameLabel         plugin.TValue[string]
+	Start             plugin.TValue[*mqlTerraformFileposition]
+	End               plugin.TValue[*mqlTerraformFileposition]
+	Arguments         plugin.TValue[any]
+	ResolvedArguments plugin.TValue[any]
+	Attributes        plugin.TValue[any]
+	Blocks            plugin.TValue[[]any]
+	Related           plugin.TValue[[]any]
+	Snippet           plugin.TValue[string]
 }
 
 // createTerraformBlock creates a new instance of this resource
@@ -1333,6 +1466,12 @@ func (c *mqlTerraformBlock) GetArguments() *plugin.TValue[any] {
 	})
 }
 
+func (c *mqlTerraformBlock) GetResolvedArguments() *plugin.TValue[any] {
+	return plugin.GetOrCompute[any](&c.ResolvedArguments, func() (any, error) {
+		return c.resolvedArguments()
+	})
+}
+
 func (c *mqlTerraformBlock) GetAttributes() *plugin.TValue[any] {
 	return plugin.GetOrCompute[any](&c.Attributes, func() (any, error) {
 		return c.attributes()
@@ -1569,6 +1708,284 @@ func (c *mqlTerraformSettingsRequiredProvider) GetVersion() *plugin.TValue[strin
 	return &c.Version
 }
 
+// mqlTerraformGraph for the terraform.graph resource
+type mqlTerraformGraph struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlTerraformGraphInternal it will be used here
+	Nodes    plugin.TValue[[]any]
+	Edges    plugin.TValue[[]any]
+	Cycles   plugin.TValue[[]any]
+	HasCycle plugin.TValue[bool]
+}
+
+// createTerraformGraph creates a new instance of this resource
+func createTerraformGraph(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlTerraformGraph{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("terraform.graph", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlTerraformGraph) MqlName() string {
+	return "terraform.graph"
+}
+
+func (c *mqlTerraformGraph) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlTerraformGraph) GetNodes() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Nodes, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph", c.__id, "nodes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.nodes()
+	})
+}
+
+func (c *mqlTerraformGraph) GetEdges() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Edges, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph", c.__id, "edges")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.edges()
+	})
+}
+
+func (c *mqlTerraformGraph) GetCycles() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Cycles, func() ([]any, error) {
+		return c.cycles()
+	})
+}
+
+func (c *mqlTerraformGraph) GetHasCycle() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.HasCycle, func() (bool, error) {
+		return c.hasCycle()
+	})
+}
+
+// mqlTerraformGraphNode for the terraform.graph.node resource
+type mqlTerraformGraphNode struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlTerraformGraphNodeInternal it will be used here
+	Address    plugin.TValue[string]
+	Kind       plugin.TValue[string]
+	Module     plugin.TValue[string]
+	Block      plugin.TValue[*mqlTerraformBlock]
+	DependsOn  plugin.TValue[[]any]
+	Dependents plugin.TValue[[]any]
+	Impacted   plugin.TValue[[]any]
+}
+
+// createTerraformGraphNode creates a new instance of this resource
+func createTerraformGraphNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlTerraformGraphNode{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("terraform.graph.node", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlTerraformGraphNode) MqlName() string {
+	return "terraform.graph.node"
+}
+
+func (c *mqlTerraformGraphNode) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlTerraformGraphNode) GetAddress() *plugin.TValue[string] {
+	return &c.Address
+}
+
+func (c *mqlTerraformGraphNode) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlTerraformGraphNode) GetModule() *plugin.TValue[string] {
+	return &c.Module
+}
+
+func (c *mqlTerraformGraphNode) GetBlock() *plugin.TValue[*mqlTerraformBlock] {
+	return plugin.GetOrCompute[*mqlTerraformBlock](&c.Block, func() (*mqlTerraformBlock, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph.node", c.__id, "block")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlTerraformBlock), nil
+			}
+		}
+
+		return c.block()
+	})
+}
+
+func (c *mqlTerraformGraphNode) GetDependsOn() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.DependsOn, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph.node", c.__id, "dependsOn")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.dependsOn()
+	})
+}
+
+func (c *mqlTerraformGraphNode) GetDependents() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Dependents, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph.node", c.__id, "dependents")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.dependents()
+	})
+}
+
+func (c *mqlTerraformGraphNode) GetImpacted() *plugin.TValue[[]any] {
+	return plugin.GetOrCompute[[]any](&c.Impacted, func() ([]any, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("terraform.graph.node", c.__id, "impacted")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]any), nil
+			}
+		}
+
+		return c.impacted()
+	})
+}
+
+// mqlTerraformGraphEdge for the terraform.graph.edge resource
+type mqlTerraformGraphEdge struct {
+	MqlRuntime *plugin.Runtime
+	__id       string
+	// optional: if you define mqlTerraformGraphEdgeInternal it will be used here
+	From      plugin.TValue[*mqlTerraformGraphNode]
+	To        plugin.TValue[*mqlTerraformGraphNode]
+	Attribute plugin.TValue[string]
+}
+
+// createTerraformGraphEdge creates a new instance of this resource
+func createTerraformGraphEdge(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlTerraformGraphEdge{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+		res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("terraform.graph.edge", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlTerraformGraphEdge) MqlName() string {
+	return "terraform.graph.edge"
+}
+
+func (c *mqlTerraformGraphEdge) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlTerraformGraphEdge) GetFrom() *plugin.TValue[*mqlTerraformGraphNode] {
+	return &c.From
+}
+
+func (c *mqlTerraformGraphEdge) GetTo() *plugin.TValue[*mqlTerraformGraphNode] {
+	return &c.To
+}
+
+func (c *mqlTerraformGraphEdge) GetAttribute() *plugin.TValue[string] {
+	return &c.Attribute
+}
+
 // mqlTerraformState for the terraform.state resource
 type mqlTerraformState struct {
 	MqlRuntime *plugin.Runtime
@@ -0,0 +1,204 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/mql/v13/utils/sortx"
+)
+
+// VerificationConfig controls how downloaded provider packages are verified
+type VerificationConfig struct {
+	// TrustedKeys may sign provider packages. Signatures are only checked if
+	// at least one key is configured.
+	TrustedKeys []ed25519.PublicKey
+	// Strict refuses packages without a digest or a valid signature
+	Strict bool
+}
+
+// Verification is used for all provider installs and updates from the registry
+var Verification VerificationConfig
+
+// ParseTrustedKey parses a base64 encoded ed25519 public key
+func ParseTrustedKey(key string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode provider signing key")
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, errors.Newf("invalid provider signing key: expected %d bytes, got %d", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// lookupProviderFile returns the index entry for a provider package or nil
+// if the registry has none
+func lookupProviderFile(ctx context.Context, name, version, os, arch string) (*ProviderFile, error) {
+	index, ok := registry.(ProviderIndex)
+	if !ok {
+		return nil, nil
+	}
+	return index.GetProviderFile(ctx, name, version, os, arch)
+}
+
+// VerifyPackage checks a downloaded provider package against its index entry.
+// Digest and signature mismatches are always refused. Missing digests and
+// signatures are only refused in strict mode.
+func (c VerificationConfig) VerifyPackage(data []byte, file *ProviderFile) error {
+	if file == nil || file.Hash == "" {
+		if c.Strict {
+			return errors.New("the provider index has no checksum for this package")
+		}
+		log.Warn().Msg("the provider index has no checksum for this package, skipping verification")
+		return nil
+	}
+
+	hash := sha256.Sum256(data)
+	computedHash := hex.EncodeToString(hash[:])
+	if !strings.EqualFold(computedHash, file.Hash) {
+		return errors.Newf("checksum mismatch: expected %s, got %s", file.Hash, computedHash)
+	}
+
+	if len(c.TrustedKeys) == 0 {
+		if c.Strict {
+			return errors.New("no trusted keys configured to verify the package signature")
+		}
+		return nil
+	}
+
+	if file.Signature == "" {
+		if c.Strict {
+			return errors.New("the provider index has no signature for this package")
+		}
+		log.Warn().Str("package", file.Filename).Msg("the provider index has no signature for this package")
+		return nil
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(file.Signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode package signature")
+	}
+	for _, key := range c.TrustedKeys {
+		if ed25519.Verify(key, data, signature) {
+			return nil
+		}
+	}
+	return errors.New("signature of the package doesn't match any trusted key")
+}
+
+// checksumsPath is the file that records the digests of all provider files at
+// install time
+func (p *Provider) checksumsPath() string {
+	return filepath.Join(p.Path, p.Name+".sha256")
+}
+
+// writeChecksums records the digests of the installed files in the format of
+// sha256sum, so they can also be checked with other tools
+func writeChecksums(dir string, files []string) error {
+	sort.Strings(files)
+	var buf bytes.Buffer
+	for _, file := range files {
+		hash, err := fileChecksum(filepath.Join(dir, file))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(hash + "  " + file + "\n")
+	}
+	return os.WriteFile(filepath.Join(dir, filepath.Base(dir)+".sha256"), buf.Bytes(), 0o644)
+}
+
+func readChecksums(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		hash, file, ok := strings.Cut(line, "  ")
+		if !ok {
+			return nil, errors.New("invalid line in " + path + ": " + line)
+		}
+		res[file] = hash
+	}
+	return res, scanner.Err()
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ErrNoChecksums is returned for providers that were installed without
+// recording their checksums, e.g. by an older version or by copying them
+var ErrNoChecksums = errors.New("no checksums were recorded for this provider")
+
+// Verify re-computes the digests of an installed provider and compares them
+// to the ones recorded at install time. It detects files that were corrupted
+// or modified after the install, but not a package that was already bad when
+// it was installed: the registry only publishes digests of packages, which
+// are checked by VerifyPackage before anything is extracted.
+func (p *Provider) Verify() error {
+	if p.Path == "" {
+		return errors.New("cannot verify builtin provider " + p.Name)
+	}
+
+	checksums, err := readChecksums(p.checksumsPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrNoChecksums
+		}
+		return err
+	}
+
+	// the binary and its configs must always be covered
+	required := []string{filepath.Base(p.binPath()), p.Name + ".json", p.Name + ".resources.json"}
+	for _, file := range required {
+		if _, ok := checksums[file]; !ok {
+			return errors.New("no checksum was recorded for " + file)
+		}
+	}
+
+	var errs []error
+	for _, file := range sortx.Keys(checksums) {
+		hash, err := fileChecksum(filepath.Join(p.Path, file))
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "failed to read "+file))
+			continue
+		}
+		if hash != checksums[file] {
+			errs = append(errs, errors.Newf("checksum mismatch for %s: expected %s, got %s", file, checksums[file], hash))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+func TestVerificationConfig_VerifyPackage(t *testing.T) {
+	data := []byte("provider-package")
+	hash := sha256.Sum256(data)
+	digest := hex.EncodeToString(hash[:])
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, data))
+
+	tests := []struct {
+		name    string
+		conf    VerificationConfig
+		file    *ProviderFile
+		wantErr string
+	}{
+		{
+			name: "no index entry",
+			conf: VerificationConfig{},
+		},
+		{
+			name:    "no index entry in strict mode",
+			conf:    VerificationConfig{Strict: true},
+			wantErr: "no checksum",
+		},
+		{
+			name: "matching checksum",
+			file: &ProviderFile{Hash: digest},
+		},
+		{
+			name:    "checksum mismatch",
+			file:    &ProviderFile{Hash: hex.EncodeToString(make([]byte, 32))},
+			wantErr: "checksum mismatch",
+		},
+		{
+			name:    "checksum without trusted keys in strict mode",
+			conf:    VerificationConfig{Strict: true},
+			file:    &ProviderFile{Hash: digest, Signature: signature},
+			wantErr: "no trusted keys",
+		},
+		{
+			name: "valid signature",
+			conf: VerificationConfig{TrustedKeys: []ed25519.PublicKey{otherPub, pub}, Strict: true},
+			file: &ProviderFile{Hash: digest, Signature: signature},
+		},
+		{
+			name:    "signature of another key",
+			conf:    VerificationConfig{TrustedKeys: []ed25519.PublicKey{otherPub}},
+			file:    &ProviderFile{Hash: digest, Signature: signature},
+			wantErr: "doesn't match any trusted key",
+		},
+		{
+			name: "missing signature",
+			conf: VerificationConfig{TrustedKeys: []ed25519.PublicKey{pub}},
+			file: &ProviderFile{Hash: digest},
+		},
+		{
+			name:    "missing signature in strict mode",
+			conf:    VerificationConfig{TrustedKeys: []ed25519.PublicKey{pub}, Strict: true},
+			file:    &ProviderFile{Hash: digest},
+			wantErr: "no signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conf.VerifyPackage(data, tt.file)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseTrustedKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ParseTrustedKey(base64.StdEncoding.EncodeToString(pub) + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, pub, key)
+
+	_, err = ParseTrustedKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+}
+
+func testProviderPackage(t *testing.T, name string) []byte {
+	return testProviderPackageVersion(t, name, "1.0.0")
+}
+
+func testProviderPackageVersion(t *testing.T, name string, version string) []byte {
+	bin := name
+	if runtime.GOOS == "windows" {
+		bin += ".exe"
+	}
+	files := map[string]string{
+		bin:                      "#!/bin/sh\n",
+		name + ".json":           `{"name":"` + name + `","version":"` + version + `"}`,
+		name + ".resources.json": `{}`,
+	}
+
+	var buf bytes.Buffer
+	xzw, err := xz.NewWriter(&buf)
+	require.NoError(t, err)
+	tw := tar.NewWriter(xzw)
+	for filename, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     filename,
+			Mode:     0o755,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := io.WriteString(tw, content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, xzw.Close())
+	return buf.Bytes()
+}
+
+func TestProvider_Verify(t *testing.T) {
+	dst := t.TempDir()
+	installed, err := InstallIO(io.NopCloser(bytes.NewReader(testProviderPackage(t, "dummy"))), InstallConf{Dst: dst})
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+
+	provider := installed[0]
+	assert.Equal(t, "1.0.0", provider.Version)
+	require.NoError(t, provider.Verify())
+
+	// changes to any of the files are detected
+	require.NoError(t, os.WriteFile(provider.confJSONPath(), []byte(`{"name":"dummy","version":"9.9.9"}`), 0o644))
+	err = provider.Verify()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch for dummy.json")
+
+	require.NoError(t, os.Remove(provider.binPath()))
+	err = provider.Verify()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read "+filepath.Base(provider.binPath()))
+
+	require.NoError(t, os.Remove(provider.checksumsPath()))
+	assert.ErrorIs(t, provider.Verify(), ErrNoChecksums)
+
+	builtin := &Provider{Provider: provider.Provider}
+	assert.Error(t, builtin.Verify())
+}
@@ -6,12 +6,15 @@ package sbom
 import (
 	"errors"
 	"io"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	cyclonedx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/google/uuid"
 	"github.com/package-url/packageurl-go"
+	"go.mondoo.com/mql/v13/sbom/vex"
 )
 
 func NewCycloneDX(format string) *CycloneDX {
@@ -59,6 +62,8 @@ func (ccx *CycloneDX) convertToCycloneDx(bom *Sbom) (*cyclonedx.BOM, error) {
 	}
 
 	components := []cyclonedx.Component{}
+	// VEX statements refer to packages by purl or cpe
+	productRefs := map[string][]string{}
 
 	// add os as component
 	cpe := ""
@@ -109,8 +114,15 @@ func (ccx *CycloneDX) convertToCycloneDx(bom *Sbom) (*cyclonedx.BOM, error) {
 			}
 		}
 
+		bomRef := uuid.New().String() // temporary, we need to store the relationships next
+		for _, id := range append([]string{pkg.Purl}, pkg.Cpes...) {
+			if id != "" {
+				productRefs[bomRef] = append(productRefs[bomRef], id)
+			}
+		}
+
 		bomPkg := cyclonedx.Component{
-			BOMRef:     uuid.New().String(), // temporary, we need to store the relationships next
+			BOMRef:     bomRef,
 			Type:       cyclonedx.ComponentTypeLibrary,
 			Name:       pkg.Name,
 			Version:    pkg.Version,
@@ -123,10 +135,61 @@ func (ccx *CycloneDX) convertToCycloneDx(bom *Sbom) (*cyclonedx.BOM, error) {
 	}
 
 	sbom.Components = &components
+	if ccx.opts.Vex != nil {
+		sbom.Vulnerabilities = cycloneDxVulnerabilities(ccx.opts.Vex, sbom.Metadata.Component.BOMRef, productRefs)
+	}
 
 	return sbom, nil
 }
 
+// cycloneDxVulnerabilities adds the analysis of VEX statements to the
+// components they apply to. Statements without products apply to the asset.
+func cycloneDxVulnerabilities(doc *vex.Document, assetRef string, productRefs map[string][]string) *[]cyclonedx.Vulnerability {
+	bomRefs := make([]string, 0, len(productRefs))
+	for ref := range productRefs {
+		bomRefs = append(bomRefs, ref)
+	}
+	sort.Strings(bomRefs)
+
+	vulnerabilities := []cyclonedx.Vulnerability{}
+	for _, s := range doc.Statements {
+		affects := []cyclonedx.Affects{}
+		if len(s.Products) == 0 {
+			affects = append(affects, cyclonedx.Affects{Ref: assetRef})
+		}
+		for _, ref := range bomRefs {
+			if slices.ContainsFunc(productRefs[ref], func(id string) bool {
+				return slices.ContainsFunc(s.Products, func(p string) bool { return vex.MatchProduct(p, id) })
+			}) {
+				affects = append(affects, cyclonedx.Affects{Ref: ref})
+			}
+		}
+		if len(affects) == 0 {
+			continue
+		}
+
+		vuln := cyclonedx.Vulnerability{
+			BOMRef:   uuid.New().String(),
+			ID:       s.Vulnerability,
+			Analysis: s.CycloneDXAnalysis(),
+			Affects:  &affects,
+		}
+		if len(s.Aliases) > 0 {
+			references := make([]cyclonedx.VulnerabilityReference, len(s.Aliases))
+			for i := range s.Aliases {
+				references[i] = cyclonedx.VulnerabilityReference{ID: s.Aliases[i]}
+			}
+			vuln.References = &references
+		}
+		vulnerabilities = append(vulnerabilities, vuln)
+	}
+
+	if len(vulnerabilities) == 0 {
+		return nil
+	}
+	return &vulnerabilities
+}
+
 func (s *CycloneDX) ApplyOptions(opts ...renderOption) {
 	for _, opt := range opts {
 		opt(&s.opts)
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.mondoo.com/mql/v13/sbom"
 	"go.mondoo.com/mql/v13/sbom/generator"
+	"go.mondoo.com/mql/v13/sbom/vex"
 )
 
 func TestCycloneDxOutput(t *testing.T) {
@@ -175,3 +176,60 @@ func TestCycloneDxJsonDecoding_Alpine_syft(t *testing.T) {
 	assert.Equal(t, "3.19.9", bom.Asset.Platform.Version)
 	assert.Equal(t, "//platformid.api.mondoo.app/runtime/docker/images/cd03a8ea6f29f815", bom.Asset.PlatformIds[0])
 }
+
+func TestCycloneDxVex(t *testing.T) {
+	bom := &sbom.Sbom{
+		Generator: &sbom.Generator{Vendor: "Mondoo, Inc.", Name: "mql", Version: "unstable"},
+		Asset: &sbom.Asset{
+			Name:     "payments-api",
+			Platform: &sbom.Platform{Name: "debian", Version: "12.1"},
+		},
+		Packages: []*sbom.Package{
+			{Name: "openssl", Version: "3.0.9-1", Purl: "pkg:deb/debian/openssl@3.0.9-1?arch=amd64&distro=debian-12.1"},
+			{Name: "curl", Version: "7.88.1-10", Purl: "pkg:deb/debian/curl@7.88.1-10?arch=amd64&distro=debian-12.1"},
+		},
+	}
+
+	doc, err := vex.Parse([]byte(`{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "timestamp": "2023-09-01T12:00:00Z",
+  "statements": [
+    {
+      "vulnerability": { "name": "CVE-2023-2650" },
+      "products": [{ "@id": "pkg:deb/debian/openssl" }],
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_in_execute_path",
+      "impact_statement": "OBJ_obj2txt is not used."
+    },
+    {
+      "vulnerability": { "name": "CVE-2023-9999" },
+      "products": [{ "@id": "pkg:npm/lodash" }],
+      "status": "not_affected"
+    }
+  ]
+}`))
+	require.NoError(t, err)
+
+	exporter := sbom.New(sbom.FormatCycloneDxJSON)
+	exporter.ApplyOptions(sbom.WithVex(doc))
+	converted, err := exporter.Convert(bom)
+	require.NoError(t, err)
+	cdx := converted.(*cyclonedx.BOM)
+
+	// statements for packages that are not in the SBOM are skipped
+	require.NotNil(t, cdx.Vulnerabilities)
+	require.Len(t, *cdx.Vulnerabilities, 1)
+	vuln := (*cdx.Vulnerabilities)[0]
+	assert.Equal(t, "CVE-2023-2650", vuln.ID)
+	assert.Equal(t, cyclonedx.IASNotAffected, vuln.Analysis.State)
+	assert.Equal(t, cyclonedx.IAJCodeNotReachable, vuln.Analysis.Justification)
+	assert.Equal(t, "OBJ_obj2txt is not used.", vuln.Analysis.Detail)
+
+	require.Len(t, *vuln.Affects, 1)
+	ref := (*vuln.Affects)[0].Ref
+	for _, c := range *cdx.Components {
+		if c.BOMRef == ref {
+			assert.Equal(t, "openssl", c.Name)
+		}
+	}
+}
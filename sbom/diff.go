@@ -0,0 +1,220 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// BomDiff lists the package differences between two SBOMs
+type BomDiff struct {
+	Added   []*Package
+	Removed []*Package
+	Changed []*PackageChange
+}
+
+// PackageChange is a package whose version differs between two SBOMs
+type PackageChange struct {
+	Name       string `json:"name"`
+	Type       string `json:"type,omitempty"`
+	Arch       string `json:"arch,omitempty"`
+	OldVersion string `json:"oldVersion"`
+	NewVersion string `json:"newVersion"`
+}
+
+// IsEmpty returns true if both SBOMs contain the same packages
+func (d *BomDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares the packages of two SBOMs. Packages are matched by their
+// package URL without version, so SBOMs in different formats can be compared.
+// If a package has no package URL in either SBOM, all packages of that name
+// are matched by their normalized name and architecture instead, on both
+// sides.
+func Diff(before *Sbom, after *Sbom) *BomDiff {
+	withoutPurl := namesWithoutPurl(before.GetPackages(), after.GetPackages())
+	oldPkgs := groupPackages(before.GetPackages(), withoutPurl)
+	newPkgs := groupPackages(after.GetPackages(), withoutPurl)
+
+	res := &BomDiff{
+		Added:   []*Package{},
+		Removed: []*Package{},
+		Changed: []*PackageChange{},
+	}
+	for key, oldList := range oldPkgs {
+		newList := newPkgs[key]
+
+		// packages that are installed in multiple versions are only changed
+		// for the versions that are not part of both SBOMs
+		oldList, newList = withoutCommonVersions(oldList, newList)
+		n := min(len(oldList), len(newList))
+		for i := 0; i < n; i++ {
+			res.Changed = append(res.Changed, &PackageChange{
+				Name:       newList[i].Name,
+				Type:       newList[i].Type,
+				Arch:       packageArch(newList[i]),
+				OldVersion: oldList[i].Version,
+				NewVersion: newList[i].Version,
+			})
+		}
+		res.Removed = append(res.Removed, oldList[n:]...)
+		res.Added = append(res.Added, newList[n:]...)
+	}
+	for key, newList := range newPkgs {
+		if _, ok := oldPkgs[key]; !ok {
+			res.Added = append(res.Added, newList...)
+		}
+	}
+
+	slices.SortFunc(res.Added, SortFn)
+	slices.SortFunc(res.Removed, SortFn)
+	slices.SortFunc(res.Changed, func(a, b *PackageChange) int {
+		return strings.Compare(a.Name+"/"+a.Type+"/"+a.OldVersion, b.Name+"/"+b.Type+"/"+b.OldVersion)
+	})
+	return res
+}
+
+func groupPackages(packages []*Package, withoutPurl map[string]struct{}) map[string][]*Package {
+	res := map[string][]*Package{}
+	for _, pkg := range packages {
+		key := packageKey(pkg, withoutPurl)
+		res[key] = append(res[key], pkg)
+	}
+	for key := range res {
+		slices.SortFunc(res[key], SortFn)
+	}
+	return res
+}
+
+// namesWithoutPurl returns the normalized names of all packages that have no
+// valid package URL in any of the lists
+func namesWithoutPurl(lists ...[]*Package) map[string]struct{} {
+	res := map[string]struct{}{}
+	for _, list := range lists {
+		for _, pkg := range list {
+			if _, err := packageurl.FromString(pkg.Purl); pkg.Purl == "" || err != nil {
+				res[normalizedName(pkg)] = struct{}{}
+			}
+		}
+	}
+	return res
+}
+
+func normalizedName(pkg *Package) string {
+	return strings.ToLower(strings.TrimSpace(pkg.Name))
+}
+
+// packageKey identifies a package independent of its version. Packages
+// whose name is in withoutPurl are identified by name and architecture, since
+// their package URL is missing on at least one side.
+func packageKey(pkg *Package, withoutPurl map[string]struct{}) string {
+	name := normalizedName(pkg)
+	if _, ok := withoutPurl[name]; !ok {
+		if purl, err := packageurl.FromString(pkg.Purl); err == nil {
+			return strings.Join([]string{"purl", purl.Type, purl.Namespace, purl.Name, purl.Qualifiers.Map()["arch"]}, "/")
+		}
+	}
+	return strings.Join([]string{"name", name, strings.ToLower(packageArch(pkg))}, "/")
+}
+
+func packageArch(pkg *Package) string {
+	if pkg.Architecture != "" {
+		return pkg.Architecture
+	}
+	if purl, err := packageurl.FromString(pkg.Purl); err == nil {
+		return purl.Qualifiers.Map()["arch"]
+	}
+	return ""
+}
+
+func withoutCommonVersions(before []*Package, after []*Package) ([]*Package, []*Package) {
+	common := map[string]struct{}{}
+	for _, o := range before {
+		for _, n := range after {
+			if o.Version == n.Version {
+				common[o.Version] = struct{}{}
+			}
+		}
+	}
+	filter := func(list []*Package) []*Package {
+		res := []*Package{}
+		for _, pkg := range list {
+			if _, ok := common[pkg.Version]; !ok {
+				res = append(res, pkg)
+			}
+		}
+		return res
+	}
+	return filter(before), filter(after)
+}
+
+// RenderText writes a human-readable list of the differences
+func (d *BomDiff) RenderText(w io.Writer) error {
+	if d.IsEmpty() {
+		_, err := fmt.Fprintln(w, "no differences found")
+		return err
+	}
+
+	for _, pkg := range d.Added {
+		if _, err := fmt.Fprintf(w, "+ %s %s\n", diffPackageName(pkg.Type, pkg.Name), pkg.Version); err != nil {
+			return err
+		}
+	}
+	for _, pkg := range d.Removed {
+		if _, err := fmt.Fprintf(w, "- %s %s\n", diffPackageName(pkg.Type, pkg.Name), pkg.Version); err != nil {
+			return err
+		}
+	}
+	for _, change := range d.Changed {
+		if _, err := fmt.Fprintf(w, "~ %s %s -> %s\n", diffPackageName(change.Type, change.Name), change.OldVersion, change.NewVersion); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d added, %d removed, %d changed\n", len(d.Added), len(d.Removed), len(d.Changed))
+	return err
+}
+
+// RenderJSON writes the differences as JSON
+func (d *BomDiff) RenderJSON(w io.Writer) error {
+	type jsonPackage struct {
+		Name    string `json:"name"`
+		Type    string `json:"type,omitempty"`
+		Version string `json:"version"`
+		Purl    string `json:"purl,omitempty"`
+	}
+	convert := func(list []*Package) []jsonPackage {
+		res := make([]jsonPackage, len(list))
+		for i, pkg := range list {
+			res[i] = jsonPackage{Name: pkg.Name, Type: pkg.Type, Version: pkg.Version, Purl: pkg.Purl}
+		}
+		return res
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Added   []jsonPackage    `json:"added"`
+		Removed []jsonPackage    `json:"removed"`
+		Changed []*PackageChange `json:"changed"`
+	}{
+		Added:   convert(d.Added),
+		Removed: convert(d.Removed),
+		Changed: d.Changed,
+	})
+}
+
+func diffPackageName(pkgType string, name string) string {
+	if pkgType == "" {
+		return name
+	}
+	return pkgType + "/" + name
+}
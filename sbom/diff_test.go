@@ -0,0 +1,100 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sbom
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	before := &Sbom{
+		Packages: []*Package{
+			{Name: "musl", Version: "1.2.4-r2", Type: "apk", Purl: "pkg:apk/alpine/musl@1.2.4-r2?arch=aarch64"},
+			{Name: "busybox", Version: "1.36.1-r15", Type: "apk", Purl: "pkg:apk/alpine/busybox@1.36.1-r15?arch=aarch64"},
+			{Name: "zlib", Version: "1.3-r2", Type: "apk", Purl: "pkg:apk/alpine/zlib@1.3-r2?arch=aarch64"},
+			{Name: "lodash", Version: "4.17.20", Type: "npm", Purl: "pkg:npm/lodash@4.17.20"},
+			{Name: "lodash", Version: "3.10.1", Type: "npm", Purl: "pkg:npm/lodash@3.10.1"},
+		},
+	}
+	after := &Sbom{
+		Packages: []*Package{
+			{Name: "musl", Version: "1.2.4-r3", Type: "apk", Purl: "pkg:apk/alpine/musl@1.2.4-r3?arch=aarch64"},
+			{Name: "busybox", Version: "1.36.1-r15", Type: "apk", Purl: "pkg:apk/alpine/busybox@1.36.1-r15?arch=aarch64"},
+			{Name: "curl", Version: "8.5.0-r0", Type: "apk", Purl: "pkg:apk/alpine/curl@8.5.0-r0?arch=aarch64"},
+			{Name: "lodash", Version: "4.17.21", Type: "npm", Purl: "pkg:npm/lodash@4.17.21"},
+			{Name: "lodash", Version: "3.10.1", Type: "npm", Purl: "pkg:npm/lodash@3.10.1"},
+		},
+	}
+
+	diff := Diff(before, after)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "curl", diff.Added[0].Name)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "zlib", diff.Removed[0].Name)
+	assert.Equal(t, []*PackageChange{
+		{Name: "lodash", Type: "npm", OldVersion: "4.17.20", NewVersion: "4.17.21"},
+		{Name: "musl", Type: "apk", Arch: "aarch64", OldVersion: "1.2.4-r2", NewVersion: "1.2.4-r3"},
+	}, diff.Changed)
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.RenderText(&buf))
+	assert.Equal(t, "+ apk/curl 8.5.0-r0\n"+
+		"- apk/zlib 1.3-r2\n"+
+		"~ npm/lodash 4.17.20 -> 4.17.21\n"+
+		"~ apk/musl 1.2.4-r2 -> 1.2.4-r3\n"+
+		"\n1 added, 1 removed, 2 changed\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, diff.RenderJSON(&buf))
+	assert.Contains(t, buf.String(), `"oldVersion": "4.17.20"`)
+}
+
+func TestDiffWithoutPurl(t *testing.T) {
+	before := &Sbom{
+		Packages: []*Package{
+			{Name: "openssl", Version: "3.1.4-r1", Type: "apk", Architecture: "aarch64", Purl: "pkg:apk/alpine/openssl@3.1.4-r1?arch=aarch64"},
+			{Name: "zlib", Version: "1.3-r2", Type: "apk", Purl: "pkg:apk/alpine/zlib@1.3-r2?arch=aarch64"},
+		},
+	}
+	after := &Sbom{
+		Packages: []*Package{
+			// e.g. from a generator that does not write package URLs
+			{Name: "OpenSSL", Version: "3.1.4-r2", Architecture: "aarch64"},
+			{Name: "zlib", Version: "1.3-r2", Type: "apk", Purl: "pkg:apk/alpine/zlib@1.3-r2?arch=aarch64"},
+		},
+	}
+
+	diff := Diff(before, after)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Equal(t, []*PackageChange{
+		{Name: "OpenSSL", Arch: "aarch64", OldVersion: "3.1.4-r1", NewVersion: "3.1.4-r2"},
+	}, diff.Changed)
+}
+
+func TestDiffAcrossFormats(t *testing.T) {
+	decode := func(path string) *Sbom {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		bom, err := DefaultMultiDecoder().Parse(f)
+		require.NoError(t, err)
+		return bom
+	}
+
+	cyclonedx := decode("./testdata/alpine-319.cyclone.json")
+	xml := decode("./testdata/alpine-319.cyclone.xml")
+
+	diff := Diff(cyclonedx, xml)
+	assert.True(t, diff.IsEmpty())
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.RenderText(&buf))
+	assert.Equal(t, "no differences found\n", buf.String())
+}
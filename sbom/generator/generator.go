@@ -156,30 +156,42 @@ func GenerateBom(r *reporter.Report) []*sbom.Sbom {
 				bom.Packages = append(bom.Packages, bomPkg)
 			}
 
-			for _, pkg := range rb.NpmPackages {
-				bomPkg := &sbom.Package{
-					Name:    pkg.Name,
-					Version: pkg.Version,
-					Purl:    pkg.Purl,
-					Cpes:    pkg.CPEs,
-					Type:    "npm",
-				}
-
-				for _, filepath := range pkg.FilePaths {
-					bomPkg.EvidenceList = append(bomPkg.EvidenceList, &sbom.Evidence{
-						Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
-						Value: filepath,
-					})
-				}
-
-				bom.Packages = append(bom.Packages, bomPkg)
-			}
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.NpmPackages, "npm")
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.JavaPackages, "maven")
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.GoModules, "golang")
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.RustPackages, "cargo")
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.RubyPackages, "gem")
+			bom.Packages = appendLanguagePackages(bom.Packages, rb.PhpPackages, "composer")
 		}
 		boms = append(boms, bom)
 	}
 	return boms
 }
 
+// appendLanguagePackages adds the packages of a language ecosystem like npm,
+// which all have their files as evidence
+func appendLanguagePackages(packages []*sbom.Package, pkgs []BomPackage, pkgType string) []*sbom.Package {
+	for _, pkg := range pkgs {
+		bomPkg := &sbom.Package{
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			Purl:    pkg.Purl,
+			Cpes:    pkg.CPEs,
+			Type:    pkgType,
+		}
+
+		for _, filepath := range pkg.FilePaths {
+			bomPkg.EvidenceList = append(bomPkg.EvidenceList, &sbom.Evidence{
+				Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+				Value: filepath,
+			})
+		}
+
+		packages = append(packages, bomPkg)
+	}
+	return packages
+}
+
 // enrichPlatformIds adds the platform id based on cnquery ids
 // - AWS EC2 instance ARN
 func enrichPlatformIds(ids []string) []string {
@@ -0,0 +1,65 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package generator
+
+import (
+	"go.mondoo.com/mql/v13/cli/reporter"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// QueryMrnPrefix is the prefix of the keys that query results are stored
+// under in a report
+const QueryMrnPrefix = "//local.cnquery.io/run/local-execution/queries/"
+
+// Query collects a part of the data that is needed for an SBOM
+type Query struct {
+	Uid string
+	Mql string
+}
+
+// Mrn returns the key under which the query result is stored in a report
+func (q Query) Mrn() string {
+	return QueryMrnPrefix + q.Uid
+}
+
+// Queries collect all data that GenerateBom uses. Queries for resources that
+// are not supported by an asset cannot be compiled and may be skipped.
+var Queries = []Query{
+	{Uid: "mondoo-sbom-asset", Mql: "asset { name platform version build family arch cpes.map(uri) ids labels }"},
+	{Uid: "mondoo-sbom-packages", Mql: "packages.list { name version purl cpes.map(uri) format origin arch files.map(path) }"},
+	{Uid: "mondoo-sbom-python-packages", Mql: "python.packages { name version purl cpes.map(uri) file.path }"},
+	{Uid: "mondoo-sbom-npm-packages", Mql: "npm.packages.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-java-packages", Mql: "java.packages.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-go-modules", Mql: "go.modules.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-rust-packages", Mql: "rust.packages.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-ruby-packages", Mql: "ruby.packages.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-php-packages", Mql: "php.packages.list { name version purl cpes.map(uri) files.map(path) }"},
+	{Uid: "mondoo-sbom-kernel-installed", Mql: "kernel.installed"},
+}
+
+// AddQueryResult stores the JSON result of a query for an asset in the
+// report, so it can be turned into an SBOM with GenerateBom
+func AddQueryResult(r *reporter.Report, asset *reporter.Asset, query Query, data []byte) error {
+	content := &structpb.Value{}
+	if err := protojson.Unmarshal(data, content); err != nil {
+		return err
+	}
+
+	if r.Assets == nil {
+		r.Assets = map[string]*reporter.Asset{}
+	}
+	if r.Data == nil {
+		r.Data = map[string]*reporter.DataValues{}
+	}
+	r.Assets[asset.Mrn] = asset
+
+	values, ok := r.Data[asset.Mrn]
+	if !ok {
+		values = &reporter.DataValues{Values: map[string]*reporter.DataValue{}}
+		r.Data[asset.Mrn] = values
+	}
+	values.Values[query.Mrn()] = &reporter.DataValue{Content: content}
+	return nil
+}
@@ -51,6 +51,11 @@ type BomFields struct {
 	Packages        []BomPackage      `json:"packages.list,omitempty"`
 	PythonPackages  []BomPackage      `json:"python.packages,omitempty"`
 	NpmPackages     []BomPackage      `json:"npm.packages.list,omitempty"`
+	JavaPackages    []BomPackage      `json:"java.packages.list,omitempty"`
+	GoModules       []BomPackage      `json:"go.modules.list,omitempty"`
+	RustPackages    []BomPackage      `json:"rust.packages.list,omitempty"`
+	RubyPackages    []BomPackage      `json:"ruby.packages.list,omitempty"`
+	PhpPackages     []BomPackage      `json:"php.packages.list,omitempty"`
 	KernelInstalled []KernelInstalled `json:"kernel.installed,omitempty"`
 }
 
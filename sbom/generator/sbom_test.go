@@ -6,6 +6,7 @@ package generator
 import (
 	"testing"
 
+	"go.mondoo.com/mql/v13/cli/reporter"
 	"go.mondoo.com/mql/v13/sbom"
 
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,59 @@ func TestSbomGeneration(t *testing.T) {
 		})
 	})
 
+	t.Run("generate sbom from a report with language packages", func(t *testing.T) {
+		report, err := LoadReport("testdata/debian-language-packages.json")
+		require.NoError(t, err)
+
+		sboms := GenerateBom(report)
+
+		selectedBom := sboms[0]
+		assert.Equal(t, sbom.Status_STATUS_SUCCEEDED, selectedBom.Status)
+		assert.Len(t, selectedBom.Packages, 5)
+
+		pkg := findProtoPkg(selectedBom.Packages, "log4j-core")
+		require.Equal(t, &sbom.Package{
+			Name: "log4j-core",
+			Type: "maven",
+			Cpes: []string{
+				"cpe:2.3:a:apache:log4j-core:2.14.1:*:*:*:*:*:*:*",
+			},
+			EvidenceList: []*sbom.Evidence{
+				{
+					Type:  sbom.EvidenceType_EVIDENCE_TYPE_FILE,
+					Value: "/opt/tomcat/webapps/app.war",
+				},
+			},
+			Purl:    "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			Version: "2.14.1",
+		}, pkg)
+
+		assert.Equal(t, "golang", findProtoPkg(selectedBom.Packages, "golang.org/x/net").Type)
+		assert.Equal(t, "cargo", findProtoPkg(selectedBom.Packages, "serde").Type)
+		assert.Equal(t, "composer", findProtoPkg(selectedBom.Packages, "symfony/console").Type)
+
+		pkg = findProtoPkg(selectedBom.Packages, "rake")
+		assert.Equal(t, "gem", pkg.Type)
+		assert.Len(t, pkg.EvidenceList, 2)
+	})
+
+	t.Run("generate sbom from query results", func(t *testing.T) {
+		report := &reporter.Report{}
+		asset := &reporter.Asset{Mrn: "//assets/debian", Name: "debian:12"}
+		require.NoError(t, AddQueryResult(report, asset, Queries[0],
+			[]byte(`{"asset": {"name": "debian:12", "platform": "debian", "version": "12"}}`)))
+		require.NoError(t, AddQueryResult(report, asset, Queries[1],
+			[]byte(`{"packages.list": [{"name": "bash", "version": "5.2.15-2", "format": "deb", "purl": "pkg:deb/debian/bash@5.2.15-2"}]}`)))
+		assert.Len(t, report.Data[asset.Mrn].Values, 2)
+		assert.Contains(t, report.Data[asset.Mrn].Values, "//local.cnquery.io/run/local-execution/queries/mondoo-sbom-packages")
+
+		sboms := GenerateBom(report)
+		require.Len(t, sboms, 1)
+		assert.Equal(t, sbom.Status_STATUS_SUCCEEDED, sboms[0].Status)
+		assert.Equal(t, "debian", sboms[0].Asset.Platform.Name)
+		assert.Equal(t, "deb", findProtoPkg(sboms[0].Packages, "bash").Type)
+	})
+
 	t.Run("generate sbom from a report with a sbom package error", func(t *testing.T) {
 		report, err := LoadReport("testdata/alpine-failed-sbom-package.json")
 		require.NoError(t, err)
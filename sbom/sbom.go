@@ -11,6 +11,7 @@ import (
 	"io"
 
 	"github.com/mitchellh/hashstructure/v2"
+	"go.mondoo.com/mql/v13/sbom/vex"
 )
 
 type Decoder interface {
@@ -40,6 +41,7 @@ type renderOption func(*renderOpts)
 type renderOpts struct {
 	IncludeEvidence bool
 	IncludeCPE      bool
+	Vex             *vex.Document
 }
 
 func WithEvidence() renderOption {
@@ -53,3 +55,11 @@ func WithCPE() renderOption {
 		opts.IncludeCPE = true
 	}
 }
+
+// WithVex adds the statements of a VEX document for the packages in the
+// SBOM. Only formats that support vulnerability analysis use them.
+func WithVex(doc *vex.Document) renderOption {
+	return func(opts *renderOpts) {
+		opts.Vex = doc
+	}
+}
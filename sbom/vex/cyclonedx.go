@@ -0,0 +1,153 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	cyclonedx "github.com/CycloneDX/cyclonedx-go"
+)
+
+// cycloneDxStatus maps CycloneDX analysis states to VEX statuses
+var cycloneDxStatus = map[cyclonedx.ImpactAnalysisState]Status{
+	cyclonedx.IASResolved:             StatusFixed,
+	cyclonedx.IASResolvedWithPedigree: StatusFixed,
+	cyclonedx.IASExploitable:          StatusAffected,
+	cyclonedx.IASInTriage:             StatusUnderInvestigation,
+	cyclonedx.IASFalsePositive:        StatusNotAffected,
+	cyclonedx.IASNotAffected:          StatusNotAffected,
+}
+
+// cycloneDxJustification maps CycloneDX justifications to the closest
+// OpenVEX justification
+var cycloneDxJustification = map[cyclonedx.ImpactAnalysisJustification]string{
+	cyclonedx.IAJCodeNotPresent:               JustificationVulnerableCodeNotPresent,
+	cyclonedx.IAJCodeNotReachable:             JustificationVulnerableCodeNotInExecutePath,
+	cyclonedx.IAJRequiresConfiguration:        JustificationCannotBeControlledByAdversary,
+	cyclonedx.IAJRequiresDependency:           JustificationCannotBeControlledByAdversary,
+	cyclonedx.IAJRequiresEnvironment:          JustificationCannotBeControlledByAdversary,
+	cyclonedx.IAJProtectedByCompiler:          JustificationInlineMitigationsAlreadyExist,
+	cyclonedx.IAJProtectedAtRuntime:           JustificationInlineMitigationsAlreadyExist,
+	cyclonedx.IAJProtectedAtPerimeter:         JustificationInlineMitigationsAlreadyExist,
+	cyclonedx.IAJProtectedByMitigatingControl: JustificationInlineMitigationsAlreadyExist,
+}
+
+// parseCycloneDX reads the analysis of vulnerabilities in a CycloneDX BOM
+func parseCycloneDX(data []byte, isXML bool) (*Document, error) {
+	format := cyclonedx.BOMFileFormatJSON
+	if isXML {
+		format = cyclonedx.BOMFileFormatXML
+	}
+	bom := cyclonedx.NewBOM()
+	if err := cyclonedx.NewBOMDecoder(bytes.NewReader(data), format).Decode(bom); err != nil {
+		return nil, err
+	}
+
+	// affects refer to components by their bom-ref, which often is a purl
+	refs := map[string][]string{}
+	if bom.Components != nil {
+		for _, c := range *bom.Components {
+			addComponentRefs(refs, c)
+		}
+	}
+
+	doc := &Document{}
+	if bom.Vulnerabilities == nil {
+		return doc, nil
+	}
+	for _, v := range *bom.Vulnerabilities {
+		if v.Analysis == nil || v.Analysis.State == "" {
+			continue
+		}
+		s := &Statement{
+			Vulnerability:   v.ID,
+			Status:          cycloneDxStatus[v.Analysis.State],
+			Justification:   cycloneDxJustification[v.Analysis.Justification],
+			ImpactStatement: v.Analysis.Detail,
+			Timestamp:       parseTime(v.Analysis.LastUpdated),
+		}
+		if s.Timestamp.IsZero() {
+			s.Timestamp = parseTime(v.Updated)
+		}
+		if v.Analysis.Response != nil {
+			responses := []string{}
+			for _, r := range *v.Analysis.Response {
+				responses = append(responses, string(r))
+			}
+			s.ActionStatement = strings.Join(responses, ", ")
+		}
+		if v.References != nil {
+			for _, r := range *v.References {
+				s.Aliases = append(s.Aliases, r.ID)
+			}
+		}
+		if v.Affects != nil {
+			for _, a := range *v.Affects {
+				if ids, ok := refs[a.Ref]; ok {
+					s.Products = append(s.Products, ids...)
+				} else {
+					s.Products = append(s.Products, a.Ref)
+				}
+			}
+		}
+		doc.Statements = append(doc.Statements, s)
+	}
+	return doc, nil
+}
+
+func addComponentRefs(refs map[string][]string, c cyclonedx.Component) {
+	ids := []string{}
+	for _, id := range []string{c.PackageURL, c.CPE} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if c.BOMRef != "" && len(ids) > 0 {
+		refs[c.BOMRef] = ids
+	}
+	if c.Components != nil {
+		for _, sub := range *c.Components {
+			addComponentRefs(refs, sub)
+		}
+	}
+}
+
+// CycloneDXAnalysis converts a statement into a CycloneDX analysis
+func (s *Statement) CycloneDXAnalysis() *cyclonedx.VulnerabilityAnalysis {
+	res := &cyclonedx.VulnerabilityAnalysis{
+		Detail: s.ImpactStatement,
+	}
+	switch s.Status {
+	case StatusNotAffected:
+		res.State = cyclonedx.IASNotAffected
+	case StatusAffected:
+		res.State = cyclonedx.IASExploitable
+	case StatusFixed:
+		res.State = cyclonedx.IASResolved
+	case StatusUnderInvestigation:
+		res.State = cyclonedx.IASInTriage
+	}
+	switch s.Justification {
+	case JustificationComponentNotPresent, JustificationVulnerableCodeNotPresent:
+		res.Justification = cyclonedx.IAJCodeNotPresent
+	case JustificationVulnerableCodeNotInExecutePath:
+		res.Justification = cyclonedx.IAJCodeNotReachable
+	case JustificationCannotBeControlledByAdversary:
+		res.Justification = cyclonedx.IAJRequiresEnvironment
+	case JustificationInlineMitigationsAlreadyExist:
+		res.Justification = cyclonedx.IAJProtectedByMitigatingControl
+	}
+	if s.ActionStatement != "" {
+		if res.Detail != "" {
+			res.Detail += "\n"
+		}
+		res.Detail += s.ActionStatement
+	}
+	if !s.Timestamp.IsZero() {
+		res.LastUpdated = s.Timestamp.Format(time.RFC3339)
+	}
+	return res
+}
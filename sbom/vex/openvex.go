@@ -0,0 +1,108 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"encoding/json"
+)
+
+// openVexContext prefixes the @context of all OpenVEX versions
+const openVexContext = "https://openvex.dev/ns"
+
+// openVexDocument is an OpenVEX document, see https://github.com/openvex/spec.
+// Vulnerabilities and products are strings in v0.0.1 and objects in v0.2.0.
+type openVexDocument struct {
+	Context    string `json:"@context"`
+	Timestamp  string `json:"timestamp"`
+	Statements []struct {
+		Vulnerability   json.RawMessage   `json:"vulnerability"`
+		Products        []json.RawMessage `json:"products"`
+		Status          string            `json:"status"`
+		Justification   string            `json:"justification"`
+		ImpactStatement string            `json:"impact_statement"`
+		ActionStatement string            `json:"action_statement"`
+		Timestamp       string            `json:"timestamp"`
+	} `json:"statements"`
+}
+
+type openVexVulnerability struct {
+	ID      string   `json:"@id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type openVexProduct struct {
+	ID          string `json:"@id"`
+	Identifiers struct {
+		Purl  string `json:"purl"`
+		Cpe22 string `json:"cpe22"`
+		Cpe23 string `json:"cpe23"`
+	} `json:"identifiers"`
+	Subcomponents []openVexProduct `json:"subcomponents"`
+}
+
+// ids returns all identifiers of the product and its subcomponents
+func (p *openVexProduct) ids() []string {
+	res := []string{}
+	for _, id := range []string{p.ID, p.Identifiers.Purl, p.Identifiers.Cpe22, p.Identifiers.Cpe23} {
+		if id != "" {
+			res = append(res, id)
+		}
+	}
+	for i := range p.Subcomponents {
+		res = append(res, p.Subcomponents[i].ids()...)
+	}
+	return res
+}
+
+func parseOpenVex(data []byte) (*Document, error) {
+	var ov openVexDocument
+	if err := json.Unmarshal(data, &ov); err != nil {
+		return nil, err
+	}
+	docTime := parseTime(ov.Timestamp)
+
+	doc := &Document{}
+	for _, st := range ov.Statements {
+		s := &Statement{
+			Status:          Status(st.Status),
+			Justification:   st.Justification,
+			ImpactStatement: st.ImpactStatement,
+			ActionStatement: st.ActionStatement,
+			Timestamp:       parseTime(st.Timestamp),
+		}
+		if s.Timestamp.IsZero() {
+			s.Timestamp = docTime
+		}
+
+		var name string
+		var vuln openVexVulnerability
+		if err := json.Unmarshal(st.Vulnerability, &name); err == nil {
+			s.Vulnerability = name
+		} else if err := json.Unmarshal(st.Vulnerability, &vuln); err == nil {
+			s.Vulnerability = vuln.Name
+			if s.Vulnerability == "" {
+				s.Vulnerability = vuln.ID
+			}
+			s.Aliases = vuln.Aliases
+		} else {
+			return nil, err
+		}
+
+		for _, raw := range st.Products {
+			var id string
+			var product openVexProduct
+			if err := json.Unmarshal(raw, &id); err == nil {
+				s.Products = append(s.Products, id)
+			} else if err := json.Unmarshal(raw, &product); err == nil {
+				s.Products = append(s.Products, product.ids()...)
+			} else {
+				return nil, err
+			}
+		}
+
+		doc.Statements = append(doc.Statements, s)
+	}
+	return doc, nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package vex reads Vulnerability Exploitability eXchange (VEX) documents in
+// the OpenVEX and CycloneDX formats. VEX statements record whether a product
+// is affected by a vulnerability, so that findings that were triaged once do
+// not need to be triaged again in every scan.
+package vex
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/facebookincubator/nvdtools/wfn"
+	"github.com/package-url/packageurl-go"
+)
+
+// Status is the impact status of a vulnerability on a product, using the
+// OpenVEX vocabulary
+type Status string
+
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// Suppresses returns true if findings with this status can be dropped
+func (s Status) Suppresses() bool {
+	return s == StatusNotAffected || s == StatusFixed
+}
+
+// Justifications for the not_affected status
+const (
+	JustificationComponentNotPresent            = "component_not_present"
+	JustificationVulnerableCodeNotPresent       = "vulnerable_code_not_present"
+	JustificationVulnerableCodeNotInExecutePath = "vulnerable_code_not_in_execute_path"
+	JustificationCannotBeControlledByAdversary  = "vulnerable_code_cannot_be_controlled_by_adversary"
+	JustificationInlineMitigationsAlreadyExist  = "inline_mitigations_already_exist"
+)
+
+// Statement is a single VEX statement about a vulnerability
+type Statement struct {
+	Vulnerability string
+	Aliases       []string
+	// Products are package URLs, CPEs or other identifiers the statement
+	// applies to. Statements without products apply to every product.
+	Products        []string
+	Status          Status
+	Justification   string
+	ImpactStatement string
+	ActionStatement string
+	Timestamp       time.Time
+	// Source is the document the statement was read from
+	Source string
+}
+
+// Document is a collection of VEX statements
+type Document struct {
+	Statements []*Statement
+}
+
+// Option is the connection option that lists VEX documents, separated by
+// commas
+const Option = "vex"
+
+// SplitPaths returns the VEX documents of a comma-separated list, as it is
+// stored in connection options
+func SplitPaths(list string) []string {
+	res := []string{}
+	for _, path := range strings.Split(list, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			res = append(res, path)
+		}
+	}
+	return res
+}
+
+// Load reads and merges VEX documents
+func Load(paths ...string) (*Document, error) {
+	doc := &Document{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read VEX document")
+		}
+		d, err := Parse(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse VEX document "+path)
+		}
+		for _, s := range d.Statements {
+			s.Source = path
+		}
+		doc.Statements = append(doc.Statements, d.Statements...)
+	}
+	return doc, nil
+}
+
+// Parse detects the format of a VEX document and reads its statements
+func Parse(data []byte) (*Document, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("<")) {
+		return parseCycloneDX(data, true)
+	}
+
+	// OpenVEX documents are JSON-LD, CycloneDX documents name their format
+	var header struct {
+		Context   string `json:"@context"`
+		BomFormat string `json:"bomFormat"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, errors.Wrap(err, "unsupported VEX format, use OpenVEX or CycloneDX")
+	}
+	switch {
+	case strings.HasPrefix(header.Context, openVexContext):
+		return parseOpenVex(data)
+	case header.BomFormat == "CycloneDX":
+		return parseCycloneDX(data, false)
+	}
+	return nil, errors.New("unsupported VEX format, use OpenVEX or CycloneDX")
+}
+
+// Lookup returns the most recent statement about a vulnerability that
+// applies to any of the products. A statement about the vulnerability or
+// one of its aliases matches. Products can be package URLs or CPEs.
+func (d *Document) Lookup(vulnerability string, products ...string) *Statement {
+	if d == nil {
+		return nil
+	}
+	var res *Statement
+	for _, s := range d.Statements {
+		if !s.describes(vulnerability) || !s.appliesTo(products) {
+			continue
+		}
+		if res == nil || !s.Timestamp.Before(res.Timestamp) {
+			res = s
+		}
+	}
+	return res
+}
+
+// Vulnerabilities returns all vulnerability IDs that have statements
+func (d *Document) Vulnerabilities() []string {
+	ids := map[string]struct{}{}
+	for _, s := range d.Statements {
+		ids[s.Vulnerability] = struct{}{}
+	}
+	res := make([]string, 0, len(ids))
+	for id := range ids {
+		res = append(res, id)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (s *Statement) describes(vulnerability string) bool {
+	if strings.EqualFold(s.Vulnerability, vulnerability) {
+		return true
+	}
+	for _, alias := range s.Aliases {
+		if strings.EqualFold(alias, vulnerability) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Statement) appliesTo(products []string) bool {
+	if len(s.Products) == 0 {
+		return true
+	}
+	for _, p := range s.Products {
+		for _, candidate := range products {
+			if MatchProduct(p, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchProduct compares a product identifier from a VEX statement with the
+// identifier of an installed product. Package URLs match on type, namespace
+// and name and, if the statement names one, on the version; qualifiers are
+// ignored. CPEs match on vendor, product and, if given, version.
+func MatchProduct(statement, product string) bool {
+	if statement == product {
+		return true
+	}
+
+	if strings.HasPrefix(statement, "pkg:") && strings.HasPrefix(product, "pkg:") {
+		a, err := packageurl.FromString(statement)
+		if err != nil {
+			return false
+		}
+		b, err := packageurl.FromString(product)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(a.Type, b.Type) &&
+			strings.EqualFold(a.Namespace, b.Namespace) &&
+			strings.EqualFold(a.Name, b.Name) &&
+			(a.Version == "" || a.Version == b.Version)
+	}
+
+	if strings.HasPrefix(statement, "cpe:") && strings.HasPrefix(product, "cpe:") {
+		a, err := wfn.Parse(statement)
+		if err != nil {
+			return false
+		}
+		b, err := wfn.Parse(product)
+		if err != nil {
+			return false
+		}
+		return a.Vendor == b.Vendor && a.Product == b.Product &&
+			(a.Version == wfn.Any || a.Version == "" || a.Version == b.Version)
+	}
+
+	return false
+}
+
+func parseTime(s string) time.Time {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t
+	}
+	return time.Time{}
+}
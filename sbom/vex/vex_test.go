@@ -0,0 +1,127 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vex
+
+import (
+	"testing"
+	"time"
+
+	cyclonedx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenVex(t *testing.T) {
+	doc, err := Load("testdata/openvex.json")
+	require.NoError(t, err)
+	require.Len(t, doc.Statements, 3)
+	assert.Equal(t, []string{"CVE-2023-2650", "CVE-2023-44487"}, doc.Vulnerabilities())
+
+	s := doc.Statements[0]
+	assert.Equal(t, "CVE-2023-44487", s.Vulnerability)
+	assert.Equal(t, []string{"GHSA-qppj-fm5r-hxr3"}, s.Aliases)
+	assert.Equal(t, []string{"pkg:oci/payments-api", "pkg:golang/golang.org/x/net@v0.15.0"}, s.Products)
+	assert.Equal(t, StatusNotAffected, s.Status)
+	assert.Equal(t, JustificationVulnerableCodeNotInExecutePath, s.Justification)
+	assert.Equal(t, "The service does not expose an HTTP/2 server.", s.ImpactStatement)
+	assert.Equal(t, "testdata/openvex.json", s.Source)
+	// statements inherit the document timestamp
+	assert.Equal(t, time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC), s.Timestamp)
+
+	// subcomponents match independent of qualifiers, aliases match the vulnerability
+	assert.Equal(t, s, doc.Lookup("CVE-2023-44487", "pkg:golang/golang.org/x/net@v0.15.0?type=module"))
+	assert.Equal(t, s, doc.Lookup("GHSA-qppj-fm5r-hxr3", "pkg:golang/golang.org/x/net@v0.15.0"))
+	assert.Nil(t, doc.Lookup("CVE-2023-44487", "pkg:golang/golang.org/x/net@v0.17.0"))
+
+	// the most recent statement wins, statements without a version match all versions
+	latest := doc.Lookup("CVE-2023-2650", "pkg:deb/debian/openssl@3.0.9-1?arch=amd64")
+	require.NotNil(t, latest)
+	assert.Equal(t, StatusFixed, latest.Status)
+	assert.True(t, latest.Status.Suppresses())
+}
+
+func TestOpenVex_v001(t *testing.T) {
+	doc, err := Load("testdata/openvex-v0.0.1.json")
+	require.NoError(t, err)
+	require.Len(t, doc.Statements, 1)
+
+	s := doc.Lookup("CVE-2022-3786", "pkg:apk/wolfi/openssl@3.0.7-r0")
+	require.NotNil(t, s)
+	assert.Equal(t, StatusAffected, s.Status)
+	assert.False(t, s.Status.Suppresses())
+	assert.Equal(t, "Update to 3.0.7-r1", s.ActionStatement)
+	assert.Nil(t, doc.Lookup("CVE-2022-3786", "pkg:apk/wolfi/openssl@3.0.7-r1"))
+}
+
+func TestCycloneDX(t *testing.T) {
+	doc, err := Load("testdata/cyclonedx-vex.json")
+	require.NoError(t, err)
+	// vulnerabilities without analysis are not statements
+	require.Len(t, doc.Statements, 1)
+
+	s := doc.Lookup("GHSA-p6mc-m468-83gw", "pkg:npm/lodash@4.17.15")
+	require.NotNil(t, s)
+	assert.Equal(t, "CVE-2020-8203", s.Vulnerability)
+	assert.Equal(t, StatusNotAffected, s.Status)
+	assert.Equal(t, JustificationVulnerableCodeNotInExecutePath, s.Justification)
+	assert.Equal(t, "zipObjectDeep is never called.", s.ImpactStatement)
+	assert.Equal(t, "will_not_fix", s.ActionStatement)
+
+	analysis := s.CycloneDXAnalysis()
+	assert.Equal(t, cyclonedx.IASNotAffected, analysis.State)
+	assert.Equal(t, cyclonedx.IAJCodeNotReachable, analysis.Justification)
+	assert.Equal(t, "2023-10-01T00:00:00Z", analysis.LastUpdated)
+}
+
+func TestMatchProduct(t *testing.T) {
+	assert.True(t, MatchProduct("pkg:deb/debian/curl", "pkg:deb/debian/curl@7.88.1-10?arch=amd64"))
+	assert.False(t, MatchProduct("pkg:deb/debian/curl", "pkg:deb/ubuntu/curl@7.88.1-10"))
+	assert.True(t, MatchProduct("cpe:2.3:a:haxx:curl:*:*:*:*:*:*:*:*", "cpe:2.3:a:haxx:curl:7.88.1:*:*:*:*:*:*:*"))
+	assert.False(t, MatchProduct("cpe:2.3:a:haxx:curl:8.0.0:*:*:*:*:*:*:*", "cpe:2.3:a:haxx:curl:7.88.1:*:*:*:*:*:*:*"))
+	assert.True(t, MatchProduct("payments-api", "payments-api"))
+}
+
+func TestSplitPaths(t *testing.T) {
+	assert.Equal(t, []string{"a.json", "b.json"}, SplitPaths("a.json, b.json,"))
+	assert.Empty(t, SplitPaths(""))
+}
+
+func TestParse_Format(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "openvex",
+			data: `{"@context": "https://openvex.dev/ns/v0.2.0", "statements": []}`,
+		},
+		{
+			name: "cyclonedx",
+			data: `{"bomFormat": "CycloneDX", "specVersion": "1.5", "vulnerabilities": []}`,
+		},
+		{
+			// documents that only mention openvex are not OpenVEX documents
+			name:    "other json",
+			data:    `{"description": "see openvex.dev", "statements": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			data:    `not a document`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}